@@ -5,25 +5,41 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getAccountPath = "/:address"
-	getBalancePath = "/:address/balance"
-	getKeyPath     = "/:address/key/:key"
+	getAccountPath      = "/:address"
+	getBalancePath      = "/:address/balance"
+	getKeyPath          = "/:address/key/:key"
+	getTransactionsPath = "/:address/transactions"
+	getProofPath        = "/:address/proof"
+	getKeyProofPath     = "/:address/key/:key/proof"
+)
+
+const (
+	pageQueryParam       = "page"
+	pageSizeQueryParam   = "pageSize"
+	defaultPageSize      = 20
+	blockNonceQueryParam = "blockNonce"
+	rootHashQueryParam   = "rootHash"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error)
+	GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error)
+	GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetTransactionsByAddress(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error)
+	GetAccountProof(address string) (*state.ApiAccountProof, error)
+	GetAccountStorageProof(address string, key string) (*state.ApiStorageProof, error)
 	IsInterfaceNil() bool
 }
 
@@ -41,6 +57,9 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getAccountPath, GetAccount)
 	router.RegisterHandler(http.MethodGet, getBalancePath, GetBalance)
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
+	router.RegisterHandler(http.MethodGet, getTransactionsPath, GetTransactions)
+	router.RegisterHandler(http.MethodGet, getProofPath, GetProof)
+	router.RegisterHandler(http.MethodGet, getKeyProofPath, GetKeyProof)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -81,8 +100,21 @@ func GetAccount(c *gin.Context) {
 		return
 	}
 
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	addr := c.Param("address")
-	acc, err := facade.GetAccount(addr)
+	acc, err := facade.GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -125,7 +157,20 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := facade.GetBalance(addr)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, err := facade.GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -180,7 +225,20 @@ func GetValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetValueForKey(addr, key)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := facade.GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -203,6 +261,111 @@ func GetValueForKey(c *gin.Context) {
 	)
 }
 
+// GetTransactions returns a page of the transactions of the given address, from the newest to the oldest. The page
+// index and the page size can be provided through the page and pageSize query parameters
+func GetTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	page, errPage := getUint32QueryParam(c, pageQueryParam, 0)
+	pageSize, errPageSize := getUint32QueryParam(c, pageSizeQueryParam, defaultPageSize)
+	if errPage != nil || errPageSize != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrInvalidPaginationParams.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	transactions, err := facade.GetTransactionsByAddress(addr, page, pageSize)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": transactions, "page": page, "pageSize": pageSize},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getUint32QueryParam(c *gin.Context, name string, defaultValue uint32) (uint32, error) {
+	param, found := c.GetQuery(name)
+	if !found {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(value), nil
+}
+
+// getAccountQueryOptions reads the optional blockNonce and rootHash query parameters, which select the historical
+// state an account query is answered against. At most one of them can be provided
+func getAccountQueryOptions(c *gin.Context) (state.AccountQueryOptions, error) {
+	options := state.AccountQueryOptions{}
+
+	blockNonce, hasBlockNonce := c.GetQuery(blockNonceQueryParam)
+	if hasBlockNonce {
+		nonce, err := strconv.ParseUint(blockNonce, 10, 64)
+		if err != nil {
+			return options, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, blockNonceQueryParam)
+		}
+
+		options.BlockNonce = nonce
+		options.HasBlockNonce = true
+	}
+
+	rootHash, hasRootHash := c.GetQuery(rootHashQueryParam)
+	if hasRootHash {
+		rootHashBytes, err := hex.DecodeString(rootHash)
+		if err != nil || len(rootHashBytes) == 0 {
+			return options, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, rootHashQueryParam)
+		}
+
+		options.RootHash = rootHashBytes
+	}
+
+	if hasBlockNonce && hasRootHash {
+		return options, errors.ErrBlockNonceAndRootHashProvided
+	}
+
+	return options, nil
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -213,3 +376,79 @@ func accountResponseFromBaseAccount(address string, account state.UserAccountHan
 		RootHash: account.GetRootHash(),
 	}
 }
+
+// GetProof returns the Merkle proof of the given account against the state root hash of the last committed block
+func GetProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		respondWithProofError(c, http.StatusBadRequest, errors.ErrEmptyAddress, shared.ReturnCodeRequestError)
+		return
+	}
+
+	proof, err := facade.GetAccountProof(addr)
+	if err != nil {
+		respondWithProofError(c, http.StatusInternalServerError, err, shared.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetKeyProof returns the Merkle proof of the value held by the given account under the given key, chained to the
+// state root hash of the last committed block through the proof of the account
+func GetKeyProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		respondWithProofError(c, http.StatusBadRequest, errors.ErrEmptyAddress, shared.ReturnCodeRequestError)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		respondWithProofError(c, http.StatusBadRequest, errors.ErrEmptyKey, shared.ReturnCodeRequestError)
+		return
+	}
+
+	proof, err := facade.GetAccountStorageProof(addr, key)
+	if err != nil {
+		respondWithProofError(c, http.StatusInternalServerError, err, shared.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithProofError(c *gin.Context, status int, err error, code shared.ReturnCode) {
+	c.JSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+			Code:  code,
+		},
+	)
+}
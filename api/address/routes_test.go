@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,9 +20,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -39,6 +42,16 @@ type AccountResponse struct {
 	} `json:"account"`
 }
 
+type transactionsResponseData struct {
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+}
+
+type transactionsResponse struct {
+	Data  transactionsResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
 type valueForKeyResponseData struct {
 	Value string `json:"value"`
 }
@@ -92,7 +105,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -114,11 +127,79 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	assert.Equal(t, "", response.Error)
 }
 
+func TestGetBalance_WithBlockNonceShouldQueryHistoricalState(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string, options state.AccountQueryOptions) (i *big.Int, e error) {
+			assert.True(t, options.HasBlockNonce)
+			assert.Equal(t, uint64(37), options.BlockNonce)
+			assert.Nil(t, options.RootHash)
+			return big.NewInt(10), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/balance?blockNonce=37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "10", getValueForKey(response.Data, "balance"))
+}
+
+func TestGetBalance_WithRootHashShouldQueryHistoricalState(t *testing.T) {
+	t.Parallel()
+	rootHash := []byte("root hash")
+	facade := mock.Facade{
+		BalanceHandler: func(s string, options state.AccountQueryOptions) (i *big.Int, e error) {
+			assert.False(t, options.HasBlockNonce)
+			assert.Equal(t, rootHash, options.RootHash)
+			return big.NewInt(10), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/testAddress/balance?rootHash=%s", hex.EncodeToString(rootHash)), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestGetBalance_WithInvalidStateQueryParamsShouldError(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	for _, queryParams := range []string{"blockNonce=abc", "rootHash=zz", "blockNonce=1&rootHash=aa"} {
+		req, _ := http.NewRequest("GET", "/address/testAddress/balance?"+queryParams, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBalance.Error()))
+	}
+}
+
 func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -140,7 +221,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -160,7 +241,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShoudReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -215,7 +296,7 @@ func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ state.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -238,7 +319,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ state.AccountQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -287,7 +368,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	t.Parallel()
 	returnedError := "i am an error"
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.AccountQueryOptions) (state.UserAccountHandler, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -308,7 +389,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.AccountQueryOptions) (state.UserAccountHandler, error) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
@@ -374,6 +455,209 @@ func startNodeServerWrongFacade() *gin.Engine {
 	return ws
 }
 
+func TestGetTransactions_ShouldUseDefaultPagination(t *testing.T) {
+	t.Parallel()
+
+	addr := "testAddress"
+	var calledPage, calledPageSize uint32
+	facade := mock.Facade{
+		GetTransactionsByAddressHandler: func(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error) {
+			assert.Equal(t, addr, address)
+			calledPage = page
+			calledPageSize = pageSize
+			return []*transaction.ApiTransactionResult{{Type: "normal", Hash: "aa"}}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions", addr), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(0), calledPage)
+	assert.Equal(t, uint32(20), calledPageSize)
+	require.Equal(t, 1, len(response.Data.Transactions))
+	assert.Equal(t, "aa", response.Data.Transactions[0].Hash)
+}
+
+func TestGetTransactions_ShouldPassPaginationParams(t *testing.T) {
+	t.Parallel()
+
+	var calledPage, calledPageSize uint32
+	facade := mock.Facade{
+		GetTransactionsByAddressHandler: func(_ string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error) {
+			calledPage = page
+			calledPageSize = pageSize
+			return make([]*transaction.ApiTransactionResult, 0), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/address/testAddress/transactions?page=3&pageSize=50", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(3), calledPage)
+	assert.Equal(t, uint32(50), calledPageSize)
+}
+
+func TestGetTransactions_InvalidPaginationParamsShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/address/testAddress/transactions?page=-1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidPaginationParams.Error()))
+}
+
+func TestGetTransactions_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsByAddressHandler: func(_ string, _ uint32, _ uint32) ([]*transaction.ApiTransactionResult, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/address/testAddress/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsByAddress.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+type accountProofResponse struct {
+	Data struct {
+		Proof *state.ApiAccountProof `json:"proof"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type storageProofResponse struct {
+	Data struct {
+		Proof *state.ApiStorageProof `json:"proof"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProof := &state.ApiAccountProof{
+		Address:  "testAddress",
+		RootHash: "aabb",
+		Proof:    []string{"root", "leaf"},
+		Value:    "ccdd",
+	}
+	facade := mock.Facade{
+		GetAccountProofHandler: func(address string) (*state.ApiAccountProof, error) {
+			assert.Equal(t, "testAddress", address)
+			return expectedProof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/address/testAddress/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProof, response.Data.Proof)
+}
+
+func TestGetProof_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAccountProofHandler: func(_ string) (*state.ApiAccountProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/address/testAddress/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetKeyProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProof := &state.ApiStorageProof{
+		Address:          "testAddress",
+		Key:              "6b6579",
+		AccountProof:     []string{"root", "leaf"},
+		DataTrieRootHash: "eeff",
+		Proof:            []string{"data root", "data leaf"},
+	}
+	facade := mock.Facade{
+		GetAccountStorageProofHandler: func(address string, key string) (*state.ApiStorageProof, error) {
+			assert.Equal(t, "testAddress", address)
+			assert.Equal(t, "6b6579", key)
+			return expectedProof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/address/testAddress/key/6b6579/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := storageProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProof, response.Data.Proof)
+}
+
+func TestGetKeyProof_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAccountStorageProofHandler: func(_ string, _ string) (*state.ApiStorageProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/address/testAddress/key/6b6579/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -382,6 +666,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/key/:key", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
 				},
 			},
 		},
@@ -2,22 +2,31 @@ package api
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"net/http"
 	"reflect"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/api/txLogs"
+	"github.com/ElrondNetwork/elrond-go/api/txPool"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	coreEvents "github.com/ElrondNetwork/elrond-go/core/events"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
@@ -135,6 +144,24 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		valStats.Routes(wrappedValidatorsRouter)
 	}
 
+	blockRoutes := ws.Group("/block")
+	wrappedBlockRouter, err := wrapper.NewRouterWrapper("block", blockRoutes, routesConfig)
+	if err == nil {
+		block.Routes(wrappedBlockRouter)
+	}
+
+	txPoolRoutes := ws.Group("/transaction-pool")
+	wrappedTxPoolRouter, err := wrapper.NewRouterWrapper("transaction-pool", txPoolRoutes, routesConfig)
+	if err == nil {
+		txPool.Routes(wrappedTxPoolRouter)
+	}
+
+	logsRoutes := ws.Group("/logs")
+	wrappedLogsRouter, err := wrapper.NewRouterWrapper("logs", logsRoutes, routesConfig)
+	if err == nil {
+		txLogs.Routes(wrappedLogsRouter)
+	}
+
 	hardforkRoutes := ws.Group("/hardfork")
 	wrappedHardforkRouter, err := wrapper.NewRouterWrapper("hardfork", hardforkRoutes, routesConfig)
 	if err == nil {
@@ -146,20 +173,26 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		pprof.Register(ws)
 	}
 
-	if isLogRouteEnabled(routesConfig) {
+	if isWsRouteEnabled(routesConfig, "log", "/log") {
 		marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 		registerLoggerWsRoute(ws, marshalizerForLogs)
 	}
+
+	eventsHandler, ok := elrondFacade.(events.FacadeHandler)
+	if ok && isWsRouteEnabled(routesConfig, "events", "/events") {
+		marshalizerForEvents := &marshal.JsonMarshalizer{}
+		registerEventsWsRoute(ws, eventsHandler, marshalizerForEvents)
+	}
 }
 
-func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+func isWsRouteEnabled(routesConfig config.ApiRoutesConfig, packageName string, routeName string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == routeName && cfg.Open {
 			return true
 		}
 	}
@@ -206,6 +239,57 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 	})
 }
 
+func registerEventsWsRoute(ws *gin.Engine, facade events.FacadeHandler, marshalizer marshal.Marshalizer) {
+	upgrader := websocket.Upgrader{}
+
+	ws.GET("/events", func(c *gin.Context) {
+		upgrader.CheckOrigin = func(r *http.Request) bool {
+			return true
+		}
+
+		subscriber, err := facade.NewEventsSubscriber()
+		if errors.Is(err, coreEvents.ErrTooManySubscribers) {
+			c.JSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", apiErrors.ErrEventsSubscription.Error(), err.Error()),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+		if err != nil {
+			c.JSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", apiErrors.ErrEventsSubscription.Error(), err.Error()),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			subscriber.Close()
+			log.Error(err.Error())
+			return
+		}
+
+		es, err := events.NewEventsSender(marshalizer, conn, subscriber, log)
+		if err != nil {
+			subscriber.Close()
+			_ = conn.Close()
+			log.Error(err.Error())
+			return
+		}
+
+		es.StartSendingBlocking()
+	})
+}
+
 // skValidator validates a secret key from user input for correctness
 func skValidator(
 	_ *validator.Validate,
@@ -0,0 +1,153 @@
+package block
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getBlockByNoncePath = "/by-nonce/:nonce"
+	getBlockByHashPath  = "/by-hash/:hash"
+	withTxsQueryParam   = "withTxs"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines block related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getBlockByNoncePath, GetBlockByNonce)
+	router.RegisterHandler(http.MethodGet, getBlockByHashPath, GetBlockByHash)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetBlockByNonce returns the block with the given nonce. The transactions are included if the withTxs query
+// parameter is set to true
+func GetBlockByNonce(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		respondWithRequestError(c, errors.ErrInvalidBlockNonce)
+		return
+	}
+
+	withTxs, err := getWithTxsQueryParam(c)
+	if err != nil {
+		respondWithRequestError(c, errors.ErrInvalidQueryParameter)
+		return
+	}
+
+	apiBlock, err := facade.GetBlockByNonce(nonce, withTxs)
+	respondWithBlock(c, apiBlock, err)
+}
+
+// GetBlockByHash returns the block with the given hash. The transactions are included if the withTxs query
+// parameter is set to true
+func GetBlockByHash(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		respondWithRequestError(c, errors.ErrValidationEmptyBlockHash)
+		return
+	}
+
+	withTxs, err := getWithTxsQueryParam(c)
+	if err != nil {
+		respondWithRequestError(c, errors.ErrInvalidQueryParameter)
+		return
+	}
+
+	apiBlock, err := facade.GetBlockByHash(hash, withTxs)
+	respondWithBlock(c, apiBlock, err)
+}
+
+func getWithTxsQueryParam(c *gin.Context) (bool, error) {
+	withTxs, found := c.GetQuery(withTxsQueryParam)
+	if !found {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTxs)
+}
+
+func respondWithRequestError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusBadRequest,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			Code:  shared.ReturnCodeRequestError,
+		},
+	)
+}
+
+func respondWithBlock(c *gin.Context, apiBlock *block.ApiBlock, err error) {
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"block": apiBlock},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -0,0 +1,237 @@
+package block_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type blockResponseData struct {
+	Block dataBlock.ApiBlock `json:"block"`
+}
+
+type blockResponse struct {
+	Data  blockResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+func TestGetBlockByNonce_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetBlockByNonce_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetBlockByNonce_InvalidNonceShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetBlockByNonce_InvalidWithTxsShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/1?withTxs=maybe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetBlockByNonce_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetBlockByNonceHandler: func(_ uint64, _ bool) (*dataBlock.ApiBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBlock.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := &dataBlock.ApiBlock{
+		Nonce: 37,
+		Hash:  "aabb",
+		MiniBlocks: []*dataBlock.ApiMiniBlock{
+			{Hash: "ccdd", Type: "TxBlock", TxCount: 1},
+		},
+	}
+	facade := &mock.Facade{
+		GetBlockByNonceHandler: func(nonce uint64, withTxs bool) (*dataBlock.ApiBlock, error) {
+			assert.Equal(t, uint64(37), nonce)
+			assert.True(t, withTxs)
+			return expectedBlock, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-nonce/37?withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedBlock, response.Data.Block)
+}
+
+func TestGetBlockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := &dataBlock.ApiBlock{
+		Nonce: 37,
+		Hash:  "aabb",
+	}
+	facade := &mock.Facade{
+		GetBlockByHashHandler: func(hash string, withTxs bool) (*dataBlock.ApiBlock, error) {
+			assert.Equal(t, "aabb", hash)
+			assert.False(t, withTxs)
+			return expectedBlock, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedBlock, response.Data.Block)
+}
+
+func TestGetBlockByHash_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetBlockByHashHandler: func(_ string, _ bool) (*dataBlock.ApiBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/block/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler block.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	blockRoutes := ws.Group("/block")
+	if handler != nil {
+		blockRoutes.Use(middleware.WithFacade(handler))
+	}
+	blockRouteWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	blockRoutes := ws.Group("/block")
+	blockRouteWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
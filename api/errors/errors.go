@@ -22,6 +22,9 @@ var ErrGetBalance = errors.New("get balance error")
 // ErrGetValueForKey signals an error in getting the value of a key for an account
 var ErrGetValueForKey = errors.New("get value for key error")
 
+// ErrGetProof signals an error in getting the Merkle proof of an account or of a storage value
+var ErrGetProof = errors.New("get proof error")
+
 // ErrEmptyAddress signals an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -40,6 +43,9 @@ var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 // ErrGetTransaction signals an error happened trying to fetch a transaction
 var ErrGetTransaction = errors.New("transaction getting failed")
 
+// ErrSimulateTransaction signals an error happened while simulating the execution of a transaction
+var ErrSimulateTransaction = errors.New("transaction simulation failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -48,3 +54,36 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrGetTransactionsByAddress signals an error in getting the transactions of an address
+var ErrGetTransactionsByAddress = errors.New("get transactions by address error")
+
+// ErrInvalidPaginationParams signals that invalid pagination parameters were provided
+var ErrInvalidPaginationParams = errors.New("invalid pagination parameters")
+
+// ErrGetTransactionsPool signals an error in getting the transactions pool information
+var ErrGetTransactionsPool = errors.New("get transactions pool error")
+
+// ErrEventsSubscription signals an error in subscribing to the chain events
+var ErrEventsSubscription = errors.New("events subscription error")
+
+// ErrGetBlock signals an error in getting a block
+var ErrGetBlock = errors.New("get block error")
+
+// ErrInvalidBlockNonce signals that an invalid block nonce was provided
+var ErrInvalidBlockNonce = errors.New("invalid block nonce")
+
+// ErrValidationEmptyBlockHash signals that an empty block hash was provided
+var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
+
+// ErrFilterLogs signals an error in filtering the smart contract log events
+var ErrFilterLogs = errors.New("filter logs error")
+
+// ErrInvalidBlockRange signals that the end of the requested block range is lower than its start
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
+// ErrInvalidQueryParameter signals that an invalid query parameter was provided
+var ErrInvalidQueryParameter = errors.New("invalid query parameter")
+
+// ErrBlockNonceAndRootHashProvided signals that both a block nonce and a root hash were provided for a state query
+var ErrBlockNonceAndRootHashProvided = errors.New("only one of block nonce and root hash can be provided")
@@ -0,0 +1,18 @@
+package events
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrNilSubscriber signals that a nil events subscriber has been provided
+var ErrNilSubscriber = errors.New("nil events subscriber")
+
+// ErrUnknownAction signals that the client requested an action which is neither subscribe nor unsubscribe
+var ErrUnknownAction = errors.New("unknown action")
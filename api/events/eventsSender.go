@@ -0,0 +1,174 @@
+package events
+
+import (
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	coreEvents "github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	actionSubscribe   = "subscribe"
+	actionUnsubscribe = "unsubscribe"
+)
+
+// subscriptionRequest is the message a client sends in order to change its subscriptions, for example:
+// {"action": "subscribe", "topic": "transactions", "address": "erd1..."}. The logs topic also accepts a filter:
+// {"action": "subscribe", "topic": "logs", "filter": {"addresses": ["erd1..."], "identifiers": [], "topics": [[]]}}
+type subscriptionRequest struct {
+	Action  string                   `json:"action"`
+	Topic   coreEvents.Topic         `json:"topic"`
+	Address string                   `json:"address,omitempty"`
+	Filter  *logsIndex.FilterRequest `json:"filter,omitempty"`
+}
+
+// subscriptionResponse is the message sent back to the client for each of its subscription requests
+type subscriptionResponse struct {
+	Action  string           `json:"action"`
+	Topic   coreEvents.Topic `json:"topic"`
+	Address string           `json:"address,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+type eventsSender struct {
+	marshalizer marshal.Marshalizer
+	conn        wsConn
+	subscriber  coreEvents.Subscriber
+	log         logger.Logger
+	mutWrite    sync.Mutex
+}
+
+// NewEventsSender returns a new component that serves an events websocket client: it applies the subscription
+// requests received from the client and sends it the events of the subscribed topics
+func NewEventsSender(
+	marshalizer marshal.Marshalizer,
+	conn wsConn,
+	subscriber coreEvents.Subscriber,
+	log logger.Logger,
+) (*eventsSender, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if conn == nil {
+		return nil, ErrNilWsConn
+	}
+	if check.IfNil(subscriber) {
+		return nil, ErrNilSubscriber
+	}
+	if check.IfNil(log) {
+		return nil, ErrNilLogger
+	}
+
+	return &eventsSender{
+		marshalizer: marshalizer,
+		conn:        conn,
+		subscriber:  subscriber,
+		log:         log,
+	}, nil
+}
+
+// StartSendingBlocking sends the events to the client until the connection ends. In the same time, it handles
+// the subscription requests coming from the client
+func (es *eventsSender) StartSendingBlocking() {
+	defer func() {
+		_ = es.conn.Close()
+		es.subscriber.Close()
+	}()
+
+	go es.handleRequests()
+	es.doSendContinuously()
+}
+
+func (es *eventsSender) handleRequests() {
+	defer es.subscriber.Close()
+
+	for {
+		mt, message, err := es.conn.ReadMessage()
+		if err != nil || mt == websocket.CloseMessage {
+			return
+		}
+		if mt != websocket.TextMessage {
+			continue
+		}
+
+		response := es.handleRequest(message)
+		if es.write(response) {
+			return
+		}
+	}
+}
+
+func (es *eventsSender) handleRequest(message []byte) *subscriptionResponse {
+	request := &subscriptionRequest{}
+	err := es.marshalizer.Unmarshal(request, message)
+	if err != nil {
+		return &subscriptionResponse{Error: err.Error()}
+	}
+
+	switch request.Action {
+	case actionSubscribe:
+		if request.Topic == coreEvents.TopicLogs && request.Filter != nil {
+			err = es.subscriber.SubscribeLogs(request.Filter)
+			break
+		}
+		err = es.subscriber.Subscribe(request.Topic, request.Address)
+	case actionUnsubscribe:
+		err = es.subscriber.Unsubscribe(request.Topic, request.Address)
+	default:
+		err = ErrUnknownAction
+	}
+
+	response := &subscriptionResponse{
+		Action:  request.Action,
+		Topic:   request.Topic,
+		Address: request.Address,
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	return response
+}
+
+func (es *eventsSender) doSendContinuously() {
+	for event := range es.subscriber.Events() {
+		shouldStop := es.write(event)
+		if shouldStop {
+			return
+		}
+	}
+}
+
+func (es *eventsSender) write(obj interface{}) (shouldStop bool) {
+	data, err := es.marshalizer.Marshal(obj)
+	if err != nil {
+		es.log.Error("events web socket marshal", "error", err.Error())
+		return false
+	}
+
+	es.mutWrite.Lock()
+	err = es.conn.WriteMessage(websocket.TextMessage, data)
+	es.mutWrite.Unlock()
+	if err != nil {
+		isConnectionClosed := strings.Contains(err.Error(), "websocket: close sent")
+		if !isConnectionClosed {
+			es.log.Error("events web socket error", "error", err.Error())
+		} else {
+			es.log.Debug("events web socket", "connection", "closed")
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *eventsSender) IsInterfaceNil() bool {
+	return es == nil
+}
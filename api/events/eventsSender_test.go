@@ -0,0 +1,176 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	coreEvents "github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEventsHub() coreEvents.EventsHub {
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+	hub, _ := coreEvents.NewEventsHub(coreEvents.ArgsEventsHub{
+		PubkeyConverter:               converter,
+		LogEventsReader:               &mock.LogEventsReaderStub{},
+		SubscriberBufferSize:          10,
+		MaxSubscribers:                10,
+		MaxSubscriptionsPerSubscriber: 10,
+		MaxAddressesPerSubscription:   10,
+	})
+
+	return hub
+}
+
+func TestNewEventsSender_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	subscriber, _ := createEventsHub().NewSubscriber()
+	es, err := events.NewEventsSender(nil, &mock.WsConnStub{}, subscriber, &mock.LoggerStub{})
+
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilMarshalizer, err)
+}
+
+func TestNewEventsSender_NilConnectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	subscriber, _ := createEventsHub().NewSubscriber()
+	es, err := events.NewEventsSender(&marshal.JsonMarshalizer{}, nil, subscriber, &mock.LoggerStub{})
+
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilWsConn, err)
+}
+
+func TestNewEventsSender_NilSubscriberShouldErr(t *testing.T) {
+	t.Parallel()
+
+	es, err := events.NewEventsSender(&marshal.JsonMarshalizer{}, &mock.WsConnStub{}, nil, &mock.LoggerStub{})
+
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilSubscriber, err)
+}
+
+func TestNewEventsSender_NilLoggerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	subscriber, _ := createEventsHub().NewSubscriber()
+	es, err := events.NewEventsSender(&marshal.JsonMarshalizer{}, &mock.WsConnStub{}, subscriber, nil)
+
+	assert.Nil(t, es)
+	assert.Equal(t, events.ErrNilLogger, err)
+}
+
+func TestNewEventsSender_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	subscriber, _ := createEventsHub().NewSubscriber()
+	es, err := events.NewEventsSender(&marshal.JsonMarshalizer{}, &mock.WsConnStub{}, subscriber, &mock.LoggerStub{})
+
+	assert.False(t, es.IsInterfaceNil())
+	assert.Nil(t, err)
+}
+
+func TestEventsSender_StartSendingBlockingShouldHandleRequestsAndSendEvents(t *testing.T) {
+	t.Parallel()
+
+	hub := createEventsHub()
+	subscriber, _ := hub.NewSubscriber()
+
+	requests := []string{
+		`{"action": "subscribe", "topic": "blocks"}`,
+		`{"action": "subscribe", "topic": "unknown"}`,
+		`{"action": "delete", "topic": "blocks"}`,
+		`{"action": "subscribe", "topic": "logs", "filter": {"identifiers": ["7472616e73666572"]}}`,
+		`{"action": "subscribe", "topic": "logs", "filter": {"identifiers": ["not hex"]}}`,
+	}
+	numReads := 0
+	conn := &mock.WsConnStub{}
+	conn.SetReadMessageHandler(func() (messageType int, p []byte, err error) {
+		numReads++
+		if numReads <= len(requests) {
+			return websocket.TextMessage, []byte(requests[numReads-1]), nil
+		}
+
+		hub.NotifyBlockCommitted([]byte{0xaa}, &block.Header{Nonce: 4}, map[string]data.TransactionHandler{})
+		return websocket.CloseMessage, nil, errors.New("connection closed")
+	})
+	mutWritten := sync.Mutex{}
+	written := make([]map[string]interface{}, 0)
+	conn.SetWriteMessageHandler(func(messageType int, buff []byte) error {
+		msg := make(map[string]interface{})
+		_ = json.Unmarshal(buff, &msg)
+
+		mutWritten.Lock()
+		written = append(written, msg)
+		mutWritten.Unlock()
+
+		return nil
+	})
+	closeCalled := false
+	conn.SetCloseHandler(func() error {
+		closeCalled = true
+		return nil
+	})
+
+	es, _ := events.NewEventsSender(&marshal.JsonMarshalizer{}, conn, subscriber, &mock.LoggerStub{})
+	es.StartSendingBlocking()
+
+	assert.True(t, closeCalled)
+	assert.False(t, hub.HasSubscribers())
+
+	mutWritten.Lock()
+	defer mutWritten.Unlock()
+
+	require.Equal(t, 6, len(written))
+	assert.Equal(t, "subscribe", written[0]["action"])
+	assert.Nil(t, written[0]["error"])
+	assert.Equal(t, coreEvents.ErrUnknownTopic.Error(), written[1]["error"])
+	assert.Equal(t, events.ErrUnknownAction.Error(), written[2]["error"])
+	assert.Nil(t, written[3]["error"])
+	assert.NotNil(t, written[4]["error"])
+	assert.Equal(t, string(coreEvents.TopicBlocks), written[5]["topic"])
+	blockEvent := written[5]["block"].(map[string]interface{})
+	assert.Equal(t, "aa", blockEvent["hash"])
+	assert.Equal(t, float64(4), blockEvent["nonce"])
+}
+
+func TestEventsSender_StartSendingBlockingShouldStopOnWriteError(t *testing.T) {
+	t.Parallel()
+
+	hub := createEventsHub()
+	subscriber, _ := hub.NewSubscriber()
+	_ = subscriber.Subscribe(coreEvents.TopicEpochStart, "")
+	hub.EpochStartEventHandler().EpochStartAction(&block.MetaBlock{Epoch: 2})
+
+	isClosed := false
+	conn := &mock.WsConnStub{}
+	conn.SetReadMessageHandler(func() (messageType int, p []byte, err error) {
+		if isClosed {
+			return websocket.CloseMessage, nil, errors.New("connection closed")
+		}
+		return websocket.BinaryMessage, nil, nil
+	})
+	conn.SetWriteMessageHandler(func(messageType int, buff []byte) error {
+		return errors.New("websocket: close sent")
+	})
+	conn.SetCloseHandler(func() error {
+		isClosed = true
+		return nil
+	})
+
+	es, _ := events.NewEventsSender(&marshal.JsonMarshalizer{}, conn, subscriber, &mock.LoggerStub{})
+	es.StartSendingBlocking()
+
+	assert.False(t, hub.HasSubscribers())
+}
@@ -0,0 +1,19 @@
+package events
+
+import (
+	"io"
+
+	coreEvents "github.com/ElrondNetwork/elrond-go/core/events"
+)
+
+type wsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+}
+
+// FacadeHandler defines the methods the facade should implement in order to serve the events websocket route
+type FacadeHandler interface {
+	NewEventsSubscriber() (coreEvents.Subscriber, error)
+	IsInterfaceNil() bool
+}
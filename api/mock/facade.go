@@ -5,11 +5,15 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -17,27 +21,39 @@ import (
 
 // Facade is the mock implementation of a node router handler
 type Facade struct {
-	ShouldErrorStart           bool
-	ShouldErrorStop            bool
-	TpsBenchmarkHandler        func() *statistics.TpsBenchmark
-	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (state.UserAccountHandler, error)
-	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
-	GetTransactionHandler      func(hash string) (*transaction.ApiTransactionResult, error)
-	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
+	ShouldErrorStart                     bool
+	ShouldErrorStop                      bool
+	TpsBenchmarkHandler                  func() *statistics.TpsBenchmark
+	GetHeartbeatsHandler                 func() ([]data.PubKeyHeartbeat, error)
+	BalanceHandler                       func(string, state.AccountQueryOptions) (*big.Int, error)
+	GetAccountHandler                    func(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
+	GenerateTransactionHandler           func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
+	GetTransactionHandler                func(hash string) (*transaction.ApiTransactionResult, error)
+	GetTransactionsByAddressHandler      func(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error)
+	GetTransactionsPoolForSenderHandler  func(sender string) (*transaction.ApiSenderTransactionsPool, error)
+	GetTransactionsPoolStatisticsHandler func() ([]*transaction.ApiTransactionsPoolCache, error)
+	NewEventsSubscriberHandler           func() (events.Subscriber, error)
+	GetAccountProofHandler               func(address string) (*state.ApiAccountProof, error)
+	GetAccountStorageProofHandler        func(address string, key string) (*state.ApiStorageProof, error)
+	GetBlockByNonceHandler               func(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByHashHandler                func(hash string, withTxs bool) (*block.ApiBlock, error)
+	FilterLogsHandler                    func(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error)
+	CreateTransactionHandler             func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler        func(tx *transaction.Transaction) error
-	SendBulkTransactionsHandler       func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	StatusMetricsHandler              func() external.StatusMetricsHandler
-	ValidatorStatisticsHandler        func() (map[string]*state.ValidatorApiResponse, error)
-	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
-	NodeConfigCalled                  func() map[string]interface{}
-	GetQueryHandlerCalled             func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled              func(address string, key string) (string, error)
-	GetPeerInfoCalled                 func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetThrottlerForEndpointCalled     func(endpoint string) (core.Throttler, bool)
+	ValidateTransactionHandler          func(tx *transaction.Transaction) error
+	SendBulkTransactionsHandler         func(txs []*transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler               func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	StatusMetricsHandler                func() external.StatusMetricsHandler
+	ValidatorStatisticsHandler          func() (map[string]*state.ValidatorApiResponse, error)
+	ComputeTransactionGasLimitHandler   func(tx *transaction.Transaction) (uint64, error)
+	SimulateTransactionExecutionHandler func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	NodeConfigCalled                    func() map[string]interface{}
+	GetQueryHandlerCalled               func(name string) (debug.QueryHandler, error)
+	GetValueForKeyCalled                func(address string, key string, options state.AccountQueryOptions) (string, error)
+	GetStateRootHashCalled              func(options state.AccountQueryOptions) ([]byte, error)
+	GetPeerInfoCalled                   func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetManagedKeysMetricsCalled         func() []keysManagement.ManagedKeyMetrics
+	GetThrottlerForEndpointCalled       func(endpoint string) (core.Throttler, bool)
 }
 
 // GetThrottlerForEndpoint -
@@ -78,22 +94,22 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *Facade) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *Facade) GetValueForKey(address string, key string) (string, error) {
+func (f *Facade) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
 // GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return f.GetAccountHandler(address)
+func (f *Facade) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -117,12 +133,57 @@ func (f *Facade) GetTransaction(hash string) (*transaction.ApiTransactionResult,
 	return f.GetTransactionHandler(hash)
 }
 
+// GetTransactionsByAddress is the mock implementation of a handler's GetTransactionsByAddress method
+func (f *Facade) GetTransactionsByAddress(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error) {
+	return f.GetTransactionsByAddressHandler(address, page, pageSize)
+}
+
+// GetTransactionsPoolForSender is the mock implementation of a handler's GetTransactionsPoolForSender method
+func (f *Facade) GetTransactionsPoolForSender(sender string) (*transaction.ApiSenderTransactionsPool, error) {
+	return f.GetTransactionsPoolForSenderHandler(sender)
+}
+
+// GetTransactionsPoolStatistics is the mock implementation of a handler's GetTransactionsPoolStatistics method
+func (f *Facade) GetTransactionsPoolStatistics() ([]*transaction.ApiTransactionsPoolCache, error) {
+	return f.GetTransactionsPoolStatisticsHandler()
+}
+
+// GetAccountProof is the mock implementation of a handler's GetAccountProof method
+func (f *Facade) GetAccountProof(address string) (*state.ApiAccountProof, error) {
+	return f.GetAccountProofHandler(address)
+}
+
+// GetAccountStorageProof is the mock implementation of a handler's GetAccountStorageProof method
+func (f *Facade) GetAccountStorageProof(address string, key string) (*state.ApiStorageProof, error) {
+	return f.GetAccountStorageProofHandler(address, key)
+}
+
+// NewEventsSubscriber is the mock implementation of a handler's NewEventsSubscriber method
+func (f *Facade) NewEventsSubscriber() (events.Subscriber, error) {
+	return f.NewEventsSubscriberHandler()
+}
+
+// GetBlockByNonce is the mock implementation of a handler's GetBlockByNonce method
+func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	return f.GetBlockByNonceHandler(nonce, withTxs)
+}
+
+// GetBlockByHash is the mock implementation of a handler's GetBlockByHash method
+func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	return f.GetBlockByHashHandler(hash, withTxs)
+}
+
+// FilterLogs is the mock implementation of a handler's FilterLogs method
+func (f *Facade) FilterLogs(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error) {
+	return f.FilterLogsHandler(fromNonce, toNonce, request)
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *Facade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
 }
 
-//ValidateTransaction --
+// ValidateTransaction --
 func (f *Facade) ValidateTransaction(tx *transaction.Transaction) error {
 	return f.ValidateTransactionHandler(tx)
 }
@@ -137,6 +198,15 @@ func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, err
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// GetStateRootHash is the mock implementation of a handler's GetStateRootHash method
+func (f *Facade) GetStateRootHash(options state.AccountQueryOptions) ([]byte, error) {
+	if f.GetStateRootHashCalled != nil {
+		return f.GetStateRootHashCalled(options)
+	}
+
+	return options.RootHash, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *Facade) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
@@ -147,6 +217,11 @@ func (f *Facade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64
 	return f.ComputeTransactionGasLimitHandler(tx)
 }
 
+// SimulateTransactionExecution -
+func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return f.SimulateTransactionExecutionHandler(tx)
+}
+
 // NodeConfig -
 func (f *Facade) NodeConfig() map[string]interface{} {
 	return f.NodeConfigCalled()
@@ -172,6 +247,15 @@ func (f *Facade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetManagedKeysMetrics -
+func (f *Facade) GetManagedKeysMetrics() []keysManagement.ManagedKeyMetrics {
+	if f.GetManagedKeysMetricsCalled != nil {
+		return f.GetManagedKeysMetricsCalled()
+	}
+
+	return make([]keysManagement.ManagedKeyMetrics, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	return f == nil
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
@@ -24,6 +25,7 @@ const (
 	p2pStatusPath       = "/p2pstatus"
 	debugPath           = "/debug"
 	peerInfoPath        = "/peerinfo"
+	managedKeysPath     = "/managed-keys"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -33,6 +35,7 @@ type FacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetManagedKeysMetrics() []keysManagement.ManagedKeyMetrics
 	IsInterfaceNil() bool
 }
 
@@ -74,6 +77,7 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, p2pStatusPath, P2pStatusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, managedKeysPath, ManagedKeys)
 	// placeholder for custom routes
 }
 
@@ -300,3 +304,20 @@ func PeerInfo(c *gin.Context) {
 		},
 	)
 }
+
+// ManagedKeys returns the BLS keys hosted by the node along with their consensus and heartbeat metrics
+func ManagedKeys(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"keys": facade.GetManagedKeysMetrics()},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
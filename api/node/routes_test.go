@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-contrib/cors"
@@ -60,6 +61,14 @@ type StatisticsResponse struct {
 	} `json:"statistics"`
 }
 
+type managedKeysResponse struct {
+	Data struct {
+		Keys []keysManagement.ManagedKeyMetrics `json:"keys"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -447,6 +456,31 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestManagedKeys_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	metrics := []keysManagement.ManagedKeyMetrics{
+		{PublicKey: "aa", IsMainKey: true, NumRoundsAsLeader: 2},
+		{PublicKey: "bb", NumSignaturesSent: 5},
+	}
+	facade := &mock.Facade{
+		GetManagedKeysMetricsCalled: func() []keysManagement.ManagedKeyMetrics {
+			return metrics
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/managed-keys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &managedKeysResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, metrics, response.Data.Keys)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -506,6 +540,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/managed-keys", Open: true},
 				},
 			},
 		},
@@ -18,9 +18,11 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
+	simulateTransactionEndpoint      = "/transaction/simulate"
 	getTransactionEndpoint           = "/transaction/:hash"
 	sendTransactionPath              = "/send"
 	costPath                         = "/cost"
+	simulatePath                     = "/simulate"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
 )
@@ -33,6 +35,7 @@ type FacadeHandler interface {
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
@@ -75,6 +78,12 @@ type TxResponse struct {
 	BlockNumber uint64 `json:"blockNumber"`
 	BlockHash   string `json:"blockHash"`
 	Timestamp   uint64 `json:"timestamp"`
+
+	Status               core.TransactionStatus                `json:"status"`
+	GasUsed              uint64                                `json:"gasUsed"`
+	SmartContractResults []*transaction.ApiSmartContractResult `json:"smartContractResults"`
+	Logs                 *transaction.ApiLogs                  `json:"logs"`
+	Receipt              *transaction.ApiReceipt               `json:"receipt"`
 }
 
 // Routes defines transaction related routes
@@ -86,6 +95,12 @@ func Routes(router *wrapper.RouterWrapper) {
 		SendTransaction,
 	)
 	router.RegisterHandler(http.MethodPost, costPath, ComputeTransactionGasLimit)
+	router.RegisterHandler(
+		http.MethodPost,
+		simulatePath,
+		middleware.CreateEndpointThrottler(simulateTransactionEndpoint),
+		SimulateTransaction,
+	)
 	router.RegisterHandler(
 		http.MethodPost,
 		sendMultiplePath,
@@ -402,3 +417,72 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 		},
 	)
 }
+
+// SimulateTransaction will receive a transaction from the client and will execute it against the last committed state,
+// without propagating it and without persisting its effects. The signature is optional and it is not verified
+func SimulateTransaction(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var gtx SendTxRequest
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tx, _, err := facade.CreateTransaction(
+		gtx.Nonce,
+		gtx.Value,
+		gtx.Receiver,
+		gtx.Sender,
+		gtx.GasPrice,
+		gtx.GasLimit,
+		gtx.Data,
+		gtx.Signature,
+		gtx.ChainID,
+		gtx.Version,
+	)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	simulationResults, err := facade.SimulateTransactionExecution(tx)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrSimulateTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": simulationResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
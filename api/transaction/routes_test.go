@@ -25,6 +25,7 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type transactionResponseData struct {
@@ -68,6 +69,16 @@ type transactionCostResponse struct {
 	Code  string                      `json:"code"`
 }
 
+type simulateTransactionResponseData struct {
+	Result *tr.SimulationResults `json:"result"`
+}
+
+type simulateTransactionResponse struct {
+	Data  simulateTransactionResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -119,6 +130,41 @@ func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 	assert.Equal(t, txData, txResp.Data)
 }
 
+func TestGetTransaction_ShouldReturnStatusAndResults(t *testing.T) {
+	facade := mock.Facade{
+		GetTransactionHandler: func(hash string) (*tr.ApiTransactionResult, error) {
+			return &tr.ApiTransactionResult{
+				Status:  core.TxStatusFailed,
+				GasUsed: 1000,
+				SmartContractResults: []*tr.ApiSmartContractResult{
+					{Hash: "scrHash", ReturnMessage: "out of funds"},
+				},
+				Logs:    &tr.ApiLogs{Address: "scAddress", Events: []*tr.ApiLogEvent{{Identifier: "6964"}}},
+				Receipt: &tr.ApiReceipt{Value: "10", Data: "refundedGas"},
+			}, nil
+		},
+	}
+
+	req, _ := http.NewRequest("GET", "/transaction/hash", nil)
+	ws := startNodeServer(&facade)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionResponse{}
+	loadResponse(resp.Body, &response)
+
+	txResp := response.Data.TxResp
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, core.TxStatusFailed, txResp.Status)
+	assert.Equal(t, uint64(1000), txResp.GasUsed)
+	require.Equal(t, 1, len(txResp.SmartContractResults))
+	assert.Equal(t, "out of funds", txResp.SmartContractResults[0].ReturnMessage)
+	require.NotNil(t, txResp.Logs)
+	assert.Equal(t, "6964", txResp.Logs.Events[0].Identifier)
+	require.NotNil(t, txResp.Receipt)
+	assert.Equal(t, "refundedGas", txResp.Receipt.Data)
+}
+
 func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
 	sender := "sender"
 	receiver := "receiver"
@@ -555,6 +601,118 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 	assert.Equal(t, expectedGasLimit, txCostResp.Data.Cost)
 }
 
+func TestSimulateTransaction_ShouldReturnResults(t *testing.T) {
+	t.Parallel()
+
+	expectedResults := &tr.SimulationResults{
+		Status:     core.TxStatusExecuted,
+		ReturnCode: "ok",
+		Hash:       "hash",
+		Balances:   map[string]string{"sender1": "90"},
+	}
+	facade := mock.Facade{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string, _ string, _ uint32,
+		) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, nil, nil
+		},
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+			return expectedResults, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "10"})
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResp := simulateTransactionResponse{}
+	loadResponse(resp.Body, &simulateResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResults, simulateResp.Data.Result)
+}
+
+func TestSimulateTransaction_ErrorWithExceededNumGoRoutines(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			assert.Equal(t, "/transaction/simulate", endpoint)
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool { return false },
+			}, true
+		},
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+			assert.Fail(t, "should have not simulated the transaction")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "10"})
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResp := simulateTransactionResponse{}
+	loadResponse(resp.Body, &simulateResp)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.True(t, strings.Contains(simulateResp.Error, apiErrors.ErrTooManyRequests.Error()))
+	assert.Equal(t, string(shared.ReturnCodeSystemBusy), simulateResp.Code)
+}
+
+func TestSimulateTransaction_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ string, _ string, _ string, _ uint32,
+		) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, nil, nil
+		},
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "10"})
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResp := simulateTransactionResponse{}
+	loadResponse(resp.Body, &simulateResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(simulateResp.Error, apiErrors.ErrSimulateTransaction.Error()))
+	assert.True(t, strings.Contains(simulateResp.Error, expectedErr.Error()))
+}
+
+func TestGetTransaction_PoolHashShouldNotBeReservedForTheTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionHandler: func(hash string) (*tr.ApiTransactionResult, error) {
+			assert.Equal(t, "pool", hash)
+			return &tr.ApiTransactionResult{Sender: "sender"}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "sender", response.Data.TxResp.Sender)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -601,6 +759,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send", Open: true},
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
+					{Name: "/simulate", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 				},
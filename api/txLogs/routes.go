@@ -0,0 +1,117 @@
+package txLogs
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+)
+
+const filterLogsPath = "/filter"
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	FilterLogs(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error)
+	IsInterfaceNil() bool
+}
+
+// FilterLogsRequest is the body of a logs filter request. The block range is given by nonces, both ends included,
+// while the addresses, identifiers and topics are those of logsIndex.FilterRequest
+type FilterLogsRequest struct {
+	FromBlock uint64 `json:"fromBlock"`
+	ToBlock   uint64 `json:"toBlock"`
+	logsIndex.FilterRequest
+}
+
+// Routes defines smart contract logs related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodPost, filterLogsPath, FilterLogs)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// FilterLogs returns the smart contract log events, generated in the requested block range, which match the
+// requested addresses, identifiers and topics
+func FilterLogs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	request := &FilterLogsRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		respondWithRequestError(c, errors.ErrInvalidJSONRequest)
+		return
+	}
+	if request.ToBlock < request.FromBlock {
+		respondWithRequestError(c, errors.ErrInvalidBlockRange)
+		return
+	}
+
+	logEvents, err := facade.FilterLogs(request.FromBlock, request.ToBlock, &request.FilterRequest)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrFilterLogs.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"logs": logEvents},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithRequestError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusBadRequest,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrFilterLogs.Error(), err.Error()),
+			Code:  shared.ReturnCodeRequestError,
+		},
+	)
+}
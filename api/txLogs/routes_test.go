@@ -0,0 +1,174 @@
+package txLogs_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/txLogs"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type logsResponseData struct {
+	Logs []*transaction.ApiFilteredLogEvent `json:"logs"`
+}
+
+type logsResponse struct {
+	Data  logsResponseData  `json:"data"`
+	Error string            `json:"error"`
+	Code  shared.ReturnCode `json:"code"`
+}
+
+func postFilter(ws *gin.Engine, body string) (*httptest.ResponseRecorder, *logsResponse) {
+	req, _ := http.NewRequest("POST", "/logs/filter", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := &logsResponse{}
+	loadResponse(resp.Body, response)
+
+	return resp, response
+}
+
+func TestFilterLogs_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(nil)
+
+	_, response := postFilter(ws, `{}`)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestFilterLogs_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServerWrongFacade()
+
+	_, response := postFilter(ws, `{}`)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestFilterLogs_InvalidRequestShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(&mock.Facade{})
+
+	resp, response := postFilter(ws, `not json`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidJSONRequest.Error()))
+
+	resp, response = postFilter(ws, `{"fromBlock": 5, "toBlock": 4}`)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockRange.Error()))
+}
+
+func TestFilterLogs_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		FilterLogsHandler: func(_ uint64, _ uint64, _ *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+
+	resp, response := postFilter(ws, `{"fromBlock": 1, "toBlock": 2}`)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrFilterLogs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestFilterLogs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedEvents := []*transaction.ApiFilteredLogEvent{
+		{TxHash: "aa", BlockHash: "bb", BlockNonce: 2, LogAddress: "erd1", Identifier: "cc", Topics: []string{"dd"}},
+	}
+	facade := &mock.Facade{
+		FilterLogsHandler: func(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error) {
+			assert.Equal(t, uint64(1), fromNonce)
+			assert.Equal(t, uint64(3), toNonce)
+			assert.Equal(t, &logsIndex.FilterRequest{
+				Addresses:   []string{"erd1"},
+				Identifiers: []string{"cc"},
+				Topics:      [][]string{{}, {"dd", "ee"}},
+			}, request)
+			return expectedEvents, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	body := `{"fromBlock": 1, "toBlock": 3, "addresses": ["erd1"], "identifiers": ["cc"], "topics": [[], ["dd", "ee"]]}`
+	resp, response := postFilter(ws, body)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedEvents, response.Data.Logs)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler txLogs.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	logsRoutes := ws.Group("/logs")
+	if handler != nil {
+		logsRoutes.Use(middleware.WithFacade(handler))
+	}
+	logsRouteWrapper, _ := wrapper.NewRouterWrapper("logs", logsRoutes, getRoutesConfig())
+	txLogs.Routes(logsRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	logsRoutes := ws.Group("/logs")
+	logsRouteWrapper, _ := wrapper.NewRouterWrapper("logs", logsRoutes, getRoutesConfig())
+	txLogs.Routes(logsRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "/filter", Open: true},
+				},
+			},
+		},
+	}
+}
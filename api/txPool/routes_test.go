@@ -0,0 +1,244 @@
+package txPool_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/txPool"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type statisticsResponseData struct {
+	Caches []*transaction.ApiTransactionsPoolCache `json:"caches"`
+}
+
+type statisticsResponse struct {
+	Data  statisticsResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+type senderPoolResponseData struct {
+	SenderPool *transaction.ApiSenderTransactionsPool `json:"senderPool"`
+}
+
+type senderPoolResponse struct {
+	Data  senderPoolResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+func TestGetStatistics_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statsResp := statisticsResponse{}
+	loadResponse(resp.Body, &statsResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(statsResp.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetStatistics_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statsResp := statisticsResponse{}
+	loadResponse(resp.Body, &statsResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(statsResp.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetStatistics_ShouldReturnCachesStatistics(t *testing.T) {
+	t.Parallel()
+
+	expectedCaches := []*transaction.ApiTransactionsPoolCache{
+		{CacheID: "0", NumTxs: 3, NumBytes: 300, NumSenders: 2, NumEvictedTxs: 1},
+		{CacheID: "1_0", NumTxs: 1, NumBytes: 100},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolStatisticsHandler: func() ([]*transaction.ApiTransactionsPoolCache, error) {
+			return expectedCaches, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statsResp := statisticsResponse{}
+	loadResponse(resp.Body, &statsResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedCaches, statsResp.Data.Caches)
+}
+
+func TestGetStatistics_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolStatisticsHandler: func() ([]*transaction.ApiTransactionsPoolCache, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statsResp := statisticsResponse{}
+	loadResponse(resp.Body, &statsResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(statsResp.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(statsResp.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsForSender_ShouldReturnSenderPool(t *testing.T) {
+	t.Parallel()
+
+	expectedSenderPool := &transaction.ApiSenderTransactionsPool{
+		Sender:       "sender",
+		AccountNonce: 4,
+		Transactions: []*transaction.ApiPendingTransaction{{Hash: "aa", Nonce: 5}, {Hash: "bb", Nonce: 8}},
+		Nonces:       []uint64{5, 8},
+		Gaps:         []*transaction.ApiNonceGap{{From: 4, To: 4}, {From: 6, To: 7}},
+		Score:        42,
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderHandler: func(sender string) (*transaction.ApiSenderTransactionsPool, error) {
+			assert.Equal(t, "sender", sender)
+			return expectedSenderPool, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/sender", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	senderResp := senderPoolResponse{}
+	loadResponse(resp.Body, &senderResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedSenderPool, senderResp.Data.SenderPool)
+}
+
+func TestGetTransactionsForSender_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderHandler: func(sender string) (*transaction.ApiSenderTransactionsPool, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/sender", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	senderResp := senderPoolResponse{}
+	loadResponse(resp.Body, &senderResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(senderResp.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(senderResp.Error, expectedErr.Error()))
+}
+
+func TestRoutes_ClosedRouteShouldNotBeRegistered(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getRoutesConfig()
+	routesConfig.APIPackages["transaction-pool"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "/statistics", Open: true},
+			{Name: "/by-sender/:address", Open: false},
+		},
+	}
+	ws := gin.New()
+	ginTxPoolRoute := ws.Group("/transaction-pool")
+	ginTxPoolRoute.Use(middleware.WithFacade(&mock.Facade{}))
+	txPoolRoute, _ := wrapper.NewRouterWrapper("transaction-pool", ginTxPoolRoute, routesConfig)
+	txPool.Routes(txPoolRoute)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/sender", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	_ = jsonParser.Decode(destination)
+}
+
+func startNodeServer(handler txPool.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ginTxPoolRoute := ws.Group("/transaction-pool")
+	if handler != nil {
+		ginTxPoolRoute.Use(middleware.WithFacade(handler))
+	}
+	txPoolRoute, _ := wrapper.NewRouterWrapper("transaction-pool", ginTxPoolRoute, getRoutesConfig())
+	txPool.Routes(txPoolRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginTxPoolRoute := ws.Group("/transaction-pool")
+	txPoolRoute, _ := wrapper.NewRouterWrapper("transaction-pool", ginTxPoolRoute, getRoutesConfig())
+	txPool.Routes(txPoolRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"transaction-pool": {
+				Routes: []config.RouteConfig{
+					{Name: "/statistics", Open: true},
+					{Name: "/by-sender/:address", Open: true},
+				},
+			},
+		},
+	}
+}
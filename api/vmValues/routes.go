@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-gonic/gin"
@@ -23,15 +24,19 @@ const (
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vmcommon.VMOutput, error)
+	GetStateRootHash(options state.AccountQueryOptions) ([]byte, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
-// VMValueRequest represents the structure on which user input for generating a new transaction will validate against
+// VMValueRequest represents the structure on which user input for generating a new transaction will validate against.
+// The query is executed against the current state, unless a block nonce or a hex encoded state root hash is provided
 type VMValueRequest struct {
-	ScAddress string   `form:"scAddress" json:"scAddress"`
-	FuncName  string   `form:"funcName" json:"funcName"`
-	Args      []string `form:"args"  json:"args"`
+	ScAddress  string   `form:"scAddress" json:"scAddress"`
+	FuncName   string   `form:"funcName" json:"funcName"`
+	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce,omitempty"`
+	RootHash   string   `form:"rootHash" json:"rootHash,omitempty"`
 }
 
 // Routes defines address related routes
@@ -132,13 +137,44 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 		arguments[i] = append(arguments[i], argBytes...)
 	}
 
+	rootHash, err := getQueryRootHash(fh, request)
+	if err != nil {
+		return nil, err
+	}
+
 	return &process.SCQuery{
 		ScAddress: decodedAddress,
 		FuncName:  request.FuncName,
 		Arguments: arguments,
+		RootHash:  rootHash,
 	}, nil
 }
 
+func getQueryRootHash(fh FacadeHandler, request *VMValueRequest) ([]byte, error) {
+	options := state.AccountQueryOptions{}
+	if request.BlockNonce != nil {
+		options.BlockNonce = *request.BlockNonce
+		options.HasBlockNonce = true
+	}
+	if len(request.RootHash) > 0 {
+		rootHash, err := hex.DecodeString(request.RootHash)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid root hash: %s", request.RootHash, err.Error())
+		}
+
+		options.RootHash = rootHash
+	}
+
+	if !options.IsHistorical() {
+		return nil, nil
+	}
+	if options.HasBlockNonce && len(options.RootHash) > 0 {
+		return nil, errors.ErrBlockNonceAndRootHashProvided
+	}
+
+	return fh.GetStateRootHash(options)
+}
+
 func returnBadRequest(context *gin.Context, errScope string, err error) {
 	message := fmt.Sprintf("%s: %s", errScope, err)
 	context.JSON(
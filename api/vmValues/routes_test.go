@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/gin-contrib/cors"
@@ -161,6 +162,64 @@ func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	require.Contains(t, err.Error(), "'bad arg' is not a valid hex string")
 }
 
+func TestQuery_WithBlockNonceShouldQueryHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(37)
+	rootHash := []byte("root hash")
+	facade := mock.Facade{
+		GetStateRootHashCalled: func(options state.AccountQueryOptions) ([]byte, error) {
+			require.True(t, options.HasBlockNonce)
+			require.Equal(t, blockNonce, options.BlockNonce)
+			return rootHash, nil
+		},
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+			require.Equal(t, rootHash, query.RootHash)
+			return &vmcommon.VMOutput{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		Args:       []string{},
+		BlockNonce: &blockNonce,
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+}
+
+func TestCreateSCQuery_WithRootHashShouldSetRootHash(t *testing.T) {
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		RootHash:  hex.EncodeToString([]byte("root hash")),
+	}
+
+	query, err := createSCQuery(&mock.Facade{}, &request)
+	require.Nil(t, err)
+	require.Equal(t, []byte("root hash"), query.RootHash)
+}
+
+func TestCreateSCQuery_WithBlockNonceAndRootHashShouldErr(t *testing.T) {
+	blockNonce := uint64(37)
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+		RootHash:   hex.EncodeToString([]byte("root hash")),
+	}
+
+	_, err := createSCQuery(&mock.Facade{}, &request)
+	require.Equal(t, apiErrors.ErrBlockNonceAndRootHashProvided, err)
+}
+
 func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
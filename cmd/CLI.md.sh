@@ -6,6 +6,7 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForTrieInspector
 }
 
 generateForNode() {
@@ -78,6 +79,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForTrieInspector() {
+    HELP="
+# Trieinspector CLI
+
+The **Trie Inspector Tool** exposes the following Command Line Interface:
+$(code)
+\$ trieinspector --help
+
+$(./trieinspector/trieinspector --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./trieinspector/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
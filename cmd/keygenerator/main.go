@@ -1,31 +1,51 @@
 package main
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto/mnemonic"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 type cfg struct {
-	numKeys int
-	keyType string
+	numKeys      int
+	keyType      string
+	useKeystore  bool
+	kdf          string
+	passwordFile string
+	password     []byte
+	useMnemonic  bool
+	mnemonicFile string
+	indexes      string
+	seed         []byte
 }
 
+type secretKeyDeriver func(seed []byte, index uint32) ([]byte, error)
+
 const keysFolderPattern = "node-%d"
 const blsPubkeyLen = 96
 const txSignPubkeyLen = 32
+const keystorePasswordEnvVar = "ELROND_KEYSTORE_PASSWORD"
+const mnemonicEnvVar = "ELROND_MNEMONIC"
 
 var (
 	fileGenHelpTemplate = `NAME:
@@ -36,6 +56,9 @@ USAGE:
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -60,10 +83,66 @@ VERSION:
 		Destination: &argsConfig.keyType,
 	}
 
+	// useKeystore defines a flag for saving the keys in password protected keystore files
+	useKeystore = cli.BoolFlag{
+		Name: "keystore",
+		Usage: "Boolean option for saving each key in a password protected keystore JSON file instead of a " +
+			"plaintext PEM file",
+		Destination: &argsConfig.useKeystore,
+	}
+
+	// kdf defines a flag for setting the key derivation function used by the keystore files
+	kdf = cli.StringFlag{
+		Name:        "kdf",
+		Usage:       "The key derivation function used to derive the encryption key from the password. Available options: scrypt, argon2id",
+		Value:       keystore.KDFScrypt,
+		Destination: &argsConfig.kdf,
+	}
+
+	// passwordFile defines a flag for setting the file holding the password of the keystore files
+	passwordFile = cli.StringFlag{
+		Name: "password-file",
+		Usage: "The `filepath` for the file which contains the password of the keystore files. If not set, the " +
+			"password is read from the " + keystorePasswordEnvVar + " environment variable or from the terminal",
+		Destination: &argsConfig.passwordFile,
+	}
+
+	// useMnemonic defines a flag for deriving the keys from a BIP39 mnemonic instead of generating random ones
+	useMnemonic = cli.BoolFlag{
+		Name: "mnemonic",
+		Usage: "Boolean option for deriving the keys from a BIP39 mnemonic instead of generating random ones. The " +
+			"wallet keys are derived on the m/44'/508'/0'/0'/index' path and the validator keys on the " +
+			"m/12381/508/index/0/0 path",
+		Destination: &argsConfig.useMnemonic,
+	}
+
+	// mnemonicFile defines a flag for setting the file holding the mnemonic the keys are derived from
+	mnemonicFile = cli.StringFlag{
+		Name: "mnemonic-file",
+		Usage: "The `filepath` for the file which contains the mnemonic the keys are derived from. If not set, the " +
+			"mnemonic is read from the " + mnemonicEnvVar + " environment variable or from the terminal",
+		Destination: &argsConfig.mnemonicFile,
+	}
+
+	// index defines a flag for setting the derivation indexes of the keys
+	index = cli.StringFlag{
+		Name: "index",
+		Usage: "The derivation `indexes` of the keys, used only with the mnemonic option. It accepts single values " +
+			"and ranges, for example 0,3,10-19. If not set, the indexes from 0 to num-keys - 1 are used",
+		Destination: &argsConfig.indexes,
+	}
+
 	argsConfig = &cfg{}
 
-	walletKeyFileName    = "walletKey.pem"
-	validatorKeyFileName = "validatorKey.pem"
+	walletKeyFileName         = "walletKey.pem"
+	validatorKeyFileName      = "validatorKey.pem"
+	walletKeystoreFileName    = "walletKey.json"
+	validatorKeystoreFileName = "validatorKey.json"
+
+	errPasswordsMismatch    = errors.New("the passwords do not match")
+	errEmptyMnemonic        = errors.New("empty mnemonic")
+	errIndexWithoutMnemonic = errors.New("the index option can only be used together with the mnemonic option")
+	errInvalidIndexes       = errors.New("invalid indexes")
 
 	log = logger.GetOrCreate("keygenerator")
 )
@@ -73,7 +152,8 @@ func main() {
 	cli.AppHelpTemplate = fileGenHelpTemplate
 	app.Name = "Key generation Tool"
 	app.Version = "v1.0.0"
-	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s)"
+	app.Usage = "This binary will generate a validatorKey.pem and walletKey.pem, each containing private key(s), " +
+		"or their password protected validatorKey.json and walletKey.json keystore counterparts"
 	app.Authors = []cli.Author{
 		{
 			Name:  "The Elrond Team",
@@ -83,12 +163,28 @@ func main() {
 	app.Flags = []cli.Flag{
 		numKeys,
 		keyType,
+		useKeystore,
+		kdf,
+		passwordFile,
+		useMnemonic,
+		mnemonicFile,
+		index,
 	}
 
 	app.Action = func(_ *cli.Context) error {
 		return generateAllFiles()
 	}
 
+	app.Commands = []cli.Command{
+		{
+			Name:  "new-mnemonic",
+			Usage: "Generates a new 24 words BIP39 mnemonic, to be used with the mnemonic option",
+			Action: func(_ *cli.Context) error {
+				return printNewMnemonic()
+			},
+		},
+	}
+
 	err := app.Run(os.Args)
 	if err != nil {
 		log.Error("error generating files", "error", err)
@@ -117,14 +213,18 @@ func generateFolder(index int, numKeys int) (string, error) {
 	return absPath, nil
 }
 
-func generateKeys(keyGen crypto.KeyGenerator) ([]byte, []byte, error) {
-	sk, pk := keyGen.GeneratePair()
+func generateKeys(keyGen crypto.KeyGenerator, index int, deriveSk secretKeyDeriver) ([]byte, []byte, error) {
+	sk, err := createPrivateKey(keyGen, index, deriveSk)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	skBytes, err := sk.ToByteArray()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	pkBytes, err := pk.ToByteArray()
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,6 +232,20 @@ func generateKeys(keyGen crypto.KeyGenerator) ([]byte, []byte, error) {
 	return skBytes, pkBytes, nil
 }
 
+func createPrivateKey(keyGen crypto.KeyGenerator, index int, deriveSk secretKeyDeriver) (crypto.PrivateKey, error) {
+	if !argsConfig.useMnemonic {
+		sk, _ := keyGen.GeneratePair()
+		return sk, nil
+	}
+
+	derivedSk, err := deriveSk(argsConfig.seed, uint32(index))
+	if err != nil {
+		return nil, err
+	}
+
+	return keyGen.PrivateKeyFromByteArray(derivedSk)
+}
+
 func backupFileIfExists(filename string) {
 	if _, err := os.Stat(filename); err != nil {
 		if os.IsNotExist(err) {
@@ -143,8 +257,33 @@ func backupFileIfExists(filename string) {
 }
 
 func generateAllFiles() error {
-	for i := 0; i < argsConfig.numKeys; i++ {
-		err := generateOneSetOfFiles(i, argsConfig.numKeys)
+	if argsConfig.useKeystore {
+		password, err := loadKeystorePassword()
+		if err != nil {
+			return err
+		}
+		argsConfig.password = password
+	}
+
+	indexes, err := parseIndexes()
+	if err != nil {
+		return err
+	}
+
+	if argsConfig.useMnemonic {
+		mnemonicWords, errLoad := loadMnemonic()
+		if errLoad != nil {
+			return errLoad
+		}
+
+		argsConfig.seed, err = mnemonic.NewSeed(mnemonicWords, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, idx := range indexes {
+		err = generateOneSetOfFiles(idx, len(indexes))
 		if err != nil {
 			return err
 		}
@@ -153,6 +292,51 @@ func generateAllFiles() error {
 	return nil
 }
 
+// parseIndexes returns the indexes of the keys to be generated: the ones set through the index option or, if none
+// are set, the ones from 0 to num-keys - 1
+func parseIndexes() ([]int, error) {
+	if len(argsConfig.indexes) == 0 {
+		indexes := make([]int, 0, argsConfig.numKeys)
+		for i := 0; i < argsConfig.numKeys; i++ {
+			indexes = append(indexes, i)
+		}
+
+		return indexes, nil
+	}
+	if !argsConfig.useMnemonic {
+		return nil, errIndexWithoutMnemonic
+	}
+
+	indexes := make([]int, 0)
+	alreadyAdded := make(map[int]struct{})
+	for _, interval := range strings.Split(argsConfig.indexes, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(interval), "-", 2)
+		first, err := strconv.ParseUint(bounds[0], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidIndexes, interval)
+		}
+
+		last := first
+		if len(bounds) == 2 {
+			last, err = strconv.ParseUint(bounds[1], 10, 31)
+			if err != nil || last < first {
+				return nil, fmt.Errorf("%w: %s", errInvalidIndexes, interval)
+			}
+		}
+
+		for i := int(first); i <= int(last); i++ {
+			if _, found := alreadyAdded[i]; found {
+				continue
+			}
+
+			alreadyAdded[i] = struct{}{}
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes, nil
+}
+
 func generateOneSetOfFiles(index int, numKeys int) error {
 	switch argsConfig.keyType {
 	case "validator":
@@ -184,7 +368,12 @@ func generateBlockKey(index int, numKeys int) error {
 
 	genForBlockSigningSk := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
 
-	return generateAndSave(index, numKeys, validatorKeyFileName, genForBlockSigningSk, pubkeyConverter)
+	filename := validatorKeyFileName
+	if argsConfig.useKeystore {
+		filename = validatorKeystoreFileName
+	}
+
+	return generateAndSave(index, numKeys, filename, genForBlockSigningSk, pubkeyConverter, mnemonic.DeriveValidatorKey)
 }
 
 func generateTxKey(index int, numKeys int) error {
@@ -200,10 +389,22 @@ func generateTxKey(index int, numKeys int) error {
 
 	genForBlockSigningSk := signing.NewKeyGenerator(ed25519.NewEd25519())
 
-	return generateAndSave(index, numKeys, walletKeyFileName, genForBlockSigningSk, pubkeyConverter)
+	filename := walletKeyFileName
+	if argsConfig.useKeystore {
+		filename = walletKeystoreFileName
+	}
+
+	return generateAndSave(index, numKeys, filename, genForBlockSigningSk, pubkeyConverter, mnemonic.DeriveWalletKey)
 }
 
-func generateAndSave(index int, numKeys int, baseFilename string, genForBlockSigningSk crypto.KeyGenerator, pubkeyConverter core.PubkeyConverter) error {
+func generateAndSave(
+	index int,
+	numKeys int,
+	baseFilename string,
+	genForBlockSigningSk crypto.KeyGenerator,
+	pubkeyConverter core.PubkeyConverter,
+	deriveSk secretKeyDeriver,
+) error {
 	folder, err := generateFolder(index, numKeys)
 	if err != nil {
 		return err
@@ -217,6 +418,20 @@ func generateAndSave(index int, numKeys int, baseFilename string, genForBlockSig
 		return err
 	}
 
+	sk, pk, err := generateKeys(genForBlockSigningSk, index, deriveSk)
+	if err != nil {
+		return err
+	}
+
+	pkString := pubkeyConverter.Encode(pk)
+	if argsConfig.useKeystore {
+		return saveKeystoreFile(filename, sk, pkString)
+	}
+
+	return savePemFile(filename, sk, pkString)
+}
+
+func savePemFile(filename string, sk []byte, pkString string) error {
 	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, core.FileModeUserReadWrite)
 	if err != nil {
 		return err
@@ -226,12 +441,101 @@ func generateAndSave(index int, numKeys int, baseFilename string, genForBlockSig
 		_ = file.Close()
 	}()
 
-	sk, pk, err := generateKeys(genForBlockSigningSk)
+	return core.SaveSkToPemFile(file, pkString, []byte(hex.EncodeToString(sk)))
+}
+
+func saveKeystoreFile(filename string, sk []byte, pkString string) error {
+	keyFile, err := keystore.EncryptKey(sk, pkString, argsConfig.password, argsConfig.kdf)
 	if err != nil {
 		return err
 	}
 
-	pkString := pubkeyConverter.Encode(pk)
+	return keystore.SaveKeyFile(filename, keyFile)
+}
 
-	return core.SaveSkToPemFile(file, pkString, []byte(hex.EncodeToString(sk)))
+// loadKeystorePassword reads the password from the password file or from the environment variable and, if none is
+// set, asks for it in the terminal
+func loadKeystorePassword() ([]byte, error) {
+	password, err := keystore.LoadPassword(argsConfig.passwordFile, keystorePasswordEnvVar)
+	if err == nil || len(argsConfig.passwordFile) > 0 {
+		return password, err
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(stdinFd) {
+		return nil, err
+	}
+
+	fmt.Print("Keystore password: ")
+	password, err = terminal.ReadPassword(stdinFd)
+	fmt.Println()
+	if err != nil {
+		return nil, err
+	}
+	if len(password) == 0 {
+		return nil, keystore.ErrEmptyPassword
+	}
+
+	fmt.Print("Repeat the keystore password: ")
+	confirmation, err := terminal.ReadPassword(stdinFd)
+	fmt.Println()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(password, confirmation) {
+		return nil, errPasswordsMismatch
+	}
+
+	return password, nil
+}
+
+func printNewMnemonic() error {
+	mnemonicWords, err := mnemonic.Generate(mnemonic.DefaultEntropySize)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(mnemonicWords)
+
+	return nil
+}
+
+// loadMnemonic reads the mnemonic from the mnemonic file or from the environment variable and, if none is set,
+// asks for it in the terminal
+func loadMnemonic() (string, error) {
+	if len(argsConfig.mnemonicFile) > 0 {
+		buff, err := ioutil.ReadFile(argsConfig.mnemonicFile)
+		if err != nil {
+			return "", err
+		}
+
+		return nonEmptyMnemonic(string(buff))
+	}
+
+	mnemonicWords := os.Getenv(mnemonicEnvVar)
+	if len(mnemonicWords) > 0 {
+		return mnemonicWords, nil
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(stdinFd) {
+		return "", errEmptyMnemonic
+	}
+
+	fmt.Print("Mnemonic: ")
+	buff, err := terminal.ReadPassword(stdinFd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+
+	return nonEmptyMnemonic(string(buff))
+}
+
+func nonEmptyMnemonic(mnemonicWords string) (string, error) {
+	if len(strings.TrimSpace(mnemonicWords)) == 0 {
+		return "", errEmptyMnemonic
+	}
+
+	return mnemonicWords, nil
 }
@@ -45,6 +45,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -1120,9 +1121,11 @@ func newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  mapDNSAddresses,
+		Marshalizer:      core.InternalMarshalizer,
+		Accounts:         stateComponents.AccountsAdapter,
+		ShardCoordinator: shardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -1403,6 +1406,16 @@ func newMetaBlockProcessor(
 		Uint64Converter:  core.Uint64ByteSliceConverter,
 		BuiltInFunctions: builtInFuncs, // no built-in functions for meta.
 	}
+	evidenceVerifier, err := slash.NewEvidenceVerifier(slash.ArgsEvidenceVerifier{
+		Marshalizer:      core.InternalMarshalizer,
+		Hasher:           core.Hasher,
+		SigVerifier:      messageSignVerifier,
+		NodesCoordinator: nodesCoordinator,
+		ChainID:          core.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
 	vmFactory, err := metachain.NewVMContainerFactory(
 		argsHook,
 		economicsData,
@@ -1413,6 +1426,7 @@ func newMetaBlockProcessor(
 		core.InternalMarshalizer,
 		systemSCConfig,
 		stateComponents.PeerAccounts,
+		evidenceVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -1875,6 +1889,7 @@ func CreateUnitOpener(
 	defaultDBPath string,
 	defaultEpochString string,
 	defaultShardString string,
+	defaultStaticDbString string,
 ) (storage.UnitOpenerHandler, error) {
 	argsStorageUnitOpener := storageFactory.ArgsNewOpenStorageUnits{
 		GeneralConfig:             generalConfig,
@@ -1886,6 +1901,7 @@ func CreateUnitOpener(
 		DefaultDBPath:             defaultDBPath,
 		DefaultEpochString:        defaultEpochString,
 		DefaultShardString:        defaultShardString,
+		DefaultStaticDbString:     defaultStaticDbString,
 	}
 
 	return storageFactory.NewStorageUnitOpenHandler(argsStorageUnitOpener)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -28,18 +29,26 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/alarm"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	dataTrie "github.com/ElrondNetwork/elrond-go/data/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool/journal"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -48,12 +57,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
@@ -61,11 +73,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
@@ -95,6 +110,9 @@ const (
 	secondsToWaitForP2PBootstrap = 20
 	maxTimeToClose               = 10 * time.Second
 	maxMachineIDLen              = 10
+	validatorKeyPasswordEnvVar   = "ELROND_VALIDATOR_KEY_PASSWORD"
+	managedKeysPasswordEnvVar    = "ELROND_MANAGED_KEYS_PASSWORD"
+	keystoreFileExtension        = ".json"
 )
 
 var (
@@ -287,6 +305,43 @@ VERSION:
 		Usage: "The `filepath` for the PEM file which contains the secret keys for the validator key.",
 		Value: "./config/validatorKey.pem",
 	}
+	// validatorKeystoreFile defines a flag for the path to the encrypted keystore holding the validator key
+	validatorKeystoreFile = cli.StringFlag{
+		Name: "validator-keystore-file",
+		Usage: "The `filepath` for the password protected keystore JSON file which contains the secret key for the " +
+			"validator key. If set, it is used instead of the PEM file.",
+		Value: "",
+	}
+	// validatorKeyPasswordFile defines a flag for the path to the file holding the password of the validator keystore
+	validatorKeyPasswordFile = cli.StringFlag{
+		Name: "validator-key-password-file",
+		Usage: "The `filepath` for the file which contains the password of the validator keystore. If not set, the " +
+			"password is read from the " + validatorKeyPasswordEnvVar + " environment variable.",
+		Value: "",
+	}
+	// managedKeysPemFile defines a flag for the path to the PEM file holding the additional validator keys
+	// managed by this node
+	managedKeysPemFile = cli.StringFlag{
+		Name: "managed-keys-pem-file",
+		Usage: "The `filepath` for the PEM file which contains the secret keys of the additional validators " +
+			"managed by this node. If not set, the node only manages its own validator key.",
+		Value: "",
+	}
+	// managedKeysKeystoreDir defines a flag for the path to the directory holding the encrypted keystores of the
+	// additional validator keys managed by this node
+	managedKeysKeystoreDir = cli.StringFlag{
+		Name: "managed-keys-keystore-dir",
+		Usage: "The `directory` which contains the password protected keystore JSON files, one for each of the " +
+			"additional validators managed by this node. The keys are added to the ones from the managed keys PEM file.",
+		Value: "",
+	}
+	// managedKeysPasswordFile defines a flag for the path to the file holding the password of the managed keystores
+	managedKeysPasswordFile = cli.StringFlag{
+		Name: "managed-keys-password-file",
+		Usage: "The `filepath` for the file which contains the password of the managed keys keystores. If not set, " +
+			"the password is read from the " + managedKeysPasswordEnvVar + " environment variable.",
+		Value: "",
+	}
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -424,6 +479,11 @@ func main() {
 		gasScheduleConfigurationFile,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		validatorKeystoreFile,
+		validatorKeyPasswordFile,
+		managedKeysPemFile,
+		managedKeysKeystoreDir,
+		managedKeysPasswordFile,
 		port,
 		profileMode,
 		useHealthService,
@@ -475,6 +535,138 @@ func getSuite(config *config.Config) (crypto.Suite, error) {
 	}
 }
 
+// loadValidatorCryptoParams loads the validator key from the keystore file, if one is provided, or from the PEM file
+func loadValidatorCryptoParams(
+	ctx *cli.Context,
+	pubkeyConverter core.PubkeyConverter,
+	suite crypto.Suite,
+) (*mainFactory.CryptoParams, error) {
+	keystoreFileName := ctx.GlobalString(validatorKeystoreFile.Name)
+	if len(keystoreFileName) == 0 {
+		cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
+			pubkeyConverter,
+			ctx.GlobalInt(validatorKeyIndex.Name),
+			ctx.GlobalString(validatorKeyPemFile.Name),
+			suite,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return cryptoParamsLoader.Get()
+	}
+
+	password, err := keystore.LoadPassword(ctx.GlobalString(validatorKeyPasswordFile.Name), validatorKeyPasswordEnvVar)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the password of the validator keystore", err)
+	}
+
+	cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoaderFromKeystore(
+		pubkeyConverter,
+		keystoreFileName,
+		password,
+		suite,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return cryptoParamsLoader.Get()
+}
+
+// createKeysHandler creates the holder of the validator keys managed by this node: the main validator key
+// and all the keys found in the managed keys PEM file, if one is provided
+func createKeysHandler(
+	ctx *cli.Context,
+	pubkeyConverter core.PubkeyConverter,
+	suite crypto.Suite,
+	keyGen crypto.KeyGenerator,
+	mainPrivateKey crypto.PrivateKey,
+) (node.KeysHandler, error) {
+	managedPrivateKeys := make([]crypto.PrivateKey, 0)
+	pemFileName := ctx.GlobalString(managedKeysPemFile.Name)
+	for skIndex := 0; len(pemFileName) > 0; skIndex++ {
+		encodedSk, _, err := core.LoadSkPkFromPemFile(pemFileName, skIndex)
+		if errors.Is(err, core.ErrInvalidIndex) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		skBytes, err := hex.DecodeString(string(encodedSk))
+		if err != nil {
+			return nil, fmt.Errorf("%w for encoded secret key at index %d", err, skIndex)
+		}
+
+		privateKey, err := keyGen.PrivateKeyFromByteArray(skBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		managedPrivateKeys = append(managedPrivateKeys, privateKey)
+	}
+
+	keystorePrivateKeys, err := loadManagedKeysFromKeystores(ctx, pubkeyConverter, suite)
+	if err != nil {
+		return nil, err
+	}
+	managedPrivateKeys = append(managedPrivateKeys, keystorePrivateKeys...)
+
+	return keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		MainPrivateKey:     mainPrivateKey,
+		ManagedPrivateKeys: managedPrivateKeys,
+	})
+}
+
+// loadManagedKeysFromKeystores decrypts the keystore files from the managed keys keystore directory, if one is provided
+func loadManagedKeysFromKeystores(
+	ctx *cli.Context,
+	pubkeyConverter core.PubkeyConverter,
+	suite crypto.Suite,
+) ([]crypto.PrivateKey, error) {
+	keystoreDir := ctx.GlobalString(managedKeysKeystoreDir.Name)
+	if len(keystoreDir) == 0 {
+		return nil, nil
+	}
+
+	files, err := ioutil.ReadDir(keystoreDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the managed keys keystore directory", err)
+	}
+
+	password, err := keystore.LoadPassword(ctx.GlobalString(managedKeysPasswordFile.Name), managedKeysPasswordEnvVar)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the password of the managed keys keystores", err)
+	}
+
+	privateKeys := make([]crypto.PrivateKey, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != keystoreFileExtension {
+			continue
+		}
+
+		cryptoParamsLoader, errLoader := mainFactory.NewCryptoSigningParamsLoaderFromKeystore(
+			pubkeyConverter,
+			filepath.Join(keystoreDir, file.Name()),
+			password,
+			suite,
+		)
+		if errLoader != nil {
+			return nil, errLoader
+		}
+
+		cryptoParams, errGet := cryptoParamsLoader.Get()
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for managed keystore file %s", errGet, file.Name())
+		}
+
+		privateKeys = append(privateKeys, cryptoParams.PrivateKey)
+	}
+
+	return privateKeys, nil
+}
+
 func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	log.Trace("startNode called")
 	workingDir := getWorkingDir(ctx, log)
@@ -642,18 +834,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	validatorKeyPemFileName := ctx.GlobalString(validatorKeyPemFile.Name)
-	cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
-		validatorPubkeyConverter,
-		ctx.GlobalInt(validatorKeyIndex.Name),
-		validatorKeyPemFileName,
-		suite,
-	)
-	if err != nil {
-		return err
-	}
-
-	cryptoParams, err := cryptoParamsLoader.Get()
+	cryptoParams, err := loadValidatorCryptoParams(ctx, validatorPubkeyConverter, suite)
 	if err != nil {
 		return fmt.Errorf("%w: consider regenerating your keys", err)
 	}
@@ -893,6 +1074,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		defaultDBPath,
 		defaultEpochString,
 		defaultShardString,
+		defaultStaticDbString,
 	)
 	if err != nil {
 		return err
@@ -972,6 +1154,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	log.Trace("creating data components")
 	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
+	registerTrieArchivesOnEpochStart(triesComponents.TrieStorageManagers, currentEpoch, epochStartNotifier)
 
 	dataArgs := mainFactory.DataComponentsFactoryArgs{
 		Config:             *generalConfig,
@@ -1131,26 +1314,59 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	if externalConfig.ElasticSearchConnector.Enabled {
-		log.Trace("creating elastic search components")
-		dbIndexer, err = createElasticIndexer(
-			ctx,
-			externalConfig.ElasticSearchConnector,
-			externalConfig.ElasticSearchConnector.URL,
-			coreComponents.InternalMarshalizer,
-			coreComponents.Hasher,
-			nodesCoordinator,
-			epochStartNotifier,
-			addressPubkeyConverter,
-			validatorPubkeyConverter,
-			shardCoordinator.SelfId(),
-		)
-		if err != nil {
-			return err
-		}
+	log.Trace("creating indexer components")
+	dbIndexer, err = createIndexer(
+		ctx,
+		externalConfig,
+		coreComponents.InternalMarshalizer,
+		coreComponents.Hasher,
+		nodesCoordinator,
+		epochStartNotifier,
+		addressPubkeyConverter,
+		validatorPubkeyConverter,
+		shardCoordinator.SelfId(),
+	)
+	if err != nil {
+		return err
+	}
+
+	historyRepository, err := createHistoryRepository(
+		generalConfig.AddressTxHistory,
+		dataComponents.Store,
+		coreComponents.InternalMarshalizer,
+		shardCoordinator,
+	)
+	if err != nil {
+		return err
+	}
+
+	logEventsReader, err := logsIndex.NewLogEventsReader(logsIndex.ArgsLogEventsReader{
+		TxLogsStorer: dataComponents.Store.GetStorer(dataRetriever.TxLogsUnit),
+		Marshalizer:  coreComponents.InternalMarshalizer,
+	})
+	if err != nil {
+		return err
+	}
+
+	logsIdx, err := createLogsIndex(generalConfig.LogsIndex, dataComponents.Store, logEventsReader, coreComponents)
+	if err != nil {
+		return err
+	}
+
+	eventsHub, err := events.NewEventsHub(events.ArgsEventsHub{
+		PubkeyConverter:               addressPubkeyConverter,
+		LogEventsReader:               logEventsReader,
+		SubscriberBufferSize:          generalConfig.EventsHub.SubscriberBufferSize,
+		MaxSubscribers:                generalConfig.EventsHub.MaxSubscribers,
+		MaxSubscriptionsPerSubscriber: generalConfig.EventsHub.MaxSubscriptionsPerSubscriber,
+		MaxAddressesPerSubscription:   generalConfig.EventsHub.MaxAddressesPerSubscription,
+	})
+	if err != nil {
+		return err
 	}
+	epochStartNotifier.RegisterHandler(eventsHub.EpochStartEventHandler())
 
-	err = setServiceContainer(shardCoordinator, tpsBenchmark)
+	err = setServiceContainer(shardCoordinator, tpsBenchmark, historyRepository, eventsHub, logsIdx)
 	if err != nil {
 		return err
 	}
@@ -1257,6 +1473,33 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		processComponents.TxLogsProcessor.EnableLogToBeSavedInCache()
 	}
 
+	log.Trace("creating transactions pool journal")
+	txPoolJournal, err := createTxPoolJournal(
+		generalConfig.TxPoolJournal,
+		dataComponents,
+		stateComponents.AccountsAdapter,
+		coreComponents.InternalMarshalizer,
+		coreComponents.Hasher,
+		shardCoordinator,
+		economicsData,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("creating managed keys holder")
+	keysHandler, err := createKeysHandler(
+		ctx,
+		validatorPubkeyConverter,
+		suite,
+		cryptoParams.KeyGenerator,
+		cryptoParams.PrivateKey,
+	)
+	if err != nil {
+		return err
+	}
+	log.Info("managed validator keys", "num keys", len(keysHandler.ManagedPublicKeys()))
+
 	log.Trace("creating node structure")
 	currentNode, err := createNode(
 		generalConfig,
@@ -1285,6 +1528,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		whiteListerVerifiedTxs,
 		chanStopNodeProcess,
 		hardForkTrigger,
+		txPoolJournal,
+		keysHandler,
 	)
 	if err != nil {
 		return err
@@ -1303,6 +1548,17 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		indexValidatorsListIfNeeded(elasticIndexer, nodesCoordinator, processComponents.EpochStartTrigger.Epoch(), log)
 	}
 
+	evidenceVerifier, err := slash.NewEvidenceVerifier(slash.ArgsEvidenceVerifier{
+		Marshalizer:      coreComponents.InternalMarshalizer,
+		Hasher:           coreComponents.Hasher,
+		SigVerifier:      cryptoComponents.MessageSignVerifier,
+		NodesCoordinator: nodesCoordinator,
+		ChainID:          coreComponents.ChainID,
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating api resolver structure")
 	apiResolver, err := createApiResolver(
 		generalConfig,
@@ -1319,8 +1575,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		gasSchedule,
 		economicsData,
 		cryptoComponents.MessageSignVerifier,
+		evidenceVerifier,
 		genesisNodesConfig,
 		systemSCConfig,
+		triesComponents.TriesContainer.Get([]byte(trieFactory.UserAccountTrie)),
+		dataComponents.Datapool,
+		coreComponents.TxSignMarshalizer,
 	)
 	if err != nil {
 		return err
@@ -1391,7 +1651,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, txPoolJournal, dataComponents, triesComponents, networkComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1408,6 +1668,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	txPoolJournal io.Closer,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1417,6 +1678,16 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("saving the transactions pool journal...")
+	err = txPoolJournal.Close()
+	log.LogIfError(err)
+
+	if dbIndexer != nil {
+		log.Debug("closing the indexer...")
+		err = dbIndexer.Close()
+		log.LogIfError(err)
+	}
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1869,12 +2140,11 @@ func processDestinationShardAsObserver(prefsConfig config.PreferencesConfig) (ui
 	return uint32(val), err
 }
 
-// createElasticIndexer creates a new elasticIndexer where the server listens on the url,
-// authentication for the server is using the username and password
-func createElasticIndexer(
+// createIndexer creates the indexer drivers enabled in the external config. A nil value is returned if no driver is
+// enabled
+func createIndexer(
 	ctx *cli.Context,
-	elasticSearchConfig config.ElasticSearchConfig,
-	url string,
+	externalConfig *config.ExternalConfig,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
 	nodesCoordinator sharding.NodesCoordinator,
@@ -1883,27 +2153,27 @@ func createElasticIndexer(
 	validatorPubkeyConverter core.PubkeyConverter,
 	shardId uint32,
 ) (indexer.Indexer, error) {
-	arguments := indexer.ElasticIndexerArgs{
-		Url:                      url,
-		UserName:                 elasticSearchConfig.Username,
-		Password:                 elasticSearchConfig.Password,
+	arguments := indexer.ArgsIndexer{
+		ShardId:                  shardId,
 		Marshalizer:              marshalizer,
 		Hasher:                   hasher,
-		Options:                  &indexer.Options{TxIndexingEnabled: ctx.GlobalBoolT(enableTxIndexing.Name)},
-		NodesCoordinator:         nodesCoordinator,
 		EpochStartNotifier:       startNotifier,
+		NodesCoordinator:         nodesCoordinator,
 		AddressPubkeyConverter:   addressPubkeyConverter,
 		ValidatorPubkeyConverter: validatorPubkeyConverter,
-		ShardId:                  shardId,
+		Options:                  &indexer.Options{TxIndexingEnabled: ctx.GlobalBoolT(enableTxIndexing.Name)},
+		ExternalConfig:           *externalConfig,
 	}
 
-	var err error
-	dbIndexer, err = indexer.NewElasticIndexer(arguments)
+	dbIndexerDrivers, err := indexer.NewIndexer(arguments)
 	if err != nil {
 		return nil, err
 	}
+	if dbIndexerDrivers.IsNilIndexer() {
+		return nil, nil
+	}
 
-	return dbIndexer, nil
+	return dbIndexerDrivers, nil
 }
 
 func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sharding.Coordinator) (uint32, error) {
@@ -2041,6 +2311,8 @@ func createNode(
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	chanStopNodeProcess chan endProcess.ArgEndProcess,
 	hardForkTrigger node.HardforkTrigger,
+	txPoolJournal node.TxPoolJournal,
+	keysHandler node.KeysHandler,
 ) (*node.Node, error) {
 	var err error
 	var consensusGroupSize uint32
@@ -2135,6 +2407,9 @@ func createNode(
 		node.WithBootstrapRoundIndex(bootstrapRoundIndex),
 		node.WithAppStatusHandler(coreData.StatusHandler),
 		node.WithIndexer(indexer),
+		node.WithHistoryRepository(coreServiceContainer.HistoryRepository()),
+		node.WithEventsHub(coreServiceContainer.EventsHub()),
+		node.WithLogsIndex(coreServiceContainer.LogsIndex()),
 		node.WithEpochStartTrigger(process.EpochStartTrigger),
 		node.WithEpochStartEventNotifier(epochStartRegistrationHandler),
 		node.WithBlockBlackListHandler(process.BlackListHandler),
@@ -2161,6 +2436,8 @@ func createNode(
 		node.WithPeerHonestyHandler(peerHonestyHandler),
 		node.WithWatchdogTimer(watchdogTimer),
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
+		node.WithTxPoolJournal(txPoolJournal),
+		node.WithKeysHandler(keysHandler),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2237,10 +2514,92 @@ func initStatsFileMonitor(
 	return nil
 }
 
-func setServiceContainer(shardCoordinator sharding.Coordinator, tpsBenchmark *statistics.TpsBenchmark) error {
+func createHistoryRepository(
+	historyConfig config.AddressTxHistoryConfig,
+	store dataRetriever.StorageService,
+	marshalizer marshal.Marshalizer,
+	shardCoordinator sharding.Coordinator,
+) (history.HistoryRepository, error) {
+	if !historyConfig.Enabled {
+		return history.NewDisabledHistoryRepository(), nil
+	}
+
+	args := history.ArgsHistoryRepository{
+		Storer:                    store.GetStorer(dataRetriever.AddressTxHistoryUnit),
+		ResultsStorer:             store.GetStorer(dataRetriever.TransactionResultsUnit),
+		Marshalizer:               marshalizer,
+		ShardCoordinator:          shardCoordinator,
+		MaxTransactionsPerAddress: historyConfig.MaxTransactionsPerAddress,
+	}
+
+	return history.NewHistoryRepository(args)
+}
+
+func createLogsIndex(
+	logsIndexConfig config.LogsIndexConfig,
+	store dataRetriever.StorageService,
+	logEventsReader logsIndex.LogEventsReader,
+	coreComponents *mainFactory.CoreComponents,
+) (logsIndex.LogsIndex, error) {
+	if !logsIndexConfig.Enabled {
+		return logsIndex.NewDisabledLogsIndex(), nil
+	}
+
+	args := logsIndex.ArgsLogsIndex{
+		Storer:             store.GetStorer(dataRetriever.LogsIndexUnit),
+		LogEventsReader:    logEventsReader,
+		Marshalizer:        coreComponents.InternalMarshalizer,
+		Hasher:             coreComponents.Hasher,
+		Uint64Converter:    coreComponents.Uint64ByteSliceConverter,
+		MaxBlocksPerFilter: logsIndexConfig.MaxBlocksPerFilter,
+	}
+
+	return logsIndex.NewLogsIndex(args)
+}
+
+func createTxPoolJournal(
+	journalConfig config.TxPoolJournalConfig,
+	dataComponents *mainFactory.DataComponents,
+	accounts state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	shardCoordinator sharding.Coordinator,
+	feeHandler process.FeeHandler,
+) (journal.TxPoolJournal, error) {
+	if !journalConfig.Enabled {
+		return journal.NewDisabledTxPoolJournal(), nil
+	}
+
+	args := journal.ArgsTxPoolJournal{
+		Storer:           dataComponents.Store.GetStorer(dataRetriever.TxPoolJournalUnit),
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+		TxPool:           dataComponents.Datapool.Transactions(),
+		UnsignedTxPool:   dataComponents.Datapool.UnsignedTransactions(),
+		Store:            dataComponents.Store,
+		Accounts:         accounts,
+		ShardCoordinator: shardCoordinator,
+		FeeHandler:       feeHandler,
+		SaveInterval:     time.Duration(journalConfig.SaveIntervalInSeconds) * time.Second,
+	}
+
+	return journal.NewTxPoolJournal(args)
+}
+
+func setServiceContainer(
+	shardCoordinator sharding.Coordinator,
+	tpsBenchmark *statistics.TpsBenchmark,
+	historyRepository history.HistoryRepository,
+	eventsHub events.EventsHub,
+	logsIdx logsIndex.LogsIndex,
+) error {
 	var err error
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
-		coreServiceContainer, err = serviceContainer.NewServiceContainer(serviceContainer.WithIndexer(dbIndexer))
+		coreServiceContainer, err = serviceContainer.NewServiceContainer(
+			serviceContainer.WithIndexer(dbIndexer),
+			serviceContainer.WithHistoryRepository(historyRepository),
+			serviceContainer.WithEventsHub(eventsHub),
+			serviceContainer.WithLogsIndex(logsIdx))
 		if err != nil {
 			return err
 		}
@@ -2254,7 +2613,10 @@ func setServiceContainer(shardCoordinator sharding.Coordinator, tpsBenchmark *st
 		}
 		coreServiceContainer, err = serviceContainer.NewServiceContainer(
 			serviceContainer.WithIndexer(indexerToUse),
-			serviceContainer.WithTPSBenchmark(tpsBenchmark))
+			serviceContainer.WithTPSBenchmark(tpsBenchmark),
+			serviceContainer.WithHistoryRepository(historyRepository),
+			serviceContainer.WithEventsHub(eventsHub),
+			serviceContainer.WithLogsIndex(logsIdx))
 		if err != nil {
 			return err
 		}
@@ -2309,20 +2671,142 @@ func createApiResolver(
 	gasSchedule map[string]map[string]uint64,
 	economics *economics.EconomicsData,
 	messageSigVerifier vm.MessageSignVerifier,
+	evidenceVerifier vm.EvidenceVerifier,
 	nodesSetup sharding.GenesisNodesSetupHandler,
 	systemSCConfig *config.SystemSmartContractsConfig,
+	userAccountsTrie data.Trie,
+	dataPool dataRetriever.PoolsHolder,
+	txSignMarshalizer marshal.Marshalizer,
 ) (facade.ApiResolver, error) {
+	builtInFuncs, scQueryService, err := createScQueryService(
+		config,
+		accnts,
+		validatorAccounts,
+		pubkeyConv,
+		storageService,
+		blockChain,
+		marshalizer,
+		hasher,
+		uint64Converter,
+		shardCoordinator,
+		gasSchedule,
+		economics,
+		messageSigVerifier,
+		evidenceVerifier,
+		nodesSetup,
+		systemSCConfig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the queries on historical states recreate the state trie, so they need their own accounts adapter and VMs
+	historicalAccounts, err := state.NewAccountsDB(userAccountsTrie, hasher, marshalizer, stateFactory.NewAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	_, historicalScQueryService, err := createScQueryService(
+		config,
+		historicalAccounts,
+		validatorAccounts,
+		pubkeyConv,
+		storageService,
+		blockChain,
+		marshalizer,
+		hasher,
+		uint64Converter,
+		shardCoordinator,
+		gasSchedule,
+		economics,
+		messageSigVerifier,
+		evidenceVerifier,
+		nodesSetup,
+		systemSCConfig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	scQueryServiceWithHistory, err := smartContract.NewHistoricalSCQueryService(smartContract.ArgsHistoricalSCQueryService{
+		CurrentStateQueryService:    scQueryService,
+		HistoricalStateQueryService: historicalScQueryService,
+		HistoricalAccounts:          historicalAccounts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
+		PubkeyConverter:  pubkeyConv,
+		ShardCoordinator: shardCoordinator,
+		BuiltInFuncNames: builtInFuncs.Keys(),
+		ArgumentParser:   parsers.NewCallArgsParser(),
+	}
+	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	txCostHandler, err := transaction.NewTransactionCostEstimator(txTypeHandler, economics, scQueryService, gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	txSimulator, err := createTxSimulator(
+		config,
+		userAccountsTrie,
+		pubkeyConv,
+		storageService,
+		blockChain,
+		dataPool,
+		marshalizer,
+		txSignMarshalizer,
+		hasher,
+		uint64Converter,
+		shardCoordinator,
+		gasSchedule,
+		economics,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(scQueryServiceWithHistory, statusMetrics, txCostHandler, txSimulator)
+}
+
+// createScQueryService creates a SC query service whose virtual machines run on top of the provided accounts adapter
+func createScQueryService(
+	config *config.Config,
+	accnts state.AccountsAdapter,
+	validatorAccounts state.AccountsAdapter,
+	pubkeyConv core.PubkeyConverter,
+	storageService dataRetriever.StorageService,
+	blockChain data.ChainHandler,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	shardCoordinator sharding.Coordinator,
+	gasSchedule map[string]map[string]uint64,
+	economics *economics.EconomicsData,
+	messageSigVerifier vm.MessageSignVerifier,
+	evidenceVerifier vm.EvidenceVerifier,
+	nodesSetup sharding.GenesisNodesSetupHandler,
+	systemSCConfig *config.SystemSmartContractsConfig,
+) (process.BuiltInFunctionContainer, *smartContract.SCQueryService, error) {
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      marshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: shardCoordinator,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	argsHook := hooks.ArgBlockChainHook{
@@ -2347,9 +2831,10 @@ func createApiResolver(
 			marshalizer,
 			systemSCConfig,
 			validatorAccounts,
+			evidenceVerifier,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
 		vmFactory, err = shard.NewVMContainerFactory(
@@ -2358,16 +2843,114 @@ func createApiResolver(
 			gasSchedule,
 			argsHook)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	vmContainer, err := vmFactory.Create()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return builtInFuncs, scQueryService, nil
+}
+
+// createTxSimulator creates the transaction simulator which processes the transactions received on the
+// /transaction/simulate route. It has its own accounts adapter, virtual machines and processors so that the simulated
+// executions can not alter the state used by the block processor
+func createTxSimulator(
+	config *config.Config,
+	userAccountsTrie data.Trie,
+	pubkeyConv core.PubkeyConverter,
+	storageService dataRetriever.StorageService,
+	blockChain data.ChainHandler,
+	dataPool dataRetriever.PoolsHolder,
+	marshalizer marshal.Marshalizer,
+	txSignMarshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	shardCoordinator sharding.Coordinator,
+	gasSchedule map[string]map[string]uint64,
+	economics *economics.EconomicsData,
+) (external.TransactionSimulatorProcessor, error) {
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return txsimulator.NewDisabledTxSimulator(), nil
+	}
+
+	accounts, err := state.NewAccountsDB(userAccountsTrie, hasher, marshalizer, stateFactory.NewAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      marshalizer,
+		Accounts:         accounts,
+		ShardCoordinator: shardCoordinator,
+	}
+	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
+	if err != nil {
+		return nil, err
+	}
+
+	argsHook := hooks.ArgBlockChainHook{
+		Accounts:         accounts,
+		PubkeyConv:       pubkeyConv,
+		StorageService:   storageService,
+		BlockChain:       blockChain,
+		ShardCoordinator: shardCoordinator,
+		Marshalizer:      marshalizer,
+		Uint64Converter:  uint64Converter,
+		BuiltInFunctions: builtInFuncs,
+	}
+	vmFactory, err := shard.NewVMContainerFactory(
+		config.VirtualMachineConfig,
+		economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+		gasSchedule,
+		argsHook)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
+		shardCoordinator,
+		marshalizer,
+		hasher,
+		pubkeyConv,
+		storageService,
+		dataPool,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	interimProcContainer, err := interimProcFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	scForwarder, err := interimProcContainer.Get(dataBlock.SmartContractResultBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptTxInterim, err := interimProcContainer.Get(dataBlock.ReceiptBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	badTxInterim, err := interimProcContainer.Get(dataBlock.InvalidBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -2383,12 +2966,84 @@ func createApiResolver(
 		return nil, err
 	}
 
-	txCostHandler, err := transaction.NewTransactionCostEstimator(txTypeHandler, economics, scQueryService, gasSchedule)
+	gasHandler, err := preprocess.NewGasComputation(economics, txTypeHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	txFeeHandler, err := postprocess.NewFeeAccumulator()
+	if err != nil {
+		return nil, err
+	}
+
+	// the logs are kept only in memory as the simulated executions must not be persisted
+	txLogsProcessor, err := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      storageUnit.NewNilStorer(),
+		Marshalizer: marshalizer,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler)
+	argsParser := smartContract.NewArgumentParser()
+	argsNewScProcessor := smartContract.ArgsNewSmartContractProcessor{
+		VmContainer:      vmContainer,
+		ArgsParser:       argsParser,
+		Hasher:           hasher,
+		Marshalizer:      marshalizer,
+		AccountsDB:       accounts,
+		TempAccounts:     vmFactory.BlockChainHookImpl(),
+		PubkeyConv:       pubkeyConv,
+		Coordinator:      shardCoordinator,
+		ScrForwarder:     scForwarder,
+		TxFeeHandler:     txFeeHandler,
+		EconomicsFee:     economics,
+		GasHandler:       gasHandler,
+		BuiltInFunctions: vmFactory.BlockChainHookImpl().GetBuiltInFunctions(),
+		TxLogsProcessor:  txLogsProcessor,
+		TxTypeHandler:    txTypeHandler,
+	}
+	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	txProcessor, err := transaction.NewTxProcessor(
+		accounts,
+		hasher,
+		pubkeyConv,
+		marshalizer,
+		txSignMarshalizer,
+		shardCoordinator,
+		scProcessor,
+		txFeeHandler,
+		txTypeHandler,
+		economics,
+		receiptTxInterim,
+		badTxInterim,
+		argsParser,
+		scForwarder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	argsTxSimulator := txsimulator.ArgsTxSimulator{
+		TransactionProcessor:      txProcessor,
+		IntermediateProcContainer: interimProcContainer,
+		TxFeeHandler:              txFeeHandler,
+		GasHandler:                gasHandler,
+		AccountsAdapter:           accounts,
+		BlockChainHook:            vmFactory.BlockChainHookImpl(),
+		BlockChain:                blockChain,
+		TxLogsProcessor:           txLogsProcessor,
+		AddressPubkeyConverter:    pubkeyConv,
+		ShardCoordinator:          shardCoordinator,
+		Marshalizer:               marshalizer,
+		Hasher:                    hasher,
+	}
+
+	return txsimulator.NewTransactionSimulator(argsTxSimulator)
 }
 
 func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteListHandler, error) {
@@ -2398,3 +3053,27 @@ func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteLi
 	}
 	return interceptors.NewWhiteListDataVerifier(whiteListCacheVerified)
 }
+
+// registerTrieArchivesOnEpochStart makes the trie storage managers running in archive mode move the pruned trie nodes
+// in the archive partition of the current epoch
+func registerTrieArchivesOnEpochStart(
+	trieStorageManagers map[string]data.StorageManager,
+	currentEpoch uint32,
+	epochStartNotifier epochStart.RegistrationHandler,
+) {
+	for _, trieStorageManager := range trieStorageManagers {
+		archiveStorageManager, ok := trieStorageManager.(dataTrie.ArchiveStorageManager)
+		if !ok {
+			continue
+		}
+
+		archiveStorageManager.SetEpoch(currentEpoch)
+		epochStartNotifier.RegisterHandler(notifier.NewHandlerForEpochStart(
+			func(hdr data.HeaderHandler) {
+				archiveStorageManager.SetEpoch(hdr.GetEpoch())
+			},
+			func(_ data.HeaderHandler) {},
+			core.TrieArchiveOrder,
+		))
+	}
+}
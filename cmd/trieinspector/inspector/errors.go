@@ -0,0 +1,30 @@
+package inspector
+
+import "errors"
+
+// ErrNilDatabase signals that a nil database has been provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilTrieNodesFetcher signals that a nil trie nodes fetcher has been provided
+var ErrNilTrieNodesFetcher = errors.New("nil trie nodes fetcher")
+
+// ErrEmptyTopic signals that an empty topic has been provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrInvalidNumPeersToQuery signals that an invalid number of peers to query has been provided
+var ErrInvalidNumPeersToQuery = errors.New("invalid number of peers to query")
+
+// ErrNoPeersToQuery signals that the request could not be sent to any peer
+var ErrNoPeersToQuery = errors.New("no peers to query")
+
+// ErrRepairStalled signals that a repair round did not fetch any of the requested trie nodes
+var ErrRepairStalled = errors.New("trie repair stalled: no requested node was received")
@@ -0,0 +1,7 @@
+package inspector
+
+// TrieNodesFetcher defines a component able to fetch serialized trie nodes from other sources
+type TrieNodesFetcher interface {
+	FetchNodes(hashes [][]byte) (map[string][]byte, error)
+	IsInterfaceNil() bool
+}
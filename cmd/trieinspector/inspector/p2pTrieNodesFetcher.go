@@ -0,0 +1,227 @@
+package inspector
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// requestTopicSuffix is the suffix of the topics on which the resolvers wait for requests
+const requestTopicSuffix = "_REQUEST"
+
+// maxHashesPerRequest limits the size of a request, as a resolver answers with at most 256KB of nodes anyway
+const maxHashesPerRequest = 100
+
+// ArgsP2PTrieNodesFetcher holds the arguments needed to create a p2p trie nodes fetcher
+type ArgsP2PTrieNodesFetcher struct {
+	Messenger       p2p.Messenger
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	Topic           string
+	NumPeersToQuery int
+	RequestTimeout  time.Duration
+}
+
+type p2pTrieNodesFetcher struct {
+	messenger       p2p.Messenger
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	topic           string
+	numPeersToQuery int
+	requestTimeout  time.Duration
+	mutReceived     sync.Mutex
+	receivedNodes   map[string][]byte
+	chanReceived    chan struct{}
+}
+
+// NewP2PTrieNodesFetcher creates a fetcher that requests trie nodes from the resolvers of the connected peers, the
+// same way a syncing node does. The answers are received on the given topic
+func NewP2PTrieNodesFetcher(args ArgsP2PTrieNodesFetcher) (*p2pTrieNodesFetcher, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(args.Topic) == 0 {
+		return nil, ErrEmptyTopic
+	}
+	if args.NumPeersToQuery < 1 {
+		return nil, ErrInvalidNumPeersToQuery
+	}
+
+	fetcher := &p2pTrieNodesFetcher{
+		messenger:       args.Messenger,
+		marshalizer:     args.Marshalizer,
+		hasher:          args.Hasher,
+		topic:           args.Topic,
+		numPeersToQuery: args.NumPeersToQuery,
+		requestTimeout:  args.RequestTimeout,
+		receivedNodes:   make(map[string][]byte),
+		chanReceived:    make(chan struct{}, 1),
+	}
+
+	err := args.Messenger.RegisterMessageProcessor(args.Topic, fetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetcher, nil
+}
+
+// FetchNodes requests the given hashes from the connected peers and returns the nodes received until all of them
+// arrived or the request timeout expired. The returned nodes are keyed by their hash and may contain other nodes of
+// the requested sub-tries
+func (f *p2pTrieNodesFetcher) FetchNodes(hashes [][]byte) (map[string][]byte, error) {
+	fetchedNodes := make(map[string][]byte)
+	for len(hashes) > 0 {
+		numHashes := len(hashes)
+		if numHashes > maxHashesPerRequest {
+			numHashes = maxHashesPerRequest
+		}
+
+		nodes, err := f.fetchChunk(hashes[:numHashes])
+		if err != nil {
+			return nil, err
+		}
+
+		for hash, encodedNode := range nodes {
+			fetchedNodes[hash] = encodedNode
+		}
+		hashes = hashes[numHashes:]
+	}
+
+	return fetchedNodes, nil
+}
+
+func (f *p2pTrieNodesFetcher) fetchChunk(hashes [][]byte) (map[string][]byte, error) {
+	f.mutReceived.Lock()
+	f.receivedNodes = make(map[string][]byte)
+	f.mutReceived.Unlock()
+
+	err := f.sendRequest(hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.After(f.requestTimeout)
+	for {
+		select {
+		case <-f.chanReceived:
+			if f.receivedAll(hashes) {
+				return f.extractReceivedNodes(), nil
+			}
+		case <-timeout:
+			return f.extractReceivedNodes(), nil
+		}
+	}
+}
+
+func (f *p2pTrieNodesFetcher) sendRequest(hashes [][]byte) error {
+	buffHashes, err := f.marshalizer.Marshal(&batch.Batch{Data: hashes})
+	if err != nil {
+		return err
+	}
+
+	buff, err := f.marshalizer.Marshal(&dataRetriever.RequestData{
+		Type:  dataRetriever.HashArrayType,
+		Value: buffHashes,
+	})
+	if err != nil {
+		return err
+	}
+
+	requestTopic := f.topic + requestTopicSuffix
+	peers := f.messenger.ConnectedPeersOnTopic(requestTopic)
+	if len(peers) == 0 {
+		peers = f.messenger.ConnectedPeers()
+	}
+
+	numSent := 0
+	for _, idx := range rand.Perm(len(peers)) {
+		if numSent >= f.numPeersToQuery {
+			break
+		}
+
+		errSend := f.messenger.SendToConnectedPeer(requestTopic, buff, peers[idx])
+		if errSend != nil {
+			log.Trace("can not send trie nodes request", "peer", peers[idx].Pretty(), "error", errSend.Error())
+			continue
+		}
+		numSent++
+	}
+
+	if numSent == 0 {
+		return ErrNoPeersToQuery
+	}
+
+	return nil
+}
+
+func (f *p2pTrieNodesFetcher) receivedAll(hashes [][]byte) bool {
+	f.mutReceived.Lock()
+	defer f.mutReceived.Unlock()
+
+	for _, hash := range hashes {
+		_, ok := f.receivedNodes[string(hash)]
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *p2pTrieNodesFetcher) extractReceivedNodes() map[string][]byte {
+	f.mutReceived.Lock()
+	defer f.mutReceived.Unlock()
+
+	nodes := f.receivedNodes
+	f.receivedNodes = make(map[string][]byte)
+
+	return nodes
+}
+
+// ProcessReceivedMessage is called by the messenger for each answer received on the topic. The nodes are keyed by
+// their computed hash so that no peer can store a node under a hash it does not match
+func (f *p2pTrieNodesFetcher) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	b := &batch.Batch{}
+	err := f.marshalizer.Unmarshal(b, message.Data())
+	if err != nil {
+		return err
+	}
+
+	f.mutReceived.Lock()
+	for _, encodedNode := range b.Data {
+		f.receivedNodes[string(f.hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+	f.mutReceived.Unlock()
+
+	select {
+	case f.chanReceived <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Close unregisters the fetcher from the messenger
+func (f *p2pTrieNodesFetcher) Close() error {
+	return f.messenger.UnregisterMessageProcessor(f.topic)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (f *p2pTrieNodesFetcher) IsInterfaceNil() bool {
+	return f == nil
+}
@@ -0,0 +1,152 @@
+package inspector
+
+import (
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("trieinspector/inspector")
+
+// ArgsTrieInspector holds the arguments needed to create a trie inspector
+type ArgsTrieInspector struct {
+	Database       data.DBWriteCacher
+	Marshalizer    marshal.Marshalizer
+	Hasher         hashing.Hasher
+	CheckDataTries bool
+}
+
+type trieInspector struct {
+	database       data.DBWriteCacher
+	marshalizer    marshal.Marshalizer
+	hasher         hashing.Hasher
+	checkDataTries bool
+}
+
+// NewTrieInspector creates a component able to check and repair the tries stored in a database
+func NewTrieInspector(args ArgsTrieInspector) (*trieInspector, error) {
+	if check.IfNil(args.Database) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &trieInspector{
+		database:       args.Database,
+		marshalizer:    args.Marshalizer,
+		hasher:         args.Hasher,
+		checkDataTries: args.CheckDataTries,
+	}, nil
+}
+
+// Inspect walks the trie starting from the given root hash and reports its missing or corrupted nodes. If enabled,
+// the leaves are treated as user accounts and their data tries are checked as well
+func (ti *trieInspector) Inspect(rootHash []byte) (*trie.IntegrityReport, error) {
+	dataTriesRootHashes := make(map[string]struct{})
+	var leafHandler func(key []byte, value []byte)
+	if ti.checkDataTries {
+		leafHandler = func(key []byte, value []byte) {
+			account := &state.UserAccountData{}
+			err := ti.marshalizer.Unmarshal(account, value)
+			if err != nil {
+				log.Debug("leaf is not an user account", "key", key, "error", err.Error())
+				return
+			}
+
+			if len(account.RootHash) > 0 {
+				dataTriesRootHashes[string(account.RootHash)] = struct{}{}
+			}
+		}
+	}
+
+	report, err := trie.CheckIntegrity(ti.database, rootHash, ti.marshalizer, ti.hasher, leafHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	for dataTrieRootHash := range dataTriesRootHashes {
+		dataTrieReport, errCheck := trie.CheckIntegrity(ti.database, []byte(dataTrieRootHash), ti.marshalizer, ti.hasher, nil)
+		if errCheck != nil {
+			return nil, errCheck
+		}
+
+		report.Merge(dataTrieReport)
+	}
+
+	return report, nil
+}
+
+// Repair inspects the trie and saves in the database the missing or corrupted nodes received from the fetcher. It
+// stops when the trie is complete, when maxRounds fetches were done or when a fetch did not bring any requested node.
+// The returned report is the one of the last inspection
+func (ti *trieInspector) Repair(rootHash []byte, fetcher TrieNodesFetcher, maxRounds int) (*trie.IntegrityReport, error) {
+	if check.IfNil(fetcher) {
+		return nil, ErrNilTrieNodesFetcher
+	}
+
+	for round := 0; ; round++ {
+		report, err := ti.Inspect(rootHash)
+		if err != nil {
+			return nil, err
+		}
+		if report.IsComplete() || round >= maxRounds {
+			return report, nil
+		}
+
+		hashesToFetch := make([][]byte, 0, len(report.MissingHashes)+len(report.CorruptedHashes))
+		hashesToFetch = append(hashesToFetch, report.MissingHashes...)
+		hashesToFetch = append(hashesToFetch, report.CorruptedHashes...)
+
+		nodes, err := fetcher.FetchNodes(hashesToFetch)
+		if err != nil {
+			return report, err
+		}
+
+		numRequestedReceived := 0
+		for _, hash := range hashesToFetch {
+			if _, ok := nodes[string(hash)]; ok {
+				numRequestedReceived++
+			}
+		}
+
+		err = ti.saveNodes(nodes)
+		if err != nil {
+			return report, err
+		}
+
+		log.Info("trie repair round",
+			"round", round,
+			"num requested", len(hashesToFetch),
+			"num requested received", numRequestedReceived,
+			"num saved", len(nodes),
+		)
+
+		if numRequestedReceived == 0 {
+			return report, ErrRepairStalled
+		}
+	}
+}
+
+func (ti *trieInspector) saveNodes(nodes map[string][]byte) error {
+	for hash, encodedNode := range nodes {
+		err := ti.database.Put([]byte(hash), encodedNode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ti *trieInspector) IsInterfaceNil() bool {
+	return ti == nil
+}
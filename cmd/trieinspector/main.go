@@ -0,0 +1,578 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/trieinspector/inspector"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/display"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	hashingFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultDBPath         = "db"
+	defaultEpochString    = "Epoch"
+	defaultShardString    = "Shard"
+	defaultStaticDbString = "Static"
+	waitForPeersTimeout   = time.Minute
+)
+
+type trieToInspect struct {
+	name           string
+	dbConfig       config.DBConfig
+	rootHash       []byte
+	topic          string
+	checkDataTries bool
+}
+
+type storageUnitOpener interface {
+	GetMostRecentBootstrapStorageUnit() (storage.Storer, error)
+	GetMostRecentShard() (string, error)
+	OpenStaticStorageUnit(dbConfig config.DBConfig, shardIDStr string) (storage.Storer, error)
+	OpenEpochStorageUnit(dbConfig config.DBConfig, epoch uint32, shardIDStr string) (storage.Storer, error)
+}
+
+var (
+	trieInspectorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// workingDirectory defines a flag for the path of the node's working directory, the one holding the db directory
+	workingDirectory = cli.StringFlag{
+		Name:  "working-directory",
+		Usage: "The node's working `directory`, the one containing the db directory. Defaults to the current directory",
+		Value: "",
+	}
+	// configurationFile defines a flag for the path to the node's main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name:  "config",
+		Usage: "The `[path]` for the node's main configuration file, used to locate the tries storage",
+		Value: "./config/config.toml",
+	}
+	// p2pConfigurationFile defines a flag for the path to the p2p toml configuration file used when repairing
+	p2pConfigurationFile = cli.StringFlag{
+		Name:  "p2p-config",
+		Usage: "The `[path]` for the p2p configuration file, used to connect to the network when repairing",
+		Value: "./config/p2p.toml",
+	}
+	// chainID defines a flag for the chain ID, as found in the db directory structure
+	chainID = cli.StringFlag{
+		Name:  "chain-id",
+		Usage: "The chain ID directory to be inspected. If not set, the only chain ID directory found in db is used",
+		Value: "",
+	}
+	// accountsRootHash defines a flag for the root hash from which the accounts trie is checked
+	accountsRootHash = cli.StringFlag{
+		Name:  "accounts-root-hash",
+		Usage: "The hex encoded root hash of the accounts trie. If not set, the root hash of the last committed block is used",
+		Value: "",
+	}
+	// peerAccountsRootHash defines a flag for the root hash from which the peer accounts trie is checked
+	peerAccountsRootHash = cli.StringFlag{
+		Name: "peer-accounts-root-hash",
+		Usage: "The hex encoded root hash of the peer accounts trie, only used for the metachain. If not set, the " +
+			"validator statistics root hash of the last committed block is used",
+		Value: "",
+	}
+	// skipDataTries defines a flag that disables the check of the accounts data tries
+	skipDataTries = cli.BoolFlag{
+		Name:  "skip-data-tries",
+		Usage: "Boolean option for checking only the main tries, without the data tries of the accounts",
+	}
+	// repair defines a flag that enables fetching the missing or corrupted nodes from the network
+	repair = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Boolean option for fetching only the missing or corrupted trie nodes from the connected peers and saving them",
+	}
+	// numPeersToQuery defines a flag for the number of peers each repair request is sent to
+	numPeersToQuery = cli.IntFlag{
+		Name:  "num-peers-to-query",
+		Usage: "The number of peers each repair request is sent to",
+		Value: 3,
+	}
+	// requestTimeout defines a flag for the time to wait for the answers of a repair request
+	requestTimeout = cli.IntFlag{
+		Name:  "request-timeout",
+		Usage: "The number of seconds to wait for the answers of a repair request",
+		Value: 10,
+	}
+	// maxRepairRounds defines a flag for the maximum number of fetch rounds of a repair
+	maxRepairRounds = cli.IntFlag{
+		Name:  "max-repair-rounds",
+		Usage: "The maximum number of fetch rounds done for each trie. Each round fetches the nodes found missing by the previous check",
+		Value: 100,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	errIncompleteTries = errors.New("tries with missing or corrupted nodes found")
+
+	log = logger.GetOrCreate("trieinspector")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = trieInspectorHelpTemplate
+	app.Name = "Trie Inspector Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary checks the accounts and peer accounts tries stored by a stopped node, reports the missing " +
+		"or corrupted nodes and can fetch only those nodes from the network"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		p2pConfigurationFile,
+		chainID,
+		accountsRootHash,
+		peerAccountsRootHash,
+		skipDataTries,
+		repair,
+		numPeersToQuery,
+		requestTimeout,
+		maxRepairRounds,
+		logLevel,
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startInspector(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startInspector(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := factoryMarshalizer.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return fmt.Errorf("error creating marshalizer: %w", err)
+	}
+	hasher, err := hashingFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return fmt.Errorf("error creating hasher: %w", err)
+	}
+
+	workingDir := ctx.GlobalString(workingDirectory.Name)
+	if len(workingDir) == 0 {
+		workingDir, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+
+	chainIDValue := ctx.GlobalString(chainID.Name)
+	if len(chainIDValue) == 0 {
+		chainIDValue, err = detectChainID(workingDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	unitOpener, err := createUnitOpener(*generalConfig, marshalizer, hasher, workingDir, chainIDValue)
+	if err != nil {
+		return err
+	}
+
+	shardIDStr, err := unitOpener.GetMostRecentShard()
+	if err != nil {
+		return err
+	}
+	log.Info("inspecting storage", "working directory", workingDir, "chain ID", chainIDValue, "shard", shardIDStr)
+
+	tries, err := createTriesToInspect(ctx, *generalConfig, marshalizer, unitOpener, shardIDStr)
+	if err != nil {
+		return err
+	}
+
+	var messenger p2p.Messenger
+	if ctx.GlobalBool(repair.Name) {
+		messenger, err = createConnectedMessenger(ctx.GlobalString(p2pConfigurationFile.Name), marshalizer)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = messenger.Close()
+		}()
+	}
+
+	numIncompleteTries := 0
+	for _, trieInfo := range tries {
+		report, errInspect := inspectTrie(ctx, trieInfo, unitOpener, shardIDStr, marshalizer, hasher, messenger)
+		if errInspect != nil {
+			log.Error("trie inspection failed", "trie", trieInfo.name, "error", errInspect.Error())
+		}
+		if report == nil {
+			numIncompleteTries++
+			continue
+		}
+
+		displayReport(trieInfo, report)
+		if !report.IsComplete() {
+			numIncompleteTries++
+		}
+	}
+
+	if numIncompleteTries > 0 {
+		return fmt.Errorf("%w: %d out of %d", errIncompleteTries, numIncompleteTries, len(tries))
+	}
+
+	log.Info("all inspected tries are complete")
+
+	return nil
+}
+
+func detectChainID(workingDir string) (string, error) {
+	dbPath := filepath.Join(workingDir, defaultDBPath)
+	chainIDs, err := storageFactory.NewDirectoryReader().ListDirectoriesAsString(dbPath)
+	if err != nil {
+		return "", err
+	}
+	if len(chainIDs) != 1 {
+		return "", fmt.Errorf("found %d chain ID directories in %s, please provide the --%s flag", len(chainIDs), dbPath, chainID.Name)
+	}
+
+	return chainIDs[0], nil
+}
+
+func createUnitOpener(
+	generalConfig config.Config,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	workingDir string,
+	chainIDValue string,
+) (storageUnitOpener, error) {
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	latestStorageDataProvider, err := storageFactory.NewLatestDataProvider(storageFactory.ArgsLatestDataProvider{
+		GeneralConfig:         generalConfig,
+		Marshalizer:           marshalizer,
+		Hasher:                hasher,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       storageFactory.NewDirectoryReader(),
+		WorkingDir:            workingDir,
+		ChainID:               chainIDValue,
+		DefaultDBPath:         defaultDBPath,
+		DefaultEpochString:    defaultEpochString,
+		DefaultShardString:    defaultShardString,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return storageFactory.NewStorageUnitOpenHandler(storageFactory.ArgsNewOpenStorageUnits{
+		GeneralConfig:             generalConfig,
+		Marshalizer:               marshalizer,
+		BootstrapDataProvider:     bootstrapDataProvider,
+		LatestStorageDataProvider: latestStorageDataProvider,
+		WorkingDir:                workingDir,
+		ChainID:                   chainIDValue,
+		DefaultDBPath:             defaultDBPath,
+		DefaultEpochString:        defaultEpochString,
+		DefaultShardString:        defaultShardString,
+		DefaultStaticDbString:     defaultStaticDbString,
+	})
+}
+
+func createTriesToInspect(
+	ctx *cli.Context,
+	generalConfig config.Config,
+	marshalizer marshal.Marshalizer,
+	unitOpener storageUnitOpener,
+	shardIDStr string,
+) ([]trieToInspect, error) {
+	shardID, err := parseShardID(shardIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsRoot, err := hex.DecodeString(ctx.GlobalString(accountsRootHash.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%w for the accounts root hash", err)
+	}
+	peerAccountsRoot, err := hex.DecodeString(ctx.GlobalString(peerAccountsRootHash.Name))
+	if err != nil {
+		return nil, fmt.Errorf("%w for the peer accounts root hash", err)
+	}
+
+	isMetachain := shardID == core.MetachainShardId
+	needsLastHeader := len(accountsRoot) == 0 || (isMetachain && len(peerAccountsRoot) == 0)
+	if needsLastHeader {
+		lastHeader, errGet := getLastHeader(generalConfig, marshalizer, unitOpener, shardIDStr, isMetachain)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		log.Info("using the root hashes of the last committed block", "nonce", lastHeader.GetNonce(), "epoch", lastHeader.GetEpoch())
+		if len(accountsRoot) == 0 {
+			accountsRoot = lastHeader.GetRootHash()
+		}
+		if len(peerAccountsRoot) == 0 {
+			peerAccountsRoot = lastHeader.GetValidatorStatsRootHash()
+		}
+	}
+
+	tries := []trieToInspect{
+		{
+			name:           "accounts",
+			dbConfig:       generalConfig.AccountsTrieStorage.DB,
+			rootHash:       accountsRoot,
+			topic:          factory.AccountTrieNodesTopic + core.CommunicationIdentifierBetweenShards(shardID, core.MetachainShardId),
+			checkDataTries: !ctx.GlobalBool(skipDataTries.Name),
+		},
+	}
+	if isMetachain {
+		tries = append(tries, trieToInspect{
+			name:     "peer accounts",
+			dbConfig: generalConfig.PeerAccountsTrieStorage.DB,
+			rootHash: peerAccountsRoot,
+			topic:    factory.ValidatorTrieNodesTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId),
+		})
+	}
+
+	return tries, nil
+}
+
+func parseShardID(shardIDStr string) (uint32, error) {
+	if shardIDStr == core.GetShardIDString(core.MetachainShardId) {
+		return core.MetachainShardId, nil
+	}
+
+	shardID, err := strconv.ParseUint(shardIDStr, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w for the shard directory %s", err, shardIDStr)
+	}
+
+	return uint32(shardID), nil
+}
+
+func getLastHeader(
+	generalConfig config.Config,
+	marshalizer marshal.Marshalizer,
+	unitOpener storageUnitOpener,
+	shardIDStr string,
+	isMetachain bool,
+) (data.HeaderHandler, error) {
+	bootstrapUnit, err := unitOpener.GetMostRecentBootstrapStorageUnit()
+	if err != nil {
+		return nil, err
+	}
+	defer closeStorageUnit(bootstrapUnit)
+
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(marshalizer, bootstrapUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapData, err := bootStorer.Get(bootStorer.GetHighestRound())
+	if err != nil {
+		return nil, err
+	}
+
+	var header data.HeaderHandler = &block.Header{}
+	headersDBConfig := generalConfig.BlockHeaderStorage.DB
+	if isMetachain {
+		header = &block.MetaBlock{}
+		headersDBConfig = generalConfig.MetaBlockStorage.DB
+	}
+
+	headersUnit, err := unitOpener.OpenEpochStorageUnit(headersDBConfig, bootstrapData.LastHeader.Epoch, shardIDStr)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStorageUnit(headersUnit)
+
+	headerBytes, err := headersUnit.Get(bootstrapData.LastHeader.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func createConnectedMessenger(p2pConfigFile string, marshalizer marshal.Marshalizer) (p2p.Messenger, error) {
+	p2pConfig, err := core.LoadP2PConfig(p2pConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// the inspector does not know the shards of its peers, so it will not trim its connections by shard
+	p2pConfig.Sharding.Type = p2p.NilListSharder
+	messenger, err := libp2p.NewNetworkMessenger(libp2p.ArgsNetworkMessenger{
+		Marshalizer:   marshalizer,
+		ListenAddress: libp2p.ListenAddrWithIp4AndTcp,
+		P2pConfig:     *p2pConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = messenger.Bootstrap()
+	if err != nil {
+		_ = messenger.Close()
+		return nil, err
+	}
+
+	log.Info("waiting for peers...")
+	deadline := time.Now().Add(waitForPeersTimeout)
+	for len(messenger.ConnectedPeers()) == 0 {
+		if time.Now().After(deadline) {
+			_ = messenger.Close()
+			return nil, inspector.ErrNoPeersToQuery
+		}
+		time.Sleep(time.Second)
+	}
+	log.Info("connected to the network", "num peers", len(messenger.ConnectedPeers()))
+
+	return messenger, nil
+}
+
+func inspectTrie(
+	ctx *cli.Context,
+	trieInfo trieToInspect,
+	unitOpener storageUnitOpener,
+	shardIDStr string,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	messenger p2p.Messenger,
+) (*trie.IntegrityReport, error) {
+	log.Info("inspecting trie", "trie", trieInfo.name, "root hash", trieInfo.rootHash)
+
+	trieUnit, err := unitOpener.OpenStaticStorageUnit(trieInfo.dbConfig, shardIDStr)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStorageUnit(trieUnit)
+
+	trieInspector, err := inspector.NewTrieInspector(inspector.ArgsTrieInspector{
+		Database:       trieUnit,
+		Marshalizer:    marshalizer,
+		Hasher:         hasher,
+		CheckDataTries: trieInfo.checkDataTries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if check.IfNil(messenger) {
+		return trieInspector.Inspect(trieInfo.rootHash)
+	}
+
+	fetcher, err := inspector.NewP2PTrieNodesFetcher(inspector.ArgsP2PTrieNodesFetcher{
+		Messenger:       messenger,
+		Marshalizer:     marshalizer,
+		Hasher:          hasher,
+		Topic:           trieInfo.topic,
+		NumPeersToQuery: ctx.GlobalInt(numPeersToQuery.Name),
+		RequestTimeout:  time.Duration(ctx.GlobalInt(requestTimeout.Name)) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fetcher.Close()
+	}()
+
+	return trieInspector.Repair(trieInfo.rootHash, fetcher, ctx.GlobalInt(maxRepairRounds.Name))
+}
+
+func closeStorageUnit(unit storage.Storer) {
+	err := unit.Close()
+	if err != nil {
+		log.Warn("error closing storage unit", "error", err.Error())
+	}
+}
+
+func displayReport(trieInfo trieToInspect, report *trie.IntegrityReport) {
+	for _, hash := range report.MissingHashes {
+		log.Debug("missing trie node", "trie", trieInfo.name, "hash", hash)
+	}
+	for _, hash := range report.CorruptedHashes {
+		log.Debug("corrupted trie node", "trie", trieInfo.name, "hash", hash)
+	}
+
+	header := []string{"Trie", "Root hash", "Checked nodes", "Leaves", "Missing nodes", "Corrupted nodes"}
+	lines := []*display.LineData{
+		display.NewLineData(false, []string{
+			trieInfo.name,
+			hex.EncodeToString(trieInfo.rootHash),
+			strconv.Itoa(report.NumCheckedNodes),
+			strconv.Itoa(report.NumLeaves),
+			strconv.Itoa(len(report.MissingHashes)),
+			strconv.Itoa(len(report.CorruptedHashes)),
+		}),
+	}
+
+	tbl, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Warn("error displaying the report", "error", err.Error())
+		return
+	}
+
+	log.Info("\n" + tbl)
+}
@@ -2,13 +2,15 @@ package config
 
 // CacheConfig will map the json cache configuration
 type CacheConfig struct {
-	Name                 string
-	Type                 string
-	Capacity             uint32
-	SizePerSender        uint32
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Shards               uint32
+	Name                                 string
+	Type                                 string
+	Capacity                             uint32
+	SizePerSender                        uint32
+	SizeInBytes                          uint64
+	SizeInBytesPerSender                 uint32
+	Shards                               uint32
+	ScoreComputer                        string
+	MinGasPriceBumpPercentForReplacement uint32
 }
 
 //HeadersPoolConfig will map the headers cache configuration
@@ -113,6 +115,7 @@ type Config struct {
 	AccountsTrieStorage      StorageConfig
 	PeerAccountsTrieStorage  StorageConfig
 	TrieSnapshotDB           DBConfig
+	TrieArchiveDB            DBConfig
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
@@ -149,6 +152,10 @@ type Config struct {
 	Consensus           TypeConfig
 	StoragePruning      StoragePruningConfig
 	TxLogsStorage       StorageConfig
+	AddressTxHistory    AddressTxHistoryConfig
+	EventsHub           EventsHubConfig
+	TxPoolJournal       TxPoolJournalConfig
+	LogsIndex           LogsIndexConfig
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
@@ -170,6 +177,37 @@ type StoragePruningConfig struct {
 	NumActivePersisters uint64
 }
 
+// AddressTxHistoryConfig will hold the settings of the local index which maps addresses to their transactions
+type AddressTxHistoryConfig struct {
+	Enabled                   bool
+	MaxTransactionsPerAddress uint32
+	HistoryStorage            StorageConfig
+	TransactionResultsStorage StorageConfig
+}
+
+// TxPoolJournalConfig will hold the settings of the journal which persists the pending transactions across restarts
+type TxPoolJournalConfig struct {
+	Enabled               bool
+	SaveIntervalInSeconds uint32
+	Storage               StorageConfig
+}
+
+// LogsIndexConfig will hold the settings of the local index which keeps, for each block, a bloom filter over the
+// log events generated by its transactions
+type LogsIndexConfig struct {
+	Enabled            bool
+	MaxBlocksPerFilter uint64
+	IndexStorage       StorageConfig
+}
+
+// EventsHubConfig will hold the settings of the component which pushes the chain events to the websocket subscribers
+type EventsHubConfig struct {
+	SubscriberBufferSize          int
+	MaxSubscribers                int
+	MaxSubscriptionsPerSubscriber int
+	MaxAddressesPerSubscription   int
+}
+
 // ResourceStatsConfig will hold all resource stats settings
 type ResourceStatsConfig struct {
 	Enabled              bool
@@ -209,6 +247,7 @@ type StateTriesConfig struct {
 	CheckpointRoundsModulus     uint
 	AccountsStatePruningEnabled bool
 	PeerStatePruningEnabled     bool
+	ArchiveModeEnabled          bool
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
 }
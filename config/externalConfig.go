@@ -3,12 +3,37 @@ package config
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
+	SQLConnector           SQLConnectorConfig
+	JSONFileConnector      JSONFileConnectorConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
 type ElasticSearchConfig struct {
+	Enabled    bool
+	URL        string
+	Username   string
+	Password   string
+	RetryQueue ElasticSearchRetryQueueConfig
+}
+
+// ElasticSearchRetryQueueConfig will hold the configuration for the persistent queue of the failed elastic search
+// requests
+type ElasticSearchRetryQueueConfig struct {
+	Enabled                bool
+	DirectoryPath          string
+	RetryIntervalInSeconds uint32
+	MaxNumRequests         uint32
+}
+
+// SQLConnectorConfig will hold the configuration for the SQL indexer driver
+type SQLConnectorConfig struct {
+	Enabled        bool
+	DriverName     string
+	DataSourceName string
+}
+
+// JSONFileConnectorConfig will hold the configuration for the newline delimited JSON file indexer driver
+type JSONFileConnectorConfig struct {
 	Enabled  bool
-	URL      string
-	Username string
-	Password string
+	FilePath string
 }
@@ -192,6 +192,10 @@ func TestTomlExternalParser(t *testing.T) {
 	indexerURL := "url"
 	elasticUsername := "user"
 	elasticPassword := "pass"
+	retryQueuePath := "./IndexerRetryQueue"
+	sqlDriverName := "sqlite3"
+	sqlDataSourceName := "./indexer.sqlite"
+	jsonFilePath := "./indexer.ndjson"
 
 	cfgExternalExpected := ExternalConfig{
 		ElasticSearchConnector: ElasticSearchConfig{
@@ -199,6 +203,21 @@ func TestTomlExternalParser(t *testing.T) {
 			URL:      indexerURL,
 			Username: elasticUsername,
 			Password: elasticPassword,
+			RetryQueue: ElasticSearchRetryQueueConfig{
+				Enabled:                true,
+				DirectoryPath:          retryQueuePath,
+				RetryIntervalInSeconds: 10,
+				MaxNumRequests:         1000,
+			},
+		},
+		SQLConnector: SQLConnectorConfig{
+			Enabled:        true,
+			DriverName:     sqlDriverName,
+			DataSourceName: sqlDataSourceName,
+		},
+		JSONFileConnector: JSONFileConnectorConfig{
+			Enabled:  true,
+			FilePath: jsonFilePath,
 		},
 	}
 
@@ -207,7 +226,21 @@ func TestTomlExternalParser(t *testing.T) {
     Enabled = true
     URL = "` + indexerURL + `"
     Username = "` + elasticUsername + `"
-    Password = "` + elasticPassword + `"`
+    Password = "` + elasticPassword + `"
+    [ElasticSearchConnector.RetryQueue]
+        Enabled = true
+        DirectoryPath = "` + retryQueuePath + `"
+        RetryIntervalInSeconds = 10
+        MaxNumRequests = 1000
+
+[SQLConnector]
+    Enabled = true
+    DriverName = "` + sqlDriverName + `"
+    DataSourceName = "` + sqlDataSourceName + `"
+
+[JSONFileConnector]
+    Enabled = true
+    FilePath = "` + jsonFilePath + `"`
 
 	cfg := ExternalConfig{}
 
@@ -42,6 +42,7 @@ type commonMessenger struct {
 	shardCoordinator        sharding.Coordinator
 	peerSignatureHandler    crypto.PeerSignatureHandler
 	delayedBlockBroadcaster delayedBroadcaster
+	keysHandler             consensus.KeysHandler
 }
 
 // CommonMessengerArgs holds the arguments for creating commonMessenger instance
@@ -52,6 +53,7 @@ type CommonMessengerArgs struct {
 	PrivateKey                 crypto.PrivateKey
 	ShardCoordinator           sharding.Coordinator
 	PeerSignatureHandler       crypto.PeerSignatureHandler
+	KeysHandler                consensus.KeysHandler
 	HeadersSubscriber          consensus.HeadersPoolSubscriber
 	InterceptorsContainer      process.InterceptorsContainer
 	MaxDelayCacheSize          uint32
@@ -79,6 +81,9 @@ func checkCommonMessengerNilParameters(
 	if check.IfNil(args.PeerSignatureHandler) {
 		return spos.ErrNilPeerSignatureHandler
 	}
+	if check.IfNil(args.KeysHandler) {
+		return spos.ErrNilKeysHandler
+	}
 	if check.IfNil(args.InterceptorsContainer) {
 		return spos.ErrNilInterceptorsContainer
 	}
@@ -92,9 +97,15 @@ func checkCommonMessengerNilParameters(
 	return nil
 }
 
-// BroadcastConsensusMessage will send on consensus topic the consensus message
+// BroadcastConsensusMessage will send on consensus topic the consensus message, signed with the private key of the
+// message's public key when that key is hosted by the current node
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	privateKey, err := cm.getPrivateKey(message.PubKey)
+	if err != nil {
+		return err
+	}
+
+	signature, err := cm.peerSignatureHandler.GetPeerSignature(privateKey, message.OriginatorPid)
 	if err != nil {
 		return err
 	}
@@ -114,6 +125,14 @@ func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message)
 	return nil
 }
 
+func (cm *commonMessenger) getPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if !cm.keysHandler.IsKeyManagedByCurrentNode(pkBytes) {
+		return cm.privateKey, nil
+	}
+
+	return cm.keysHandler.GetPrivateKey(pkBytes)
+}
+
 // BroadcastMiniBlocks will send on miniblocks topic the cross-shard miniblocks
 func (cm *commonMessenger) BroadcastMiniBlocks(miniBlocks map[uint32][]byte) error {
 	for k, v := range miniBlocks {
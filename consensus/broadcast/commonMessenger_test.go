@@ -1,6 +1,7 @@
 package broadcast_test
 
 import (
+	"bytes"
 	"sync"
 	"testing"
 	"time"
@@ -48,6 +49,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&mock.KeysHandlerStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -76,6 +78,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&mock.KeysHandlerStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -83,6 +86,42 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_BroadcastConsensusMessageShouldSignWithTheManagedKey(t *testing.T) {
+	managedPubKey := []byte("managed pub key")
+	managedPrivateKey := &mock.PrivateKeyMock{}
+	var signingKey crypto.PrivateKey
+	singleSignerMock := &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			signingKey = private
+			return []byte("signature"), nil
+		},
+	}
+	keysHandler := &mock.KeysHandlerStub{
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return bytes.Equal(pkBytes, managedPubKey)
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return managedPrivateKey, nil
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {},
+		},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.PeerSignatureHandler{Signer: singleSignerMock},
+		keysHandler,
+	)
+
+	msg := &consensus.Message{PubKey: managedPubKey}
+	err := cm.BroadcastConsensusMessage(msg)
+	assert.Nil(t, err)
+	assert.True(t, signingKey == managedPrivateKey)
+}
+
 func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
@@ -102,6 +141,7 @@ func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&mock.KeysHandlerStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -157,6 +197,7 @@ func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&mock.KeysHandlerStub{},
 	)
 
 	metaMiniBlocks, metaTransactions := cm.ExtractMetaMiniBlocksAndTransactions(miniBlocks, transactions)
@@ -199,6 +240,7 @@ func TestCommonMessenger_BroadcastBlockData(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&mock.KeysHandlerStub{},
 	)
 
 	miniBlocks := map[uint32][]byte{0: []byte("mbs data1"), 1: []byte("mbs data2")}
@@ -177,6 +177,7 @@ func NewCommonMessenger(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	peerSigHandler crypto.PeerSignatureHandler,
+	keysHandler consensus.KeysHandler,
 ) (*commonMessenger, error) {
 
 	return &commonMessenger{
@@ -185,5 +186,6 @@ func NewCommonMessenger(
 		privateKey:           privateKey,
 		shardCoordinator:     shardCoordinator,
 		peerSignatureHandler: peerSigHandler,
+		keysHandler:          keysHandler,
 	}, nil
 }
@@ -52,6 +52,7 @@ func NewMetaChainMessenger(
 		shardCoordinator:        args.ShardCoordinator,
 		peerSignatureHandler:    args.PeerSignatureHandler,
 		delayedBlockBroadcaster: dbb,
+		keysHandler:             args.KeysHandler,
 	}
 
 	mcm := &metaChainMessenger{
@@ -42,6 +42,7 @@ func NewShardChainMessenger(
 		privateKey:           args.PrivateKey,
 		shardCoordinator:     args.ShardCoordinator,
 		peerSignatureHandler: args.PeerSignatureHandler,
+		keysHandler:          args.KeysHandler,
 	}
 
 	dbbArgs := &ArgsDelayedBlockBroadcaster{
@@ -68,6 +68,7 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
 			PeerSignatureHandler:       peerSigHandler,
+			KeysHandler:                &mock.KeysHandlerStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxDelayCacheSize:          1,
@@ -121,6 +122,15 @@ func TestShardChainMessenger_NewShardChainMessengerNilPeerSignatureHandlerShould
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilKeysHandlerShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.KeysHandler = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilKeysHandler, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerNilInterceptorsContainerShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	args.InterceptorsContainer = nil
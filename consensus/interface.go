@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
@@ -108,6 +109,19 @@ type PeerHonestyHandler interface {
 	IsInterfaceNil() bool
 }
 
+// KeysHandler defines the BLS keys hosted by the current node, for which it takes part in consensus
+type KeysHandler interface {
+	MainPublicKey() []byte
+	IsMultiKeyMode() bool
+	IsKeyManagedByCurrentNode(pkBytes []byte) bool
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	IncrementRoundsInConsensusGroup(pkBytes []byte)
+	IncrementRoundsAsLeader(pkBytes []byte)
+	IncrementBlocksProposed(pkBytes []byte)
+	IncrementSignaturesSent(pkBytes []byte)
+	IsInterfaceNil() bool
+}
+
 // InterceptorSubscriber can subscribe for notifications when data is received by an interceptor
 type InterceptorSubscriber interface {
 	RegisterHandler(handler func(toShard uint32, data []byte))
@@ -32,6 +32,7 @@ type ConsensusCoreMock struct {
 	epochStartNotifier     epochStart.RegistrationHandler
 	antifloodHandler       consensus.P2PAntifloodHandler
 	peerHonestyHandler     consensus.PeerHonestyHandler
+	keysHandler            consensus.KeysHandler
 }
 
 // GetAntiFloodHandler -
@@ -189,6 +190,16 @@ func (ccm *ConsensusCoreMock) PeerHonestyHandler() consensus.PeerHonestyHandler
 	return ccm.peerHonestyHandler
 }
 
+// KeysHandler -
+func (ccm *ConsensusCoreMock) KeysHandler() consensus.KeysHandler {
+	return ccm.keysHandler
+}
+
+// SetKeysHandler -
+func (ccm *ConsensusCoreMock) SetKeysHandler(keysHandler consensus.KeysHandler) {
+	ccm.keysHandler = keysHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
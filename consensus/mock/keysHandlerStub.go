@@ -0,0 +1,82 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// KeysHandlerStub -
+type KeysHandlerStub struct {
+	MainPublicKeyCalled                   func() []byte
+	IsMultiKeyModeCalled                  func() bool
+	IsKeyManagedByCurrentNodeCalled       func(pkBytes []byte) bool
+	GetPrivateKeyCalled                   func(pkBytes []byte) (crypto.PrivateKey, error)
+	IncrementRoundsInConsensusGroupCalled func(pkBytes []byte)
+	IncrementRoundsAsLeaderCalled         func(pkBytes []byte)
+	IncrementBlocksProposedCalled         func(pkBytes []byte)
+	IncrementSignaturesSentCalled         func(pkBytes []byte)
+}
+
+// MainPublicKey -
+func (khs *KeysHandlerStub) MainPublicKey() []byte {
+	if khs.MainPublicKeyCalled != nil {
+		return khs.MainPublicKeyCalled()
+	}
+	return nil
+}
+
+// IsMultiKeyMode -
+func (khs *KeysHandlerStub) IsMultiKeyMode() bool {
+	if khs.IsMultiKeyModeCalled != nil {
+		return khs.IsMultiKeyModeCalled()
+	}
+	return false
+}
+
+// IsKeyManagedByCurrentNode -
+func (khs *KeysHandlerStub) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	if khs.IsKeyManagedByCurrentNodeCalled != nil {
+		return khs.IsKeyManagedByCurrentNodeCalled(pkBytes)
+	}
+	return false
+}
+
+// GetPrivateKey -
+func (khs *KeysHandlerStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if khs.GetPrivateKeyCalled != nil {
+		return khs.GetPrivateKeyCalled(pkBytes)
+	}
+	return &PrivateKeyMock{}, nil
+}
+
+// IncrementRoundsInConsensusGroup -
+func (khs *KeysHandlerStub) IncrementRoundsInConsensusGroup(pkBytes []byte) {
+	if khs.IncrementRoundsInConsensusGroupCalled != nil {
+		khs.IncrementRoundsInConsensusGroupCalled(pkBytes)
+	}
+}
+
+// IncrementRoundsAsLeader -
+func (khs *KeysHandlerStub) IncrementRoundsAsLeader(pkBytes []byte) {
+	if khs.IncrementRoundsAsLeaderCalled != nil {
+		khs.IncrementRoundsAsLeaderCalled(pkBytes)
+	}
+}
+
+// IncrementBlocksProposed -
+func (khs *KeysHandlerStub) IncrementBlocksProposed(pkBytes []byte) {
+	if khs.IncrementBlocksProposedCalled != nil {
+		khs.IncrementBlocksProposedCalled(pkBytes)
+	}
+}
+
+// IncrementSignaturesSent -
+func (khs *KeysHandlerStub) IncrementSignaturesSent(pkBytes []byte) {
+	if khs.IncrementSignaturesSentCalled != nil {
+		khs.IncrementSignaturesSentCalled(pkBytes)
+	}
+}
+
+// IsInterfaceNil -
+func (khs *KeysHandlerStub) IsInterfaceNil() bool {
+	return khs == nil
+}
@@ -132,6 +132,7 @@ func InitConsensusCore() *ConsensusCoreMock {
 	antifloodHandler := &P2PAntifloodHandlerStub{}
 	headerPoolSubscriber := &HeadersCacherStub{}
 	peerHonestyHandler := &testscommon.PeerHonestyHandlerStub{}
+	keysHandler := &KeysHandlerStub{}
 
 	container := &ConsensusCoreMock{
 		blockChain:             blockChain,
@@ -152,6 +153,7 @@ func InitConsensusCore() *ConsensusCoreMock {
 		epochStartNotifier:     epochStartSubscriber,
 		antifloodHandler:       antifloodHandler,
 		peerHonestyHandler:     peerHonestyHandler,
+		keysHandler:            keysHandler,
 	}
 
 	return container
@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// SlashingDetectorStub -
+type SlashingDetectorStub struct {
+	AddProposedHeaderCalled  func(pubKey []byte, header data.HeaderHandler)
+	AddSignatureShareCalled  func(pubKey []byte, headerHash []byte, signatureShare []byte)
+	AddLeaderSignatureCalled func(pubKey []byte, headerHash []byte, aggregatedSignature []byte, pubKeysBitmap []byte, leaderSignature []byte)
+}
+
+// AddProposedHeader -
+func (sds *SlashingDetectorStub) AddProposedHeader(pubKey []byte, header data.HeaderHandler) {
+	if sds.AddProposedHeaderCalled != nil {
+		sds.AddProposedHeaderCalled(pubKey, header)
+	}
+}
+
+// AddSignatureShare -
+func (sds *SlashingDetectorStub) AddSignatureShare(pubKey []byte, headerHash []byte, signatureShare []byte) {
+	if sds.AddSignatureShareCalled != nil {
+		sds.AddSignatureShareCalled(pubKey, headerHash, signatureShare)
+	}
+}
+
+// AddLeaderSignature -
+func (sds *SlashingDetectorStub) AddLeaderSignature(
+	pubKey []byte,
+	headerHash []byte,
+	aggregatedSignature []byte,
+	pubKeysBitmap []byte,
+	leaderSignature []byte,
+) {
+	if sds.AddLeaderSignatureCalled != nil {
+		sds.AddLeaderSignatureCalled(pubKey, headerHash, aggregatedSignature, pubKeysBitmap, leaderSignature)
+	}
+}
+
+// IsInterfaceNil -
+func (sds *SlashingDetectorStub) IsInterfaceNil() bool {
+	return sds == nil
+}
@@ -97,6 +97,8 @@ func (sr *subroundBlock) doBlockJob() bool {
 		return false
 	}
 
+	sr.KeysHandler().IncrementBlocksProposed([]byte(sr.SelfPubKey()))
+
 	err = sr.SetSelfJobDone(sr.Current(), true)
 	if err != nil {
 		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
@@ -287,7 +289,12 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
 	hdr.SetPrevHash(prevHash)
 
-	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	privateKey, err := sr.SelfPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	randSeed, err := sr.SingleSigner().Sign(privateKey, prevRandSeed)
 	if err != nil {
 		return nil, err
 	}
@@ -388,7 +388,12 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, err
 	}
 
-	return sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	privateKey, err := sr.SelfPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sr.SingleSigner().Sign(privateKey, marshalizedHdr)
 }
 
 func (sr *subroundEndRound) updateMetricsForLeader() {
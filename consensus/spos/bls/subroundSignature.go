@@ -72,7 +72,7 @@ func (sr *subroundSignature) doSignatureJob() bool {
 		return false
 	}
 
-	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
+	signatureShare, err := sr.createSignatureShare(sr.SelfPubKey())
 	if err != nil {
 		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
 		return false
@@ -81,24 +81,7 @@ func (sr *subroundSignature) doSignatureJob() bool {
 	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
 
 	if !isSelfLeader {
-		//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
-		cnsMsg := consensus.NewConsensusMessage(
-			sr.GetData(),
-			signatureShare,
-			nil,
-			nil,
-			[]byte(sr.SelfPubKey()),
-			nil,
-			int(MtSignature),
-			sr.Rounder().Index(),
-			sr.ChainID(),
-			nil,
-			nil,
-			nil,
-			sr.CurrentPid(),
-		)
-
-		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+		err = sr.sendSignatureShare(signatureShare, sr.SelfPubKey())
 		if err != nil {
 			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
 			return false
@@ -106,6 +89,9 @@ func (sr *subroundSignature) doSignatureJob() bool {
 
 		log.Debug("step 2: signature has been sent")
 	}
+	sr.KeysHandler().IncrementSignaturesSent([]byte(sr.SelfPubKey()))
+
+	sr.doManagedKeysSignatureJob(isSelfLeader)
 
 	err = sr.SetSelfJobDone(sr.Current(), true)
 	if err != nil {
@@ -122,6 +108,91 @@ func (sr *subroundSignature) doSignatureJob() bool {
 	return true
 }
 
+// createSignatureShare signs the consensus data with the private key of the given public key. When the node hosts
+// more keys, the multi signer only knows the main key, so the share is created with the single signer, which gives
+// the same BLS signature, and stored at the index of the key
+func (sr *subroundSignature) createSignatureShare(pubKey string) ([]byte, error) {
+	if !sr.KeysHandler().IsMultiKeyMode() {
+		return sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
+	}
+
+	index, err := sr.ConsensusGroupIndex(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := sr.KeysHandler().GetPrivateKey([]byte(pubKey))
+	if err != nil {
+		return nil, err
+	}
+
+	signatureShare, err := sr.SingleSigner().Sign(privateKey, sr.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().StoreSignatureShare(uint16(index), signatureShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return signatureShare, nil
+}
+
+func (sr *subroundSignature) sendSignatureShare(signatureShare []byte, pubKey string) error {
+	//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.GetData(),
+		signatureShare,
+		nil,
+		nil,
+		[]byte(pubKey),
+		nil,
+		int(MtSignature),
+		sr.Rounder().Index(),
+		sr.ChainID(),
+		nil,
+		nil,
+		nil,
+		sr.CurrentPid(),
+	)
+
+	return sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+}
+
+// doManagedKeysSignatureJob signs the block with each of the other hosted keys from the consensus group. If the node
+// is the leader the signatures are stored directly, otherwise they are sent as any other validator would
+func (sr *subroundSignature) doManagedKeysSignatureJob(isSelfLeader bool) {
+	keysHandler := sr.KeysHandler()
+	if !keysHandler.IsMultiKeyMode() {
+		return
+	}
+
+	for _, pubKey := range sr.ConsensusGroup() {
+		if pubKey == sr.SelfPubKey() || !keysHandler.IsKeyManagedByCurrentNode([]byte(pubKey)) {
+			continue
+		}
+
+		signatureShare, err := sr.createSignatureShare(pubKey)
+		if err != nil {
+			log.Debug("doManagedKeysSignatureJob.createSignatureShare", "error", err.Error())
+			continue
+		}
+
+		if isSelfLeader {
+			err = sr.SetJobDone(pubKey, sr.Current(), true)
+		} else {
+			err = sr.sendSignatureShare(signatureShare, pubKey)
+		}
+		if err != nil {
+			log.Debug("doManagedKeysSignatureJob", "error", err.Error())
+			continue
+		}
+
+		keysHandler.IncrementSignaturesSent([]byte(pubKey))
+	}
+}
+
 // receivedSignature method is called when a signature is received through the signature channel.
 // If the signature is valid, than the jobDone map corresponding to the node which sent it,
 // is set on true for the subround Signature
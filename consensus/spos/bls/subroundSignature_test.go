@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -286,6 +287,96 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func createMultiKeyHandlerStub(managedKeys ...string) (*mock.KeysHandlerStub, map[string]int) {
+	signaturesSent := make(map[string]int)
+	keysHandler := &mock.KeysHandlerStub{
+		IsMultiKeyModeCalled: func() bool {
+			return true
+		},
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			for _, managedKey := range managedKeys {
+				if managedKey == string(pkBytes) {
+					return true
+				}
+			}
+			return false
+		},
+		IncrementSignaturesSentCalled: func(pkBytes []byte) {
+			signaturesSent[string(pkBytes)]++
+		},
+	}
+
+	return keysHandler, signaturesSent
+}
+
+func TestSubroundSignature_DoSignatureJobMultiKeyLeaderShouldStoreTheHostedKeysSignatures(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSigner := mock.InitMultiSignerMock()
+	multiSigner.CreateSignatureShareMock = func(msg []byte, bitmap []byte) ([]byte, error) {
+		assert.Fail(t, "the multi signer should not create the share in multi key mode")
+		return nil, nil
+	}
+	container.SetMultiSigner(multiSigner)
+	container.SetSingleSigner(&mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return []byte("signature"), nil
+		},
+	})
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			assert.Fail(t, "the leader should not broadcast signatures")
+			return nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+	consensusGroup := sr.ConsensusGroup()
+	sr.SetSelfPubKey(consensusGroup[0])
+	keysHandler, signaturesSent := createMultiKeyHandlerStub(consensusGroup[0], consensusGroup[2], consensusGroup[3])
+	container.SetKeysHandler(keysHandler)
+
+	r := sr.DoSignatureJob()
+
+	assert.True(t, r)
+	for _, index := range []int{0, 2, 3} {
+		isJobDone, _ := sr.JobDone(consensusGroup[index], bls.SrSignature)
+		assert.True(t, isJobDone)
+		signatureShare, _ := multiSigner.SignatureShare(uint16(index))
+		assert.Equal(t, []byte("signature"), signatureShare)
+	}
+	isJobDone, _ := sr.JobDone(consensusGroup[1], bls.SrSignature)
+	assert.False(t, isJobDone)
+	assert.Equal(t, map[string]int{consensusGroup[0]: 1, consensusGroup[2]: 1, consensusGroup[3]: 1}, signaturesSent)
+}
+
+func TestSubroundSignature_DoSignatureJobMultiKeyValidatorShouldSendOneSignaturePerHostedKey(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sentPubKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			sentPubKeys = append(sentPubKeys, string(message.PubKey))
+			return nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+	consensusGroup := sr.ConsensusGroup()
+	keysHandler, signaturesSent := createMultiKeyHandlerStub(sr.SelfPubKey(), consensusGroup[4])
+	container.SetKeysHandler(keysHandler)
+
+	r := sr.DoSignatureJob()
+
+	assert.True(t, r)
+	assert.Equal(t, []string{sr.SelfPubKey(), consensusGroup[4]}, sentPubKeys)
+	assert.Equal(t, map[string]int{sr.SelfPubKey(): 1, consensusGroup[4]: 1}, signaturesSent)
+	isJobDone, _ := sr.JobDone(consensusGroup[4], bls.SrSignature)
+	assert.False(t, isJobDone)
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
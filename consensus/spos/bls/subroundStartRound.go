@@ -124,6 +124,9 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		return false
 	}
 
+	sr.selectSelfPubKey(leader)
+	sr.updateManagedKeysMetrics(leader)
+
 	msg := ""
 	if leader == sr.SelfPubKey() {
 		sr.AppStatusHandler().Increment(core.MetricCountLeader)
@@ -180,6 +183,43 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	return true
 }
 
+// selectSelfPubKey chooses, when the node hosts more keys, the one it acts for in the current round: the leader if it
+// is hosted, otherwise the main key if it is in the consensus group, otherwise the first hosted key of the group. The
+// other hosted keys of the group only sign the block in the signature subround
+func (sr *subroundStartRound) selectSelfPubKey(leader string) {
+	keysHandler := sr.KeysHandler()
+	if !keysHandler.IsMultiKeyMode() {
+		return
+	}
+
+	mainPubKey := string(keysHandler.MainPublicKey())
+	selfPubKey := mainPubKey
+	if keysHandler.IsKeyManagedByCurrentNode([]byte(leader)) {
+		selfPubKey = leader
+	} else if !sr.IsNodeInConsensusGroup(mainPubKey) {
+		for _, pubKey := range sr.ConsensusGroup() {
+			if keysHandler.IsKeyManagedByCurrentNode([]byte(pubKey)) {
+				selfPubKey = pubKey
+				break
+			}
+		}
+	}
+
+	sr.SetSelfPubKey(selfPubKey)
+}
+
+func (sr *subroundStartRound) updateManagedKeysMetrics(leader string) {
+	keysHandler := sr.KeysHandler()
+	for _, pubKey := range sr.ConsensusGroup() {
+		if keysHandler.IsKeyManagedByCurrentNode([]byte(pubKey)) {
+			keysHandler.IncrementRoundsInConsensusGroup([]byte(pubKey))
+		}
+	}
+	if keysHandler.IsKeyManagedByCurrentNode([]byte(leader)) {
+		keysHandler.IncrementRoundsAsLeader([]byte(leader))
+	}
+}
+
 func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	if check.IfNil(sr.indexer) {
 		return
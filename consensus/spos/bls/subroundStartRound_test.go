@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func defaultSubroundStartRoundFromSubround(sr *spos.Subround) (bls.SubroundStartRound, error) {
@@ -438,3 +439,90 @@ func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing
 
 	assert.Equal(t, err, err2)
 }
+
+func TestSubroundStartRound_InitCurrentRoundMultiKeyShouldActForAHostedKeyOfTheGroup(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(&mock.BootstrapperMock{
+		GetNodeStateCalled: func() core.NodeState {
+			return core.NsSynchronized
+		},
+	})
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+	_ = srStartRound.GenerateNextConsensusGroup(0)
+	consensusGroup := srStartRound.ConsensusGroup()
+	require.True(t, len(consensusGroup) > 3)
+
+	managedKeys := map[string]struct{}{
+		consensusGroup[2]: {},
+		consensusGroup[3]: {},
+	}
+	roundsInConsensusGroup := make(map[string]int)
+	roundsAsLeader := 0
+	container.SetKeysHandler(&mock.KeysHandlerStub{
+		MainPublicKeyCalled: func() []byte {
+			return []byte("main key not in consensus group")
+		},
+		IsMultiKeyModeCalled: func() bool {
+			return true
+		},
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			_, ok := managedKeys[string(pkBytes)]
+			return ok
+		},
+		IncrementRoundsInConsensusGroupCalled: func(pkBytes []byte) {
+			roundsInConsensusGroup[string(pkBytes)]++
+		},
+		IncrementRoundsAsLeaderCalled: func(pkBytes []byte) {
+			roundsAsLeader++
+		},
+	})
+
+	r := srStartRound.InitCurrentRound()
+
+	assert.True(t, r)
+	assert.Equal(t, consensusGroup[2], srStartRound.SelfPubKey())
+	assert.Equal(t, map[string]int{consensusGroup[2]: 1, consensusGroup[3]: 1}, roundsInConsensusGroup)
+	assert.Equal(t, 0, roundsAsLeader)
+}
+
+func TestSubroundStartRound_InitCurrentRoundMultiKeyShouldActForTheHostedLeader(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(&mock.BootstrapperMock{
+		GetNodeStateCalled: func() core.NodeState {
+			return core.NsSynchronized
+		},
+	})
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+	_ = srStartRound.GenerateNextConsensusGroup(0)
+	consensusGroup := srStartRound.ConsensusGroup()
+	mainKey := consensusGroup[1]
+	leader := consensusGroup[0]
+
+	roundsAsLeader := 0
+	container.SetKeysHandler(&mock.KeysHandlerStub{
+		MainPublicKeyCalled: func() []byte {
+			return []byte(mainKey)
+		},
+		IsMultiKeyModeCalled: func() bool {
+			return true
+		},
+		IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == mainKey || string(pkBytes) == leader
+		},
+		IncrementRoundsAsLeaderCalled: func(pkBytes []byte) {
+			assert.Equal(t, leader, string(pkBytes))
+			roundsAsLeader++
+		},
+	})
+
+	r := srStartRound.InitCurrentRound()
+
+	assert.True(t, r)
+	assert.Equal(t, leader, srStartRound.SelfPubKey())
+	assert.True(t, srStartRound.IsSelfLeaderInCurrentRound())
+	assert.Equal(t, 1, roundsAsLeader)
+}
@@ -32,6 +32,7 @@ type ConsensusCore struct {
 	epochStartRegistrationHandler epochStart.RegistrationHandler
 	antifloodHandler              consensus.P2PAntifloodHandler
 	peerHonestyHandler            consensus.PeerHonestyHandler
+	keysHandler                   consensus.KeysHandler
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -53,6 +54,7 @@ type ConsensusCoreArgs struct {
 	EpochStartRegistrationHandler epochStart.RegistrationHandler
 	AntifloodHandler              consensus.P2PAntifloodHandler
 	PeerHonestyHandler            consensus.PeerHonestyHandler
+	KeysHandler                   consensus.KeysHandler
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -77,6 +79,7 @@ func NewConsensusCore(
 		epochStartRegistrationHandler: args.EpochStartRegistrationHandler,
 		antifloodHandler:              args.AntifloodHandler,
 		peerHonestyHandler:            args.PeerHonestyHandler,
+		keysHandler:                   args.KeysHandler,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -172,6 +175,11 @@ func (cc *ConsensusCore) PeerHonestyHandler() consensus.PeerHonestyHandler {
 	return cc.peerHonestyHandler
 }
 
+// KeysHandler returns the handler of the BLS keys hosted by the current node
+func (cc *ConsensusCore) KeysHandler() consensus.KeysHandler {
+	return cc.keysHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -29,6 +29,7 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		EpochStartRegistrationHandler: consensusCoreMock.EpochStartRegistrationHandler(),
 		AntifloodHandler:              consensusCoreMock.GetAntiFloodHandler(),
 		PeerHonestyHandler:            consensusCoreMock.PeerHonestyHandler(),
+		KeysHandler:                   consensusCoreMock.KeysHandler(),
 	}
 	return args
 }
@@ -255,6 +256,20 @@ func TestConsensusCore_WithNilPeerHonestyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPeerHonestyHandler, err)
 }
 
+func TestConsensusCore_WithNilKeysHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.KeysHandler = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilKeysHandler, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
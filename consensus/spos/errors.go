@@ -160,6 +160,9 @@ var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
 // ErrNilPoolAdder signals that a nil pool adder has been provided
 var ErrNilPoolAdder = errors.New("nil pool adder")
 
+// ErrNilSlashingDetector signals that a nil slashing detector has been provided
+var ErrNilSlashingDetector = errors.New("nil slashing detector")
+
 // ErrNilHeaderSigVerifier signals that a nil header sig verifier has been provided
 var ErrNilHeaderSigVerifier = errors.New("nil header sig verifier")
 
@@ -202,6 +205,9 @@ var ErrInvalidCacheSize = errors.New("invalid cache size")
 // ErrNilPeerHonestyHandler signals that a nil peer honesty handler has been provided
 var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
 
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
 // ErrOriginatorMismatch signals that an original consensus message has been re-broadcast manually by another peer
 var ErrOriginatorMismatch = errors.New("consensus message originator mismatch")
 
@@ -50,6 +50,8 @@ type ConsensusCoreHandler interface {
 	SingleSigner() crypto.SingleSigner
 	// PeerHonestyHandler returns the peer honesty handler which will be used in subrounds
 	PeerHonestyHandler() consensus.PeerHonestyHandler
+	// KeysHandler returns the handler of the BLS keys hosted by the current node
+	KeysHandler() consensus.KeysHandler
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -130,6 +132,14 @@ type PoolAdder interface {
 	IsInterfaceNil() bool
 }
 
+// SlashingDetector collects the headers and signatures seen in the consensus in order to detect double signing
+type SlashingDetector interface {
+	AddProposedHeader(pubKey []byte, header data.HeaderHandler)
+	AddSignatureShare(pubKey []byte, headerHash []byte, signatureShare []byte)
+	AddLeaderSignature(pubKey []byte, headerHash []byte, aggregatedSignature []byte, pubKeysBitmap []byte, leaderSignature []byte)
+	IsInterfaceNil() bool
+}
+
 // RandSeedVerifier encapsulates methods that are check if header rand seed is correct
 type RandSeedVerifier interface {
 	VerifyRandSeed(header data.HeaderHandler) error
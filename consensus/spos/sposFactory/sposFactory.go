@@ -69,6 +69,7 @@ func GetBroadcastMessenger(
 	shardCoordinator sharding.Coordinator,
 	privateKey crypto.PrivateKey,
 	peerSignatureHandler crypto.PeerSignatureHandler,
+	keysHandler consensus.KeysHandler,
 	headersSubscriber consensus.HeadersPoolSubscriber,
 	interceptorsContainer process.InterceptorsContainer,
 ) (consensus.BroadcastMessenger, error) {
@@ -80,6 +81,7 @@ func GetBroadcastMessenger(
 		PrivateKey:                 privateKey,
 		ShardCoordinator:           shardCoordinator,
 		PeerSignatureHandler:       peerSignatureHandler,
+		KeysHandler:                keysHandler,
 		HeadersSubscriber:          headersSubscriber,
 		MaxDelayCacheSize:          maxDelayCacheSize,
 		MaxValidatorDelayCacheSize: maxDelayCacheSize,
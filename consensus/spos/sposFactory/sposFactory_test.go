@@ -141,6 +141,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&mock.KeysHandlerStub{},
 		headersSubscriber,
 		interceptosContainer,
 	)
@@ -170,6 +171,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&mock.KeysHandlerStub{},
 		headersSubscriber,
 		interceptosContainer,
 	)
@@ -195,6 +197,7 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 		shardCoord,
 		nil,
 		nil,
+		nil,
 		headersSubscriber,
 		interceptosContainer,
 	)
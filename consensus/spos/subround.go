@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -203,6 +204,15 @@ func (sr *Subround) AppStatusHandler() core.AppStatusHandler {
 	return sr.appStatusHandler
 }
 
+// SelfPrivateKey returns the private key of the public key the node acts for in the current round
+func (sr *Subround) SelfPrivateKey() (crypto.PrivateKey, error) {
+	if !sr.KeysHandler().IsMultiKeyMode() {
+		return sr.PrivateKey(), nil
+	}
+
+	return sr.KeysHandler().GetPrivateKey([]byte(sr.SelfPubKey()))
+}
+
 // ConsensusChannel method returns the consensus channel
 func (sr *Subround) ConsensusChannel() chan bool {
 	return sr.consensusStateChangedChannel
@@ -68,6 +68,7 @@ type Worker struct {
 
 	antifloodHandler consensus.P2PAntifloodHandler
 	poolAdder        PoolAdder
+	slashingDetector SlashingDetector
 
 	signatureSize       int
 	publicKeySize       int
@@ -96,6 +97,7 @@ type WorkerArgs struct {
 	NetworkShardingCollector consensus.NetworkShardingCollector
 	AntifloodHandler         consensus.P2PAntifloodHandler
 	PoolAdder                PoolAdder
+	SlashingDetector         SlashingDetector
 	SignatureSize            int
 	PublicKeySize            int
 }
@@ -128,6 +130,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
+		slashingDetector:         args.SlashingDetector,
 		signatureSize:            args.SignatureSize,
 		publicKeySize:            args.PublicKeySize,
 	}
@@ -218,6 +221,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PoolAdder) {
 		return ErrNilPoolAdder
 	}
+	if check.IfNil(args.SlashingDetector) {
+		return ErrNilSlashingDetector
+	}
 
 	return nil
 }
@@ -363,6 +369,17 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 
 	if wrk.consensusService.IsMessageWithSignature(msgType) {
 		wrk.doJobOnMessageWithSignature(cnsMsg)
+		wrk.slashingDetector.AddSignatureShare(cnsMsg.PubKey, cnsMsg.BlockHeaderHash, cnsMsg.SignatureShare)
+	}
+
+	if wrk.consensusService.IsMessageWithFinalInfo(msgType) {
+		wrk.slashingDetector.AddLeaderSignature(
+			cnsMsg.PubKey,
+			cnsMsg.BlockHeaderHash,
+			cnsMsg.AggregateSignature,
+			cnsMsg.PubKeysBitmap,
+			cnsMsg.LeaderSignature,
+		)
 	}
 
 	errNotCritical := wrk.checkSelfState(cnsMsg)
@@ -427,6 +444,7 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 	}
 
 	wrk.processReceivedHeaderMetric(cnsMsg)
+	wrk.slashingDetector.AddProposedHeader(cnsMsg.PubKey, header)
 
 	errNotCritical := wrk.forkDetector.AddHeader(header, headerHash, process.BHProposed, nil, nil)
 	if errNotCritical != nil {
@@ -2,6 +2,7 @@ package core
 
 import (
 	"bytes"
+	"encoding/binary"
 )
 
 // NumInitCharactersForScAddress numbers of characters for smart contract address identifier
@@ -17,6 +18,9 @@ const ShardIdentiferLen = 2
 const metaChainShardIdentifier uint8 = 255
 const numInitCharactersForOnMetachainSC = 15
 
+const esdtGlobalSettingsAddressLength = 32
+const esdtGlobalSettingsIdentifier = "esdtGlobalSettings"
+
 // IsSmartContractAddress verifies if a set address is of type smart contract
 func IsSmartContractAddress(rcvAddress []byte) bool {
 	if len(rcvAddress) <= NumInitCharactersForScAddress {
@@ -72,3 +76,13 @@ func IsSmartContractOnMetachain(identifier []byte, rcvAddress []byte) bool {
 		make([]byte, numInitCharactersForOnMetachainSC))
 	return isOnMetaChainSCAddress
 }
+
+// ESDTGlobalSettingsAddress returns the address of the account which holds the esdt global settings (e.g. the paused
+// tokens) in the given shard. The shard identifier is written in the last bytes so the address is routed to that shard
+func ESDTGlobalSettingsAddress(shardID uint32) []byte {
+	address := make([]byte, esdtGlobalSettingsAddressLength)
+	copy(address, esdtGlobalSettingsIdentifier)
+	binary.BigEndian.PutUint16(address[esdtGlobalSettingsAddressLength-ShardIdentiferLen:], uint16(shardID))
+
+	return address
+}
@@ -75,3 +75,16 @@ func TestIsSmartContractOnMetachain_ShouldReturnTrue(t *testing.T) {
 	scAddress, _ := hex.DecodeString("000000000000000000000000000000000000000000000000000000b51e0eb3a1")
 	assert.True(t, IsSmartContractOnMetachain(identifier, scAddress))
 }
+
+func TestESDTGlobalSettingsAddress(t *testing.T) {
+	t.Parallel()
+
+	for shardID := uint32(0); shardID < 3; shardID++ {
+		address := ESDTGlobalSettingsAddress(shardID)
+		assert.Equal(t, esdtGlobalSettingsAddressLength, len(address))
+		assert.False(t, IsSmartContractAddress(address))
+		assert.Equal(t, byte(shardID), address[len(address)-1])
+	}
+
+	assert.NotEqual(t, ESDTGlobalSettingsAddress(0), ESDTGlobalSettingsAddress(1))
+}
@@ -69,6 +69,9 @@ const ConsensusTopic = "consensus"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// SlashingEvidenceTopic is the topic used to broadcast the double signing evidences
+const SlashingEvidenceTopic = "slashingEvidence"
+
 // PathShardPlaceholder represents the placeholder for the shard ID in paths
 const PathShardPlaceholder = "[S]"
 
@@ -342,6 +345,10 @@ const BuiltInCost = "BuiltInCost"
 // MetaChainSystemSCsCost represents the field name for metachain system smart contract operation costs
 const MetaChainSystemSCsCost = "MetaChainSystemSCsCost"
 
+// RefundedGasReceiptData is the data of the receipt generated when the unused gas of a move balance transaction is
+// refunded to the sender
+const RefundedGasReceiptData = "refundedGas"
+
 // TransactionStatus is the type used to represent the status of a transaction
 type TransactionStatus string
 
@@ -352,6 +359,10 @@ const (
 	TxStatusPartiallyExecuted TransactionStatus = "partially-executed"
 	// TxStatusExecuted represents the status of a transaction which was received and executed
 	TxStatusExecuted TransactionStatus = "executed"
+	// TxStatusFailed represents the status of a transaction which was executed but whose smart contract call failed
+	TxStatusFailed TransactionStatus = "failed"
+	// TxStatusInvalid represents the status of a transaction which was included in a block as invalid
+	TxStatusInvalid TransactionStatus = "invalid"
 )
 
 const (
@@ -365,6 +376,10 @@ const (
 	NetworkShardingOrder
 	// IndexerOrder defines the order in which Indexer is notified of a start of epoch event
 	IndexerOrder
+	// EventsHubOrder defines the order in which the events hub is notified of a start of epoch event
+	EventsHubOrder
+	// TrieArchiveOrder defines the order in which the trie archives are notified of a start of epoch event
+	TrieArchiveOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -427,6 +442,24 @@ const BuiltInFunctionSaveKeyValue = "SaveKeyValue"
 // BuiltInFunctionESDTTransfer is the key for the elrond standard digital token transfer built-in function
 const BuiltInFunctionESDTTransfer = "ESDTTransfer"
 
+// BuiltInFunctionESDTBurn is the key for the elrond standard digital token burn built-in function
+const BuiltInFunctionESDTBurn = "ESDTBurn"
+
+// BuiltInFunctionESDTFreeze is the key for the elrond standard digital token freeze built-in function
+const BuiltInFunctionESDTFreeze = "ESDTFreeze"
+
+// BuiltInFunctionESDTUnFreeze is the key for the elrond standard digital token unfreeze built-in function
+const BuiltInFunctionESDTUnFreeze = "ESDTUnFreeze"
+
+// BuiltInFunctionESDTWipe is the key for the elrond standard digital token wipe built-in function
+const BuiltInFunctionESDTWipe = "ESDTWipe"
+
+// BuiltInFunctionESDTPause is the key for the elrond standard digital token pause built-in function
+const BuiltInFunctionESDTPause = "ESDTPause"
+
+// BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
+const BuiltInFunctionESDTUnPause = "ESDTUnPause"
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
@@ -445,6 +478,9 @@ const SleepTimeBetweenCreateDBRetries = 5 * time.Second
 // ElrondProtectedKeyPrefix is the key prefix which is protected from writing in the trie - only for special builtin functions
 const ElrondProtectedKeyPrefix = "ELROND"
 
+// ESDTKeyIdentifier is the key identifier following the protected prefix in the data trie keys holding ESDT balances
+const ESDTKeyIdentifier = "esdt"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
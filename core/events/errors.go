@@ -0,0 +1,44 @@
+package events
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidSubscriberBufferSize signals that an invalid subscriber buffer size has been provided
+var ErrInvalidSubscriberBufferSize = errors.New("invalid subscriber buffer size")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidMaxSubscriptionsPerSubscriber signals that an invalid maximum number of subscriptions per subscriber has
+// been provided
+var ErrInvalidMaxSubscriptionsPerSubscriber = errors.New("invalid maximum number of subscriptions per subscriber")
+
+// ErrInvalidMaxAddressesPerSubscription signals that an invalid maximum number of addresses per subscription has been
+// provided
+var ErrInvalidMaxAddressesPerSubscription = errors.New("invalid maximum number of addresses per subscription")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers is already registered
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrTooManySubscriptions signals that the subscriber already holds the maximum number of subscriptions
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
+
+// ErrTooManyAddresses signals that a subscription was requested for more addresses than allowed
+var ErrTooManyAddresses = errors.New("too many addresses in subscription")
+
+// ErrNilLogsFilter signals that a logs subscription was requested without a filter
+var ErrNilLogsFilter = errors.New("nil logs filter")
+
+// ErrUnknownTopic signals that a subscription was requested for an unknown topic
+var ErrUnknownTopic = errors.New("unknown topic")
+
+// ErrMissingAddress signals that a transactions subscription was requested without an address
+var ErrMissingAddress = errors.New("missing address")
+
+// ErrSubscriberClosed signals that the subscriber has been closed and can not be used anymore
+var ErrSubscriberClosed = errors.New("subscriber is closed")
+
+// ErrNilLogEventsReader signals that a nil log events reader has been provided
+var ErrNilLogEventsReader = errors.New("nil log events reader")
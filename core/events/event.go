@@ -0,0 +1,69 @@
+package events
+
+// Topic identifies a kind of events a subscriber can receive
+type Topic string
+
+const (
+	// TopicBlocks is the topic of the blocks committed by the node
+	TopicBlocks Topic = "blocks"
+	// TopicTransactions is the topic of the transactions, included in committed blocks, sent from or to an address
+	TopicTransactions Topic = "transactions"
+	// TopicEpochStart is the topic of the start of epoch events
+	TopicEpochStart Topic = "epochStart"
+	// TopicLogs is the topic of the smart contract log events, generated in committed blocks, which match a filter
+	TopicLogs Topic = "logs"
+)
+
+// Event is a notification pushed to a subscriber. Only the payload matching the topic is set
+type Event struct {
+	Topic       Topic             `json:"topic"`
+	Block       *BlockEvent       `json:"block,omitempty"`
+	Transaction *TransactionEvent `json:"transaction,omitempty"`
+	EpochStart  *EpochStartEvent  `json:"epochStart,omitempty"`
+	Log         *LogEvent         `json:"log,omitempty"`
+}
+
+// BlockEvent holds the details of a committed block
+type BlockEvent struct {
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	Shard     uint32 `json:"shard"`
+	Timestamp uint64 `json:"timestamp"`
+	NumTxs    uint32 `json:"numTxs"`
+}
+
+// TransactionEvent holds the details of a transaction included in a committed block. Address is the subscribed
+// address which matched the sender or the receiver of the transaction
+type TransactionEvent struct {
+	Hash       string `json:"hash"`
+	Address    string `json:"address"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Value      string `json:"value"`
+	Nonce      uint64 `json:"nonce"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+}
+
+// EpochStartEvent holds the details of the header which started a new epoch
+type EpochStartEvent struct {
+	Epoch uint32 `json:"epoch"`
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Shard uint32 `json:"shard"`
+}
+
+// LogEvent holds a smart contract log event generated in a committed block. LogAddress is the address of the log
+// holding the event, while the other binary fields are hex encoded
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	LogAddress string   `json:"logAddress"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data"`
+}
@@ -0,0 +1,298 @@
+package events
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+)
+
+var log = logger.GetOrCreate("core/events")
+
+// ArgsEventsHub holds the arguments needed to create an events hub
+type ArgsEventsHub struct {
+	PubkeyConverter               core.PubkeyConverter
+	LogEventsReader               logsIndex.LogEventsReader
+	SubscriberBufferSize          int
+	MaxSubscribers                int
+	MaxSubscriptionsPerSubscriber int
+	MaxAddressesPerSubscription   int
+}
+
+type eventsHub struct {
+	pubkeyConverter               core.PubkeyConverter
+	logEventsReader               logsIndex.LogEventsReader
+	subscriberBufferSize          int
+	maxSubscribers                int
+	maxSubscriptionsPerSubscriber int
+	maxAddressesPerSubscription   int
+	mutSubscribers                sync.RWMutex
+	subscribers                   map[uint64]*subscriber
+	lastSubscriberID              uint64
+}
+
+// NewEventsHub creates the component which pushes the committed blocks, the transactions touching given addresses,
+// the smart contract log events matching given filters and the start of epoch events to its subscribers
+func NewEventsHub(args ArgsEventsHub) (*eventsHub, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.LogEventsReader) {
+		return nil, ErrNilLogEventsReader
+	}
+	if args.SubscriberBufferSize < 1 {
+		return nil, ErrInvalidSubscriberBufferSize
+	}
+	if args.MaxSubscribers < 1 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+	if args.MaxSubscriptionsPerSubscriber < 1 {
+		return nil, ErrInvalidMaxSubscriptionsPerSubscriber
+	}
+	if args.MaxAddressesPerSubscription < 1 {
+		return nil, ErrInvalidMaxAddressesPerSubscription
+	}
+
+	return &eventsHub{
+		pubkeyConverter:               args.PubkeyConverter,
+		logEventsReader:               args.LogEventsReader,
+		subscriberBufferSize:          args.SubscriberBufferSize,
+		maxSubscribers:                args.MaxSubscribers,
+		maxSubscriptionsPerSubscriber: args.MaxSubscriptionsPerSubscriber,
+		maxAddressesPerSubscription:   args.MaxAddressesPerSubscription,
+		subscribers:                   make(map[uint64]*subscriber),
+	}, nil
+}
+
+// NewSubscriber creates and registers a new subscriber, initially subscribed to no topic. An error is returned if
+// the maximum number of subscribers is already registered
+func (eh *eventsHub) NewSubscriber() (Subscriber, error) {
+	eh.mutSubscribers.Lock()
+	defer eh.mutSubscribers.Unlock()
+
+	if len(eh.subscribers) >= eh.maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	eh.lastSubscriberID++
+	sub := newSubscriber(eh.lastSubscriberID, eh, eh.subscriberBufferSize)
+	eh.subscribers[sub.id] = sub
+
+	return sub, nil
+}
+
+func (eh *eventsHub) removeSubscriber(id uint64) {
+	eh.mutSubscribers.Lock()
+	delete(eh.subscribers, id)
+	eh.mutSubscribers.Unlock()
+}
+
+// HasSubscribers returns true if at least one subscriber is registered
+func (eh *eventsHub) HasSubscribers() bool {
+	eh.mutSubscribers.RLock()
+	defer eh.mutSubscribers.RUnlock()
+
+	return len(eh.subscribers) > 0
+}
+
+func (eh *eventsHub) getSubscribers() []*subscriber {
+	eh.mutSubscribers.RLock()
+	defer eh.mutSubscribers.RUnlock()
+
+	subscribers := make([]*subscriber, 0, len(eh.subscribers))
+	for _, sub := range eh.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+
+	return subscribers
+}
+
+// NotifyBlockCommitted pushes the committed block to the blocks subscribers, each of the given transactions to
+// the subscribers of its sender or receiver and each log event generated by the transactions to the subscribers
+// whose logs filter it matches
+func (eh *eventsHub) NotifyBlockCommitted(
+	headerHash []byte,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+) {
+	if check.IfNil(header) {
+		return
+	}
+
+	subscribers := eh.getSubscribers()
+	if len(subscribers) == 0 {
+		return
+	}
+
+	blockHash := hex.EncodeToString(headerHash)
+	blockEvent := &Event{
+		Topic: TopicBlocks,
+		Block: &BlockEvent{
+			Hash:      blockHash,
+			Nonce:     header.GetNonce(),
+			Round:     header.GetRound(),
+			Epoch:     header.GetEpoch(),
+			Shard:     header.GetShardID(),
+			Timestamp: header.GetTimeStamp(),
+			NumTxs:    header.GetTxCount(),
+		},
+	}
+
+	txHashes := make([]string, 0, len(txPool))
+	for txHash := range txPool {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	logEvents := eh.readLogEventsIfNeeded(subscribers, headerHash, header.GetNonce(), txHashes)
+
+	for _, sub := range subscribers {
+		if sub.isSubscribedToTopic(TopicBlocks) {
+			sub.push(blockEvent)
+		}
+
+		for _, txHash := range txHashes {
+			tx := txPool[txHash]
+			if check.IfNil(tx) {
+				continue
+			}
+
+			address, ok := sub.matchingAddress(tx.GetSndAddr(), tx.GetRcvAddr())
+			if !ok {
+				continue
+			}
+
+			sub.push(&Event{
+				Topic:       TopicTransactions,
+				Transaction: eh.createTransactionEvent([]byte(txHash), tx, address, blockHash, header.GetNonce()),
+			})
+		}
+
+		eh.pushMatchingLogEvents(sub, logEvents)
+	}
+}
+
+// readLogEventsIfNeeded reads the log events of the block only once, and only if a subscriber has a logs filter
+func (eh *eventsHub) readLogEventsIfNeeded(
+	subscribers []*subscriber,
+	headerHash []byte,
+	nonce uint64,
+	txHashes []string,
+) []*logsIndex.LogEvent {
+	for _, sub := range subscribers {
+		if sub.getLogsFilter() == nil {
+			continue
+		}
+
+		txHashesBytes := make([][]byte, 0, len(txHashes))
+		for _, txHash := range txHashes {
+			txHashesBytes = append(txHashesBytes, []byte(txHash))
+		}
+
+		return eh.logEventsReader.ReadLogEvents(headerHash, nonce, txHashesBytes)
+	}
+
+	return nil
+}
+
+func (eh *eventsHub) pushMatchingLogEvents(sub *subscriber, logEvents []*logsIndex.LogEvent) {
+	if len(logEvents) == 0 {
+		return
+	}
+
+	filter := sub.getLogsFilter()
+	if filter == nil {
+		return
+	}
+
+	for _, logEvent := range logEvents {
+		if filter.Matches(logEvent) {
+			sub.push(&Event{
+				Topic: TopicLogs,
+				Log:   eh.createLogEvent(logEvent),
+			})
+		}
+	}
+}
+
+func (eh *eventsHub) createLogEvent(logEvent *logsIndex.LogEvent) *LogEvent {
+	topics := make([]string, 0, len(logEvent.Topics))
+	for _, topic := range logEvent.Topics {
+		topics = append(topics, hex.EncodeToString(topic))
+	}
+
+	return &LogEvent{
+		TxHash:     hex.EncodeToString(logEvent.TxHash),
+		BlockHash:  hex.EncodeToString(logEvent.BlockHash),
+		BlockNonce: logEvent.BlockNonce,
+		LogAddress: eh.pubkeyConverter.Encode(logEvent.LogAddress),
+		Address:    hex.EncodeToString(logEvent.Address),
+		Identifier: hex.EncodeToString(logEvent.Identifier),
+		Topics:     topics,
+		Data:       hex.EncodeToString(logEvent.Data),
+	}
+}
+
+func (eh *eventsHub) createTransactionEvent(
+	txHash []byte,
+	tx data.TransactionHandler,
+	address []byte,
+	blockHash string,
+	blockNonce uint64,
+) *TransactionEvent {
+	value := "0"
+	if tx.GetValue() != nil {
+		value = tx.GetValue().String()
+	}
+
+	return &TransactionEvent{
+		Hash:       hex.EncodeToString(txHash),
+		Address:    eh.pubkeyConverter.Encode(address),
+		Sender:     eh.pubkeyConverter.Encode(tx.GetSndAddr()),
+		Receiver:   eh.pubkeyConverter.Encode(tx.GetRcvAddr()),
+		Value:      value,
+		Nonce:      tx.GetNonce(),
+		BlockHash:  blockHash,
+		BlockNonce: blockNonce,
+	}
+}
+
+// EpochStartEventHandler returns the handler which should be registered on the start of epoch notifier in order
+// to push the start of epoch events to the subscribers
+func (eh *eventsHub) EpochStartEventHandler() epochStart.ActionHandler {
+	return notifier.NewHandlerForEpochStart(eh.notifyEpochStart, func(_ data.HeaderHandler) {}, core.EventsHubOrder)
+}
+
+func (eh *eventsHub) notifyEpochStart(header data.HeaderHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	event := &Event{
+		Topic: TopicEpochStart,
+		EpochStart: &EpochStartEvent{
+			Epoch: header.GetEpoch(),
+			Nonce: header.GetNonce(),
+			Round: header.GetRound(),
+			Shard: header.GetShardID(),
+		},
+	}
+
+	for _, sub := range eh.getSubscribers() {
+		if sub.isSubscribedToTopic(TopicEpochStart) {
+			sub.push(event)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eh *eventsHub) IsInterfaceNil() bool {
+	return eh == nil
+}
@@ -0,0 +1,400 @@
+package events
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var alice = []byte("alice")
+var bob = []byte("bob")
+var carol = []byte("carol")
+
+func createMockArgsEventsHub() ArgsEventsHub {
+	return ArgsEventsHub{
+		PubkeyConverter:               mock.NewPubkeyConverterMock(32),
+		LogEventsReader:               &mock.LogEventsReaderStub{},
+		SubscriberBufferSize:          10,
+		MaxSubscribers:                10,
+		MaxSubscriptionsPerSubscriber: 10,
+		MaxAddressesPerSubscription:   10,
+	}
+}
+
+func createTxPool() map[string]data.TransactionHandler {
+	return map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: alice, RcvAddr: bob},
+		"tx2": &transaction.Transaction{Nonce: 2, Value: big.NewInt(20), SndAddr: bob, RcvAddr: carol},
+		"tx3": &transaction.Transaction{Nonce: 3, SndAddr: carol, RcvAddr: carol},
+	}
+}
+
+func readEvents(sub Subscriber) []*Event {
+	events := make([]*Event, 0)
+	for {
+		select {
+		case event := <-sub.Events():
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestNewEventsHub_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.PubkeyConverter = nil
+	hub, err := NewEventsHub(args)
+
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+}
+
+func TestNewEventsHub_NilLogEventsReaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.LogEventsReader = nil
+	hub, err := NewEventsHub(args)
+
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, ErrNilLogEventsReader, err)
+}
+
+func TestNewEventsHub_InvalidBufferSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.SubscriberBufferSize = 0
+	hub, err := NewEventsHub(args)
+
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, ErrInvalidSubscriberBufferSize, err)
+}
+
+func TestNewEventsHub_InvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.MaxSubscribers = 0
+	hub, err := NewEventsHub(args)
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, ErrInvalidMaxSubscribers, err)
+
+	args = createMockArgsEventsHub()
+	args.MaxSubscriptionsPerSubscriber = 0
+	hub, err = NewEventsHub(args)
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, ErrInvalidMaxSubscriptionsPerSubscriber, err)
+
+	args = createMockArgsEventsHub()
+	args.MaxAddressesPerSubscription = 0
+	hub, err = NewEventsHub(args)
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, ErrInvalidMaxAddressesPerSubscription, err)
+}
+
+func TestNewEventsHub_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hub, err := NewEventsHub(createMockArgsEventsHub())
+
+	assert.False(t, check.IfNil(hub))
+	assert.Nil(t, err)
+	assert.False(t, hub.HasSubscribers())
+}
+
+func TestEventsHub_NewSubscriberAndClose(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	sub, err := hub.NewSubscriber()
+	require.Nil(t, err)
+	assert.True(t, hub.HasSubscribers())
+
+	sub.Close()
+	sub.Close()
+	assert.False(t, hub.HasSubscribers())
+
+	_, isOpen := <-sub.Events()
+	assert.False(t, isOpen)
+	assert.Equal(t, ErrSubscriberClosed, sub.Subscribe(TopicBlocks, ""))
+}
+
+func TestEventsHub_SubscribeErrors(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	sub, _ := hub.NewSubscriber()
+
+	assert.Equal(t, ErrUnknownTopic, sub.Subscribe("unknown", ""))
+	assert.Equal(t, ErrMissingAddress, sub.Subscribe(TopicTransactions, ""))
+	assert.NotNil(t, sub.Subscribe(TopicTransactions, "not hex"))
+	assert.NotNil(t, sub.Subscribe(TopicLogs, "not hex"))
+	assert.Equal(t, ErrNilLogsFilter, sub.SubscribeLogs(nil))
+	assert.NotNil(t, sub.SubscribeLogs(&logsIndex.FilterRequest{Identifiers: []string{"not hex"}}))
+
+	sub.Close()
+	assert.Equal(t, ErrSubscriberClosed, sub.SubscribeLogs(&logsIndex.FilterRequest{}))
+}
+
+func TestEventsHub_NewSubscriberOverTheLimitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.MaxSubscribers = 1
+	hub, _ := NewEventsHub(args)
+
+	sub, err := hub.NewSubscriber()
+	require.Nil(t, err)
+
+	_, err = hub.NewSubscriber()
+	assert.Equal(t, ErrTooManySubscribers, err)
+
+	sub.Close()
+	_, err = hub.NewSubscriber()
+	assert.Nil(t, err)
+}
+
+func TestEventsHub_SubscribeOverTheLimitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.MaxSubscriptionsPerSubscriber = 3
+	hub, _ := NewEventsHub(args)
+	sub, _ := hub.NewSubscriber()
+
+	require.Nil(t, sub.Subscribe(TopicBlocks, ""))
+	require.Nil(t, sub.Subscribe(TopicTransactions, hex.EncodeToString(alice)))
+	require.Nil(t, sub.Subscribe(TopicLogs, ""))
+	assert.Equal(t, ErrTooManySubscriptions, sub.Subscribe(TopicTransactions, hex.EncodeToString(bob)))
+	assert.Equal(t, ErrTooManySubscriptions, sub.Subscribe(TopicEpochStart, ""))
+
+	// the existing subscriptions can be renewed or replaced
+	assert.Nil(t, sub.Subscribe(TopicBlocks, ""))
+	assert.Nil(t, sub.Subscribe(TopicTransactions, hex.EncodeToString(alice)))
+	assert.Nil(t, sub.SubscribeLogs(&logsIndex.FilterRequest{}))
+
+	require.Nil(t, sub.Unsubscribe(TopicTransactions, hex.EncodeToString(alice)))
+	assert.Nil(t, sub.Subscribe(TopicTransactions, hex.EncodeToString(bob)))
+}
+
+func TestEventsHub_SubscribeLogsWithTooManyAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.MaxAddressesPerSubscription = 1
+	hub, _ := NewEventsHub(args)
+	sub, _ := hub.NewSubscriber()
+
+	request := &logsIndex.FilterRequest{Addresses: []string{hex.EncodeToString(alice), hex.EncodeToString(bob)}}
+	assert.Equal(t, ErrTooManyAddresses, sub.SubscribeLogs(request))
+
+	request.Addresses = request.Addresses[:1]
+	assert.Nil(t, sub.SubscribeLogs(request))
+}
+
+func TestEventsHub_NotifyBlockCommittedShouldPushBlocksOnlyToTheirSubscribers(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	blocksSub, _ := hub.NewSubscriber()
+	_ = blocksSub.Subscribe(TopicBlocks, "")
+	otherSub, _ := hub.NewSubscriber()
+	_ = otherSub.Subscribe(TopicEpochStart, "")
+
+	header := &block.Header{Nonce: 7, Round: 8, Epoch: 2, ShardID: 1, TimeStamp: 1000, TxCount: 3}
+	hub.NotifyBlockCommitted([]byte("hash"), header, createTxPool())
+
+	events := readEvents(blocksSub)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, TopicBlocks, events[0].Topic)
+	assert.Equal(t, &BlockEvent{
+		Hash:      hex.EncodeToString([]byte("hash")),
+		Nonce:     7,
+		Round:     8,
+		Epoch:     2,
+		Shard:     1,
+		Timestamp: 1000,
+		NumTxs:    3,
+	}, events[0].Block)
+	assert.Equal(t, 0, len(readEvents(otherSub)))
+}
+
+func TestEventsHub_NotifyBlockCommittedShouldPushTransactionsOfSubscribedAddresses(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	sub, _ := hub.NewSubscriber()
+	_ = sub.Subscribe(TopicTransactions, hex.EncodeToString(bob))
+	_ = sub.Subscribe(TopicTransactions, hex.EncodeToString(carol))
+	_ = sub.Unsubscribe(TopicTransactions, hex.EncodeToString(carol))
+
+	hub.NotifyBlockCommitted([]byte("hash"), &block.Header{Nonce: 7}, createTxPool())
+
+	events := readEvents(sub)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, TopicTransactions, events[0].Topic)
+	assert.Equal(t, &TransactionEvent{
+		Hash:       hex.EncodeToString([]byte("tx1")),
+		Address:    hex.EncodeToString(bob),
+		Sender:     hex.EncodeToString(alice),
+		Receiver:   hex.EncodeToString(bob),
+		Value:      "10",
+		Nonce:      1,
+		BlockHash:  hex.EncodeToString([]byte("hash")),
+		BlockNonce: 7,
+	}, events[0].Transaction)
+	assert.Equal(t, hex.EncodeToString([]byte("tx2")), events[1].Transaction.Hash)
+	assert.Equal(t, hex.EncodeToString(bob), events[1].Transaction.Address)
+
+	_ = sub.Subscribe(TopicTransactions, hex.EncodeToString(carol))
+	hub.NotifyBlockCommitted([]byte("hash"), &block.Header{Nonce: 8}, map[string]data.TransactionHandler{
+		"tx3": &transaction.Transaction{Nonce: 3, SndAddr: carol, RcvAddr: carol},
+	})
+
+	events = readEvents(sub)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, "0", events[0].Transaction.Value)
+}
+
+func TestEventsHub_NotifyBlockCommittedShouldNotReadLogsWithoutLogsSubscribers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.LogEventsReader = &mock.LogEventsReaderStub{
+		ReadLogEventsCalled: func(_ []byte, _ uint64, _ [][]byte) []*logsIndex.LogEvent {
+			assert.Fail(t, "should have not read the log events")
+			return nil
+		},
+	}
+	hub, _ := NewEventsHub(args)
+	sub, _ := hub.NewSubscriber()
+	_ = sub.Subscribe(TopicBlocks, "")
+	_ = sub.Subscribe(TopicLogs, "")
+	_ = sub.Unsubscribe(TopicLogs, "")
+
+	hub.NotifyBlockCommitted([]byte("hash"), &block.Header{Nonce: 7}, createTxPool())
+
+	assert.Equal(t, 1, len(readEvents(sub)))
+}
+
+func TestEventsHub_NotifyBlockCommittedShouldPushMatchingLogEvents(t *testing.T) {
+	t.Parallel()
+
+	numReads := 0
+	args := createMockArgsEventsHub()
+	args.LogEventsReader = &mock.LogEventsReaderStub{
+		ReadLogEventsCalled: func(blockHash []byte, blockNonce uint64, txHashes [][]byte) []*logsIndex.LogEvent {
+			numReads++
+			assert.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}, txHashes)
+			return []*logsIndex.LogEvent{
+				{
+					TxHash:     []byte("tx1"),
+					BlockHash:  blockHash,
+					BlockNonce: blockNonce,
+					LogAddress: bob,
+					Address:    bob,
+					Identifier: []byte("transfer"),
+					Topics:     [][]byte{alice},
+					Data:       []byte("data"),
+				},
+				{
+					TxHash:     []byte("tx2"),
+					BlockHash:  blockHash,
+					BlockNonce: blockNonce,
+					LogAddress: carol,
+					Address:    carol,
+					Identifier: []byte("mint"),
+				},
+			}
+		},
+	}
+	hub, _ := NewEventsHub(args)
+	addressSub, _ := hub.NewSubscriber()
+	_ = addressSub.Subscribe(TopicLogs, hex.EncodeToString(carol))
+	filterSub, _ := hub.NewSubscriber()
+	_ = filterSub.SubscribeLogs(&logsIndex.FilterRequest{
+		Identifiers: []string{hex.EncodeToString([]byte("transfer"))},
+		Topics:      [][]string{{hex.EncodeToString(alice)}},
+	})
+
+	hub.NotifyBlockCommitted([]byte("hash"), &block.Header{Nonce: 7}, createTxPool())
+	assert.Equal(t, 1, numReads)
+
+	events := readEvents(filterSub)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, TopicLogs, events[0].Topic)
+	assert.Equal(t, &LogEvent{
+		TxHash:     hex.EncodeToString([]byte("tx1")),
+		BlockHash:  hex.EncodeToString([]byte("hash")),
+		BlockNonce: 7,
+		LogAddress: hex.EncodeToString(bob),
+		Address:    hex.EncodeToString(bob),
+		Identifier: hex.EncodeToString([]byte("transfer")),
+		Topics:     []string{hex.EncodeToString(alice)},
+		Data:       hex.EncodeToString([]byte("data")),
+	}, events[0].Log)
+
+	events = readEvents(addressSub)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, hex.EncodeToString([]byte("tx2")), events[0].Log.TxHash)
+}
+
+func TestEventsHub_NotifyShouldDropEventsWhenSubscriberIsBusy(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.SubscriberBufferSize = 2
+	hub, _ := NewEventsHub(args)
+	sub, _ := hub.NewSubscriber()
+	_ = sub.Subscribe(TopicBlocks, "")
+
+	for i := uint64(0); i < 5; i++ {
+		hub.NotifyBlockCommitted([]byte("hash"), &block.Header{Nonce: i}, nil)
+	}
+
+	events := readEvents(sub)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, uint64(0), events[0].Block.Nonce)
+	assert.Equal(t, uint64(1), events[1].Block.Nonce)
+}
+
+func TestEventsHub_EpochStartEventHandler(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	sub, _ := hub.NewSubscriber()
+	_ = sub.Subscribe(TopicEpochStart, "")
+
+	handler := hub.EpochStartEventHandler()
+	assert.Equal(t, uint32(core.EventsHubOrder), handler.NotifyOrder())
+
+	handler.EpochStartAction(&block.MetaBlock{Epoch: 3, Nonce: 100, Round: 101})
+
+	events := readEvents(sub)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, TopicEpochStart, events[0].Topic)
+	assert.Equal(t, &EpochStartEvent{
+		Epoch: 3,
+		Nonce: 100,
+		Round: 101,
+		Shard: core.MetachainShardId,
+	}, events[0].EpochStart)
+
+	_ = sub.Unsubscribe(TopicEpochStart, "")
+	handler.EpochStartAction(&block.MetaBlock{Epoch: 4})
+	assert.Equal(t, 0, len(readEvents(sub)))
+}
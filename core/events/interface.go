@@ -0,0 +1,26 @@
+package events
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+// EventsHub defines the component which pushes the chain events, as soon as they happen, to its subscribers
+type EventsHub interface {
+	NewSubscriber() (Subscriber, error)
+	HasSubscribers() bool
+	NotifyBlockCommitted(headerHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	EpochStartEventHandler() epochStart.ActionHandler
+	IsInterfaceNil() bool
+}
+
+// Subscriber defines a client of the events hub, which receives only the events of the topics it has subscribed to
+type Subscriber interface {
+	Subscribe(topic Topic, address string) error
+	Unsubscribe(topic Topic, address string) error
+	SubscribeLogs(request *logsIndex.FilterRequest) error
+	Events() <-chan *Event
+	Close()
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,242 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+)
+
+type subscriber struct {
+	id         uint64
+	hub        *eventsHub
+	mutState   sync.RWMutex
+	topics     map[Topic]struct{}
+	addresses  map[string]struct{}
+	logsFilter *logsIndex.LogsFilter
+	events     chan *Event
+	closed     bool
+	numDropped uint64
+}
+
+func newSubscriber(id uint64, hub *eventsHub, bufferSize int) *subscriber {
+	return &subscriber{
+		id:        id,
+		hub:       hub,
+		topics:    make(map[Topic]struct{}),
+		addresses: make(map[string]struct{}),
+		events:    make(chan *Event, bufferSize),
+	}
+}
+
+// Subscribe adds the given topic to the subscriptions. The address, in its human readable form, is required
+// only by the transactions topic, which can be subscribed for more addresses at once. For the logs topic, the
+// optional address subscribes only to the log events of that address, replacing any previous logs filter
+func (s *subscriber) Subscribe(topic Topic, address string) error {
+	return s.updateSubscription(topic, address, true)
+}
+
+// Unsubscribe removes the given topic from the subscriptions. For the transactions topic, only the given address
+// is removed, while for the logs topic the address is ignored
+func (s *subscriber) Unsubscribe(topic Topic, address string) error {
+	return s.updateSubscription(topic, address, false)
+}
+
+func (s *subscriber) updateSubscription(topic Topic, address string, isSubscribe bool) error {
+	var addressBytes []byte
+	switch topic {
+	case TopicBlocks, TopicEpochStart:
+	case TopicLogs:
+		if len(address) == 0 {
+			break
+		}
+
+		var err error
+		addressBytes, err = s.hub.pubkeyConverter.Decode(address)
+		if err != nil {
+			return err
+		}
+	case TopicTransactions:
+		if len(address) == 0 {
+			return ErrMissingAddress
+		}
+
+		var err error
+		addressBytes, err = s.hub.pubkeyConverter.Decode(address)
+		if err != nil {
+			return err
+		}
+	default:
+		return ErrUnknownTopic
+	}
+
+	s.mutState.Lock()
+	defer s.mutState.Unlock()
+
+	if s.closed {
+		return ErrSubscriberClosed
+	}
+
+	if isSubscribe && !s.isSubscribed(topic, addressBytes) && s.numSubscriptions() >= s.hub.maxSubscriptionsPerSubscriber {
+		return ErrTooManySubscriptions
+	}
+
+	if topic == TopicTransactions {
+		if isSubscribe {
+			s.addresses[string(addressBytes)] = struct{}{}
+		} else {
+			delete(s.addresses, string(addressBytes))
+		}
+		return nil
+	}
+
+	if topic == TopicLogs {
+		s.logsFilter = nil
+		if isSubscribe {
+			s.logsFilter = &logsIndex.LogsFilter{}
+			if len(addressBytes) > 0 {
+				s.logsFilter.Addresses = [][]byte{addressBytes}
+			}
+		}
+		return nil
+	}
+
+	if isSubscribe {
+		s.topics[topic] = struct{}{}
+	} else {
+		delete(s.topics, topic)
+	}
+
+	return nil
+}
+
+// SubscribeLogs subscribes to the log events which match the given filter, replacing any previous logs filter
+func (s *subscriber) SubscribeLogs(request *logsIndex.FilterRequest) error {
+	if request == nil {
+		return ErrNilLogsFilter
+	}
+	if len(request.Addresses) > s.hub.maxAddressesPerSubscription {
+		return ErrTooManyAddresses
+	}
+
+	filter, err := logsIndex.NewLogsFilter(request, s.hub.pubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	s.mutState.Lock()
+	defer s.mutState.Unlock()
+
+	if s.closed {
+		return ErrSubscriberClosed
+	}
+	if !s.isSubscribed(TopicLogs, nil) && s.numSubscriptions() >= s.hub.maxSubscriptionsPerSubscriber {
+		return ErrTooManySubscriptions
+	}
+
+	s.logsFilter = filter
+
+	return nil
+}
+
+// isSubscribed returns true if the subscription already exists, so that replacing it does not count as a new one.
+// The logs topic holds a single filter, whatever the address
+func (s *subscriber) isSubscribed(topic Topic, address []byte) bool {
+	switch topic {
+	case TopicTransactions:
+		_, ok := s.addresses[string(address)]
+		return ok
+	case TopicLogs:
+		return s.logsFilter != nil
+	default:
+		_, ok := s.topics[topic]
+		return ok
+	}
+}
+
+// numSubscriptions counts each subscribed topic and each address of the transactions topic as one subscription
+func (s *subscriber) numSubscriptions() int {
+	numSubscriptions := len(s.topics) + len(s.addresses)
+	if s.logsFilter != nil {
+		numSubscriptions++
+	}
+
+	return numSubscriptions
+}
+
+func (s *subscriber) getLogsFilter() *logsIndex.LogsFilter {
+	s.mutState.RLock()
+	defer s.mutState.RUnlock()
+
+	return s.logsFilter
+}
+
+func (s *subscriber) isSubscribedToTopic(topic Topic) bool {
+	s.mutState.RLock()
+	defer s.mutState.RUnlock()
+
+	_, ok := s.topics[topic]
+	return ok
+}
+
+func (s *subscriber) matchingAddress(sender []byte, receiver []byte) ([]byte, bool) {
+	s.mutState.RLock()
+	defer s.mutState.RUnlock()
+
+	_, ok := s.addresses[string(sender)]
+	if ok {
+		return sender, true
+	}
+
+	_, ok = s.addresses[string(receiver)]
+	if ok {
+		return receiver, true
+	}
+
+	return nil, false
+}
+
+// push will not block the caller: if the subscriber does not consume its events fast enough, the new ones are dropped
+func (s *subscriber) push(event *Event) {
+	s.mutState.Lock()
+	defer s.mutState.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.events <- event:
+	default:
+		s.numDropped++
+		log.Debug("events subscriber is busy, event dropped",
+			"subscriber", s.id,
+			"topic", string(event.Topic),
+			"num dropped", s.numDropped,
+		)
+	}
+}
+
+// Events returns the channel on which the events are delivered. The channel is closed when the subscriber is closed
+func (s *subscriber) Events() <-chan *Event {
+	return s.events
+}
+
+// Close unregisters the subscriber from the hub and closes its events channel. Subsequent calls have no effect
+func (s *subscriber) Close() {
+	s.hub.removeSubscriber(s.id)
+
+	s.mutState.Lock()
+	defer s.mutState.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	close(s.events)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *subscriber) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,41 @@
+package history
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+var _ HistoryRepository = (*disabledHistoryRepository)(nil)
+
+type disabledHistoryRepository struct {
+}
+
+// NewDisabledHistoryRepository returns a history repository which does not record anything. It is used when the
+// address transactions history is not enabled
+func NewDisabledHistoryRepository() *disabledHistoryRepository {
+	return &disabledHistoryRepository{}
+}
+
+// RecordBlock does nothing
+func (dhr *disabledHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// GetTransactions returns ErrHistoryRepositoryDisabled
+func (dhr *disabledHistoryRepository) GetTransactions(_ []byte, _ uint32, _ uint32) ([]*TransactionEntry, error) {
+	return nil, ErrHistoryRepositoryDisabled
+}
+
+// GetTransactionResults returns ErrHistoryRepositoryDisabled
+func (dhr *disabledHistoryRepository) GetTransactionResults(_ []byte) (*TransactionResults, error) {
+	return nil, ErrHistoryRepositoryDisabled
+}
+
+// IsEnabled returns false
+func (dhr *disabledHistoryRepository) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dhr *disabledHistoryRepository) IsInterfaceNil() bool {
+	return dhr == nil
+}
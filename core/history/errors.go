@@ -0,0 +1,31 @@
+package history
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilResultsStorer signals that a nil transaction results storer has been provided
+var ErrNilResultsStorer = errors.New("nil transaction results storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidMaxTransactionsPerAddress signals that an invalid maximum number of transactions per address has been
+// provided
+var ErrInvalidMaxTransactionsPerAddress = errors.New("invalid maximum number of transactions per address")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrHistoryRepositoryDisabled signals that the address transactions history is not enabled on this node
+var ErrHistoryRepositoryDisabled = errors.New("address transactions history is disabled")
+
+// ErrTransactionResultsNotFound signals that the results of a transaction were not recorded
+var ErrTransactionResultsNotFound = errors.New("transaction results not found")
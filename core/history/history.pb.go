@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: history.proto
+
+package history
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransactionEntry holds the data of a transaction in which an address was involved
+type TransactionEntry struct {
+	TxHash     []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"txHash"`
+	TxType     string `protobuf:"bytes,2,opt,name=TxType,proto3" json:"txType"`
+	BlockHash  []byte `protobuf:"bytes,3,opt,name=BlockHash,proto3" json:"blockHash"`
+	BlockNonce uint64 `protobuf:"varint,4,opt,name=BlockNonce,proto3" json:"blockNonce"`
+	Round      uint64 `protobuf:"varint,5,opt,name=Round,proto3" json:"round"`
+	Epoch      uint32 `protobuf:"varint,6,opt,name=Epoch,proto3" json:"epoch"`
+	Timestamp  uint64 `protobuf:"varint,7,opt,name=Timestamp,proto3" json:"timestamp"`
+}
+
+func (m *TransactionEntry) Reset()      { *m = TransactionEntry{} }
+func (*TransactionEntry) ProtoMessage() {}
+func (*TransactionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{0}
+}
+func (m *TransactionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionEntry.Merge(m, src)
+}
+func (m *TransactionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionEntry proto.InternalMessageInfo
+
+func (m *TransactionEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *TransactionEntry) GetTxType() string {
+	if m != nil {
+		return m.TxType
+	}
+	return ""
+}
+
+func (m *TransactionEntry) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TransactionEntry) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *TransactionEntry) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TransactionEntry) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *TransactionEntry) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// TransactionResults holds the hashes of the smart contract results and of the receipt generated by a transaction
+type TransactionResults struct {
+	ScResultsHashes [][]byte `protobuf:"bytes,1,rep,name=ScResultsHashes,proto3" json:"scResultsHashes"`
+	ReceiptHash     []byte   `protobuf:"bytes,2,opt,name=ReceiptHash,proto3" json:"receiptHash,omitempty"`
+}
+
+func (m *TransactionResults) Reset()      { *m = TransactionResults{} }
+func (*TransactionResults) ProtoMessage() {}
+func (*TransactionResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{1}
+}
+func (m *TransactionResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionResults.Merge(m, src)
+}
+func (m *TransactionResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionResults proto.InternalMessageInfo
+
+func (m *TransactionResults) GetScResultsHashes() [][]byte {
+	if m != nil {
+		return m.ScResultsHashes
+	}
+	return nil
+}
+
+func (m *TransactionResults) GetReceiptHash() []byte {
+	if m != nil {
+		return m.ReceiptHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TransactionEntry)(nil), "proto.TransactionEntry")
+	proto.RegisterType((*TransactionResults)(nil), "proto.TransactionResults")
+}
+
+func init() { proto.RegisterFile("history.proto", fileDescriptor_454388b49b309873) }
+
+var fileDescriptor_454388b49b309873 = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0x8e, 0x9b, 0x30,
+	0x18, 0xc7, 0x71, 0x12, 0x88, 0x70, 0x93, 0xa6, 0x72, 0x55, 0x89, 0x76, 0x30, 0x28, 0x13, 0x52,
+	0xdb, 0x64, 0xe8, 0x58, 0x75, 0x28, 0x52, 0xa4, 0x4e, 0x1d, 0x5c, 0xa6, 0x6e, 0x40, 0xdd, 0x80,
+	0x1a, 0x30, 0xc2, 0x46, 0x2a, 0x5b, 0x1f, 0x21, 0x4f, 0x51, 0xdd, 0xa3, 0xdc, 0x98, 0x31, 0x13,
+	0xba, 0x38, 0xcb, 0x89, 0x29, 0x8f, 0x70, 0xc2, 0x90, 0x4b, 0xee, 0x26, 0xf8, 0xff, 0xfe, 0x3f,
+	0x3e, 0x99, 0x4f, 0x86, 0xd3, 0x38, 0xe1, 0x82, 0x15, 0xd5, 0x22, 0x2f, 0x98, 0x60, 0x48, 0x57,
+	0x8f, 0x77, 0x1f, 0xd7, 0x89, 0x88, 0xcb, 0x70, 0x11, 0xb1, 0x74, 0xb9, 0x66, 0x6b, 0xb6, 0x54,
+	0x38, 0x2c, 0x7f, 0xab, 0xa4, 0x82, 0x7a, 0xeb, 0xbe, 0x9a, 0xff, 0x1f, 0xc0, 0x57, 0x7e, 0x11,
+	0x64, 0x3c, 0x88, 0x44, 0xc2, 0xb2, 0x55, 0x26, 0x8a, 0x0a, 0xcd, 0xa1, 0xe1, 0xff, 0xfd, 0x16,
+	0xf0, 0xd8, 0x02, 0x0e, 0x70, 0x27, 0x1e, 0x6c, 0x6a, 0xdb, 0x10, 0x8a, 0x90, 0xbe, 0xe9, 0x1c,
+	0xbf, 0xca, 0xa9, 0x35, 0x70, 0x80, 0x6b, 0x9e, 0x9d, 0x96, 0x90, 0xbe, 0x41, 0xef, 0xa1, 0xe9,
+	0x6d, 0x58, 0xf4, 0x47, 0x8d, 0x1a, 0xaa, 0x51, 0xd3, 0xa6, 0xb6, 0xcd, 0xf0, 0x0c, 0xc9, 0xa5,
+	0x47, 0x0b, 0x08, 0x55, 0xf8, 0xce, 0xb2, 0x88, 0x5a, 0x23, 0x07, 0xb8, 0x23, 0xef, 0x65, 0x53,
+	0xdb, 0x30, 0x7c, 0xa4, 0xe4, 0xca, 0x40, 0x36, 0xd4, 0x09, 0x2b, 0xb3, 0x5f, 0x96, 0xae, 0x54,
+	0xb3, 0xa9, 0x6d, 0xbd, 0x68, 0x01, 0xe9, 0x78, 0x2b, 0xac, 0x72, 0x16, 0xc5, 0x96, 0xe1, 0x00,
+	0x77, 0xda, 0x09, 0xb4, 0x05, 0xa4, 0xe3, 0xed, 0xf1, 0xfc, 0x24, 0xa5, 0x5c, 0x04, 0x69, 0x6e,
+	0x8d, 0xd5, 0x14, 0x75, 0x3c, 0x71, 0x86, 0xe4, 0xd2, 0xcf, 0xb7, 0x00, 0xa2, 0xab, 0x45, 0x11,
+	0xca, 0xcb, 0x8d, 0xe0, 0xe8, 0x0b, 0x9c, 0xfd, 0x88, 0xfa, 0xd0, 0xfe, 0x06, 0xe5, 0x16, 0x70,
+	0x86, 0xee, 0xc4, 0x7b, 0xdd, 0xd4, 0xf6, 0x8c, 0x3f, 0xad, 0xc8, 0x73, 0x17, 0x7d, 0x86, 0x2f,
+	0x08, 0x8d, 0x68, 0x92, 0x0b, 0xb5, 0xa3, 0x81, 0xda, 0xd1, 0xdb, 0xa6, 0xb6, 0xdf, 0x14, 0x17,
+	0xfc, 0x81, 0xa5, 0x89, 0xa0, 0x69, 0x2e, 0x2a, 0x72, 0x6d, 0x7b, 0x5f, 0x77, 0x07, 0xac, 0xed,
+	0x0f, 0x58, 0x3b, 0x1d, 0x30, 0xf8, 0x27, 0x31, 0xb8, 0x91, 0x18, 0xdc, 0x4a, 0x0c, 0x76, 0x12,
+	0x83, 0xbd, 0xc4, 0xe0, 0x4e, 0x62, 0x70, 0x2f, 0xb1, 0x76, 0x92, 0x18, 0x6c, 0x8f, 0x58, 0xdb,
+	0x1d, 0xb1, 0xb6, 0x3f, 0x62, 0xed, 0xe7, 0xb8, 0xbf, 0x3a, 0xa1, 0xa1, 0x6e, 0xc1, 0xa7, 0x87,
+	0x01, 0x00, 0x86, 0x43, 0x25, 0x8c, 0x4c, 0x02, 0x00, 0x00,
+}
+
+func (this *TransactionEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionEntry)
+	if !ok {
+		that2, ok := that.(TransactionEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.TxType != that1.TxType {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *TransactionResults) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionResults)
+	if !ok {
+		that2, ok := that.(TransactionResults)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ScResultsHashes) != len(that1.ScResultsHashes) {
+		return false
+	}
+	for i := range this.ScResultsHashes {
+		if !bytes.Equal(this.ScResultsHashes[i], that1.ScResultsHashes[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.ReceiptHash, that1.ReceiptHash) {
+		return false
+	}
+	return true
+}
+func (this *TransactionEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&history.TransactionEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "TxType: "+fmt.Sprintf("%#v", this.TxType)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionResults) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.TransactionResults{")
+	s = append(s, "ScResultsHashes: "+fmt.Sprintf("%#v", this.ScResultsHashes)+",\n")
+	s = append(s, "ReceiptHash: "+fmt.Sprintf("%#v", this.ReceiptHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TransactionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Round != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxType) > 0 {
+		i -= len(m.TxType)
+		copy(dAtA[i:], m.TxType)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.TxType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptHash) > 0 {
+		i -= len(m.ReceiptHash)
+		copy(dAtA[i:], m.ReceiptHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.ReceiptHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScResultsHashes) > 0 {
+		for iNdEx := len(m.ScResultsHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScResultsHashes[iNdEx])
+			copy(dAtA[i:], m.ScResultsHashes[iNdEx])
+			i = encodeVarintHistory(dAtA, i, uint64(len(m.ScResultsHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransactionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.TxType)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovHistory(uint64(m.BlockNonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovHistory(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovHistory(uint64(m.Epoch))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovHistory(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *TransactionResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScResultsHashes) > 0 {
+		for _, b := range m.ScResultsHashes {
+			l = len(b)
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	l = len(m.ReceiptHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TransactionEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`TxType:` + fmt.Sprintf("%v", this.TxType) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionResults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionResults{`,
+		`ScResultsHashes:` + fmt.Sprintf("%v", this.ScResultsHashes) + `,`,
+		`ReceiptHash:` + fmt.Sprintf("%v", this.ReceiptHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TransactionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScResultsHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScResultsHashes = append(m.ScResultsHashes, make([]byte, postIndex-iNdEx))
+			copy(m.ScResultsHashes[len(m.ScResultsHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptHash = append(m.ReceiptHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReceiptHash == nil {
+				m.ReceiptHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
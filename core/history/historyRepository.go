@@ -0,0 +1,463 @@
+package history
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("core/history")
+
+// MaxPageSize is the maximum number of transactions which can be fetched at once
+const MaxPageSize = 100
+
+const (
+	entryKeyPrefix       = "e"
+	transactionKeyPrefix = "t"
+)
+
+const (
+	// NormalTx is the type of a normal transaction
+	NormalTx = "normal"
+	// UnsignedTx is the type of a smart contract result
+	UnsignedTx = "unsignedTx"
+	// RewardTx is the type of a reward transaction
+	RewardTx = "rewardTx"
+)
+
+var _ HistoryRepository = (*historyRepository)(nil)
+
+// ArgsHistoryRepository is the argument structure used to create a new history repository
+type ArgsHistoryRepository struct {
+	Storer                    storage.Storer
+	ResultsStorer             storage.Storer
+	Marshalizer               marshal.Marshalizer
+	ShardCoordinator          ShardCoordinator
+	MaxTransactionsPerAddress uint32
+}
+
+type historyRepository struct {
+	storer                    storage.Storer
+	resultsStorer             storage.Storer
+	marshalizer               marshal.Marshalizer
+	shardCoordinator          ShardCoordinator
+	maxTransactionsPerAddress uint32
+	mutRepository             sync.RWMutex
+}
+
+// NewHistoryRepository creates a new history repository which indexes, for each address of the current shard, the
+// normal transactions, smart contract results and rewards in which that address was involved. It also indexes, for each
+// transaction, the smart contract results and the receipt generated by it in the current shard. Only the most recent
+// MaxTransactionsPerAddress transactions are kept for each address, the older ones being pruned as new ones are recorded
+func NewHistoryRepository(args ArgsHistoryRepository) (*historyRepository, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.ResultsStorer) {
+		return nil, ErrNilResultsStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.MaxTransactionsPerAddress == 0 {
+		return nil, ErrInvalidMaxTransactionsPerAddress
+	}
+
+	return &historyRepository{
+		storer:                    args.Storer,
+		resultsStorer:             args.ResultsStorer,
+		marshalizer:               args.Marshalizer,
+		shardCoordinator:          args.ShardCoordinator,
+		maxTransactionsPerAddress: args.MaxTransactionsPerAddress,
+	}, nil
+}
+
+// RecordBlock saves the transactions of a committed block in the history of the sender and receiver addresses
+// which belong to the current shard. The smart contract results and the receipts from the block are linked to the
+// transactions which generated them
+func (hr *historyRepository) RecordBlock(
+	blockHeaderHash []byte,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	txHashes := make([]string, 0, len(txPool))
+	for txHash := range txPool {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	entriesPerAddress := make(map[string][]*TransactionEntry)
+	resultsPerTx := make(map[string]*TransactionResults)
+	for _, txHash := range txHashes {
+		tx := txPool[txHash]
+		addTransactionResults(resultsPerTx, txHash, tx)
+
+		txType, ok := getTransactionType(tx)
+		if !ok {
+			continue
+		}
+
+		entry := &TransactionEntry{
+			TxHash:     []byte(txHash),
+			TxType:     txType,
+			BlockHash:  blockHeaderHash,
+			BlockNonce: header.GetNonce(),
+			Round:      header.GetRound(),
+			Epoch:      header.GetEpoch(),
+			Timestamp:  header.GetTimeStamp(),
+		}
+
+		for _, address := range hr.selfShardAddresses(tx) {
+			entriesPerAddress[address] = append(entriesPerAddress[address], entry)
+		}
+	}
+
+	hr.mutRepository.Lock()
+	defer hr.mutRepository.Unlock()
+
+	for address, entries := range entriesPerAddress {
+		err := hr.appendEntries([]byte(address), entries)
+		if err != nil {
+			return err
+		}
+	}
+
+	for txHash, results := range resultsPerTx {
+		err := hr.mergeTransactionResults([]byte(txHash), results)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addTransactionResults(resultsPerTx map[string]*TransactionResults, txHash string, tx data.TransactionHandler) {
+	getOrCreateResults := func(hash []byte) *TransactionResults {
+		results, found := resultsPerTx[string(hash)]
+		if !found {
+			results = &TransactionResults{}
+			resultsPerTx[string(hash)] = results
+		}
+
+		return results
+	}
+
+	switch currentTx := tx.(type) {
+	case *transaction.Transaction:
+		getOrCreateResults([]byte(txHash))
+	case *smartContractResult.SmartContractResult:
+		if len(currentTx.OriginalTxHash) == 0 {
+			return
+		}
+		results := getOrCreateResults(currentTx.OriginalTxHash)
+		results.ScResultsHashes = append(results.ScResultsHashes, []byte(txHash))
+	case *receipt.Receipt:
+		if len(currentTx.TxHash) == 0 {
+			return
+		}
+		results := getOrCreateResults(currentTx.TxHash)
+		results.ReceiptHash = []byte(txHash)
+	}
+}
+
+func (hr *historyRepository) mergeTransactionResults(txHash []byte, results *TransactionResults) error {
+	storedResults := hr.getTransactionResults(txHash)
+
+	// smart contract results of a cross shard transaction can be committed in a later block than the transaction
+	knownHashes := make(map[string]struct{}, len(storedResults.ScResultsHashes))
+	for _, scrHash := range storedResults.ScResultsHashes {
+		knownHashes[string(scrHash)] = struct{}{}
+	}
+	for _, scrHash := range results.ScResultsHashes {
+		_, found := knownHashes[string(scrHash)]
+		if !found {
+			storedResults.ScResultsHashes = append(storedResults.ScResultsHashes, scrHash)
+		}
+	}
+	if len(results.ReceiptHash) > 0 {
+		storedResults.ReceiptHash = results.ReceiptHash
+	}
+
+	buff, err := hr.marshalizer.Marshal(storedResults)
+	if err != nil {
+		return err
+	}
+
+	return hr.resultsStorer.Put(txHash, buff)
+}
+
+func (hr *historyRepository) getTransactionResults(txHash []byte) *TransactionResults {
+	results := &TransactionResults{}
+
+	buff, err := hr.resultsStorer.Get(txHash)
+	if err != nil {
+		return results
+	}
+
+	err = hr.marshalizer.Unmarshal(results, buff)
+	if err != nil {
+		log.Warn("historyRepository.getTransactionResults", "error", err.Error())
+		return &TransactionResults{}
+	}
+
+	return results
+}
+
+func getTransactionType(tx data.TransactionHandler) (string, bool) {
+	switch tx.(type) {
+	case *transaction.Transaction:
+		return NormalTx, true
+	case *smartContractResult.SmartContractResult:
+		return UnsignedTx, true
+	case *rewardTx.RewardTx:
+		return RewardTx, true
+	default:
+		return "", false
+	}
+}
+
+func (hr *historyRepository) selfShardAddresses(tx data.TransactionHandler) []string {
+	addresses := make([]string, 0, 2)
+	for _, address := range [][]byte{tx.GetSndAddr(), tx.GetRcvAddr()} {
+		if len(address) == 0 {
+			continue
+		}
+		if hr.shardCoordinator.ComputeId(address) != hr.shardCoordinator.SelfId() {
+			continue
+		}
+		if len(addresses) > 0 && addresses[0] == string(address) {
+			continue
+		}
+
+		addresses = append(addresses, string(address))
+	}
+
+	return addresses
+}
+
+// appendEntries stores each entry under its own key, made of the address and the big endian sequence number of the
+// entry, so that recording a block costs the same regardless of the size of the address history. The number of
+// entries recorded for the address is kept under the address key, while the sequence number of each recorded
+// transaction is kept under the transaction key, as a transaction can be recorded again if its block was reverted and
+// then committed once more. Such an entry is replaced in place, as long as it was not yet pruned
+func (hr *historyRepository) appendEntries(address []byte, entries []*TransactionEntry) error {
+	numEntries := hr.getNumEntries(address)
+	for _, entry := range entries {
+		sequence, found := hr.getEntrySequence(address, entry.TxHash, numEntries)
+		if !found {
+			sequence = numEntries
+			numEntries++
+		}
+
+		err := hr.putEntry(address, sequence, entry)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			hr.pruneEntry(address, numEntries)
+		}
+	}
+
+	return hr.storer.Put(address, uint64ToBytes(numEntries))
+}
+
+func (hr *historyRepository) getNumEntries(address []byte) uint64 {
+	buff, err := hr.storer.Get(address)
+	if err != nil {
+		return 0
+	}
+
+	return bytesToUint64(buff)
+}
+
+// getEntrySequence returns the sequence number of the entry recorded for the provided transaction, if that entry was
+// not yet pruned
+func (hr *historyRepository) getEntrySequence(address []byte, txHash []byte, numEntries uint64) (uint64, bool) {
+	buff, err := hr.storer.Get(transactionKey(address, txHash))
+	if err != nil {
+		return 0, false
+	}
+
+	sequence := bytesToUint64(buff)
+	if sequence >= numEntries || numEntries-sequence > uint64(hr.maxTransactionsPerAddress) {
+		return 0, false
+	}
+
+	return sequence, true
+}
+
+func (hr *historyRepository) putEntry(address []byte, sequence uint64, entry *TransactionEntry) error {
+	buff, err := hr.marshalizer.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	err = hr.storer.Put(entryKey(address, sequence), buff)
+	if err != nil {
+		return err
+	}
+
+	return hr.storer.Put(transactionKey(address, entry.TxHash), uint64ToBytes(sequence))
+}
+
+// pruneEntry removes the entry which is no longer kept once the address has the provided number of entries
+func (hr *historyRepository) pruneEntry(address []byte, numEntries uint64) {
+	maxEntries := uint64(hr.maxTransactionsPerAddress)
+	if numEntries <= maxEntries {
+		return
+	}
+
+	key := entryKey(address, numEntries-maxEntries-1)
+	entry, err := hr.getEntry(key)
+	if err != nil {
+		return
+	}
+
+	txKey := transactionKey(address, entry.TxHash)
+	buff, err := hr.storer.Get(txKey)
+	if err == nil && bytesToUint64(buff) == numEntries-maxEntries-1 {
+		logRemoveError(hr.storer.Remove(txKey))
+	}
+	logRemoveError(hr.storer.Remove(key))
+}
+
+func (hr *historyRepository) getEntry(key []byte) (*TransactionEntry, error) {
+	buff, err := hr.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &TransactionEntry{}
+	err = hr.marshalizer.Unmarshal(entry, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func logRemoveError(err error) {
+	if err != nil {
+		log.Debug("historyRepository.pruneEntry", "error", err.Error())
+	}
+}
+
+func entryKey(address []byte, sequence uint64) []byte {
+	key := make([]byte, 0, len(entryKeyPrefix)+len(address)+8)
+	key = append(key, entryKeyPrefix...)
+	key = append(key, address...)
+
+	return append(key, uint64ToBytes(sequence)...)
+}
+
+func transactionKey(address []byte, txHash []byte) []byte {
+	key := make([]byte, 0, len(transactionKeyPrefix)+len(address)+len(txHash))
+	key = append(key, transactionKeyPrefix...)
+	key = append(key, address...)
+
+	return append(key, txHash...)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
+
+func bytesToUint64(buff []byte) uint64 {
+	if len(buff) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(buff)
+}
+
+// GetTransactions returns a page of the transactions in which the provided address was involved, from the newest
+// to the oldest. The first page has the index 0
+func (hr *historyRepository) GetTransactions(address []byte, page uint32, pageSize uint32) ([]*TransactionEntry, error) {
+	if pageSize == 0 || pageSize > MaxPageSize {
+		return nil, ErrInvalidPageSize
+	}
+
+	hr.mutRepository.RLock()
+	defer hr.mutRepository.RUnlock()
+
+	numEntries := hr.getNumEntries(address)
+	numKeptEntries := numEntries
+	if numKeptEntries > uint64(hr.maxTransactionsPerAddress) {
+		numKeptEntries = uint64(hr.maxTransactionsPerAddress)
+	}
+
+	firstIndex := uint64(page) * uint64(pageSize)
+	if firstIndex >= numKeptEntries {
+		return make([]*TransactionEntry, 0), nil
+	}
+
+	lastIndex := firstIndex + uint64(pageSize)
+	if lastIndex > numKeptEntries {
+		lastIndex = numKeptEntries
+	}
+
+	result := make([]*TransactionEntry, 0, lastIndex-firstIndex)
+	for i := firstIndex; i < lastIndex; i++ {
+		entry, err := hr.getEntry(entryKey(address, numEntries-1-i))
+		if err != nil {
+			log.Warn("historyRepository.GetTransactions", "error", err.Error())
+			continue
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, nil
+}
+
+// GetTransactionResults returns the hashes of the smart contract results and of the receipt generated in the current
+// shard by the provided transaction
+func (hr *historyRepository) GetTransactionResults(txHash []byte) (*TransactionResults, error) {
+	hr.mutRepository.RLock()
+	defer hr.mutRepository.RUnlock()
+
+	buff, err := hr.resultsStorer.Get(txHash)
+	if err != nil {
+		return nil, ErrTransactionResultsNotFound
+	}
+
+	results := &TransactionResults{}
+	err = hr.marshalizer.Unmarshal(results, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// IsEnabled returns true as this repository records the transactions history
+func (hr *historyRepository) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hr *historyRepository) IsInterfaceNil() bool {
+	return hr == nil
+}
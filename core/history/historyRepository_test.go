@@ -0,0 +1,328 @@
+package history
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// with 2 shards, the addresses ending in an even byte belong to shard 0
+var addrShard0 = []byte("address in shard 0 ............0")
+var otherAddrShard0 = []byte("other address in shard 0 ......2")
+var addrShard1 = []byte("address in shard 1 ............1")
+
+func createMemStorer() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 10, Shards: 1})
+	persister, _ := memorydb.NewlruDB(1000)
+	storer, _ := storageUnit.NewStorageUnit(cache, persister)
+
+	return storer
+}
+
+func createMockArgsHistoryRepository() ArgsHistoryRepository {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+
+	return ArgsHistoryRepository{
+		Storer:                    createMemStorer(),
+		ResultsStorer:             createMemStorer(),
+		Marshalizer:               &marshal.GogoProtoMarshalizer{},
+		ShardCoordinator:          shardCoordinator,
+		MaxTransactionsPerAddress: 10,
+	}
+}
+
+func createTx(nonce uint64, sender []byte, receiver []byte) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:   nonce,
+		Value:   big.NewInt(0),
+		SndAddr: sender,
+		RcvAddr: receiver,
+	}
+}
+
+func recordBlock(t *testing.T, hr *historyRepository, nonce uint64, txPool map[string]data.TransactionHandler) {
+	header := &block.Header{Nonce: nonce, Round: nonce + 1, Epoch: 2, TimeStamp: 100 * nonce}
+	err := hr.RecordBlock([]byte(fmt.Sprintf("block%d", nonce)), header, txPool)
+	require.Nil(t, err)
+}
+
+func txHashes(entries []*TransactionEntry) []string {
+	hashes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		hashes = append(hashes, string(entry.TxHash))
+	}
+
+	return hashes
+}
+
+func TestNewHistoryRepository_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	args.Storer = nil
+	hr, err := NewHistoryRepository(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, ErrNilStorer, err)
+}
+
+func TestNewHistoryRepository_NilResultsStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	args.ResultsStorer = nil
+	hr, err := NewHistoryRepository(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, ErrNilResultsStorer, err)
+}
+
+func TestNewHistoryRepository_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	args.Marshalizer = nil
+	hr, err := NewHistoryRepository(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestNewHistoryRepository_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	args.ShardCoordinator = nil
+	hr, err := NewHistoryRepository(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+}
+
+func TestNewHistoryRepository_ZeroMaxTransactionsPerAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	args.MaxTransactionsPerAddress = 0
+	hr, err := NewHistoryRepository(args)
+
+	assert.True(t, check.IfNil(hr))
+	assert.Equal(t, ErrInvalidMaxTransactionsPerAddress, err)
+}
+
+func TestNewHistoryRepository_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hr, err := NewHistoryRepository(createMockArgsHistoryRepository())
+
+	assert.False(t, check.IfNil(hr))
+	assert.Nil(t, err)
+	assert.True(t, hr.IsEnabled())
+}
+
+func TestHistoryRepository_RecordBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := NewHistoryRepository(createMockArgsHistoryRepository())
+	err := hr.RecordBlock([]byte("hash"), nil, nil)
+
+	assert.Equal(t, ErrNilHeader, err)
+}
+
+func TestHistoryRepository_RecordBlockShouldIndexSelfShardAddresses(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := NewHistoryRepository(createMockArgsHistoryRepository())
+	recordBlock(t, hr, 5, map[string]data.TransactionHandler{
+		"tx":      createTx(0, addrShard0, addrShard1),
+		"scr":     &smartContractResult.SmartContractResult{Value: big.NewInt(0), SndAddr: addrShard1, RcvAddr: otherAddrShard0},
+		"reward":  &rewardTx.RewardTx{Value: big.NewInt(0), RcvAddr: addrShard0},
+		"self":    createTx(1, addrShard0, addrShard0),
+		"receipt": &receipt.Receipt{Value: big.NewInt(0), SndAddr: addrShard0},
+	})
+
+	entries, err := hr.GetTransactions(addrShard0, 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx", "self", "reward"}, txHashes(entries))
+	assert.Equal(t, RewardTx, entries[2].TxType)
+	assert.Equal(t, []byte("block5"), entries[0].BlockHash)
+	assert.Equal(t, uint64(5), entries[0].BlockNonce)
+	assert.Equal(t, uint64(6), entries[0].Round)
+	assert.Equal(t, uint32(2), entries[0].Epoch)
+	assert.Equal(t, uint64(500), entries[0].Timestamp)
+
+	entries, _ = hr.GetTransactions(otherAddrShard0, 0, 10)
+	assert.Equal(t, []string{"scr"}, txHashes(entries))
+	assert.Equal(t, UnsignedTx, entries[0].TxType)
+
+	entries, _ = hr.GetTransactions(addrShard1, 0, 10)
+	assert.Equal(t, 0, len(entries))
+}
+
+func TestHistoryRepository_GetTransactionsShouldPaginateFromNewest(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := NewHistoryRepository(createMockArgsHistoryRepository())
+	for i := uint64(0); i < 5; i++ {
+		recordBlock(t, hr, i, map[string]data.TransactionHandler{
+			fmt.Sprintf("tx%d", i): createTx(i, addrShard0, addrShard1),
+		})
+	}
+
+	entries, err := hr.GetTransactions(addrShard0, 0, 2)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"tx4", "tx3"}, txHashes(entries))
+
+	entries, _ = hr.GetTransactions(addrShard0, 2, 2)
+	assert.Equal(t, []string{"tx0"}, txHashes(entries))
+
+	entries, err = hr.GetTransactions(addrShard0, 3, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(entries))
+
+	_, err = hr.GetTransactions(addrShard0, 0, 0)
+	assert.Equal(t, ErrInvalidPageSize, err)
+
+	_, err = hr.GetTransactions(addrShard0, 0, MaxPageSize+1)
+	assert.Equal(t, ErrInvalidPageSize, err)
+}
+
+func TestHistoryRepository_RecordBlockShouldPruneOldestEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	args.MaxTransactionsPerAddress = 3
+	hr, _ := NewHistoryRepository(args)
+	for i := uint64(0); i < 5; i++ {
+		recordBlock(t, hr, i, map[string]data.TransactionHandler{
+			fmt.Sprintf("tx%d", i): createTx(i, addrShard0, addrShard1),
+		})
+	}
+
+	entries, _ := hr.GetTransactions(addrShard0, 0, 10)
+	assert.Equal(t, []string{"tx4", "tx3", "tx2"}, txHashes(entries))
+
+	assert.NotNil(t, args.Storer.Has(entryKey(addrShard0, 1)))
+	assert.NotNil(t, args.Storer.Has(transactionKey(addrShard0, []byte("tx1"))))
+	assert.Nil(t, args.Storer.Has(entryKey(addrShard0, 2)))
+	assert.Nil(t, args.Storer.Has(transactionKey(addrShard0, []byte("tx2"))))
+}
+
+func TestHistoryRepository_RecordBlockShouldStoreEachEntryUnderItsOwnKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	hr, _ := NewHistoryRepository(args)
+	recordBlock(t, hr, 1, map[string]data.TransactionHandler{"tx1": createTx(1, addrShard0, addrShard1)})
+	recordBlock(t, hr, 2, map[string]data.TransactionHandler{"tx2": createTx(2, addrShard0, addrShard1)})
+
+	// the entry of a previous block is not rewritten when a new block is recorded
+	buff, err := args.Storer.Get(entryKey(addrShard0, 0))
+	require.Nil(t, err)
+	entry := &TransactionEntry{}
+	err = args.Marshalizer.Unmarshal(entry, buff)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("tx1"), entry.TxHash)
+
+	buff, err = args.Storer.Get(addrShard0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), bytesToUint64(buff))
+}
+
+func TestHistoryRepository_RecordBlockAgainAfterPruningShouldAppendEntry(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoryRepository()
+	args.MaxTransactionsPerAddress = 2
+	hr, _ := NewHistoryRepository(args)
+	recordBlock(t, hr, 1, map[string]data.TransactionHandler{"tx1": createTx(1, addrShard0, addrShard1)})
+	recordBlock(t, hr, 2, map[string]data.TransactionHandler{"tx2": createTx(2, addrShard0, addrShard1)})
+	recordBlock(t, hr, 3, map[string]data.TransactionHandler{"tx3": createTx(3, addrShard0, addrShard1)})
+	recordBlock(t, hr, 4, map[string]data.TransactionHandler{"tx1": createTx(1, addrShard0, addrShard1)})
+
+	entries, _ := hr.GetTransactions(addrShard0, 0, 10)
+	assert.Equal(t, []string{"tx1", "tx3"}, txHashes(entries))
+	assert.Equal(t, uint64(4), entries[0].BlockNonce)
+}
+
+func TestHistoryRepository_RecordBlockAgainShouldReplaceEntries(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := NewHistoryRepository(createMockArgsHistoryRepository())
+	recordBlock(t, hr, 1, map[string]data.TransactionHandler{"tx1": createTx(1, addrShard0, addrShard1)})
+	recordBlock(t, hr, 2, map[string]data.TransactionHandler{"tx2": createTx(2, addrShard0, addrShard1)})
+	recordBlock(t, hr, 3, map[string]data.TransactionHandler{"tx2": createTx(2, addrShard0, addrShard1)})
+
+	entries, _ := hr.GetTransactions(addrShard0, 0, 10)
+	assert.Equal(t, []string{"tx2", "tx1"}, txHashes(entries))
+	assert.Equal(t, uint64(3), entries[0].BlockNonce)
+}
+
+func TestHistoryRepository_GetTransactionResultsNotRecordedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := NewHistoryRepository(createMockArgsHistoryRepository())
+	results, err := hr.GetTransactionResults([]byte("tx1"))
+
+	assert.Nil(t, results)
+	assert.Equal(t, ErrTransactionResultsNotFound, err)
+}
+
+func TestHistoryRepository_RecordBlockShouldLinkResultsToTransactions(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := NewHistoryRepository(createMockArgsHistoryRepository())
+	recordBlock(t, hr, 1, map[string]data.TransactionHandler{
+		"tx1":  createTx(1, addrShard0, addrShard1),
+		"tx2":  createTx(2, addrShard0, addrShard0),
+		"scr1": &smartContractResult.SmartContractResult{SndAddr: addrShard1, RcvAddr: addrShard0, OriginalTxHash: []byte("tx1")},
+		"rpt2": &receipt.Receipt{Value: big.NewInt(10), SndAddr: addrShard0, TxHash: []byte("tx2")},
+	})
+	// the smart contract results of a cross shard transaction can come in a later block
+	recordBlock(t, hr, 2, map[string]data.TransactionHandler{
+		"scr2": &smartContractResult.SmartContractResult{SndAddr: addrShard1, RcvAddr: addrShard0, OriginalTxHash: []byte("tx1")},
+		"scr1": &smartContractResult.SmartContractResult{SndAddr: addrShard1, RcvAddr: addrShard0, OriginalTxHash: []byte("tx1")},
+	})
+
+	results, err := hr.GetTransactionResults([]byte("tx1"))
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("scr1"), []byte("scr2")}, results.ScResultsHashes)
+	assert.Empty(t, results.ReceiptHash)
+
+	results, err = hr.GetTransactionResults([]byte("tx2"))
+	require.Nil(t, err)
+	assert.Empty(t, results.ScResultsHashes)
+	assert.Equal(t, []byte("rpt2"), results.ReceiptHash)
+}
+
+func TestDisabledHistoryRepository(t *testing.T) {
+	t.Parallel()
+
+	dhr := NewDisabledHistoryRepository()
+	assert.False(t, check.IfNil(dhr))
+	assert.False(t, dhr.IsEnabled())
+	assert.Nil(t, dhr.RecordBlock(nil, nil, nil))
+
+	entries, err := dhr.GetTransactions(addrShard0, 0, 10)
+	assert.Nil(t, entries)
+	assert.Equal(t, ErrHistoryRepositoryDisabled, err)
+
+	results, err := dhr.GetTransactionResults([]byte("tx1"))
+	assert.Nil(t, results)
+	assert.Equal(t, ErrHistoryRepositoryDisabled, err)
+}
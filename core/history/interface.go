@@ -0,0 +1,22 @@
+package history
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// HistoryRepository defines the actions of the component which keeps, for each address, the transactions in which
+// that address was involved, together with the results generated by each transaction
+type HistoryRepository interface {
+	RecordBlock(blockHeaderHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler) error
+	GetTransactions(address []byte, page uint32, pageSize uint32) ([]*TransactionEntry, error)
+	GetTransactionResults(txHash []byte) (*TransactionResults, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// ShardCoordinator defines what a shard coordinator should do for the history repository
+type ShardCoordinator interface {
+	ComputeId(address []byte) uint32
+	SelfId() uint32
+	IsInterfaceNil() bool
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 )
 
@@ -72,17 +73,7 @@ func prepareGeneralInfo(tpsBenchmark statistics.TPSBenchmark) bytes.Buffer {
 	var buff bytes.Buffer
 
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s", "_type" : "%s" } }%s`, metachainTpsDocID, tpsIndex, "\n"))
-	generalInfo := TPS{
-		LiveTPS:               tpsBenchmark.LiveTPS(),
-		PeakTPS:               tpsBenchmark.PeakTPS(),
-		NrOfShards:            tpsBenchmark.NrOfShards(),
-		BlockNumber:           tpsBenchmark.BlockNumber(),
-		RoundNumber:           tpsBenchmark.RoundNumber(),
-		RoundTime:             tpsBenchmark.RoundTime(),
-		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
-		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
-		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
-	}
+	generalInfo := prepareGeneralTPS(tpsBenchmark)
 
 	serializedInfo, err := json.Marshal(generalInfo)
 	if err != nil {
@@ -109,8 +100,37 @@ func serializeShardInfo(shardInfo statistics.ShardStatistic) ([]byte, []byte) {
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s%d", "_type" : "%s" } }%s`,
 		shardTpsDocIDPrefix, shardInfo.ShardID(), tpsIndex, "\n"))
 
+	shardTPS := prepareShardTPS(shardInfo)
+
+	serializedInfo, err := json.Marshal(shardTPS)
+	if err != nil {
+		log.Debug("indexer: could not serialize tps info, will skip indexing tps this shard")
+		return nil, nil
+	}
+	// append a newline foreach element in the bulk we create
+	serializedInfo = append(serializedInfo, "\n"...)
+
+	return serializedInfo, meta
+}
+
+func prepareGeneralTPS(tpsBenchmark statistics.TPSBenchmark) TPS {
+	return TPS{
+		LiveTPS:               tpsBenchmark.LiveTPS(),
+		PeakTPS:               tpsBenchmark.PeakTPS(),
+		NrOfShards:            tpsBenchmark.NrOfShards(),
+		BlockNumber:           tpsBenchmark.BlockNumber(),
+		RoundNumber:           tpsBenchmark.RoundNumber(),
+		RoundTime:             tpsBenchmark.RoundTime(),
+		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
+		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
+		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
+	}
+}
+
+func prepareShardTPS(shardInfo statistics.ShardStatistic) TPS {
 	bigTxCount := big.NewInt(int64(shardInfo.AverageBlockTxCount()))
-	shardTPS := TPS{
+
+	return TPS{
 		ShardID:               shardInfo.ShardID(),
 		LiveTPS:               shardInfo.LiveTPS(),
 		PeakTPS:               shardInfo.PeakTPS(),
@@ -120,16 +140,109 @@ func serializeShardInfo(shardInfo statistics.ShardStatistic) ([]byte, []byte) {
 		LastBlockTxCount:      shardInfo.LastBlockTxCount(),
 		TotalProcessedTxCount: shardInfo.TotalProcessedTxCount(),
 	}
+}
 
-	serializedInfo, err := json.Marshal(shardTPS)
+// prepareBlock creates the database representation of the provided header, returning it along with the header hash
+func prepareBlock(
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	sizeTxs int,
+) (*Block, []byte) {
+	headerBytes, err := marshalizer.Marshal(header)
 	if err != nil {
-		log.Debug("indexer: could not serialize tps info, will skip indexing tps this shard")
+		log.Debug("indexer: marshal header", "error", err)
+		return nil, nil
+	}
+	bodyBytes, err := marshalizer.Marshal(body)
+	if err != nil {
+		log.Debug("indexer: marshal body", "error", err)
 		return nil, nil
 	}
-	// append a newline foreach element in the bulk we create
-	serializedInfo = append(serializedInfo, "\n"...)
 
-	return serializedInfo, meta
+	blockSizeInBytes := len(headerBytes) + len(bodyBytes)
+
+	miniblocksHashes := make([]string, 0)
+	for _, miniblock := range body.MiniBlocks {
+		mbHash, errComputeHash := core.CalculateHash(marshalizer, hasher, miniblock)
+		if errComputeHash != nil {
+			log.Warn("internal error computing hash", "error", errComputeHash)
+
+			continue
+		}
+
+		encodedMbHash := hex.EncodeToString(mbHash)
+		miniblocksHashes = append(miniblocksHashes, encodedMbHash)
+	}
+
+	headerHash := hasher.Compute(string(headerBytes))
+	dbBlock := &Block{
+		Nonce:                 header.GetNonce(),
+		Round:                 header.GetRound(),
+		Epoch:                 header.GetEpoch(),
+		ShardID:               header.GetShardID(),
+		Hash:                  hex.EncodeToString(headerHash),
+		MiniBlocksHashes:      miniblocksHashes,
+		NotarizedBlocksHashes: notarizedHeadersHashes,
+		Proposer:              signersIndexes[0],
+		Validators:            signersIndexes,
+		PubKeyBitmap:          hex.EncodeToString(header.GetPubKeysBitmap()),
+		Size:                  int64(blockSizeInBytes),
+		SizeTxs:               int64(sizeTxs),
+		Timestamp:             time.Duration(header.GetTimeStamp()),
+		TxCount:               header.GetTxCount(),
+		StateRootHash:         hex.EncodeToString(header.GetRootHash()),
+		PrevHash:              hex.EncodeToString(header.GetPrevHash()),
+	}
+
+	return dbBlock, headerHash
+}
+
+// prepareMiniblocks creates the database representation of the miniblocks of the provided body
+func prepareMiniblocks(marshalizer marshal.Marshalizer, hasher hashing.Hasher, header data.HeaderHandler, body *block.Body) []*Miniblock {
+	headerHash, err := core.CalculateHash(marshalizer, hasher, header)
+	if err != nil {
+		log.Warn("indexer: could not calculate header hash", "error", err.Error())
+		return nil
+	}
+
+	encodedHeaderHash := hex.EncodeToString(headerHash)
+
+	miniblocks := make([]*Miniblock, 0)
+	for _, miniblock := range body.MiniBlocks {
+		mbHash, errComputeHash := core.CalculateHash(marshalizer, hasher, miniblock)
+		if errComputeHash != nil {
+			log.Warn("internal error computing hash", "error", errComputeHash)
+
+			continue
+		}
+
+		encodedMbHash := hex.EncodeToString(mbHash)
+
+		mb := &Miniblock{
+			Hash:            encodedMbHash,
+			SenderShardID:   miniblock.SenderShardID,
+			ReceiverShardID: miniblock.ReceiverShardID,
+			Type:            miniblock.Type.String(),
+		}
+
+		if mb.SenderShardID == header.GetShardID() {
+			mb.SenderBlockHash = encodedHeaderHash
+		} else {
+			mb.ReceiverBlockHash = encodedHeaderHash
+		}
+
+		if mb.SenderShardID == mb.ReceiverShardID {
+			mb.ReceiverBlockHash = encodedHeaderHash
+		}
+
+		miniblocks = append(miniblocks, mb)
+	}
+
+	return miniblocks
 }
 
 func (cm *commonProcessor) buildTransaction(
@@ -458,3 +571,88 @@ func getDecodedResponseMultiGet(response object) map[string]bool {
 
 	return founded
 }
+
+// revertedBlock holds the documents affected by the revert of a block. The documents created by the reverted block are
+// removed, while the ones only updated by it are detached from the block
+type revertedBlock struct {
+	headerHash         string
+	miniblocksToRemove []string
+	miniblocksToDetach []string
+	txsToRemove        []string
+	txsToMarkPending   []string
+}
+
+func prepareRevertedBlock(
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	header data.HeaderHandler,
+	body *block.Body,
+) (*revertedBlock, error) {
+	headerHash, err := core.CalculateHash(marshalizer, hasher, header)
+	if err != nil {
+		return nil, err
+	}
+
+	reverted := &revertedBlock{
+		headerHash:         hex.EncodeToString(headerHash),
+		miniblocksToRemove: make([]string, 0),
+		miniblocksToDetach: make([]string, 0),
+		txsToRemove:        make([]string, 0),
+		txsToMarkPending:   make([]string, 0),
+	}
+
+	for _, miniblock := range body.MiniBlocks {
+		mbHash, errComputeHash := core.CalculateHash(marshalizer, hasher, miniblock)
+		if errComputeHash != nil {
+			log.Warn("internal error computing hash", "error", errComputeHash)
+			continue
+		}
+
+		txHashes := make([]string, 0, len(miniblock.TxHashes))
+		for _, txHash := range miniblock.TxHashes {
+			txHashes = append(txHashes, hex.EncodeToString(txHash))
+		}
+
+		// the miniblocks and the transactions received from other shards were saved by the sender shard as well
+		isReceivedFromOtherShard := miniblock.SenderShardID != header.GetShardID()
+		if isReceivedFromOtherShard {
+			reverted.miniblocksToDetach = append(reverted.miniblocksToDetach, hex.EncodeToString(mbHash))
+			reverted.txsToMarkPending = append(reverted.txsToMarkPending, txHashes...)
+			continue
+		}
+
+		reverted.miniblocksToRemove = append(reverted.miniblocksToRemove, hex.EncodeToString(mbHash))
+		reverted.txsToRemove = append(reverted.txsToRemove, txHashes...)
+	}
+
+	return reverted, nil
+}
+
+// prepareBulkMeta returns the metadata line of a bulk action on the document with the provided ID. The line is JSON
+// encoded, as the ID may hold data which is not under the control of the node, such as a token identifier
+func prepareBulkMeta(action string, id string) ([]byte, error) {
+	meta, err := json.Marshal(map[string]bulkActionMeta{action: {ID: id}})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(meta, '\n'), nil
+}
+
+func serializeBulkRevert(idsToRemove []string, idsToUpdate []string, partialDocument string) bytes.Buffer {
+	var buff bytes.Buffer
+	for _, id := range idsToRemove {
+		_, err := buff.WriteString(fmt.Sprintf(`{ "delete" : { "_id" : "%s" } }%s`, id, "\n"))
+		if err != nil {
+			log.Warn("elastic search: serialize bulk revert, write delete", "error", err.Error())
+		}
+	}
+	for _, id := range idsToUpdate {
+		_, err := buff.WriteString(fmt.Sprintf(`{ "update" : { "_id" : "%s" } }%s{ "doc" : %s }%s`, id, "\n", partialDocument, "\n"))
+		if err != nil {
+			log.Warn("elastic search: serialize bulk revert, write update", "error", err.Error())
+		}
+	}
+
+	return buff
+}
@@ -10,6 +10,22 @@ const tpsIndex = "tps"
 const validatorsIndex = "validators"
 const roundIndex = "rounds"
 const ratingIndex = "rating"
+const accountsIndex = "accounts"
+const accountsESDTIndex = "accountsesdt"
+
+const bulkActionIndex = "index"
+const bulkActionDelete = "delete"
+
+// the accounts are saved and reverted in order by a single worker, the requests over the queue size being dropped
+const accountsQueueSize = 1000
+
+// the accounts saved for the last blocks are kept, so that a reverted block can restore their previous state
+const accountsHistoryMaxBlocks = 100
 
 const metachainTpsDocID = "meta"
 const shardTpsDocIDPrefix = "shard"
+
+// the requests of the retry queue are written to disk one by one, so none is lost on a crash
+const retryQueueBatchDelaySeconds = 1
+const retryQueueMaxBatchSize = 1
+const retryQueueMaxOpenFiles = 10
@@ -134,3 +134,44 @@ type TPS struct {
 	LastBlockTxCount      uint32   `json:"lastBlockTxCount"`
 	ShardID               uint32   `json:"shardID"`
 }
+
+// UpdatedAccount holds the state of an account modified by a committed block. ESDTData maps the identifiers of the
+// tokens written by the block to their serialized ESDT data
+type UpdatedAccount struct {
+	Address  []byte
+	Balance  *big.Int
+	Nonce    uint64
+	ESDTData map[string][]byte
+}
+
+// AccountsLoader returns the state of the accounts modified by a committed block. It is called by the indexer on its
+// own go routine, so that the state is not read on the block commit path
+type AccountsLoader func() []*UpdatedAccount
+
+// removedAccount holds the documents of an account which are removed when the block which created them is reverted.
+// ESDTTokens holds the identifiers of the ESDT holdings documents to remove
+type removedAccount struct {
+	Address       []byte
+	RemoveAccount bool
+	ESDTTokens    []string
+}
+
+// AccountInfo is a structure containing the balance and the nonce of an account
+type AccountInfo struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+	Nonce   uint64 `json:"nonce"`
+}
+
+// AccountESDTInfo is a structure containing the balance an account holds in an ESDT token
+type AccountESDTInfo struct {
+	Address         string `json:"address"`
+	TokenIdentifier string `json:"token"`
+	Balance         string `json:"balance"`
+	Frozen          bool   `json:"frozen"`
+}
+
+// bulkActionMeta is the structure of the action metadata of a bulk request
+type bulkActionMeta struct {
+	ID string `json:"_id"`
+}
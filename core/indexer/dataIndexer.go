@@ -0,0 +1,345 @@
+package indexer
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// dataIndexer holds the driver independent logic of the indexer, the data being saved by the database handler of
+// the driver
+type dataIndexer struct {
+	database        databaseHandler
+	options         *Options
+	coordinator     sharding.NodesCoordinator
+	marshalizer     marshal.Marshalizer
+	isNilIndexer    bool
+	accountsQueue   chan func()
+	accountsHistory []*indexedAccounts
+	cancelFunc      func()
+}
+
+// indexedAccounts holds the accounts saved for a committed block
+type indexedAccounts struct {
+	blockNonce uint64
+	accounts   []*UpdatedAccount
+}
+
+func newDataIndexer(
+	database databaseHandler,
+	shardID uint32,
+	options *Options,
+	coordinator sharding.NodesCoordinator,
+	marshalizer marshal.Marshalizer,
+	epochStartNotifier sharding.EpochStartEventNotifier,
+) *dataIndexer {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	indexer := &dataIndexer{
+		database:        database,
+		options:         options,
+		coordinator:     coordinator,
+		marshalizer:     marshalizer,
+		isNilIndexer:    false,
+		accountsQueue:   make(chan func(), accountsQueueSize),
+		accountsHistory: make([]*indexedAccounts, 0),
+		cancelFunc:      cancelFunc,
+	}
+	go indexer.processAccountsQueue(ctx)
+
+	if shardID == core.MetachainShardId {
+		epochStartNotifier.RegisterHandler(indexer.epochStartEventHandler())
+	}
+
+	return indexer
+}
+
+// SaveBlock will save the header, the miniblocks and, if enabled, the transactions of the block in the database
+func (di *dataIndexer) SaveBlock(
+	bodyHandler data.BodyHandler,
+	headerHandler data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+) {
+	body, ok := bodyHandler.(*block.Body)
+	if !ok {
+		log.Debug("indexer", "error", ErrBodyTypeAssertion.Error())
+		return
+	}
+
+	if check.IfNil(headerHandler) {
+		log.Debug("indexer: no header", "error", ErrNoHeader.Error())
+		return
+	}
+
+	txsSizeInBytes := computeSizeOfTxs(di.marshalizer, txPool)
+	di.database.SaveHeader(headerHandler, signersIndexes, body, notarizedHeadersHashes, txsSizeInBytes)
+
+	if len(body.MiniBlocks) == 0 {
+		return
+	}
+
+	mbsInDb := di.database.SaveMiniblocks(headerHandler, body)
+
+	if di.options.TxIndexingEnabled {
+		di.database.SaveTransactions(body, headerHandler, txPool, headerHandler.GetShardID(), mbsInDb)
+	}
+}
+
+// RevertIndexedBlock will remove from the database the block, the miniblocks and, if enabled, the transactions
+// created by a block which was rolled back. The canonical block is indexed when it gets committed
+func (di *dataIndexer) RevertIndexedBlock(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) {
+	if check.IfNil(headerHandler) {
+		log.Debug("indexer: no header", "error", ErrNoHeader.Error())
+		return
+	}
+
+	body, ok := bodyHandler.(*block.Body)
+	if !ok || body == nil {
+		// the header is removed even if the body of the reverted block is not available
+		body = &block.Body{}
+	}
+
+	di.database.RevertBlock(headerHandler, body)
+
+	if di.options.TxIndexingEnabled {
+		di.database.RevertTransactions(headerHandler, body)
+	}
+
+	blockNonce := headerHandler.GetNonce()
+	di.enqueueAccountsTask(func() {
+		di.revertAccounts(blockNonce)
+	})
+}
+
+// SaveRoundsInfos will save data about a slice of rounds in the database
+func (di *dataIndexer) SaveRoundsInfos(roundsInfos []RoundInfo) {
+	di.database.SaveRoundsInfos(roundsInfos)
+}
+
+func (di *dataIndexer) epochStartEventHandler() epochStart.ActionHandler {
+	subscribeHandler := notifier.NewHandlerForEpochStart(func(hdr data.HeaderHandler) {
+		currentEpoch := hdr.GetEpoch()
+		validatorsPubKeys, err := di.coordinator.GetAllEligibleValidatorsPublicKeys(currentEpoch)
+		if err != nil {
+			log.Warn("GetAllEligibleValidatorPublicKeys for current epoch failed",
+				"epoch", currentEpoch,
+				"error", err.Error())
+		}
+
+		di.SaveValidatorsPubKeys(validatorsPubKeys, currentEpoch)
+
+	}, func(_ data.HeaderHandler) {}, core.IndexerOrder)
+
+	return subscribeHandler
+}
+
+// SaveValidatorsRating will send all validators rating info to the database
+func (di *dataIndexer) SaveValidatorsRating(indexID string, validatorsRatingInfo []ValidatorRatingInfo) {
+	if validatorsRatingInfo != nil && indexID != "" {
+		di.database.SaveValidatorsRating(indexID, validatorsRatingInfo)
+	}
+}
+
+// SaveAccounts will save the balances, the nonces and the ESDT holdings of the accounts modified by a block. The
+// accounts are loaded and saved on the accounts queue go routine and the accounts of consecutive blocks are saved in
+// the order of the calls, so that a newer state is never overwritten
+func (di *dataIndexer) SaveAccounts(blockNonce uint64, loadAccounts AccountsLoader) {
+	if loadAccounts == nil {
+		return
+	}
+
+	di.enqueueAccountsTask(func() {
+		accounts := loadAccounts()
+		if len(accounts) == 0 {
+			return
+		}
+
+		di.database.SaveAccounts(accounts)
+		di.addToAccountsHistory(blockNonce, accounts)
+	})
+}
+
+func (di *dataIndexer) enqueueAccountsTask(task func()) {
+	select {
+	case di.accountsQueue <- task:
+	default:
+		log.Warn("indexer: the accounts queue is full, the request was dropped")
+	}
+}
+
+func (di *dataIndexer) processAccountsQueue(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-di.accountsQueue:
+			task()
+		}
+	}
+}
+
+func (di *dataIndexer) addToAccountsHistory(blockNonce uint64, accounts []*UpdatedAccount) {
+	di.accountsHistory = di.accountsHistory[:di.firstAccountsHistoryIndex(blockNonce)]
+	di.accountsHistory = append(di.accountsHistory, &indexedAccounts{
+		blockNonce: blockNonce,
+		accounts:   accounts,
+	})
+
+	if len(di.accountsHistory) > accountsHistoryMaxBlocks {
+		di.accountsHistory = di.accountsHistory[len(di.accountsHistory)-accountsHistoryMaxBlocks:]
+	}
+}
+
+// firstAccountsHistoryIndex returns the index of the first entry of the accounts history saved for a block with a
+// nonce higher or equal to the provided one
+func (di *dataIndexer) firstAccountsHistoryIndex(blockNonce uint64) int {
+	index := len(di.accountsHistory)
+	for index > 0 && di.accountsHistory[index-1].blockNonce >= blockNonce {
+		index--
+	}
+
+	return index
+}
+
+// revertAccounts restores the accounts saved for the blocks starting with the reverted one to the state saved for
+// the previous blocks. The documents which were not saved for any of the kept blocks are removed, as their previous
+// state is not known
+func (di *dataIndexer) revertAccounts(blockNonce uint64) {
+	firstRevertedIndex := di.firstAccountsHistoryIndex(blockNonce)
+	revertedBlocks := di.accountsHistory[firstRevertedIndex:]
+	di.accountsHistory = di.accountsHistory[:firstRevertedIndex]
+	if len(revertedBlocks) == 0 {
+		return
+	}
+
+	restoredAccounts, removedAccounts := di.prepareRevertedAccounts(revertedBlocks)
+	if len(restoredAccounts) > 0 {
+		di.database.SaveAccounts(restoredAccounts)
+	}
+	if len(removedAccounts) > 0 {
+		di.database.RemoveAccounts(removedAccounts)
+	}
+}
+
+func (di *dataIndexer) prepareRevertedAccounts(revertedBlocks []*indexedAccounts) ([]*UpdatedAccount, []*removedAccount) {
+	revertedAddresses := make([]string, 0)
+	revertedTokens := make(map[string]map[string]struct{})
+	for _, revertedBlock := range revertedBlocks {
+		for _, account := range revertedBlock.accounts {
+			address := string(account.Address)
+			tokens, ok := revertedTokens[address]
+			if !ok {
+				tokens = make(map[string]struct{})
+				revertedTokens[address] = tokens
+				revertedAddresses = append(revertedAddresses, address)
+			}
+
+			for tokenIdentifier := range account.ESDTData {
+				tokens[tokenIdentifier] = struct{}{}
+			}
+		}
+	}
+
+	previousAccounts := make(map[string]*UpdatedAccount)
+	previousESDTData := make(map[string]map[string][]byte)
+	for _, keptBlock := range di.accountsHistory {
+		for _, account := range keptBlock.accounts {
+			address := string(account.Address)
+			tokens, ok := revertedTokens[address]
+			if !ok {
+				continue
+			}
+
+			previousAccounts[address] = account
+			for tokenIdentifier, esdtData := range account.ESDTData {
+				if _, isReverted := tokens[tokenIdentifier]; !isReverted {
+					continue
+				}
+				if previousESDTData[address] == nil {
+					previousESDTData[address] = make(map[string][]byte)
+				}
+				previousESDTData[address][tokenIdentifier] = esdtData
+			}
+		}
+	}
+
+	restoredAccounts := make([]*UpdatedAccount, 0)
+	removedAccounts := make([]*removedAccount, 0)
+	for _, address := range revertedAddresses {
+		removed := &removedAccount{Address: []byte(address)}
+
+		previousAccount, found := previousAccounts[address]
+		if found {
+			restoredAccounts = append(restoredAccounts, &UpdatedAccount{
+				Address:  previousAccount.Address,
+				Balance:  previousAccount.Balance,
+				Nonce:    previousAccount.Nonce,
+				ESDTData: previousESDTData[address],
+			})
+		}
+		removed.RemoveAccount = !found
+
+		for tokenIdentifier := range revertedTokens[address] {
+			if _, ok := previousESDTData[address][tokenIdentifier]; !ok {
+				removed.ESDTTokens = append(removed.ESDTTokens, tokenIdentifier)
+			}
+		}
+		sort.Strings(removed.ESDTTokens)
+
+		if removed.RemoveAccount || len(removed.ESDTTokens) > 0 {
+			removedAccounts = append(removedAccounts, removed)
+		}
+	}
+
+	return restoredAccounts, removedAccounts
+}
+
+// SaveValidatorsPubKeys will send all validators public keys to the database
+func (di *dataIndexer) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	for shardID, shardPubKeys := range validatorsPubKeys {
+		di.database.SaveShardValidatorsPubKeys(shardID, epoch, shardPubKeys)
+	}
+}
+
+// UpdateTPS updates the tps and statistics in the database
+func (di *dataIndexer) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	if tpsBenchmark == nil {
+		log.Debug("indexer: update tps called, but the tpsBenchmark is nil")
+		return
+	}
+
+	di.database.SaveShardStatistics(tpsBenchmark)
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (di *dataIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	di.database.SetTxLogsProcessor(txLogsProc)
+}
+
+// Close will stop the accounts worker and close the database
+func (di *dataIndexer) Close() error {
+	di.cancelFunc()
+
+	return di.database.Close()
+}
+
+// IsNilIndexer will return a bool value that signals if the indexer's implementation is a NilIndexer
+func (di *dataIndexer) IsNilIndexer() bool {
+	return di.isNilIndexer
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (di *dataIndexer) IsInterfaceNil() bool {
+	return di == nil
+}
@@ -0,0 +1,154 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDataIndexerWithJSONFile(t *testing.T) (*dataIndexer, jsonFileDatabaseArgs, func()) {
+	args, cleanup := createMockJSONFileDatabaseArgs(t)
+	jfd, err := newJSONFileDatabase(args)
+	require.Nil(t, err)
+
+	di := newDataIndexer(jfd, 0, &Options{}, &mock.NodesCoordinatorMock{}, args.marshalizer, &mock.EpochStartNotifierStub{})
+
+	return di, args, cleanup
+}
+
+func createTestAccountsLoader(t *testing.T) AccountsLoader {
+	accounts := createTestUpdatedAccounts(t)
+	return func() []*UpdatedAccount {
+		return accounts
+	}
+}
+
+func waitAccountsQueue(di *dataIndexer) {
+	done := make(chan struct{})
+	di.enqueueAccountsTask(func() {
+		close(done)
+	})
+	<-done
+}
+
+func TestDataIndexer_SaveAccountsShouldSaveInCallsOrder(t *testing.T) {
+	t.Parallel()
+
+	di, args, cleanup := createTestDataIndexerWithJSONFile(t)
+	defer cleanup()
+
+	numBlocks := 50
+	for i := 1; i <= numBlocks; i++ {
+		balance := big.NewInt(int64(i))
+		di.SaveAccounts(uint64(i), func() []*UpdatedAccount {
+			return []*UpdatedAccount{{Address: []byte("address1"), Balance: balance}}
+		})
+	}
+	waitAccountsQueue(di)
+	_ = di.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	require.Equal(t, numBlocks, len(documents))
+	lastDocument := documents[numBlocks-1]["document"].(map[string]interface{})
+	require.Equal(t, "50", lastDocument["balance"])
+}
+
+func TestDataIndexer_SaveAccountsShouldLoadTheAccountsOnTheQueueGoRoutine(t *testing.T) {
+	t.Parallel()
+
+	di, args, cleanup := createTestDataIndexerWithJSONFile(t)
+	defer cleanup()
+
+	blockQueue := make(chan struct{})
+	di.enqueueAccountsTask(func() {
+		<-blockQueue
+	})
+
+	loaded := make(chan struct{})
+	di.SaveAccounts(1, func() []*UpdatedAccount {
+		close(loaded)
+		return createTestUpdatedAccounts(t)
+	})
+
+	select {
+	case <-loaded:
+		require.Fail(t, "the accounts should have not been loaded by the caller")
+	default:
+	}
+
+	close(blockQueue)
+	waitAccountsQueue(di)
+	_ = di.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	require.Equal(t, 3, len(documents))
+}
+
+func TestDataIndexer_RevertIndexedBlockShouldRestoreThePreviousAccountsState(t *testing.T) {
+	t.Parallel()
+
+	di, args, cleanup := createTestDataIndexerWithJSONFile(t)
+	defer cleanup()
+
+	di.SaveAccounts(1, createTestAccountsLoader(t))
+
+	esdtData, err := args.marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(10)})
+	require.Nil(t, err)
+	di.SaveAccounts(2, func() []*UpdatedAccount {
+		return []*UpdatedAccount{
+			{
+				Address: []byte("address1"),
+				Balance: big.NewInt(500),
+				Nonce:   6,
+				ESDTData: map[string][]byte{
+					"TKN-01": esdtData,
+					"TKN-02": esdtData,
+				},
+			},
+			{
+				Address: []byte("address3"),
+				Balance: big.NewInt(7),
+			},
+		}
+	})
+	waitAccountsQueue(di)
+
+	di.RevertIndexedBlock(&dataBlock.Header{Nonce: 2}, &dataBlock.Body{})
+	waitAccountsQueue(di)
+	_ = di.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	// 3 and 4 saved accounts documents, 1 reverted block, 2 restored and 2 removed accounts documents
+	require.Equal(t, 12, len(documents))
+
+	address1 := args.addressPubkeyConverter.Encode([]byte("address1"))
+	address3 := args.addressPubkeyConverter.Encode([]byte("address3"))
+	require.Equal(t, address1, documents[8]["id"])
+	require.Equal(t, "1000", documents[8]["document"].(map[string]interface{})["balance"])
+	require.Equal(t, address1+"_TKN-01", documents[9]["id"])
+	require.Equal(t, "37", documents[9]["document"].(map[string]interface{})["balance"])
+	require.Equal(t, address3, documents[10]["id"])
+	require.Equal(t, jsonFileDeleteAction, documents[10]["action"])
+	require.Equal(t, address1+"_TKN-02", documents[11]["id"])
+	require.Equal(t, jsonFileDeleteAction, documents[11]["action"])
+}
+
+func TestDataIndexer_RevertIndexedBlockWithoutSavedAccountsShouldNotWriteAccounts(t *testing.T) {
+	t.Parallel()
+
+	di, args, cleanup := createTestDataIndexerWithJSONFile(t)
+	defer cleanup()
+
+	di.SaveAccounts(1, createTestAccountsLoader(t))
+	di.RevertIndexedBlock(&dataBlock.Header{Nonce: 2}, &dataBlock.Body{})
+	waitAccountsQueue(di)
+	_ = di.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	// 3 saved accounts documents and 1 reverted block
+	require.Equal(t, 4, len(documents))
+}
@@ -0,0 +1,199 @@
+package indexer
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ElasticSearchDriver is the name of the driver saving the indexed data in an elastic search cluster
+const ElasticSearchDriver = "elasticsearch"
+
+// SQLDriver is the name of the driver saving the indexed data in a relational database through database/sql
+const SQLDriver = "sql"
+
+// JSONFileDriver is the name of the driver appending the indexed data as newline delimited JSON in a file
+const JSONFileDriver = "jsonfile"
+
+// ArgsIndexer is struct that is used to store all components that are needed to create the indexer drivers
+// enabled in the external config
+type ArgsIndexer struct {
+	ShardId                  uint32
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	EpochStartNotifier       sharding.EpochStartEventNotifier
+	NodesCoordinator         sharding.NodesCoordinator
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	Options                  *Options
+	ExternalConfig           config.ExternalConfig
+}
+
+type indexerDriver struct {
+	isEnabled func(cfg config.ExternalConfig) bool
+	create    func(args ArgsIndexer) (Indexer, error)
+}
+
+var drivers = map[string]indexerDriver{
+	ElasticSearchDriver: {
+		isEnabled: func(cfg config.ExternalConfig) bool {
+			return cfg.ElasticSearchConnector.Enabled
+		},
+		create: createElasticSearchDriver,
+	},
+	SQLDriver: {
+		isEnabled: func(cfg config.ExternalConfig) bool {
+			return cfg.SQLConnector.Enabled
+		},
+		create: createSQLDriver,
+	},
+	JSONFileDriver: {
+		isEnabled: func(cfg config.ExternalConfig) bool {
+			return cfg.JSONFileConnector.Enabled
+		},
+		create: createJSONFileDriver,
+	},
+}
+
+// NewIndexer creates the indexer drivers enabled in the external config. A nil indexer is returned if no driver is
+// enabled, while multiple enabled drivers will receive the same data
+func NewIndexer(arguments ArgsIndexer) (Indexer, error) {
+	err := checkIndexerArgs(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	driverNames := make([]string, 0, len(drivers))
+	for name, driver := range drivers {
+		if driver.isEnabled(arguments.ExternalConfig) {
+			driverNames = append(driverNames, name)
+		}
+	}
+	sort.Strings(driverNames)
+
+	indexers := make([]Indexer, 0, len(driverNames))
+	for _, name := range driverNames {
+		log.Debug("creating indexer driver", "driver", name)
+		idx, errCreate := drivers[name].create(arguments)
+		if errCreate != nil {
+			return nil, fmt.Errorf("%w for indexer driver %s", errCreate, name)
+		}
+
+		indexers = append(indexers, idx)
+	}
+
+	switch len(indexers) {
+	case 0:
+		return NewNilIndexer(), nil
+	case 1:
+		return indexers[0], nil
+	default:
+		return newIndexersHolder(indexers), nil
+	}
+}
+
+func checkIndexerArgs(arguments ArgsIndexer) error {
+	if check.IfNil(arguments.AddressPubkeyConverter) {
+		return fmt.Errorf("%w when setting addressPubkeyConverter in indexer", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arguments.ValidatorPubkeyConverter) {
+		return fmt.Errorf("%w when setting validatorPubkeyConverter in indexer", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arguments.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(arguments.NodesCoordinator) {
+		return core.ErrNilNodesCoordinator
+	}
+	if arguments.EpochStartNotifier == nil {
+		return core.ErrNilEpochStartNotifier
+	}
+	if arguments.Options == nil {
+		return ErrNilOptions
+	}
+
+	return nil
+}
+
+func createElasticSearchDriver(arguments ArgsIndexer) (Indexer, error) {
+	esConfig := arguments.ExternalConfig.ElasticSearchConnector
+
+	retryQueuePath := ""
+	if esConfig.RetryQueue.Enabled {
+		if len(esConfig.RetryQueue.DirectoryPath) == 0 {
+			return nil, ErrEmptyRetryQueuePath
+		}
+		retryQueuePath = esConfig.RetryQueue.DirectoryPath
+	}
+
+	return NewElasticIndexer(ElasticIndexerArgs{
+		ShardId:                  arguments.ShardId,
+		Url:                      esConfig.URL,
+		UserName:                 esConfig.Username,
+		Password:                 esConfig.Password,
+		Marshalizer:              arguments.Marshalizer,
+		Hasher:                   arguments.Hasher,
+		EpochStartNotifier:       arguments.EpochStartNotifier,
+		NodesCoordinator:         arguments.NodesCoordinator,
+		AddressPubkeyConverter:   arguments.AddressPubkeyConverter,
+		ValidatorPubkeyConverter: arguments.ValidatorPubkeyConverter,
+		Options:                  arguments.Options,
+		RetryQueuePath:           retryQueuePath,
+		RetryInterval:            time.Duration(esConfig.RetryQueue.RetryIntervalInSeconds) * time.Second,
+		RetryQueueMaxSize:        int(esConfig.RetryQueue.MaxNumRequests),
+	})
+}
+
+func createSQLDriver(arguments ArgsIndexer) (Indexer, error) {
+	sqlConfig := arguments.ExternalConfig.SQLConnector
+
+	database, err := newSQLDatabase(sqlDatabaseArgs{
+		driverName:               sqlConfig.DriverName,
+		dataSourceName:           sqlConfig.DataSourceName,
+		marshalizer:              arguments.Marshalizer,
+		hasher:                   arguments.Hasher,
+		addressPubkeyConverter:   arguments.AddressPubkeyConverter,
+		validatorPubkeyConverter: arguments.ValidatorPubkeyConverter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create indexer: %w", err)
+	}
+
+	return newDataIndexerFromArgs(database, arguments), nil
+}
+
+func createJSONFileDriver(arguments ArgsIndexer) (Indexer, error) {
+	database, err := newJSONFileDatabase(jsonFileDatabaseArgs{
+		filePath:                 arguments.ExternalConfig.JSONFileConnector.FilePath,
+		marshalizer:              arguments.Marshalizer,
+		hasher:                   arguments.Hasher,
+		addressPubkeyConverter:   arguments.AddressPubkeyConverter,
+		validatorPubkeyConverter: arguments.ValidatorPubkeyConverter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create indexer: %w", err)
+	}
+
+	return newDataIndexerFromArgs(database, arguments), nil
+}
+
+func newDataIndexerFromArgs(database databaseHandler, arguments ArgsIndexer) *dataIndexer {
+	return newDataIndexer(
+		database,
+		arguments.ShardId,
+		arguments.Options,
+		arguments.NodesCoordinator,
+		arguments.Marshalizer,
+		arguments.EpochStartNotifier,
+	)
+}
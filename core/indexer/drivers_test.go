@@ -0,0 +1,148 @@
+package indexer_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockIndexerArgs() indexer.ArgsIndexer {
+	return indexer.ArgsIndexer{
+		Marshalizer:              &mock.MarshalizerMock{},
+		Hasher:                   &mock.HasherMock{},
+		Options:                  &indexer.Options{TxIndexingEnabled: true},
+		NodesCoordinator:         &mock.NodesCoordinatorMock{},
+		EpochStartNotifier:       &mock.EpochStartNotifierStub{},
+		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(96),
+	}
+}
+
+func TestNewIndexer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockIndexerArgs()
+	args.Marshalizer = nil
+
+	idx, err := indexer.NewIndexer(args)
+	require.Nil(t, idx)
+	require.Equal(t, core.ErrNilMarshalizer, err)
+}
+
+func TestNewIndexer_NilOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockIndexerArgs()
+	args.Options = nil
+
+	idx, err := indexer.NewIndexer(args)
+	require.Nil(t, idx)
+	require.Equal(t, indexer.ErrNilOptions, err)
+}
+
+func TestNewIndexer_NoDriverEnabledShouldReturnNilIndexer(t *testing.T) {
+	t.Parallel()
+
+	idx, err := indexer.NewIndexer(createMockIndexerArgs())
+	require.Nil(t, err)
+	require.True(t, idx.IsNilIndexer())
+}
+
+func TestNewIndexer_DriverCreationErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockIndexerArgs()
+	args.ExternalConfig.JSONFileConnector.Enabled = true
+
+	idx, err := indexer.NewIndexer(args)
+	require.Nil(t, idx)
+	require.True(t, errors.Is(err, indexer.ErrEmptyFilePath))
+}
+
+func TestNewIndexer_MultipleDriversShouldSaveInAll(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "indexerDrivers")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "indexer.ndjson")
+	args := createMockIndexerArgs()
+	args.ExternalConfig = config.ExternalConfig{
+		SQLConnector: config.SQLConnectorConfig{
+			Enabled:        true,
+			DriverName:     "sqlite3",
+			DataSourceName: filepath.Join(dir, "indexer.sqlite"),
+		},
+		JSONFileConnector: config.JSONFileConnectorConfig{
+			Enabled:  true,
+			FilePath: filePath,
+		},
+	}
+
+	idx, err := indexer.NewIndexer(args)
+	require.Nil(t, err)
+	require.False(t, idx.IsNilIndexer())
+	require.False(t, idx.IsInterfaceNil())
+
+	idx.SaveBlock(&block.Body{}, &block.Header{Nonce: 1}, nil, []uint64{0}, nil)
+	idx.SaveRoundsInfos([]indexer.RoundInfo{{Index: 1}})
+
+	buff, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	require.Contains(t, string(buff), `"index":"blocks"`)
+	require.Contains(t, string(buff), `"index":"rounds"`)
+}
+
+func TestNewIndexer_RevertIndexedBlockWithoutBodyShouldRemoveHeader(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "indexerDrivers")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "indexer.ndjson")
+	args := createMockIndexerArgs()
+	args.ExternalConfig.JSONFileConnector = config.JSONFileConnectorConfig{
+		Enabled:  true,
+		FilePath: filePath,
+	}
+
+	idx, _ := indexer.NewIndexer(args)
+	idx.RevertIndexedBlock(&block.Header{Nonce: 1}, nil)
+	require.Nil(t, idx.Close())
+
+	buff, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	require.Contains(t, string(buff), `"index":"blocks"`)
+	require.Contains(t, string(buff), `"action":"delete"`)
+}
+
+func TestNewIndexer_RetryQueueWithoutPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockIndexerArgs()
+	args.ExternalConfig.ElasticSearchConnector = config.ElasticSearchConfig{
+		Enabled: true,
+		RetryQueue: config.ElasticSearchRetryQueueConfig{
+			Enabled: true,
+		},
+	}
+
+	idx, err := indexer.NewIndexer(args)
+	require.Nil(t, idx)
+	require.True(t, errors.Is(err, indexer.ErrEmptyRetryQueuePath))
+}
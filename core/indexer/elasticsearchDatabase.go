@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
@@ -27,6 +28,9 @@ type elasticSearchDatabaseArgs struct {
 	hasher                   hashing.Hasher
 	addressPubkeyConverter   core.PubkeyConverter
 	validatorPubkeyConverter core.PubkeyConverter
+	retryQueuePath           string
+	retryInterval            time.Duration
+	retryQueueMaxSize        int
 }
 
 // elasticSearchDatabase object it contains business logic built over databaseWriterHandler glue code wrapper
@@ -49,8 +53,13 @@ func newElasticSearchDatabase(arguments elasticSearchDatabaseArgs) (*elasticSear
 		return nil, err
 	}
 
+	dbClient, err := createDatabaseClient(es, arguments)
+	if err != nil {
+		return nil, err
+	}
+
 	esdb := &elasticSearchDatabase{
-		dbClient:    es,
+		dbClient:    dbClient,
 		marshalizer: arguments.marshalizer,
 		hasher:      arguments.hasher,
 	}
@@ -63,12 +72,32 @@ func newElasticSearchDatabase(arguments elasticSearchDatabaseArgs) (*elasticSear
 
 	err = esdb.createIndexes()
 	if err != nil {
+		_ = dbClient.Close()
 		return nil, err
 	}
 
 	return esdb, nil
 }
 
+func createDatabaseClient(client *databaseClient, arguments elasticSearchDatabaseArgs) (databaseClientHandler, error) {
+	if len(arguments.retryQueuePath) == 0 {
+		return client, nil
+	}
+
+	persister, err := leveldb.NewDB(arguments.retryQueuePath, retryQueueBatchDelaySeconds, retryQueueMaxBatchSize, retryQueueMaxOpenFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	retryClient, err := newRetryDatabaseClient(client, persister, arguments.retryInterval, arguments.retryQueueMaxSize)
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	return retryClient, nil
+}
+
 func (esd *elasticSearchDatabase) createIndexes() error {
 	err := esd.dbClient.CheckAndCreateIndex(blockIndex, timestampMapping())
 	if err != nil {
@@ -105,6 +134,16 @@ func (esd *elasticSearchDatabase) createIndexes() error {
 		return err
 	}
 
+	err = esd.dbClient.CheckAndCreateIndex(accountsIndex, nil)
+	if err != nil {
+		return err
+	}
+
+	err = esd.dbClient.CheckAndCreateIndex(accountsESDTIndex, nil)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -147,52 +186,11 @@ func (esd *elasticSearchDatabase) getSerializedElasticBlockAndHeaderHash(
 	notarizedHeadersHashes []string,
 	sizeTxs int,
 ) ([]byte, []byte) {
-	headerBytes, err := esd.marshalizer.Marshal(header)
-	if err != nil {
-		log.Debug("indexer: marshal header", "error", err)
-		return nil, nil
-	}
-	bodyBytes, err := esd.marshalizer.Marshal(body)
-	if err != nil {
-		log.Debug("indexer: marshal body", "error", err)
+	elasticBlock, headerHash := prepareBlock(esd.marshalizer, esd.hasher, header, signersIndexes, body, notarizedHeadersHashes, sizeTxs)
+	if elasticBlock == nil {
 		return nil, nil
 	}
 
-	blockSizeInBytes := len(headerBytes) + len(bodyBytes)
-
-	miniblocksHashes := make([]string, 0)
-	for _, miniblock := range body.MiniBlocks {
-		mbHash, errComputeHash := core.CalculateHash(esd.marshalizer, esd.hasher, miniblock)
-		if errComputeHash != nil {
-			log.Warn("internal error computing hash", "error", errComputeHash)
-
-			continue
-		}
-
-		encodedMbHash := hex.EncodeToString(mbHash)
-		miniblocksHashes = append(miniblocksHashes, encodedMbHash)
-	}
-
-	headerHash := esd.hasher.Compute(string(headerBytes))
-	elasticBlock := Block{
-		Nonce:                 header.GetNonce(),
-		Round:                 header.GetRound(),
-		Epoch:                 header.GetEpoch(),
-		ShardID:               header.GetShardID(),
-		Hash:                  hex.EncodeToString(headerHash),
-		MiniBlocksHashes:      miniblocksHashes,
-		NotarizedBlocksHashes: notarizedHeadersHashes,
-		Proposer:              signersIndexes[0],
-		Validators:            signersIndexes,
-		PubKeyBitmap:          hex.EncodeToString(header.GetPubKeysBitmap()),
-		Size:                  int64(blockSizeInBytes),
-		SizeTxs:               int64(sizeTxs),
-		Timestamp:             time.Duration(header.GetTimeStamp()),
-		TxCount:               header.GetTxCount(),
-		StateRootHash:         hex.EncodeToString(header.GetRootHash()),
-		PrevHash:              hex.EncodeToString(header.GetPrevHash()),
-	}
-
 	serializedBlock, err := json.Marshal(elasticBlock)
 	if err != nil {
 		log.Debug("indexer: marshal", "error", "could not marshal elastic header")
@@ -230,7 +228,7 @@ func (esd *elasticSearchDatabase) SetTxLogsProcessor(txLogsProc process.Transact
 
 // SaveMiniblocks will prepare and save information about miniblocks in elasticsearch server
 func (esd *elasticSearchDatabase) SaveMiniblocks(header data.HeaderHandler, body *block.Body) map[string]bool {
-	miniblocks := esd.getMiniblocks(header, body)
+	miniblocks := prepareMiniblocks(esd.marshalizer, esd.hasher, header, body)
 	if miniblocks == nil {
 		log.Warn("indexer: could not index miniblocks")
 		return make(map[string]bool)
@@ -248,49 +246,6 @@ func (esd *elasticSearchDatabase) SaveMiniblocks(header data.HeaderHandler, body
 	return mbHashDb
 }
 
-func (esd *elasticSearchDatabase) getMiniblocks(header data.HeaderHandler, body *block.Body) []*Miniblock {
-	headerHash, err := core.CalculateHash(esd.marshalizer, esd.hasher, header)
-	if err != nil {
-		log.Warn("indexer: could not calculate header hash", "error", err.Error())
-		return nil
-	}
-
-	encodedHeaderHash := hex.EncodeToString(headerHash)
-
-	miniblocks := make([]*Miniblock, 0)
-	for _, miniblock := range body.MiniBlocks {
-		mbHash, errComputeHash := core.CalculateHash(esd.marshalizer, esd.hasher, miniblock)
-		if errComputeHash != nil {
-			log.Warn("internal error computing hash", "error", errComputeHash)
-
-			continue
-		}
-
-		encodedMbHash := hex.EncodeToString(mbHash)
-
-		mb := &Miniblock{
-			Hash:            encodedMbHash,
-			SenderShardID:   miniblock.SenderShardID,
-			ReceiverShardID: miniblock.ReceiverShardID,
-			Type:            miniblock.Type.String(),
-		}
-
-		if mb.SenderShardID == header.GetShardID() {
-			mb.SenderBlockHash = encodedHeaderHash
-		} else {
-			mb.ReceiverBlockHash = encodedHeaderHash
-		}
-
-		if mb.SenderShardID == mb.ReceiverShardID {
-			mb.ReceiverBlockHash = encodedHeaderHash
-		}
-
-		miniblocks = append(miniblocks, mb)
-	}
-
-	return miniblocks
-}
-
 // SaveRoundsInfos will prepare and save information about a slice of rounds in elasticsearch server
 func (esd *elasticSearchDatabase) SaveRoundsInfos(infos []RoundInfo) {
 	var buff bytes.Buffer
@@ -402,6 +357,125 @@ func (esd *elasticSearchDatabase) SaveValidatorsRating(index string, validatorsR
 	}
 }
 
+// SaveAccounts will prepare and save the modified accounts and their ESDT holdings in elasticsearch server
+func (esd *elasticSearchDatabase) SaveAccounts(updatedAccounts []*UpdatedAccount) {
+	accounts, esdtAccounts := esd.prepareAccounts(updatedAccounts)
+
+	if len(accounts) > 0 {
+		buff := serializeAccounts(accounts)
+		err := esd.dbClient.DoBulkRequest(&buff, accountsIndex)
+		if err != nil {
+			log.Warn("indexer: cannot index accounts", "error", err.Error())
+		}
+	}
+
+	if len(esdtAccounts) > 0 {
+		buff := serializeAccountsESDT(esdtAccounts)
+		err := esd.dbClient.DoBulkRequest(&buff, accountsESDTIndex)
+		if err != nil {
+			log.Warn("indexer: cannot index accounts ESDT", "error", err.Error())
+		}
+	}
+}
+
+// RemoveAccounts will remove the accounts and the ESDT holdings written by a reverted block from elasticsearch server
+func (esd *elasticSearchDatabase) RemoveAccounts(removedAccounts []*removedAccount) {
+	addresses, esdtAccounts := esd.prepareRemovedAccounts(removedAccounts)
+
+	if len(addresses) > 0 {
+		buff := serializeBulkDelete(addresses)
+		err := esd.dbClient.DoBulkRequest(&buff, accountsIndex)
+		if err != nil {
+			log.Warn("indexer: cannot remove accounts", "error", err.Error())
+		}
+	}
+
+	if len(esdtAccounts) > 0 {
+		ids := make([]string, 0, len(esdtAccounts))
+		for _, esdtAccount := range esdtAccounts {
+			ids = append(ids, accountESDTDocumentID(esdtAccount))
+		}
+
+		buff := serializeBulkDelete(ids)
+		err := esd.dbClient.DoBulkRequest(&buff, accountsESDTIndex)
+		if err != nil {
+			log.Warn("indexer: cannot remove accounts ESDT", "error", err.Error())
+		}
+	}
+}
+
+func serializeAccounts(accounts []*AccountInfo) bytes.Buffer {
+	var buff bytes.Buffer
+	for _, account := range accounts {
+		meta, err := prepareBulkMeta(bulkActionIndex, account.Address)
+		if err != nil {
+			log.Debug("indexer: marshal account meta", "error", err.Error())
+			continue
+		}
+		serializedAccount, err := json.Marshal(account)
+		if err != nil {
+			log.Debug("indexer: marshal account", "error", err.Error())
+			continue
+		}
+
+		writeBulkDocument(&buff, meta, serializedAccount)
+	}
+
+	return buff
+}
+
+func serializeAccountsESDT(esdtAccounts []*AccountESDTInfo) bytes.Buffer {
+	var buff bytes.Buffer
+	for _, esdtAccount := range esdtAccounts {
+		meta, err := prepareBulkMeta(bulkActionIndex, accountESDTDocumentID(esdtAccount))
+		if err != nil {
+			log.Debug("indexer: marshal account ESDT meta", "error", err.Error())
+			continue
+		}
+		serializedESDTAccount, err := json.Marshal(esdtAccount)
+		if err != nil {
+			log.Debug("indexer: marshal account ESDT", "error", err.Error())
+			continue
+		}
+
+		writeBulkDocument(&buff, meta, serializedESDTAccount)
+	}
+
+	return buff
+}
+
+func serializeBulkDelete(ids []string) bytes.Buffer {
+	var buff bytes.Buffer
+	for _, id := range ids {
+		meta, err := prepareBulkMeta(bulkActionDelete, id)
+		if err != nil {
+			log.Debug("indexer: marshal delete meta", "error", err.Error())
+			continue
+		}
+
+		_, err = buff.Write(meta)
+		if err != nil {
+			log.Warn("indexer: cannot write meta", "error", err.Error())
+		}
+	}
+
+	return buff
+}
+
+func writeBulkDocument(buff *bytes.Buffer, meta []byte, serializedData []byte) {
+	// append a newline for each element in the bulk
+	serializedData = append(serializedData, "\n"...)
+	buff.Grow(len(meta) + len(serializedData))
+	_, err := buff.Write(meta)
+	if err != nil {
+		log.Warn("indexer: cannot write meta", "error", err.Error())
+	}
+	_, err = buff.Write(serializedData)
+	if err != nil {
+		log.Warn("indexer: cannot write serialized document", "error", err.Error())
+	}
+}
+
 // SaveShardStatistics will prepare and save information about a shard statistics in elasticsearch server
 func (esd *elasticSearchDatabase) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) {
 	buff := prepareGeneralInfo(tpsBenchmark)
@@ -430,6 +504,57 @@ func (esd *elasticSearchDatabase) SaveShardStatistics(tpsBenchmark statistics.TP
 	}
 }
 
+// RevertBlock will remove the block and the miniblocks created by it from elasticsearch server. The miniblocks
+// received from other shards are only detached from the block
+func (esd *elasticSearchDatabase) RevertBlock(header data.HeaderHandler, body *block.Body) {
+	reverted, err := prepareRevertedBlock(esd.marshalizer, esd.hasher, header, body)
+	if err != nil {
+		log.Warn("indexer: could not prepare the reverted block", "error", err.Error())
+		return
+	}
+
+	buff := serializeBulkRevert([]string{reverted.headerHash}, nil, "")
+	err = esd.dbClient.DoBulkRequest(&buff, blockIndex)
+	if err != nil {
+		log.Warn("indexer: could not remove reverted block", "error", err.Error())
+	}
+
+	if len(reverted.miniblocksToRemove)+len(reverted.miniblocksToDetach) == 0 {
+		return
+	}
+
+	buff = serializeBulkRevert(reverted.miniblocksToRemove, reverted.miniblocksToDetach, `{ "receiverBlockHash" : "" }`)
+	err = esd.dbClient.DoBulkRequest(&buff, miniblocksIndex)
+	if err != nil {
+		log.Warn("indexer: could not revert miniblocks", "error", err.Error())
+	}
+}
+
+// RevertTransactions will remove the transactions created by the block from elasticsearch server. The transactions
+// received from other shards are marked as pending
+func (esd *elasticSearchDatabase) RevertTransactions(header data.HeaderHandler, body *block.Body) {
+	reverted, err := prepareRevertedBlock(esd.marshalizer, esd.hasher, header, body)
+	if err != nil {
+		log.Warn("indexer: could not prepare the reverted block", "error", err.Error())
+		return
+	}
+	if len(reverted.txsToRemove)+len(reverted.txsToMarkPending) == 0 {
+		return
+	}
+
+	partialDocument := fmt.Sprintf(`{ "status" : "%s" }`, txStatusPending)
+	buff := serializeBulkRevert(reverted.txsToRemove, reverted.txsToMarkPending, partialDocument)
+	err = esd.dbClient.DoBulkRequest(&buff, txIndex)
+	if err != nil {
+		log.Warn("indexer: could not revert transactions", "error", err.Error())
+	}
+}
+
+// Close will close the database client
+func (esd *elasticSearchDatabase) Close() error {
+	return esd.dbClient.Close()
+}
+
 func (esd *elasticSearchDatabase) foundedObjMap(hashes []string, index string) (map[string]bool, error) {
 	if len(hashes) == 0 {
 		return make(map[string]bool), nil
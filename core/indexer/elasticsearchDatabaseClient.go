@@ -101,6 +101,7 @@ func (dc *databaseClient) DoRequest(req *esapi.IndexRequest) error {
 
 	if res.IsError() {
 		log.Warn("indexer do request", "error", res.String())
+		return responseError(res)
 	}
 
 	return nil
@@ -126,7 +127,7 @@ func (dc *databaseClient) DoBulkRequest(buff *bytes.Buffer, index string) error
 	if res.IsError() {
 		log.Warn("indexer do bulk request",
 			"error", res.String())
-		return fmt.Errorf("do bulk request %s", res.String())
+		return responseError(res)
 	}
 
 	return nil
@@ -176,6 +177,22 @@ func (dc *databaseClient) DoMultiGet(obj object, index string) (object, error) {
 	return decodedBody, nil
 }
 
+// responseError returns the error of a failed request. The requests rejected by the server, which will fail again if
+// sent unchanged, are marked with ErrRequestRejected
+func responseError(res *esapi.Response) error {
+	isTransient := res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests
+	if isTransient {
+		return fmt.Errorf("do request %s", res.String())
+	}
+
+	return fmt.Errorf("%w: %s", ErrRequestRejected, res.String())
+}
+
+// Close does nothing as the client does not hold any resource
+func (dc *databaseClient) Close() error {
+	return nil
+}
+
 func closeESResponseBody(res *esapi.Response) {
 	if res != nil && res.Body != nil {
 		err := res.Body.Close()
@@ -689,3 +689,77 @@ func TestTrimSliceInBulks(t *testing.T) {
 	require.Equal(t, len(bulksBigCapacity1), sliceSize/bulkSize+1)
 	require.Equal(t, len(bulksBigCapacity2), sliceSize/bulkSize+1)
 }
+
+func TestElasticsearch_RevertBlockAndTransactions(t *testing.T) {
+	header := &dataBlock.Header{Nonce: 1, ShardID: 0}
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx1")}, SenderShardID: 0, ReceiverShardID: 1},
+			{TxHashes: [][]byte{[]byte("tx2")}, SenderShardID: 1, ReceiverShardID: 0},
+		},
+	}
+
+	requests := make(map[string]string)
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			requests[index] = buff.String()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.RevertBlock(header, body)
+	elasticDatabase.RevertTransactions(header, body)
+
+	headerHash, _ := core.CalculateHash(arguments.marshalizer, arguments.hasher, header)
+	require.Equal(t, fmt.Sprintf(`{ "delete" : { "_id" : "%s" } }%s`, hex.EncodeToString(headerHash), "\n"), requests[blockIndex])
+	require.Equal(t, 1, strings.Count(requests[miniblocksIndex], `"delete"`))
+	require.Equal(t, 1, strings.Count(requests[miniblocksIndex], `{ "doc" : { "receiverBlockHash" : "" } }`))
+	require.True(t, strings.Contains(requests[txIndex], fmt.Sprintf(`{ "delete" : { "_id" : "%s" } }`, hex.EncodeToString([]byte("tx1")))))
+	require.True(t, strings.Contains(requests[txIndex], fmt.Sprintf(`{ "update" : { "_id" : "%s" } }`, hex.EncodeToString([]byte("tx2")))))
+	require.True(t, strings.Contains(requests[txIndex], `{ "doc" : { "status" : "Pending" } }`))
+}
+
+func TestElasticsearch_SaveAccounts(t *testing.T) {
+	requests := make(map[string]string)
+	arguments := createMockElasticsearchDatabaseArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			requests[index] = buff.String()
+			return nil
+		},
+	}
+
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+	elasticDatabase.SaveAccounts(createTestUpdatedAccounts(t))
+
+	address1 := arguments.addressPubkeyConverter.Encode([]byte("address1"))
+	address2 := arguments.addressPubkeyConverter.Encode([]byte("address2"))
+	require.Equal(t, 2, strings.Count(requests[accountsIndex], `"index"`))
+	require.True(t, strings.Contains(requests[accountsIndex], fmt.Sprintf(`{"index":{"_id":"%s"}}`, address1)))
+	require.True(t, strings.Contains(requests[accountsIndex], fmt.Sprintf(`{"index":{"_id":"%s"}}`, address2)))
+	require.True(t, strings.Contains(requests[accountsIndex], `"balance":"1000","nonce":5`))
+	require.True(t, strings.Contains(requests[accountsESDTIndex], fmt.Sprintf(`{"index":{"_id":"%s_TKN-01"}}`, address1)))
+	require.True(t, strings.Contains(requests[accountsESDTIndex], `"token":"TKN-01","balance":"37","frozen":true`))
+}
+
+func TestSerializeAccountsESDT_TokenIdentifierIsEscaped(t *testing.T) {
+	t.Parallel()
+
+	esdtAccount := &AccountESDTInfo{
+		Address:         "erd1",
+		TokenIdentifier: `TKN" } }` + "\n" + `{ "delete" : { "_id" : "\x`,
+		Balance:         "10",
+	}
+
+	buff := serializeAccountsESDT([]*AccountESDTInfo{esdtAccount})
+
+	lines := strings.Split(strings.TrimSuffix(buff.String(), "\n"), "\n")
+	require.Equal(t, 2, len(lines))
+
+	meta := make(map[string]bulkActionMeta)
+	err := json.Unmarshal([]byte(lines[0]), &meta)
+	require.Nil(t, err)
+	require.Equal(t, "erd1_"+esdtAccount.TokenIdentifier, meta[bulkActionIndex].ID)
+}
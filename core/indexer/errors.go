@@ -24,3 +24,33 @@ var ErrEmptyPassword = errors.New("password is empty")
 
 // ErrNilPubkeyConverter signals that an operation has been attempted to or with a nil public key converter implementation
 var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrEmptySQLDriverName signals that the database/sql driver name of the SQL indexer driver is empty
+var ErrEmptySQLDriverName = errors.New("empty sql driver name")
+
+// ErrEmptySQLDataSourceName signals that the data source name of the SQL indexer driver is empty
+var ErrEmptySQLDataSourceName = errors.New("empty sql data source name")
+
+// ErrEmptyFilePath signals that the file path of the JSON file indexer driver is empty
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrNilOptions signals that the indexer options are nil
+var ErrNilOptions = errors.New("nil indexer options")
+
+// ErrNilDatabaseClient signals that a nil database client has been provided
+var ErrNilDatabaseClient = errors.New("nil database client")
+
+// ErrNilRetryQueuePersister signals that a nil persister of the retry queue has been provided
+var ErrNilRetryQueuePersister = errors.New("nil retry queue persister")
+
+// ErrInvalidRetryInterval signals that the interval between the replays of the retry queue is too small
+var ErrInvalidRetryInterval = errors.New("invalid retry interval")
+
+// ErrInvalidRetryQueueMaxSize signals that the maximum number of requests held by the retry queue is invalid
+var ErrInvalidRetryQueueMaxSize = errors.New("invalid retry queue max size")
+
+// ErrRequestRejected signals that the elastic search server rejected the request and sending it again will not help
+var ErrRequestRejected = errors.New("request rejected by the server")
+
+// ErrEmptyRetryQueuePath signals that the retry queue is enabled but its directory path is empty
+var ErrEmptyRetryQueuePath = errors.New("empty retry queue directory path")
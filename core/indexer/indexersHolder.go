@@ -0,0 +1,119 @@
+package indexer
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// indexersHolder forwards the indexed data to all the enabled indexer drivers
+type indexersHolder struct {
+	indexers []Indexer
+}
+
+func newIndexersHolder(indexers []Indexer) *indexersHolder {
+	return &indexersHolder{
+		indexers: indexers,
+	}
+}
+
+// SetTxLogsProcessor will set the tx logs processor on all the drivers
+func (ih *indexersHolder) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	for _, idx := range ih.indexers {
+		idx.SetTxLogsProcessor(txLogsProc)
+	}
+}
+
+// SaveBlock will save the block in all the drivers
+func (ih *indexersHolder) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+) {
+	for _, idx := range ih.indexers {
+		idx.SaveBlock(body, header, txPool, signersIndexes, notarizedHeadersHashes)
+	}
+}
+
+// SaveRoundsInfos will save the rounds information in all the drivers
+func (ih *indexersHolder) SaveRoundsInfos(roundsInfos []RoundInfo) {
+	for _, idx := range ih.indexers {
+		idx.SaveRoundsInfos(roundsInfos)
+	}
+}
+
+// UpdateTPS will update the tps statistics in all the drivers
+func (ih *indexersHolder) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	for _, idx := range ih.indexers {
+		idx.UpdateTPS(tpsBenchmark)
+	}
+}
+
+// SaveValidatorsPubKeys will save the validators public keys in all the drivers
+func (ih *indexersHolder) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	for _, idx := range ih.indexers {
+		idx.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+	}
+}
+
+// SaveValidatorsRating will save the validators rating in all the drivers
+func (ih *indexersHolder) SaveValidatorsRating(indexID string, infoRating []ValidatorRatingInfo) {
+	for _, idx := range ih.indexers {
+		idx.SaveValidatorsRating(indexID, infoRating)
+	}
+}
+
+// SaveAccounts will save the modified accounts in all the drivers. The accounts are loaded only once, by the first
+// driver which saves them
+func (ih *indexersHolder) SaveAccounts(blockNonce uint64, loadAccounts AccountsLoader) {
+	if loadAccounts == nil {
+		return
+	}
+
+	var accounts []*UpdatedAccount
+	loadOnce := sync.Once{}
+	loadAccountsOnce := func() []*UpdatedAccount {
+		loadOnce.Do(func() {
+			accounts = loadAccounts()
+		})
+		return accounts
+	}
+
+	for _, idx := range ih.indexers {
+		idx.SaveAccounts(blockNonce, loadAccountsOnce)
+	}
+}
+
+// RevertIndexedBlock will revert the block in all the drivers
+func (ih *indexersHolder) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	for _, idx := range ih.indexers {
+		idx.RevertIndexedBlock(header, body)
+	}
+}
+
+// Close will close all the drivers
+func (ih *indexersHolder) Close() error {
+	var lastErr error
+	for _, idx := range ih.indexers {
+		err := idx.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsNilIndexer returns false as at least two drivers are held
+func (ih *indexersHolder) IsNilIndexer() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ih *indexersHolder) IsInterfaceNil() bool {
+	return ih == nil
+}
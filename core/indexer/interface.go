@@ -20,6 +20,9 @@ type Indexer interface {
 	UpdateTPS(tpsBenchmark statistics.TPSBenchmark)
 	SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32)
 	SaveValidatorsRating(indexID string, infoRating []ValidatorRatingInfo)
+	SaveAccounts(blockNonce uint64, loadAccounts AccountsLoader)
+	RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler)
+	Close() error
 	IsInterfaceNil() bool
 	IsNilIndexer() bool
 }
@@ -34,6 +37,11 @@ type databaseHandler interface {
 	SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte)
 	SaveValidatorsRating(Index string, validatorsRatingInfo []ValidatorRatingInfo)
 	SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark)
+	SaveAccounts(accounts []*UpdatedAccount)
+	RemoveAccounts(accounts []*removedAccount)
+	RevertBlock(header data.HeaderHandler, body *block.Body)
+	RevertTransactions(header data.HeaderHandler, body *block.Body)
+	Close() error
 }
 
 // databaseClientHandler is an interface that do requests to elasticsearch server
@@ -42,4 +50,5 @@ type databaseClientHandler interface {
 	DoBulkRequest(buff *bytes.Buffer, index string) error
 	DoMultiGet(query object, index string) (object, error)
 	CheckAndCreateIndex(index string, body io.Reader) error
+	Close() error
 }
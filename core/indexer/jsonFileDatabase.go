@@ -0,0 +1,272 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const jsonFileUpdateAction = "update"
+const jsonFileDeleteAction = "delete"
+
+// jsonFileDocument is the structure of each line written by the JSON file driver. An empty action means that the
+// document is indexed, the update action holds only the changed fields and the delete action holds no document
+type jsonFileDocument struct {
+	Index    string      `json:"index"`
+	ID       string      `json:"id"`
+	Action   string      `json:"action,omitempty"`
+	Document interface{} `json:"document,omitempty"`
+}
+
+// jsonFileDatabaseArgs is struct that is used to store all parameters that are needed to create a JSON file database
+type jsonFileDatabaseArgs struct {
+	filePath                 string
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+}
+
+// jsonFileDatabase appends the indexed data as newline delimited JSON documents in a file. Each line holds the
+// index name, the document ID and the document, so the file can be replayed in any other store
+type jsonFileDatabase struct {
+	*txDatabaseProcessor
+	mutFile     sync.Mutex
+	file        *os.File
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// newJSONFileDatabase opens, or creates, the file in which the indexed data is appended
+func newJSONFileDatabase(arguments jsonFileDatabaseArgs) (*jsonFileDatabase, error) {
+	if len(arguments.filePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	file, err := os.OpenFile(arguments.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, core.FileModeUserReadWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	jfd := &jsonFileDatabase{
+		file:        file,
+		marshalizer: arguments.marshalizer,
+		hasher:      arguments.hasher,
+	}
+	jfd.txDatabaseProcessor = newTxDatabaseProcessor(
+		arguments.hasher,
+		arguments.marshalizer,
+		arguments.addressPubkeyConverter,
+		arguments.validatorPubkeyConverter,
+	)
+
+	return jfd, nil
+}
+
+// SaveHeader will prepare and append information about a header in the file
+func (jfd *jsonFileDatabase) SaveHeader(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	txsSize int,
+) {
+	dbBlock, _ := prepareBlock(jfd.marshalizer, jfd.hasher, header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	if dbBlock == nil {
+		return
+	}
+
+	jfd.writeDocuments(jsonFileDocument{Index: blockIndex, ID: dbBlock.Hash, Document: dbBlock})
+}
+
+// SaveMiniblocks will prepare and append information about miniblocks in the file
+func (jfd *jsonFileDatabase) SaveMiniblocks(header data.HeaderHandler, body *block.Body) map[string]bool {
+	miniblocks := prepareMiniblocks(jfd.marshalizer, jfd.hasher, header, body)
+
+	documents := make([]jsonFileDocument, 0, len(miniblocks))
+	for _, mb := range miniblocks {
+		documents = append(documents, jsonFileDocument{Index: miniblocksIndex, ID: mb.Hash, Document: mb})
+	}
+	jfd.writeDocuments(documents...)
+
+	// the file is never read back, so no miniblock is reported as already saved
+	return make(map[string]bool)
+}
+
+// SaveTransactions will prepare and append information about the transactions in the file
+func (jfd *jsonFileDatabase) SaveTransactions(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardID uint32,
+	_ map[string]bool,
+) {
+	txs := jfd.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+
+	documents := make([]jsonFileDocument, 0, len(txs))
+	for _, tx := range txs {
+		documents = append(documents, jsonFileDocument{Index: txIndex, ID: tx.Hash, Document: tx})
+	}
+	jfd.writeDocuments(documents...)
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (jfd *jsonFileDatabase) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	jfd.txLogsProcessor = txLogsProc
+}
+
+// SaveRoundsInfos will prepare and append information about a slice of rounds in the file
+func (jfd *jsonFileDatabase) SaveRoundsInfos(infos []RoundInfo) {
+	documents := make([]jsonFileDocument, 0, len(infos))
+	for _, info := range infos {
+		id := fmt.Sprintf("%d_%d", info.ShardId, info.Index)
+		documents = append(documents, jsonFileDocument{Index: roundIndex, ID: id, Document: info})
+	}
+	jfd.writeDocuments(documents...)
+}
+
+// SaveShardValidatorsPubKeys will prepare and append information about a shard validators public keys in the file
+func (jfd *jsonFileDatabase) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) {
+	shardValPubKeys := ValidatorsPublicKeys{
+		PublicKeys: make([]string, 0, len(shardValidatorsPubKeys)),
+	}
+	for _, validatorPk := range shardValidatorsPubKeys {
+		shardValPubKeys.PublicKeys = append(shardValPubKeys.PublicKeys, jfd.validatorPubkeyConverter.Encode(validatorPk))
+	}
+
+	id := fmt.Sprintf("%d_%d", shardID, epoch)
+	jfd.writeDocuments(jsonFileDocument{Index: validatorsIndex, ID: id, Document: shardValPubKeys})
+}
+
+// SaveValidatorsRating will append validators rating in the file
+func (jfd *jsonFileDatabase) SaveValidatorsRating(index string, validatorsRatingInfo []ValidatorRatingInfo) {
+	infosRating := ValidatorsRatingInfo{ValidatorsInfos: validatorsRatingInfo}
+	jfd.writeDocuments(jsonFileDocument{Index: ratingIndex, ID: index, Document: infosRating})
+}
+
+// SaveShardStatistics will prepare and append information about a shard statistics in the file
+func (jfd *jsonFileDatabase) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) {
+	documents := []jsonFileDocument{
+		{Index: tpsIndex, ID: metachainTpsDocID, Document: prepareGeneralTPS(tpsBenchmark)},
+	}
+	for _, shardInfo := range tpsBenchmark.ShardStatistics() {
+		id := fmt.Sprintf("%s%d", shardTpsDocIDPrefix, shardInfo.ShardID())
+		documents = append(documents, jsonFileDocument{Index: tpsIndex, ID: id, Document: prepareShardTPS(shardInfo)})
+	}
+	jfd.writeDocuments(documents...)
+}
+
+// SaveAccounts will append the modified accounts and their ESDT holdings in the file
+func (jfd *jsonFileDatabase) SaveAccounts(updatedAccounts []*UpdatedAccount) {
+	accounts, esdtAccounts := jfd.prepareAccounts(updatedAccounts)
+
+	documents := make([]jsonFileDocument, 0, len(accounts)+len(esdtAccounts))
+	for _, account := range accounts {
+		documents = append(documents, jsonFileDocument{Index: accountsIndex, ID: account.Address, Document: account})
+	}
+	for _, esdtAccount := range esdtAccounts {
+		documents = append(documents, jsonFileDocument{Index: accountsESDTIndex, ID: accountESDTDocumentID(esdtAccount), Document: esdtAccount})
+	}
+	jfd.writeDocuments(documents...)
+}
+
+// RemoveAccounts will append the removal of the accounts and of the ESDT holdings written by a reverted block in the file
+func (jfd *jsonFileDatabase) RemoveAccounts(removedAccounts []*removedAccount) {
+	addresses, esdtAccounts := jfd.prepareRemovedAccounts(removedAccounts)
+
+	documents := prepareRevertDocuments(accountsIndex, addresses, nil, nil)
+	for _, esdtAccount := range esdtAccounts {
+		documents = append(documents, jsonFileDocument{Index: accountsESDTIndex, ID: accountESDTDocumentID(esdtAccount), Action: jsonFileDeleteAction})
+	}
+	jfd.writeDocuments(documents...)
+}
+
+// RevertBlock will append the removal of the block and of the miniblocks created by it in the file. The miniblocks
+// received from other shards are only detached from the block
+func (jfd *jsonFileDatabase) RevertBlock(header data.HeaderHandler, body *block.Body) {
+	reverted, err := prepareRevertedBlock(jfd.marshalizer, jfd.hasher, header, body)
+	if err != nil {
+		log.Warn("indexer: could not prepare the reverted block", "error", err.Error())
+		return
+	}
+
+	documents := []jsonFileDocument{{Index: blockIndex, ID: reverted.headerHash, Action: jsonFileDeleteAction}}
+	documents = append(documents, prepareRevertDocuments(
+		miniblocksIndex,
+		reverted.miniblocksToRemove,
+		reverted.miniblocksToDetach,
+		map[string]string{"receiverBlockHash": ""},
+	)...)
+	jfd.writeDocuments(documents...)
+}
+
+// RevertTransactions will append the removal of the transactions created by the block in the file. The transactions
+// received from other shards are marked as pending
+func (jfd *jsonFileDatabase) RevertTransactions(header data.HeaderHandler, body *block.Body) {
+	reverted, err := prepareRevertedBlock(jfd.marshalizer, jfd.hasher, header, body)
+	if err != nil {
+		log.Warn("indexer: could not prepare the reverted block", "error", err.Error())
+		return
+	}
+
+	jfd.writeDocuments(prepareRevertDocuments(
+		txIndex,
+		reverted.txsToRemove,
+		reverted.txsToMarkPending,
+		map[string]string{"status": txStatusPending},
+	)...)
+}
+
+// Close will close the file
+func (jfd *jsonFileDatabase) Close() error {
+	jfd.mutFile.Lock()
+	defer jfd.mutFile.Unlock()
+
+	return jfd.file.Close()
+}
+
+func prepareRevertDocuments(index string, idsToRemove []string, idsToUpdate []string, partialDocument interface{}) []jsonFileDocument {
+	documents := make([]jsonFileDocument, 0, len(idsToRemove)+len(idsToUpdate))
+	for _, id := range idsToRemove {
+		documents = append(documents, jsonFileDocument{Index: index, ID: id, Action: jsonFileDeleteAction})
+	}
+	for _, id := range idsToUpdate {
+		documents = append(documents, jsonFileDocument{Index: index, ID: id, Action: jsonFileUpdateAction, Document: partialDocument})
+	}
+
+	return documents
+}
+
+func (jfd *jsonFileDatabase) writeDocuments(documents ...jsonFileDocument) {
+	if len(documents) == 0 {
+		return
+	}
+
+	buff := make([]byte, 0)
+	for _, document := range documents {
+		serializedDocument, err := json.Marshal(document)
+		if err != nil {
+			log.Debug("indexer: could not serialize document", "index", document.Index, "error", err.Error())
+			continue
+		}
+
+		buff = append(buff, serializedDocument...)
+		buff = append(buff, '\n')
+	}
+
+	jfd.mutFile.Lock()
+	_, err := jfd.file.Write(buff)
+	jfd.mutFile.Unlock()
+	if err != nil {
+		log.Warn("indexer: could not write documents in file", "error", err.Error())
+	}
+}
@@ -0,0 +1,185 @@
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockJSONFileDatabaseArgs(t *testing.T) (jsonFileDatabaseArgs, func()) {
+	dir, err := ioutil.TempDir("", "jsonFileIndexer")
+	require.Nil(t, err)
+
+	args := jsonFileDatabaseArgs{
+		filePath:                 filepath.Join(dir, "indexer.ndjson"),
+		marshalizer:              &mock.MarshalizerMock{},
+		hasher:                   &mock.HasherMock{},
+		addressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		validatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+	}
+
+	return args, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func readJSONFileDocuments(t *testing.T, filePath string) []map[string]interface{} {
+	file, err := os.Open(filePath)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	documents := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		document := make(map[string]interface{})
+		err = json.Unmarshal(scanner.Bytes(), &document)
+		require.Nil(t, err)
+
+		documents = append(documents, document)
+	}
+
+	return documents
+}
+
+func TestNewJSONFileDatabase_EmptyFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockJSONFileDatabaseArgs(t)
+	defer cleanup()
+	args.filePath = ""
+
+	jfd, err := newJSONFileDatabase(args)
+	require.Nil(t, jfd)
+	require.Equal(t, ErrEmptyFilePath, err)
+}
+
+func TestNewJSONFileDatabase_InvalidFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockJSONFileDatabaseArgs(t)
+	defer cleanup()
+	args.filePath = filepath.Join(args.filePath, "missing", "indexer.ndjson")
+
+	jfd, err := newJSONFileDatabase(args)
+	require.Nil(t, jfd)
+	require.NotNil(t, err)
+}
+
+func TestJSONFileDatabase_SaveShouldAppendDocuments(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockJSONFileDatabaseArgs(t)
+	defer cleanup()
+	jfd, err := newJSONFileDatabase(args)
+	require.Nil(t, err)
+
+	body := newTestBlockBody()
+	header := &dataBlock.Header{Nonce: 1, TxCount: 3}
+
+	jfd.SaveHeader(header, []uint64{0}, body, nil, 10)
+	jfd.SaveMiniblocks(header, body)
+	jfd.SaveTransactions(body, header, newTestTxPool(), 0, map[string]bool{})
+	jfd.SaveRoundsInfos([]RoundInfo{{Index: 1, ShardId: 0}})
+	jfd.SaveShardValidatorsPubKeys(0, 1, [][]byte{[]byte("key1")})
+	jfd.SaveValidatorsRating("0_1", []ValidatorRatingInfo{{PublicKey: "key1", Rating: 50}})
+	_ = jfd.file.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	// 1 block, 2 miniblocks, 3 transactions, 1 round, 1 validators and 1 rating document
+	require.Equal(t, 9, len(documents))
+
+	numDocumentsPerIndex := make(map[string]int)
+	for _, document := range documents {
+		numDocumentsPerIndex[document["index"].(string)]++
+		require.NotEqual(t, "", document["id"])
+		require.NotNil(t, document["document"])
+	}
+	require.Equal(t, 1, numDocumentsPerIndex[blockIndex])
+	require.Equal(t, 2, numDocumentsPerIndex[miniblocksIndex])
+	require.Equal(t, 3, numDocumentsPerIndex[txIndex])
+	require.Equal(t, 1, numDocumentsPerIndex[roundIndex])
+	require.Equal(t, 1, numDocumentsPerIndex[validatorsIndex])
+	require.Equal(t, 1, numDocumentsPerIndex[ratingIndex])
+	require.Equal(t, "0_1", documents[6]["id"])
+}
+
+func TestJSONFileDatabase_SaveAccountsShouldAppendDocuments(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockJSONFileDatabaseArgs(t)
+	defer cleanup()
+	jfd, err := newJSONFileDatabase(args)
+	require.Nil(t, err)
+
+	jfd.SaveAccounts(createTestUpdatedAccounts(t))
+	_ = jfd.file.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	require.Equal(t, 3, len(documents))
+
+	address1 := args.addressPubkeyConverter.Encode([]byte("address1"))
+	require.Equal(t, accountsIndex, documents[0]["index"])
+	require.Equal(t, address1, documents[0]["id"])
+	require.Equal(t, "1000", documents[0]["document"].(map[string]interface{})["balance"])
+	require.Equal(t, accountsIndex, documents[1]["index"])
+	require.Equal(t, accountsESDTIndex, documents[2]["index"])
+	require.Equal(t, address1+"_TKN-01", documents[2]["id"])
+	require.Equal(t, "37", documents[2]["document"].(map[string]interface{})["balance"])
+}
+
+func TestJSONFileDatabase_ShouldAppendToExistingFile(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockJSONFileDatabaseArgs(t)
+	defer cleanup()
+
+	jfd, _ := newJSONFileDatabase(args)
+	jfd.SaveRoundsInfos([]RoundInfo{{Index: 1, ShardId: 0}})
+	_ = jfd.file.Close()
+
+	jfd, _ = newJSONFileDatabase(args)
+	jfd.SaveRoundsInfos([]RoundInfo{{Index: 2, ShardId: 0}})
+	_ = jfd.file.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	require.Equal(t, 2, len(documents))
+	require.Equal(t, "0_1", documents[0]["id"])
+	require.Equal(t, "0_2", documents[1]["id"])
+}
+
+func TestJSONFileDatabase_RevertShouldAppendDeleteAndUpdateDocuments(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockJSONFileDatabaseArgs(t)
+	defer cleanup()
+	jfd, _ := newJSONFileDatabase(args)
+
+	body := newTestBlockBody()
+	header := &dataBlock.Header{Nonce: 1, ShardID: 2}
+	jfd.RevertBlock(header, body)
+	jfd.RevertTransactions(header, body)
+	_ = jfd.Close()
+
+	documents := readJSONFileDocuments(t, args.filePath)
+	// 1 block, 2 miniblocks and 3 transactions
+	require.Equal(t, 6, len(documents))
+
+	numDocumentsPerAction := make(map[string]int)
+	for _, document := range documents {
+		numDocumentsPerAction[document["action"].(string)]++
+	}
+	require.Equal(t, 4, numDocumentsPerAction[jsonFileDeleteAction])
+	require.Equal(t, 2, numDocumentsPerAction[jsonFileUpdateAction])
+	require.Equal(t, blockIndex, documents[0]["index"])
+	require.Nil(t, documents[0]["document"])
+	require.Equal(t, map[string]interface{}{"status": txStatusPending}, documents[5]["document"])
+}
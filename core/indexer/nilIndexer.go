@@ -35,10 +35,23 @@ func (ni *NilIndexer) UpdateTPS(_ statistics.TPSBenchmark) {
 func (ni *NilIndexer) SaveValidatorsRating(_ string, _ []ValidatorRatingInfo) {
 }
 
+// SaveAccounts will do nothing
+func (ni *NilIndexer) SaveAccounts(_ uint64, _ AccountsLoader) {
+}
+
 // SaveValidatorsPubKeys will do nothing
 func (ni *NilIndexer) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 }
 
+// RevertIndexedBlock will do nothing
+func (ni *NilIndexer) RevertIndexedBlock(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// Close will do nothing
+func (ni *NilIndexer) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ni *NilIndexer) IsInterfaceNil() bool {
 	return ni == nil
@@ -0,0 +1,102 @@
+package indexer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+)
+
+// prepareAccounts converts the accounts modified by a block in the documents of the accounts and of the ESDT
+// holdings indices. ESDT data which cannot be decoded is skipped, while removed ESDT data is indexed with a zero
+// balance
+func (tdp *txDatabaseProcessor) prepareAccounts(updatedAccounts []*UpdatedAccount) ([]*AccountInfo, []*AccountESDTInfo) {
+	accounts := make([]*AccountInfo, 0, len(updatedAccounts))
+	esdtAccounts := make([]*AccountESDTInfo, 0)
+
+	for _, updatedAccount := range updatedAccounts {
+		if updatedAccount == nil {
+			continue
+		}
+
+		address := tdp.addressPubkeyConverter.Encode(updatedAccount.Address)
+		balance := "0"
+		if updatedAccount.Balance != nil {
+			balance = updatedAccount.Balance.String()
+		}
+
+		accounts = append(accounts, &AccountInfo{
+			Address: address,
+			Balance: balance,
+			Nonce:   updatedAccount.Nonce,
+		})
+
+		for tokenIdentifier, esdtData := range updatedAccount.ESDTData {
+			esdtAccount, err := tdp.prepareAccountESDT(address, tokenIdentifier, esdtData)
+			if err != nil {
+				log.Debug("indexer: cannot decode ESDT data",
+					"address", address,
+					"token", tokenIdentifier,
+					"error", err.Error())
+				continue
+			}
+
+			esdtAccounts = append(esdtAccounts, esdtAccount)
+		}
+	}
+
+	return accounts, esdtAccounts
+}
+
+// prepareRemovedAccounts returns the encoded addresses of the accounts documents to remove and the ESDT holdings
+// documents to remove
+func (tdp *txDatabaseProcessor) prepareRemovedAccounts(removedAccounts []*removedAccount) ([]string, []*AccountESDTInfo) {
+	addresses := make([]string, 0, len(removedAccounts))
+	esdtAccounts := make([]*AccountESDTInfo, 0)
+
+	for _, removed := range removedAccounts {
+		if removed == nil {
+			continue
+		}
+
+		address := tdp.addressPubkeyConverter.Encode(removed.Address)
+		if removed.RemoveAccount {
+			addresses = append(addresses, address)
+		}
+
+		for _, tokenIdentifier := range removed.ESDTTokens {
+			esdtAccounts = append(esdtAccounts, &AccountESDTInfo{
+				Address:         address,
+				TokenIdentifier: tokenIdentifier,
+			})
+		}
+	}
+
+	return addresses, esdtAccounts
+}
+
+func (tdp *txDatabaseProcessor) prepareAccountESDT(address string, tokenIdentifier string, esdtData []byte) (*AccountESDTInfo, error) {
+	esdtAccount := &AccountESDTInfo{
+		Address:         address,
+		TokenIdentifier: tokenIdentifier,
+		Balance:         "0",
+	}
+	if len(esdtData) == 0 {
+		return esdtAccount, nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err := tdp.marshalizer.Unmarshal(esdtToken, esdtData)
+	if err != nil {
+		return nil, err
+	}
+
+	if esdtToken.Value != nil {
+		esdtAccount.Balance = esdtToken.Value.String()
+	}
+	esdtAccount.Frozen = esdtToken.Frozen
+
+	return esdtAccount, nil
+}
+
+// accountESDTDocumentID returns the ID of the document holding the balance of an account in an ESDT token
+func accountESDTDocumentID(esdtAccount *AccountESDTInfo) string {
+	return esdtAccount.Address + "_" + esdtAccount.TokenIdentifier
+}
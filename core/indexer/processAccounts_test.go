@@ -0,0 +1,56 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestUpdatedAccounts(t *testing.T) []*UpdatedAccount {
+	marshalizer := &mock.MarshalizerMock{}
+	esdtData, err := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(37), Frozen: true})
+	require.Nil(t, err)
+
+	return []*UpdatedAccount{
+		{
+			Address: []byte("address1"),
+			Balance: big.NewInt(1000),
+			Nonce:   5,
+			ESDTData: map[string][]byte{
+				"TKN-01": esdtData,
+			},
+		},
+		{
+			Address: []byte("address2"),
+			Nonce:   1,
+		},
+	}
+}
+
+func TestPrepareAccounts(t *testing.T) {
+	t.Parallel()
+
+	args := createMockElasticsearchDatabaseArgs()
+	tdp := newTxDatabaseProcessor(args.hasher, args.marshalizer, args.addressPubkeyConverter, args.validatorPubkeyConverter)
+
+	updatedAccounts := createTestUpdatedAccounts(t)
+	updatedAccounts[1].ESDTData = map[string][]byte{
+		"WIPED-01":   nil,
+		"INVALID-01": []byte("invalid data"),
+	}
+	accounts, esdtAccounts := tdp.prepareAccounts(append(updatedAccounts, nil))
+
+	address1 := args.addressPubkeyConverter.Encode([]byte("address1"))
+	address2 := args.addressPubkeyConverter.Encode([]byte("address2"))
+	require.Equal(t, []*AccountInfo{
+		{Address: address1, Balance: "1000", Nonce: 5},
+		{Address: address2, Balance: "0", Nonce: 1},
+	}, accounts)
+	require.Equal(t, []*AccountESDTInfo{
+		{Address: address1, TokenIdentifier: "TKN-01", Balance: "37", Frozen: true},
+		{Address: address2, TokenIdentifier: "WIPED-01", Balance: "0"},
+	}, esdtAccounts)
+}
@@ -0,0 +1,321 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+const minRetryInterval = time.Second
+
+// retryQueueItem is a request which could not be sent to the elastic search server. An empty document ID marks a bulk
+// request
+type retryQueueItem struct {
+	Index      string `json:"index"`
+	DocumentID string `json:"documentId,omitempty"`
+	Body       []byte `json:"body"`
+}
+
+// retryDatabaseClient wraps the elastic search client and saves the failed requests in a persistent queue. The queued
+// requests are replayed in the order they were issued, periodically and after a restart of the node. While the queue
+// is not empty, the new requests are queued as well, so the documents are not overwritten by older versions. When the
+// queue is full, the oldest request is dropped. The requests are never sent while holding the lock of the queue
+type retryDatabaseClient struct {
+	client        databaseClientHandler
+	persister     storage.Persister
+	mutQueue      sync.Mutex
+	mutReplay     sync.Mutex
+	nextKey       uint64
+	numItems      int
+	maxNumItems   int
+	numDropped    uint64
+	closed        bool
+	retryInterval time.Duration
+	cancelFunc    func()
+}
+
+func newRetryDatabaseClient(
+	client databaseClientHandler,
+	persister storage.Persister,
+	retryInterval time.Duration,
+	maxNumItems int,
+) (*retryDatabaseClient, error) {
+	if check.IfNilReflect(client) {
+		return nil, ErrNilDatabaseClient
+	}
+	if check.IfNil(persister) {
+		return nil, ErrNilRetryQueuePersister
+	}
+	if retryInterval < minRetryInterval {
+		return nil, ErrInvalidRetryInterval
+	}
+	if maxNumItems < 1 {
+		return nil, ErrInvalidRetryQueueMaxSize
+	}
+
+	rdc := &retryDatabaseClient{
+		client:        client,
+		persister:     persister,
+		retryInterval: retryInterval,
+		maxNumItems:   maxNumItems,
+	}
+
+	persister.RangeKeys(func(key []byte, _ []byte) bool {
+		rdc.numItems++
+		rdc.nextKey = binary.BigEndian.Uint64(key) + 1
+		return true
+	})
+	if rdc.numItems > 0 {
+		log.Info("indexer: found requests in the retry queue", "num requests", rdc.numItems)
+	}
+
+	var ctx context.Context
+	ctx, rdc.cancelFunc = context.WithCancel(context.Background())
+	go rdc.replayQueue(ctx)
+
+	return rdc, nil
+}
+
+// DoRequest will do the request, saving it in the retry queue if it fails
+func (rdc *retryDatabaseClient) DoRequest(req *esapi.IndexRequest) error {
+	body, err := readRequestBody(req.Body)
+	if err != nil {
+		return err
+	}
+
+	item := &retryQueueItem{
+		Index:      req.Index,
+		DocumentID: req.DocumentID,
+		Body:       body,
+	}
+
+	return rdc.doOrQueue(item)
+}
+
+// DoBulkRequest will do the bulk request, saving it in the retry queue if it fails
+func (rdc *retryDatabaseClient) DoBulkRequest(buff *bytes.Buffer, index string) error {
+	item := &retryQueueItem{
+		Index: index,
+		Body:  buff.Bytes(),
+	}
+
+	return rdc.doOrQueue(item)
+}
+
+// DoMultiGet will do the multi get request on the wrapped client
+func (rdc *retryDatabaseClient) DoMultiGet(query object, index string) (object, error) {
+	return rdc.client.DoMultiGet(query, index)
+}
+
+// CheckAndCreateIndex will check and create the index on the wrapped client
+func (rdc *retryDatabaseClient) CheckAndCreateIndex(index string, body io.Reader) error {
+	return rdc.client.CheckAndCreateIndex(index, body)
+}
+
+func (rdc *retryDatabaseClient) doOrQueue(item *retryQueueItem) error {
+	if rdc.NumQueuedRequests() == 0 {
+		err := rdc.doItem(item)
+		if err == nil || errors.Is(err, ErrRequestRejected) {
+			return err
+		}
+
+		log.Warn("indexer: request failed, will be retried", "index", item.Index, "error", err.Error())
+	}
+
+	rdc.mutQueue.Lock()
+	defer rdc.mutQueue.Unlock()
+
+	return rdc.push(item)
+}
+
+func (rdc *retryDatabaseClient) doItem(item *retryQueueItem) error {
+	if len(item.DocumentID) == 0 {
+		return rdc.client.DoBulkRequest(bytes.NewBuffer(item.Body), item.Index)
+	}
+
+	req := &esapi.IndexRequest{
+		Index:      item.Index,
+		DocumentID: item.DocumentID,
+		Body:       bytes.NewReader(item.Body),
+		Refresh:    "true",
+	}
+
+	return rdc.client.DoRequest(req)
+}
+
+func (rdc *retryDatabaseClient) push(item *retryQueueItem) error {
+	buff, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	if rdc.numItems >= rdc.maxNumItems {
+		rdc.dropOldest()
+	}
+
+	err = rdc.persister.Put(uint64ToKey(rdc.nextKey), buff)
+	if err != nil {
+		return err
+	}
+
+	rdc.nextKey++
+	rdc.numItems++
+
+	return nil
+}
+
+func (rdc *retryDatabaseClient) replayQueue(ctx context.Context) {
+	for {
+		rdc.replayPendingItems()
+
+		select {
+		case <-ctx.Done():
+			log.Debug("indexer: retry queue go routine is stopping...")
+			return
+		case <-time.After(rdc.retryInterval):
+		}
+	}
+}
+
+func (rdc *retryDatabaseClient) replayPendingItems() {
+	rdc.mutReplay.Lock()
+	defer rdc.mutReplay.Unlock()
+
+	for {
+		key, item, ok := rdc.nextPendingItem()
+		if !ok {
+			return
+		}
+
+		err := rdc.doItem(item)
+		if errors.Is(err, ErrRequestRejected) {
+			log.Warn("indexer: dropping rejected request from the retry queue", "index", item.Index, "error", err.Error())
+			err = nil
+		}
+		if err != nil {
+			log.Debug("indexer: cannot replay request", "index", item.Index, "error", err.Error())
+			return
+		}
+
+		rdc.mutQueue.Lock()
+		rdc.remove(key)
+		rdc.mutQueue.Unlock()
+	}
+}
+
+// nextPendingItem returns the oldest valid request from the queue, dropping the corrupted ones
+func (rdc *retryDatabaseClient) nextPendingItem() ([]byte, *retryQueueItem, bool) {
+	rdc.mutQueue.Lock()
+	defer rdc.mutQueue.Unlock()
+
+	for rdc.numItems > 0 && !rdc.closed {
+		key, item, err := rdc.first()
+		if err != nil {
+			log.Warn("indexer: dropping corrupted request from the retry queue", "error", err.Error())
+			rdc.remove(key)
+			continue
+		}
+		if key == nil {
+			rdc.numItems = 0
+			return nil, nil, false
+		}
+
+		return key, item, true
+	}
+
+	return nil, nil, false
+}
+
+func (rdc *retryDatabaseClient) dropOldest() {
+	key, _, _ := rdc.first()
+	if key == nil {
+		return
+	}
+
+	log.Warn("indexer: the retry queue is full, dropping the oldest request", "max num requests", rdc.maxNumItems)
+	rdc.remove(key)
+	rdc.numDropped++
+}
+
+func (rdc *retryDatabaseClient) first() ([]byte, *retryQueueItem, error) {
+	var firstKey, firstValue []byte
+	rdc.persister.RangeKeys(func(key []byte, val []byte) bool {
+		firstKey = key
+		firstValue = val
+		return false
+	})
+	if firstKey == nil {
+		return nil, nil, nil
+	}
+
+	item := &retryQueueItem{}
+	err := json.Unmarshal(firstValue, item)
+
+	return firstKey, item, err
+}
+
+// remove deletes the request from the queue. The request could have been already dropped while it was replayed
+func (rdc *retryDatabaseClient) remove(key []byte) {
+	if rdc.closed || rdc.persister.Has(key) != nil {
+		return
+	}
+
+	err := rdc.persister.Remove(key)
+	if err != nil {
+		log.Warn("indexer: cannot remove request from the retry queue", "error", err.Error())
+	}
+	rdc.numItems--
+}
+
+// NumQueuedRequests returns the number of requests waiting in the retry queue
+func (rdc *retryDatabaseClient) NumQueuedRequests() int {
+	rdc.mutQueue.Lock()
+	defer rdc.mutQueue.Unlock()
+
+	return rdc.numItems
+}
+
+// NumDroppedRequests returns the number of requests dropped because the retry queue was full
+func (rdc *retryDatabaseClient) NumDroppedRequests() uint64 {
+	rdc.mutQueue.Lock()
+	defer rdc.mutQueue.Unlock()
+
+	return rdc.numDropped
+}
+
+// Close stops the replay of the queue and closes the persister. The queued requests will be replayed on the next start
+func (rdc *retryDatabaseClient) Close() error {
+	rdc.cancelFunc()
+
+	rdc.mutQueue.Lock()
+	defer rdc.mutQueue.Unlock()
+
+	rdc.closed = true
+
+	return rdc.persister.Close()
+}
+
+func readRequestBody(body io.Reader) ([]byte, error) {
+	if body == nil {
+		return make([]byte, 0), nil
+	}
+
+	return ioutil.ReadAll(body)
+}
+
+func uint64ToKey(value uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, value)
+
+	return key
+}
@@ -0,0 +1,257 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/stretchr/testify/require"
+)
+
+func createRetryQueuePersister(t *testing.T, dir string) storage.Persister {
+	persister, err := leveldb.NewDB(filepath.Join(dir, "retryQueue"), retryQueueBatchDelaySeconds, retryQueueMaxBatchSize, retryQueueMaxOpenFiles)
+	require.Nil(t, err)
+
+	return persister
+}
+
+func createTempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "retryQueue")
+	require.Nil(t, err)
+
+	return dir, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+type recordingClient struct {
+	mock.DatabaseWriterStub
+	mut      sync.Mutex
+	err      error
+	requests []string
+}
+
+func newRecordingClient() *recordingClient {
+	rc := &recordingClient{
+		requests: make([]string, 0),
+	}
+	rc.DoBulkRequestCalled = func(buff *bytes.Buffer, index string) error {
+		return rc.record(index + ":" + buff.String())
+	}
+	rc.DoRequestCalled = func(req *esapi.IndexRequest) error {
+		body, _ := ioutil.ReadAll(req.Body)
+		return rc.record(req.Index + "/" + req.DocumentID + ":" + string(body))
+	}
+
+	return rc
+}
+
+func (rc *recordingClient) record(request string) error {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	if rc.err != nil {
+		return rc.err
+	}
+	rc.requests = append(rc.requests, request)
+
+	return nil
+}
+
+func (rc *recordingClient) setError(err error) {
+	rc.mut.Lock()
+	rc.err = err
+	rc.mut.Unlock()
+}
+
+func (rc *recordingClient) recordedRequests() []string {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	return append([]string{}, rc.requests...)
+}
+
+func TestNewRetryDatabaseClient_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, cleanup := createTempDir(t)
+	defer cleanup()
+	persister := createRetryQueuePersister(t, dir)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	rdc, err := newRetryDatabaseClient(nil, persister, time.Second, 10)
+	require.Nil(t, rdc)
+	require.Equal(t, ErrNilDatabaseClient, err)
+
+	rdc, err = newRetryDatabaseClient(newRecordingClient(), nil, time.Second, 10)
+	require.Nil(t, rdc)
+	require.Equal(t, ErrNilRetryQueuePersister, err)
+
+	rdc, err = newRetryDatabaseClient(newRecordingClient(), persister, time.Millisecond, 10)
+	require.Nil(t, rdc)
+	require.Equal(t, ErrInvalidRetryInterval, err)
+
+	rdc, err = newRetryDatabaseClient(newRecordingClient(), persister, time.Second, 0)
+	require.Nil(t, rdc)
+	require.Equal(t, ErrInvalidRetryQueueMaxSize, err)
+}
+
+func TestRetryDatabaseClient_FailedRequestsShouldBeReplayedInOrder(t *testing.T) {
+	t.Parallel()
+
+	dir, cleanup := createTempDir(t)
+	defer cleanup()
+
+	client := newRecordingClient()
+	rdc, err := newRetryDatabaseClient(client, createRetryQueuePersister(t, dir), time.Hour, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = rdc.Close()
+	}()
+
+	client.setError(errors.New("connection refused"))
+	err = rdc.DoBulkRequest(bytes.NewBufferString("first"), txIndex)
+	require.Nil(t, err)
+
+	// the client is available but the request is queued behind the failed one
+	client.setError(nil)
+	err = rdc.DoRequest(&esapi.IndexRequest{Index: blockIndex, DocumentID: "hash", Body: bytes.NewBufferString("second")})
+	require.Nil(t, err)
+	require.Equal(t, 2, rdc.NumQueuedRequests())
+	require.Equal(t, 0, len(client.recordedRequests()))
+
+	rdc.replayPendingItems()
+	require.Equal(t, 0, rdc.NumQueuedRequests())
+	require.Equal(t, []string{txIndex + ":first", blockIndex + "/hash:second"}, client.recordedRequests())
+
+	err = rdc.DoBulkRequest(bytes.NewBufferString("third"), txIndex)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(client.recordedRequests()))
+}
+
+func TestRetryDatabaseClient_RejectedRequestShouldNotBeQueued(t *testing.T) {
+	t.Parallel()
+
+	dir, cleanup := createTempDir(t)
+	defer cleanup()
+
+	client := newRecordingClient()
+	rdc, _ := newRetryDatabaseClient(client, createRetryQueuePersister(t, dir), time.Hour, 10)
+	defer func() {
+		_ = rdc.Close()
+	}()
+
+	client.setError(fmt.Errorf("%w: mapping error", ErrRequestRejected))
+	err := rdc.DoBulkRequest(bytes.NewBufferString("data"), txIndex)
+	require.True(t, errors.Is(err, ErrRequestRejected))
+	require.Equal(t, 0, rdc.NumQueuedRequests())
+}
+
+func TestRetryDatabaseClient_QueueShouldSurviveRestart(t *testing.T) {
+	t.Parallel()
+
+	dir, cleanup := createTempDir(t)
+	defer cleanup()
+
+	client := newRecordingClient()
+	client.setError(errors.New("connection refused"))
+	rdc, _ := newRetryDatabaseClient(client, createRetryQueuePersister(t, dir), time.Hour, 10)
+	for i := 0; i < 3; i++ {
+		_ = rdc.DoBulkRequest(bytes.NewBufferString(fmt.Sprintf("data%d", i)), txIndex)
+	}
+	require.Equal(t, 3, rdc.NumQueuedRequests())
+	_ = rdc.Close()
+
+	client.setError(nil)
+	rdc, _ = newRetryDatabaseClient(client, createRetryQueuePersister(t, dir), time.Hour, 10)
+	defer func() {
+		_ = rdc.Close()
+	}()
+
+	// the queue is replayed on start
+	require.Eventually(t, func() bool {
+		return rdc.NumQueuedRequests() == 0
+	}, time.Second*5, time.Millisecond*10)
+	expectedRequests := []string{txIndex + ":data0", txIndex + ":data1", txIndex + ":data2"}
+	require.Equal(t, expectedRequests, client.recordedRequests())
+}
+
+func TestRetryDatabaseClient_FullQueueShouldDropTheOldestRequest(t *testing.T) {
+	t.Parallel()
+
+	dir, cleanup := createTempDir(t)
+	defer cleanup()
+
+	client := newRecordingClient()
+	client.setError(errors.New("connection refused"))
+	rdc, _ := newRetryDatabaseClient(client, createRetryQueuePersister(t, dir), time.Hour, 2)
+	defer func() {
+		_ = rdc.Close()
+	}()
+
+	for i := 0; i < 3; i++ {
+		_ = rdc.DoBulkRequest(bytes.NewBufferString(fmt.Sprintf("data%d", i)), txIndex)
+	}
+	require.Equal(t, 2, rdc.NumQueuedRequests())
+	require.Equal(t, uint64(1), rdc.NumDroppedRequests())
+
+	client.setError(nil)
+	rdc.replayPendingItems()
+	require.Equal(t, 0, rdc.NumQueuedRequests())
+	require.Equal(t, []string{txIndex + ":data1", txIndex + ":data2"}, client.recordedRequests())
+}
+
+func TestRetryDatabaseClient_ReplayShouldNotBlockTheNewRequests(t *testing.T) {
+	t.Parallel()
+
+	dir, cleanup := createTempDir(t)
+	defer cleanup()
+
+	replayStarted := make(chan struct{})
+	releaseReplay := make(chan struct{})
+	once := sync.Once{}
+	client := newRecordingClient()
+	client.DoBulkRequestCalled = func(buff *bytes.Buffer, index string) error {
+		err := client.record(index + ":" + buff.String())
+		if err == nil && buff.String() == "first" {
+			once.Do(func() {
+				close(replayStarted)
+			})
+			<-releaseReplay
+		}
+		return err
+	}
+	client.setError(errors.New("connection refused"))
+	rdc, _ := newRetryDatabaseClient(client, createRetryQueuePersister(t, dir), time.Hour, 10)
+	defer func() {
+		_ = rdc.Close()
+	}()
+	_ = rdc.DoBulkRequest(bytes.NewBufferString("first"), txIndex)
+
+	client.setError(nil)
+	go rdc.replayPendingItems()
+	<-replayStarted
+
+	// the request is queued behind the one being replayed, without waiting for it
+	err := rdc.DoBulkRequest(bytes.NewBufferString("second"), txIndex)
+	require.Nil(t, err)
+	require.Equal(t, 2, rdc.NumQueuedRequests())
+
+	close(releaseReplay)
+	require.Eventually(t, func() bool {
+		return rdc.NumQueuedRequests() == 0
+	}, time.Second*5, time.Millisecond*10)
+	require.Equal(t, []string{txIndex + ":first", txIndex + ":second"}, client.recordedRequests())
+}
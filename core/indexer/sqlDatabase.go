@@ -0,0 +1,581 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	// the database/sql drivers usable by the SQL indexer driver
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqlSchema holds the tables created by the SQL driver. The statements only use types and clauses understood by
+// both sqlite3 and postgres
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		hash TEXT PRIMARY KEY,
+		nonce BIGINT NOT NULL,
+		round BIGINT NOT NULL,
+		epoch BIGINT NOT NULL,
+		shard_id BIGINT NOT NULL,
+		proposer BIGINT NOT NULL,
+		validators TEXT NOT NULL,
+		pub_key_bitmap TEXT NOT NULL,
+		size BIGINT NOT NULL,
+		size_txs BIGINT NOT NULL,
+		timestamp BIGINT NOT NULL,
+		tx_count BIGINT NOT NULL,
+		state_root_hash TEXT NOT NULL,
+		prev_hash TEXT NOT NULL,
+		miniblocks_hashes TEXT NOT NULL,
+		notarized_blocks_hashes TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS blocks_shard_nonce ON blocks (shard_id, nonce)`,
+	`CREATE TABLE IF NOT EXISTS miniblocks (
+		hash TEXT PRIMARY KEY,
+		sender_shard BIGINT NOT NULL,
+		receiver_shard BIGINT NOT NULL,
+		sender_block_hash TEXT NOT NULL,
+		receiver_block_hash TEXT NOT NULL,
+		type TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash TEXT PRIMARY KEY,
+		miniblock_hash TEXT NOT NULL,
+		nonce BIGINT NOT NULL,
+		round BIGINT NOT NULL,
+		value TEXT NOT NULL,
+		receiver TEXT NOT NULL,
+		sender TEXT NOT NULL,
+		receiver_shard BIGINT NOT NULL,
+		sender_shard BIGINT NOT NULL,
+		gas_price BIGINT NOT NULL,
+		gas_limit BIGINT NOT NULL,
+		gas_used BIGINT NOT NULL,
+		data TEXT NOT NULL,
+		signature TEXT NOT NULL,
+		timestamp BIGINT NOT NULL,
+		status TEXT NOT NULL,
+		sc_results TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS transactions_sender ON transactions (sender)`,
+	`CREATE INDEX IF NOT EXISTS transactions_receiver ON transactions (receiver)`,
+	`CREATE TABLE IF NOT EXISTS rounds (
+		shard_id BIGINT NOT NULL,
+		round BIGINT NOT NULL,
+		signers_indexes TEXT NOT NULL,
+		block_was_proposed BOOLEAN NOT NULL,
+		timestamp BIGINT NOT NULL,
+		PRIMARY KEY (shard_id, round)
+	)`,
+	`CREATE TABLE IF NOT EXISTS validators (
+		shard_id BIGINT NOT NULL,
+		epoch BIGINT NOT NULL,
+		public_keys TEXT NOT NULL,
+		PRIMARY KEY (shard_id, epoch)
+	)`,
+	`CREATE TABLE IF NOT EXISTS rating (
+		id TEXT PRIMARY KEY,
+		validators_rating TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS tps (
+		id TEXT PRIMARY KEY,
+		statistics TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS accounts (
+		address TEXT PRIMARY KEY,
+		balance TEXT NOT NULL,
+		nonce BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS accountsesdt (
+		address TEXT NOT NULL,
+		token_identifier TEXT NOT NULL,
+		balance TEXT NOT NULL,
+		frozen BOOLEAN NOT NULL,
+		PRIMARY KEY (address, token_identifier)
+	)`,
+}
+
+const insertBlockQuery = `INSERT INTO blocks (hash, nonce, round, epoch, shard_id, proposer, validators, pub_key_bitmap,
+	size, size_txs, timestamp, tx_count, state_root_hash, prev_hash, miniblocks_hashes, notarized_blocks_hashes)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	ON CONFLICT (hash) DO NOTHING`
+
+const upsertMiniblockQuery = `INSERT INTO miniblocks (hash, sender_shard, receiver_shard, sender_block_hash,
+	receiver_block_hash, type) VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (hash) DO UPDATE SET
+	sender_block_hash = CASE WHEN excluded.sender_block_hash <> '' THEN excluded.sender_block_hash ELSE miniblocks.sender_block_hash END,
+	receiver_block_hash = CASE WHEN excluded.receiver_block_hash <> '' THEN excluded.receiver_block_hash ELSE miniblocks.receiver_block_hash END`
+
+const insertTransactionQuery = `INSERT INTO transactions (hash, miniblock_hash, nonce, round, value, receiver, sender,
+	receiver_shard, sender_shard, gas_price, gas_limit, gas_used, data, signature, timestamp, status, sc_results)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+
+// the transactions executed in the destination shard have their execution results overwritten, like the elastic
+// search driver does
+const insertTransactionIgnoreConflictQuery = insertTransactionQuery + ` ON CONFLICT (hash) DO NOTHING`
+const upsertTransactionResultsQuery = insertTransactionQuery + ` ON CONFLICT (hash) DO UPDATE SET
+	status = excluded.status, gas_used = excluded.gas_used, sc_results = excluded.sc_results, timestamp = excluded.timestamp`
+
+const upsertRoundQuery = `INSERT INTO rounds (shard_id, round, signers_indexes, block_was_proposed, timestamp)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (shard_id, round) DO UPDATE SET signers_indexes = excluded.signers_indexes,
+	block_was_proposed = excluded.block_was_proposed, timestamp = excluded.timestamp`
+
+const upsertValidatorsQuery = `INSERT INTO validators (shard_id, epoch, public_keys) VALUES ($1, $2, $3)
+	ON CONFLICT (shard_id, epoch) DO UPDATE SET public_keys = excluded.public_keys`
+
+const upsertRatingQuery = `INSERT INTO rating (id, validators_rating) VALUES ($1, $2)
+	ON CONFLICT (id) DO UPDATE SET validators_rating = excluded.validators_rating`
+
+const upsertTpsQuery = `INSERT INTO tps (id, statistics) VALUES ($1, $2)
+	ON CONFLICT (id) DO UPDATE SET statistics = excluded.statistics`
+
+const upsertAccountQuery = `INSERT INTO accounts (address, balance, nonce) VALUES ($1, $2, $3)
+	ON CONFLICT (address) DO UPDATE SET balance = excluded.balance, nonce = excluded.nonce`
+
+const upsertAccountESDTQuery = `INSERT INTO accountsesdt (address, token_identifier, balance, frozen) VALUES ($1, $2, $3, $4)
+	ON CONFLICT (address, token_identifier) DO UPDATE SET balance = excluded.balance, frozen = excluded.frozen`
+
+const deleteBlockQuery = `DELETE FROM blocks WHERE hash = $1`
+const deleteAccountQuery = `DELETE FROM accounts WHERE address = $1`
+const deleteAccountESDTQuery = `DELETE FROM accountsesdt WHERE address = $1 AND token_identifier = $2`
+const deleteMiniblockQuery = `DELETE FROM miniblocks WHERE hash = $1`
+const detachMiniblockQuery = `UPDATE miniblocks SET receiver_block_hash = '' WHERE hash = $1`
+const deleteTransactionQuery = `DELETE FROM transactions WHERE hash = $1`
+const markTransactionPendingQuery = `UPDATE transactions SET status = '` + txStatusPending + `' WHERE hash = $1`
+
+// sqlDatabaseArgs is struct that is used to store all parameters that are needed to create a SQL database
+type sqlDatabaseArgs struct {
+	driverName               string
+	dataSourceName           string
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+}
+
+// sqlDatabase saves the indexed data in a relational database accessed through database/sql
+type sqlDatabase struct {
+	*txDatabaseProcessor
+	db          *sql.DB
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// newSQLDatabase opens the database and creates the indexer tables, if they do not exist
+func newSQLDatabase(arguments sqlDatabaseArgs) (*sqlDatabase, error) {
+	if len(arguments.driverName) == 0 {
+		return nil, ErrEmptySQLDriverName
+	}
+	if len(arguments.dataSourceName) == 0 {
+		return nil, ErrEmptySQLDataSourceName
+	}
+
+	db, err := sql.Open(arguments.driverName, arguments.dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	sqldb := &sqlDatabase{
+		db:          db,
+		marshalizer: arguments.marshalizer,
+		hasher:      arguments.hasher,
+	}
+	sqldb.txDatabaseProcessor = newTxDatabaseProcessor(
+		arguments.hasher,
+		arguments.marshalizer,
+		arguments.addressPubkeyConverter,
+		arguments.validatorPubkeyConverter,
+	)
+
+	err = sqldb.createTables()
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return sqldb, nil
+}
+
+func (sd *sqlDatabase) createTables() error {
+	for _, statement := range sqlSchema {
+		_, err := sd.db.Exec(statement)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrCannotCreateIndex, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// SaveHeader will prepare and save information about a header in the blocks table
+func (sd *sqlDatabase) SaveHeader(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	txsSize int,
+) {
+	dbBlock, _ := prepareBlock(sd.marshalizer, sd.hasher, header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	if dbBlock == nil {
+		return
+	}
+
+	_, err := sd.db.Exec(insertBlockQuery,
+		dbBlock.Hash,
+		int64(dbBlock.Nonce),
+		int64(dbBlock.Round),
+		int64(dbBlock.Epoch),
+		int64(dbBlock.ShardID),
+		int64(dbBlock.Proposer),
+		toJSONString(dbBlock.Validators),
+		dbBlock.PubKeyBitmap,
+		dbBlock.Size,
+		dbBlock.SizeTxs,
+		int64(dbBlock.Timestamp),
+		int64(dbBlock.TxCount),
+		dbBlock.StateRootHash,
+		dbBlock.PrevHash,
+		toJSONString(dbBlock.MiniBlocksHashes),
+		toJSONString(dbBlock.NotarizedBlocksHashes),
+	)
+	if err != nil {
+		log.Warn("indexer: could not save block header in sql database", "error", err.Error())
+	}
+}
+
+// SaveMiniblocks will prepare and save information about miniblocks in the miniblocks table. The already saved
+// miniblocks have only their block hashes updated
+func (sd *sqlDatabase) SaveMiniblocks(header data.HeaderHandler, body *block.Body) map[string]bool {
+	miniblocks := prepareMiniblocks(sd.marshalizer, sd.hasher, header, body)
+	if len(miniblocks) == 0 {
+		return make(map[string]bool)
+	}
+
+	err := sd.execInTransaction(upsertMiniblockQuery, func(stmt *sql.Stmt) error {
+		for _, mb := range miniblocks {
+			_, err := stmt.Exec(
+				mb.Hash,
+				int64(mb.SenderShardID),
+				int64(mb.ReceiverShardID),
+				mb.SenderBlockHash,
+				mb.ReceiverBlockHash,
+				mb.Type,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not save miniblocks in sql database", "error", err.Error())
+	}
+
+	// the transactions conflicts are solved by the queries, so no miniblock is reported as already saved
+	return make(map[string]bool)
+}
+
+// SaveTransactions will prepare and save information about the transactions in the transactions table
+func (sd *sqlDatabase) SaveTransactions(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardID uint32,
+	_ map[string]bool,
+) {
+	txs := sd.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+	if len(txs) == 0 {
+		return
+	}
+
+	tx, err := sd.db.Begin()
+	if err != nil {
+		log.Warn("indexer: could not save transactions in sql database", "error", err.Error())
+		return
+	}
+
+	for _, dbTx := range txs {
+		query := insertTransactionIgnoreConflictQuery
+		if isCrossShardDstMe(dbTx, selfShardID) && dbTx.Status != txStatusInvalid {
+			query = upsertTransactionResultsQuery
+		}
+
+		_, err = tx.Exec(query,
+			dbTx.Hash,
+			dbTx.MBHash,
+			int64(dbTx.Nonce),
+			int64(dbTx.Round),
+			dbTx.Value,
+			dbTx.Receiver,
+			dbTx.Sender,
+			int64(dbTx.ReceiverShard),
+			int64(dbTx.SenderShard),
+			int64(dbTx.GasPrice),
+			int64(dbTx.GasLimit),
+			int64(dbTx.GasUsed),
+			dbTx.Data,
+			dbTx.Signature,
+			int64(dbTx.Timestamp),
+			dbTx.Status,
+			toJSONString(dbTx.SmartContractResults),
+		)
+		if err != nil {
+			_ = tx.Rollback()
+			log.Warn("indexer: could not save transactions in sql database", "error", err.Error())
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Warn("indexer: could not save transactions in sql database", "error", err.Error())
+	}
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (sd *sqlDatabase) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	sd.txLogsProcessor = txLogsProc
+}
+
+// SaveRoundsInfos will prepare and save information about a slice of rounds in the rounds table
+func (sd *sqlDatabase) SaveRoundsInfos(infos []RoundInfo) {
+	err := sd.execInTransaction(upsertRoundQuery, func(stmt *sql.Stmt) error {
+		for _, info := range infos {
+			_, err := stmt.Exec(
+				int64(info.ShardId),
+				int64(info.Index),
+				toJSONString(info.SignersIndexes),
+				info.BlockWasProposed,
+				int64(info.Timestamp),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not save rounds info in sql database", "error", err.Error())
+	}
+}
+
+// SaveShardValidatorsPubKeys will prepare and save information about a shard validators public keys in the
+// validators table
+func (sd *sqlDatabase) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) {
+	publicKeys := make([]string, 0, len(shardValidatorsPubKeys))
+	for _, validatorPk := range shardValidatorsPubKeys {
+		publicKeys = append(publicKeys, sd.validatorPubkeyConverter.Encode(validatorPk))
+	}
+
+	_, err := sd.db.Exec(upsertValidatorsQuery, int64(shardID), int64(epoch), toJSONString(publicKeys))
+	if err != nil {
+		log.Warn("indexer: could not save validators pubkeys in sql database", "error", err.Error())
+	}
+}
+
+// SaveValidatorsRating will save validators rating in the rating table
+func (sd *sqlDatabase) SaveValidatorsRating(index string, validatorsRatingInfo []ValidatorRatingInfo) {
+	_, err := sd.db.Exec(upsertRatingQuery, index, toJSONString(validatorsRatingInfo))
+	if err != nil {
+		log.Warn("indexer: could not save validators rating in sql database", "error", err.Error())
+	}
+}
+
+// SaveShardStatistics will prepare and save information about a shard statistics in the tps table
+func (sd *sqlDatabase) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) {
+	err := sd.execInTransaction(upsertTpsQuery, func(stmt *sql.Stmt) error {
+		_, err := stmt.Exec(metachainTpsDocID, toJSONString(prepareGeneralTPS(tpsBenchmark)))
+		if err != nil {
+			return err
+		}
+
+		for _, shardInfo := range tpsBenchmark.ShardStatistics() {
+			id := fmt.Sprintf("%s%d", shardTpsDocIDPrefix, shardInfo.ShardID())
+			_, err = stmt.Exec(id, toJSONString(prepareShardTPS(shardInfo)))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not save tps information in sql database", "error", err.Error())
+	}
+}
+
+// SaveAccounts will save the modified accounts in the accounts table and their ESDT holdings in the accountsesdt table
+func (sd *sqlDatabase) SaveAccounts(updatedAccounts []*UpdatedAccount) {
+	accounts, esdtAccounts := sd.prepareAccounts(updatedAccounts)
+
+	err := sd.execInTransaction(upsertAccountQuery, func(stmt *sql.Stmt) error {
+		for _, account := range accounts {
+			_, err := stmt.Exec(account.Address, account.Balance, int64(account.Nonce))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not save accounts in sql database", "error", err.Error())
+	}
+
+	if len(esdtAccounts) == 0 {
+		return
+	}
+
+	err = sd.execInTransaction(upsertAccountESDTQuery, func(stmt *sql.Stmt) error {
+		for _, esdtAccount := range esdtAccounts {
+			_, err := stmt.Exec(esdtAccount.Address, esdtAccount.TokenIdentifier, esdtAccount.Balance, esdtAccount.Frozen)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not save accounts ESDT in sql database", "error", err.Error())
+	}
+}
+
+// RemoveAccounts will remove the accounts and the ESDT holdings written by a reverted block from the database
+func (sd *sqlDatabase) RemoveAccounts(removedAccounts []*removedAccount) {
+	addresses, esdtAccounts := sd.prepareRemovedAccounts(removedAccounts)
+
+	err := sd.execForEach(deleteAccountQuery, addresses)
+	if err != nil {
+		log.Warn("indexer: could not remove accounts from sql database", "error", err.Error())
+	}
+
+	if len(esdtAccounts) == 0 {
+		return
+	}
+
+	err = sd.execInTransaction(deleteAccountESDTQuery, func(stmt *sql.Stmt) error {
+		for _, esdtAccount := range esdtAccounts {
+			_, err := stmt.Exec(esdtAccount.Address, esdtAccount.TokenIdentifier)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: could not remove accounts ESDT from sql database", "error", err.Error())
+	}
+}
+
+// RevertBlock will remove the block and the miniblocks created by it from the database. The miniblocks received from
+// other shards are only detached from the block
+func (sd *sqlDatabase) RevertBlock(header data.HeaderHandler, body *block.Body) {
+	reverted, err := prepareRevertedBlock(sd.marshalizer, sd.hasher, header, body)
+	if err != nil {
+		log.Warn("indexer: could not prepare the reverted block", "error", err.Error())
+		return
+	}
+
+	_, err = sd.db.Exec(deleteBlockQuery, reverted.headerHash)
+	if err != nil {
+		log.Warn("indexer: could not remove reverted block from sql database", "error", err.Error())
+	}
+
+	err = sd.execForEach(deleteMiniblockQuery, reverted.miniblocksToRemove)
+	if err != nil {
+		log.Warn("indexer: could not remove reverted miniblocks from sql database", "error", err.Error())
+	}
+
+	err = sd.execForEach(detachMiniblockQuery, reverted.miniblocksToDetach)
+	if err != nil {
+		log.Warn("indexer: could not detach reverted miniblocks in sql database", "error", err.Error())
+	}
+}
+
+// RevertTransactions will remove the transactions created by the block from the database. The transactions received
+// from other shards are marked as pending
+func (sd *sqlDatabase) RevertTransactions(header data.HeaderHandler, body *block.Body) {
+	reverted, err := prepareRevertedBlock(sd.marshalizer, sd.hasher, header, body)
+	if err != nil {
+		log.Warn("indexer: could not prepare the reverted block", "error", err.Error())
+		return
+	}
+
+	err = sd.execForEach(deleteTransactionQuery, reverted.txsToRemove)
+	if err != nil {
+		log.Warn("indexer: could not remove reverted transactions from sql database", "error", err.Error())
+	}
+
+	err = sd.execForEach(markTransactionPendingQuery, reverted.txsToMarkPending)
+	if err != nil {
+		log.Warn("indexer: could not mark reverted transactions as pending in sql database", "error", err.Error())
+	}
+}
+
+// Close will close the database
+func (sd *sqlDatabase) Close() error {
+	return sd.db.Close()
+}
+
+func (sd *sqlDatabase) execForEach(query string, hashes []string) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	return sd.execInTransaction(query, func(stmt *sql.Stmt) error {
+		for _, hash := range hashes {
+			_, err := stmt.Exec(hash)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (sd *sqlDatabase) execInTransaction(query string, handler func(stmt *sql.Stmt) error) error {
+	tx, err := sd.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = handler(stmt)
+	_ = stmt.Close()
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func toJSONString(value interface{}) string {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		log.Debug("indexer: could not serialize value", "error", err.Error())
+		return ""
+	}
+
+	return string(buff)
+}
@@ -0,0 +1,312 @@
+package indexer
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSQLDatabaseArgs(t *testing.T) (sqlDatabaseArgs, func()) {
+	dir, err := ioutil.TempDir("", "sqlIndexer")
+	require.Nil(t, err)
+
+	args := sqlDatabaseArgs{
+		driverName:               "sqlite3",
+		dataSourceName:           filepath.Join(dir, "indexer.sqlite"),
+		marshalizer:              &mock.MarshalizerMock{},
+		hasher:                   &mock.HasherMock{},
+		addressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		validatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+	}
+
+	return args, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func countRows(t *testing.T, sd *sqlDatabase, table string) int {
+	var count int
+	err := sd.db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+	require.Nil(t, err)
+
+	return count
+}
+
+func TestNewSQLDatabase_EmptyDriverNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	args.driverName = ""
+
+	sd, err := newSQLDatabase(args)
+	require.Nil(t, sd)
+	require.Equal(t, ErrEmptySQLDriverName, err)
+}
+
+func TestNewSQLDatabase_EmptyDataSourceNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	args.dataSourceName = ""
+
+	sd, err := newSQLDatabase(args)
+	require.Nil(t, sd)
+	require.Equal(t, ErrEmptySQLDataSourceName, err)
+}
+
+func TestNewSQLDatabase_UnknownDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	args.driverName = "unknown"
+
+	sd, err := newSQLDatabase(args)
+	require.Nil(t, sd)
+	require.NotNil(t, err)
+}
+
+func TestNewSQLDatabase_ShouldCreateTablesIdempotent(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+
+	sd, err := newSQLDatabase(args)
+	require.Nil(t, err)
+	require.NotNil(t, sd)
+	_ = sd.db.Close()
+
+	sd, err = newSQLDatabase(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = sd.db.Close()
+	}()
+
+	for _, table := range []string{"blocks", "miniblocks", "transactions", "rounds", "validators", "rating", "tps"} {
+		require.Equal(t, 0, countRows(t, sd, table))
+	}
+}
+
+func TestSQLDatabase_SaveHeader(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	sd, _ := newSQLDatabase(args)
+	defer func() {
+		_ = sd.db.Close()
+	}()
+
+	header := &dataBlock.Header{Nonce: 7, Round: 8, ShardID: 1}
+	body := &dataBlock.Body{MiniBlocks: []*dataBlock.MiniBlock{{Type: dataBlock.TxBlock}}}
+
+	sd.SaveHeader(header, []uint64{0, 1}, body, nil, 10)
+	// saving the same header twice should keep a single row
+	sd.SaveHeader(header, []uint64{0, 1}, body, nil, 10)
+	require.Equal(t, 1, countRows(t, sd, "blocks"))
+
+	var nonce, round, shardID int64
+	var validators string
+	err := sd.db.QueryRow("SELECT nonce, round, shard_id, validators FROM blocks").Scan(&nonce, &round, &shardID, &validators)
+	require.Nil(t, err)
+	require.Equal(t, int64(7), nonce)
+	require.Equal(t, int64(8), round)
+	require.Equal(t, int64(1), shardID)
+	require.Equal(t, "[0,1]", validators)
+}
+
+func TestSQLDatabase_SaveMiniblocksShouldKeepBothBlockHashes(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	sd, _ := newSQLDatabase(args)
+	defer func() {
+		_ = sd.db.Close()
+	}()
+
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx1")}, SenderShardID: 0, ReceiverShardID: 1},
+		},
+	}
+
+	mbsInDb := sd.SaveMiniblocks(&dataBlock.Header{Nonce: 1, ShardID: 0}, body)
+	require.Equal(t, 0, len(mbsInDb))
+	sd.SaveMiniblocks(&dataBlock.Header{Nonce: 2, ShardID: 1}, body)
+	require.Equal(t, 1, countRows(t, sd, "miniblocks"))
+
+	var senderBlockHash, receiverBlockHash string
+	err := sd.db.QueryRow("SELECT sender_block_hash, receiver_block_hash FROM miniblocks").Scan(&senderBlockHash, &receiverBlockHash)
+	require.Nil(t, err)
+	require.NotEqual(t, "", senderBlockHash)
+	require.NotEqual(t, "", receiverBlockHash)
+	require.NotEqual(t, senderBlockHash, receiverBlockHash)
+}
+
+func TestSQLDatabase_SaveTransactions(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	sd, _ := newSQLDatabase(args)
+	defer func() {
+		_ = sd.db.Close()
+	}()
+
+	body := newTestBlockBody()
+	header := &dataBlock.Header{Nonce: 1, TxCount: 3}
+	txPool := newTestTxPool()
+
+	sd.SaveTransactions(body, header, txPool, 2, map[string]bool{})
+	sd.SaveTransactions(body, header, txPool, 2, map[string]bool{})
+	require.Equal(t, 3, countRows(t, sd, "transactions"))
+
+	var sender string
+	var nonce int64
+	err := sd.db.QueryRow("SELECT sender, nonce FROM transactions WHERE hash = $1", "747831").Scan(&sender, &nonce)
+	require.Nil(t, err)
+	require.Equal(t, args.addressPubkeyConverter.Encode([]byte("sender_address1")), sender)
+	require.Equal(t, int64(1), nonce)
+}
+
+func TestSQLDatabase_SaveRoundsValidatorsRatingAndStatistics(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	sd, _ := newSQLDatabase(args)
+	defer func() {
+		_ = sd.db.Close()
+	}()
+
+	sd.SaveRoundsInfos([]RoundInfo{{Index: 1, ShardId: 0}, {Index: 2, ShardId: 0, BlockWasProposed: true}})
+	sd.SaveRoundsInfos([]RoundInfo{{Index: 2, ShardId: 0, BlockWasProposed: true}})
+	require.Equal(t, 2, countRows(t, sd, "rounds"))
+
+	sd.SaveShardValidatorsPubKeys(0, 1, [][]byte{[]byte("key1"), []byte("key2")})
+	sd.SaveShardValidatorsPubKeys(1, 1, [][]byte{[]byte("key3")})
+	require.Equal(t, 2, countRows(t, sd, "validators"))
+
+	sd.SaveValidatorsRating("0_1", []ValidatorRatingInfo{{PublicKey: "key1", Rating: 50}})
+	require.Equal(t, 1, countRows(t, sd, "rating"))
+
+	tpsBenchmark := &mock.TpsBenchmarkMock{}
+	tpsBenchmark.UpdateWithShardStats(&dataBlock.MetaBlock{
+		TxCount: 2, Nonce: 1,
+		ShardInfo: []dataBlock.ShardData{{HeaderHash: []byte("hash")}},
+	})
+	sd.SaveShardStatistics(tpsBenchmark)
+	require.Equal(t, 1+len(tpsBenchmark.ShardStatistics()), countRows(t, sd, "tps"))
+}
+
+func TestSQLDatabase_RemoveAccountsShouldDelete(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	sd, _ := newSQLDatabase(args)
+	defer func() {
+		_ = sd.db.Close()
+	}()
+
+	sd.SaveAccounts(createTestUpdatedAccounts(t))
+	sd.RemoveAccounts([]*removedAccount{
+		{Address: []byte("address1"), ESDTTokens: []string{"TKN-01"}},
+		{Address: []byte("address2"), RemoveAccount: true},
+	})
+	require.Equal(t, 1, countRows(t, sd, "accounts"))
+	require.Equal(t, 0, countRows(t, sd, "accountsesdt"))
+}
+
+func TestSQLDatabase_SaveAccountsShouldUpsert(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	sd, _ := newSQLDatabase(args)
+	defer func() {
+		_ = sd.db.Close()
+	}()
+
+	updatedAccounts := createTestUpdatedAccounts(t)
+	sd.SaveAccounts(updatedAccounts)
+	require.Equal(t, 2, countRows(t, sd, "accounts"))
+	require.Equal(t, 1, countRows(t, sd, "accountsesdt"))
+
+	updatedAccounts[0].Balance = big.NewInt(999)
+	updatedAccounts[0].Nonce = 6
+	updatedAccounts[0].ESDTData["TKN-01"] = nil
+	sd.SaveAccounts(updatedAccounts[:1])
+	require.Equal(t, 2, countRows(t, sd, "accounts"))
+	require.Equal(t, 1, countRows(t, sd, "accountsesdt"))
+
+	address := args.addressPubkeyConverter.Encode([]byte("address1"))
+	var balance string
+	var nonce int64
+	err := sd.db.QueryRow("SELECT balance, nonce FROM accounts WHERE address = $1", address).Scan(&balance, &nonce)
+	require.Nil(t, err)
+	require.Equal(t, "999", balance)
+	require.Equal(t, int64(6), nonce)
+
+	var frozen bool
+	err = sd.db.QueryRow("SELECT balance, frozen FROM accountsesdt WHERE address = $1 AND token_identifier = $2", address, "TKN-01").Scan(&balance, &frozen)
+	require.Nil(t, err)
+	require.Equal(t, "0", balance)
+	require.False(t, frozen)
+}
+
+func TestSQLDatabase_RevertBlockAndTransactions(t *testing.T) {
+	t.Parallel()
+
+	args, cleanup := createMockSQLDatabaseArgs(t)
+	defer cleanup()
+	sd, _ := newSQLDatabase(args)
+	defer func() {
+		_ = sd.Close()
+	}()
+
+	crossShardBody := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx3")}, SenderShardID: 1, ReceiverShardID: 4},
+		},
+	}
+	// the cross shard miniblock is saved by its sender shard first
+	sd.SaveMiniblocks(&dataBlock.Header{Nonce: 1, ShardID: 1}, crossShardBody)
+
+	body := newTestBlockBody()
+	header := &dataBlock.Header{Nonce: 1, ShardID: 2, TxCount: 3}
+	sd.SaveHeader(header, []uint64{0}, body, nil, 10)
+	sd.SaveMiniblocks(header, body)
+	sd.SaveTransactions(body, header, newTestTxPool(), 2, map[string]bool{})
+	require.Equal(t, 1, countRows(t, sd, "blocks"))
+	require.Equal(t, 2, countRows(t, sd, "miniblocks"))
+	require.Equal(t, 3, countRows(t, sd, "transactions"))
+
+	sd.RevertBlock(header, body)
+	sd.RevertTransactions(header, body)
+
+	require.Equal(t, 0, countRows(t, sd, "blocks"))
+	require.Equal(t, 1, countRows(t, sd, "miniblocks"))
+	require.Equal(t, 1, countRows(t, sd, "transactions"))
+
+	var senderBlockHash, receiverBlockHash string
+	err := sd.db.QueryRow("SELECT sender_block_hash, receiver_block_hash FROM miniblocks").Scan(&senderBlockHash, &receiverBlockHash)
+	require.Nil(t, err)
+	require.NotEqual(t, "", senderBlockHash)
+	require.Equal(t, "", receiverBlockHash)
+
+	var status string
+	err = sd.db.QueryRow("SELECT status FROM transactions WHERE hash = $1", "747833").Scan(&status)
+	require.Nil(t, err)
+	require.Equal(t, txStatusPending, status)
+}
@@ -0,0 +1,63 @@
+package logsIndex
+
+import (
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+// BloomByteLength is the size, in bytes, of the bloom filter computed for each block
+const BloomByteLength = 256
+
+const bloomBitLength = BloomByteLength * 8
+const numBloomBitsPerValue = 3
+
+// minHashSize is the number of hash bytes needed to select the bits of a value, two bytes for each bit
+const minHashSize = 2 * numBloomBitsPerValue
+
+// logsBloom is a 2048 bits bloom filter in which each value sets 3 bits, selected from the first 6 bytes of its hash
+type logsBloom []byte
+
+func newLogsBloom() logsBloom {
+	return make(logsBloom, BloomByteLength)
+}
+
+func (lb logsBloom) add(hasher hashing.Hasher, value []byte) {
+	hash := hasher.Compute(string(value))
+	for i := 0; i < numBloomBitsPerValue; i++ {
+		byteIndex, bitMask := bloomPosition(hash, i)
+		lb[byteIndex] |= bitMask
+	}
+}
+
+// contains returns false if the value was surely not added to the bloom filter. A malformed bloom filter is
+// considered to contain any value, so that the block is not skipped
+func (lb logsBloom) contains(hasher hashing.Hasher, value []byte) bool {
+	if len(lb) != BloomByteLength {
+		return true
+	}
+
+	hash := hasher.Compute(string(value))
+	for i := 0; i < numBloomBitsPerValue; i++ {
+		byteIndex, bitMask := bloomPosition(hash, i)
+		if lb[byteIndex]&bitMask == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (lb logsBloom) containsAny(hasher hashing.Hasher, values [][]byte) bool {
+	for _, value := range values {
+		if lb.contains(hasher, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func bloomPosition(hash []byte, index int) (int, byte) {
+	bit := (uint(hash[2*index])<<8 | uint(hash[2*index+1])) % bloomBitLength
+
+	return BloomByteLength - 1 - int(bit/8), byte(1) << (bit % 8)
+}
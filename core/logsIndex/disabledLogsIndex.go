@@ -0,0 +1,36 @@
+package logsIndex
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+var _ LogsIndex = (*disabledLogsIndex)(nil)
+
+type disabledLogsIndex struct {
+}
+
+// NewDisabledLogsIndex returns a log events index which does not record anything. It is used when the log events
+// index is not enabled
+func NewDisabledLogsIndex() *disabledLogsIndex {
+	return &disabledLogsIndex{}
+}
+
+// RecordBlock does nothing
+func (dli *disabledLogsIndex) RecordBlock(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// FilterLogs returns ErrLogsIndexDisabled
+func (dli *disabledLogsIndex) FilterLogs(_ uint64, _ uint64, _ *LogsFilter) ([]*LogEvent, error) {
+	return nil, ErrLogsIndexDisabled
+}
+
+// IsEnabled returns false
+func (dli *disabledLogsIndex) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dli *disabledLogsIndex) IsInterfaceNil() bool {
+	return dli == nil
+}
@@ -0,0 +1,45 @@
+package logsIndex
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilTxLogsStorer signals that a nil transaction logs storer has been provided
+var ErrNilTxLogsStorer = errors.New("nil transaction logs storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidHasherSize signals that the provided hasher computes hashes too short for the bloom filter
+var ErrInvalidHasherSize = errors.New("invalid hasher size")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilLogEventsReader signals that a nil log events reader has been provided
+var ErrNilLogEventsReader = errors.New("nil log events reader")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidMaxBlocksPerFilter signals that an invalid maximum number of blocks per filter has been provided
+var ErrInvalidMaxBlocksPerFilter = errors.New("invalid maximum number of blocks per filter")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNilLogsFilter signals that a nil logs filter has been provided
+var ErrNilLogsFilter = errors.New("nil logs filter")
+
+// ErrInvalidBlockRange signals that the end of the requested block range is lower than its start
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
+// ErrBlockRangeTooLarge signals that the requested block range exceeds the maximum number of blocks per filter
+var ErrBlockRangeTooLarge = errors.New("block range too large")
+
+// ErrLogsIndexDisabled signals that the log events index is not enabled on this node
+var ErrLogsIndexDisabled = errors.New("log events index is disabled")
@@ -0,0 +1,20 @@
+package logsIndex
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// LogsIndex defines the actions of the component which indexes, for each committed block, the log events generated
+// by its transactions and filters them by address, identifier and topics
+type LogsIndex interface {
+	RecordBlock(blockHeaderHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler) error
+	FilterLogs(fromNonce uint64, toNonce uint64, filter *LogsFilter) ([]*LogEvent, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// LogEventsReader defines the component which reads the log events generated by the transactions of a block
+type LogEventsReader interface {
+	ReadLogEvents(blockHash []byte, blockNonce uint64, txHashes [][]byte) []*LogEvent
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,101 @@
+package logsIndex
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// LogEvent is an event from the logs generated by a transaction, together with the transaction and the block
+// which generated it. LogAddress is the address of the log holding the event
+type LogEvent struct {
+	TxHash     []byte
+	BlockHash  []byte
+	BlockNonce uint64
+	LogAddress []byte
+	Address    []byte
+	Identifier []byte
+	Topics     [][]byte
+	Data       []byte
+}
+
+var _ LogEventsReader = (*logEventsReader)(nil)
+
+// ArgsLogEventsReader is the argument structure used to create a new log events reader
+type ArgsLogEventsReader struct {
+	TxLogsStorer storage.Storer
+	Marshalizer  marshal.Marshalizer
+}
+
+type logEventsReader struct {
+	txLogsStorer storage.Storer
+	marshalizer  marshal.Marshalizer
+}
+
+// NewLogEventsReader creates the component which reads the log events saved by the transaction logs processor
+func NewLogEventsReader(args ArgsLogEventsReader) (*logEventsReader, error) {
+	if check.IfNil(args.TxLogsStorer) {
+		return nil, ErrNilTxLogsStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &logEventsReader{
+		txLogsStorer: args.TxLogsStorer,
+		marshalizer:  args.Marshalizer,
+	}, nil
+}
+
+// ReadLogEvents returns, in the order of the provided transactions, the log events generated by them. The
+// transactions without logs are skipped
+func (ler *logEventsReader) ReadLogEvents(blockHash []byte, blockNonce uint64, txHashes [][]byte) []*LogEvent {
+	logEvents := make([]*LogEvent, 0)
+	for _, txHash := range txHashes {
+		txLog, ok := ler.getLog(txHash)
+		if !ok {
+			continue
+		}
+
+		for _, event := range txLog.Events {
+			if event == nil {
+				continue
+			}
+
+			logEvents = append(logEvents, &LogEvent{
+				TxHash:     txHash,
+				BlockHash:  blockHash,
+				BlockNonce: blockNonce,
+				LogAddress: txLog.Address,
+				Address:    event.Address,
+				Identifier: event.Identifier,
+				Topics:     event.Topics,
+				Data:       event.Data,
+			})
+		}
+	}
+
+	return logEvents
+}
+
+func (ler *logEventsReader) getLog(txHash []byte) (*transaction.Log, bool) {
+	buff, err := ler.txLogsStorer.SearchFirst(txHash)
+	if err != nil {
+		return nil, false
+	}
+
+	txLog := &transaction.Log{}
+	err = ler.marshalizer.Unmarshal(txLog, buff)
+	if err != nil {
+		log.Warn("logEventsReader.getLog", "error", err.Error())
+		return nil, false
+	}
+
+	return txLog, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ler *logEventsReader) IsInterfaceNil() bool {
+	return ler == nil
+}
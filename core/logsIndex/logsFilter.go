@@ -0,0 +1,133 @@
+package logsIndex
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+// FilterRequest holds the criteria of a log events filter in their human readable form: the addresses are encoded
+// with the address public key converter while the identifiers and the topics are hex encoded
+type FilterRequest struct {
+	Addresses   []string   `json:"addresses"`
+	Identifiers []string   `json:"identifiers"`
+	Topics      [][]string `json:"topics"`
+}
+
+// LogsFilter selects the log events which match all its criteria. An event matches the addresses if either the
+// address of the event or the address of the log holding it is one of them, and it matches the identifiers if its
+// identifier is one of them. Topics are positional: the topic of the event found at each position has to be one of
+// the values given for that position, while a position without values matches any topic. Empty criteria match
+// any event
+type LogsFilter struct {
+	Addresses   [][]byte
+	Identifiers [][]byte
+	Topics      [][][]byte
+}
+
+// NewLogsFilter decodes a filter request into a logs filter
+func NewLogsFilter(request *FilterRequest, pubkeyConverter core.PubkeyConverter) (*LogsFilter, error) {
+	if request == nil {
+		return nil, ErrNilLogsFilter
+	}
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	filter := &LogsFilter{
+		Addresses:   make([][]byte, 0, len(request.Addresses)),
+		Identifiers: make([][]byte, 0, len(request.Identifiers)),
+		Topics:      make([][][]byte, 0, len(request.Topics)),
+	}
+
+	for _, address := range request.Addresses {
+		addressBytes, err := pubkeyConverter.Decode(address)
+		if err != nil {
+			return nil, err
+		}
+		filter.Addresses = append(filter.Addresses, addressBytes)
+	}
+
+	var err error
+	filter.Identifiers, err = decodeHexValues(request.Identifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, topicValues := range request.Topics {
+		topics, errDecode := decodeHexValues(topicValues)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		filter.Topics = append(filter.Topics, topics)
+	}
+
+	return filter, nil
+}
+
+func decodeHexValues(values []string) ([][]byte, error) {
+	decoded := make([][]byte, 0, len(values))
+	for _, value := range values {
+		valueBytes, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, valueBytes)
+	}
+
+	return decoded, nil
+}
+
+// Matches returns true if the log event satisfies all the criteria of the filter
+func (lf *LogsFilter) Matches(event *LogEvent) bool {
+	if event == nil {
+		return false
+	}
+
+	if len(lf.Addresses) > 0 && !containsValue(lf.Addresses, event.Address) && !containsValue(lf.Addresses, event.LogAddress) {
+		return false
+	}
+	if len(lf.Identifiers) > 0 && !containsValue(lf.Identifiers, event.Identifier) {
+		return false
+	}
+	if len(lf.Topics) > len(event.Topics) {
+		return false
+	}
+	for i, topicValues := range lf.Topics {
+		if len(topicValues) > 0 && !containsValue(topicValues, event.Topics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mayMatchBloom returns false if the bloom filter of a block proves that none of its log events matches the filter
+func (lf *LogsFilter) mayMatchBloom(bloom logsBloom, hasher hashing.Hasher) bool {
+	if len(lf.Addresses) > 0 && !bloom.containsAny(hasher, lf.Addresses) {
+		return false
+	}
+	if len(lf.Identifiers) > 0 && !bloom.containsAny(hasher, lf.Identifiers) {
+		return false
+	}
+	for _, topicValues := range lf.Topics {
+		if len(topicValues) > 0 && !bloom.containsAny(hasher, topicValues) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsValue(values [][]byte, value []byte) bool {
+	for _, v := range values {
+		if bytes.Equal(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+package logsIndex
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogsFilter(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(len(scAddress))
+
+	_, err := NewLogsFilter(nil, converter)
+	assert.Equal(t, ErrNilLogsFilter, err)
+
+	_, err = NewLogsFilter(&FilterRequest{}, nil)
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	_, err = NewLogsFilter(&FilterRequest{Addresses: []string{"invalid"}}, converter)
+	assert.NotNil(t, err)
+
+	_, err = NewLogsFilter(&FilterRequest{Identifiers: []string{"zz"}}, converter)
+	assert.NotNil(t, err)
+
+	_, err = NewLogsFilter(&FilterRequest{Topics: [][]string{{"zz"}}}, converter)
+	assert.NotNil(t, err)
+
+	filter, err := NewLogsFilter(&FilterRequest{
+		Addresses:   []string{converter.Encode(scAddress)},
+		Identifiers: []string{hex.EncodeToString([]byte("transfer"))},
+		Topics:      [][]string{{}, {hex.EncodeToString([]byte("a")), hex.EncodeToString([]byte("b"))}},
+	}, converter)
+	require.Nil(t, err)
+	assert.Equal(t, &LogsFilter{
+		Addresses:   [][]byte{scAddress},
+		Identifiers: [][]byte{[]byte("transfer")},
+		Topics:      [][][]byte{{}, {[]byte("a"), []byte("b")}},
+	}, filter)
+}
+
+func TestLogsFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	event := &LogEvent{
+		LogAddress: scAddress,
+		Address:    otherScAddress,
+		Identifier: []byte("transfer"),
+		Topics:     [][]byte{[]byte("a"), []byte("b")},
+	}
+
+	tests := []struct {
+		name     string
+		filter   *LogsFilter
+		expected bool
+	}{
+		{name: "empty filter", filter: &LogsFilter{}, expected: true},
+		{name: "log address", filter: &LogsFilter{Addresses: [][]byte{scAddress}}, expected: true},
+		{name: "event address", filter: &LogsFilter{Addresses: [][]byte{userAddress, otherScAddress}}, expected: true},
+		{name: "other address", filter: &LogsFilter{Addresses: [][]byte{userAddress}}, expected: false},
+		{name: "identifier", filter: &LogsFilter{Identifiers: [][]byte{[]byte("mint"), []byte("transfer")}}, expected: true},
+		{name: "other identifier", filter: &LogsFilter{Identifiers: [][]byte{[]byte("mint")}}, expected: false},
+		{name: "wildcard topic", filter: &LogsFilter{Topics: [][][]byte{{}, {[]byte("b")}}}, expected: true},
+		{name: "other topic", filter: &LogsFilter{Topics: [][][]byte{{[]byte("b")}}}, expected: false},
+		{name: "too many topics", filter: &LogsFilter{Topics: [][][]byte{{}, {}, {}}}, expected: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.filter.Matches(event), tt.name)
+	}
+	assert.False(t, (&LogsFilter{}).Matches(nil))
+}
+
+func TestLogsFilter_MayMatchBloom(t *testing.T) {
+	t.Parallel()
+
+	hasher := &blake2b.Blake2b{}
+	bloom := newLogsBloom()
+	bloom.add(hasher, scAddress)
+	bloom.add(hasher, []byte("transfer"))
+	bloom.add(hasher, []byte("a"))
+
+	assert.True(t, (&LogsFilter{}).mayMatchBloom(bloom, hasher))
+	assert.True(t, (&LogsFilter{
+		Addresses:   [][]byte{userAddress, scAddress},
+		Identifiers: [][]byte{[]byte("transfer")},
+		Topics:      [][][]byte{{}, {[]byte("a")}},
+	}).mayMatchBloom(bloom, hasher))
+	assert.False(t, (&LogsFilter{Addresses: [][]byte{userAddress}}).mayMatchBloom(bloom, hasher))
+	assert.False(t, (&LogsFilter{Topics: [][][]byte{{[]byte("b")}}}).mayMatchBloom(bloom, hasher))
+	assert.True(t, (&LogsFilter{Addresses: [][]byte{userAddress}}).mayMatchBloom(logsBloom{}, hasher))
+}
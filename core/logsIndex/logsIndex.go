@@ -0,0 +1,210 @@
+package logsIndex
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("core/logsIndex")
+
+var _ LogsIndex = (*logsIndex)(nil)
+
+// ArgsLogsIndex is the argument structure used to create a new log events index
+type ArgsLogsIndex struct {
+	Storer             storage.Storer
+	LogEventsReader    LogEventsReader
+	Marshalizer        marshal.Marshalizer
+	Hasher             hashing.Hasher
+	Uint64Converter    typeConverters.Uint64ByteSliceConverter
+	MaxBlocksPerFilter uint64
+}
+
+type logsIndex struct {
+	storer             storage.Storer
+	logEventsReader    LogEventsReader
+	marshalizer        marshal.Marshalizer
+	hasher             hashing.Hasher
+	uint64Converter    typeConverters.Uint64ByteSliceConverter
+	maxBlocksPerFilter uint64
+	mutIndex           sync.RWMutex
+}
+
+// NewLogsIndex creates a new log events index which keeps, for each committed block nonce, a bloom filter over the
+// addresses, identifiers and topics of the log events generated in that block, together with the hashes of the
+// transactions which generated them
+func NewLogsIndex(args ArgsLogsIndex) (*logsIndex, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.LogEventsReader) {
+		return nil, ErrNilLogEventsReader
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.Hasher.Size() < minHashSize {
+		return nil, ErrInvalidHasherSize
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, ErrNilUint64Converter
+	}
+	if args.MaxBlocksPerFilter == 0 {
+		return nil, ErrInvalidMaxBlocksPerFilter
+	}
+
+	return &logsIndex{
+		storer:             args.Storer,
+		logEventsReader:    args.LogEventsReader,
+		marshalizer:        args.Marshalizer,
+		hasher:             args.Hasher,
+		uint64Converter:    args.Uint64Converter,
+		maxBlocksPerFilter: args.MaxBlocksPerFilter,
+	}, nil
+}
+
+// RecordBlock indexes the log events generated by the transactions of a committed block. The entry of a block
+// nonce is replaced if another block with the same nonce is committed after a rollback
+func (li *logsIndex) RecordBlock(
+	blockHeaderHash []byte,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+) error {
+	if check.IfNil(header) {
+		return ErrNilHeader
+	}
+
+	txHashes := make([]string, 0, len(txPool))
+	for txHash := range txPool {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	txHashesBytes := make([][]byte, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		txHashesBytes = append(txHashesBytes, []byte(txHash))
+	}
+
+	logEvents := li.logEventsReader.ReadLogEvents(blockHeaderHash, header.GetNonce(), txHashesBytes)
+	nonceKey := li.uint64Converter.ToByteSlice(header.GetNonce())
+
+	li.mutIndex.Lock()
+	defer li.mutIndex.Unlock()
+
+	if len(logEvents) == 0 {
+		return li.storer.Remove(nonceKey)
+	}
+
+	blockLogs := &BlockLogs{
+		BlockHash:  blockHeaderHash,
+		BlockNonce: header.GetNonce(),
+		Bloom:      li.computeBloom(logEvents),
+		TxHashes:   getTxHashesWithLogs(logEvents),
+	}
+
+	buff, err := li.marshalizer.Marshal(blockLogs)
+	if err != nil {
+		return err
+	}
+
+	return li.storer.Put(nonceKey, buff)
+}
+
+func (li *logsIndex) computeBloom(logEvents []*LogEvent) logsBloom {
+	bloom := newLogsBloom()
+	for _, logEvent := range logEvents {
+		bloom.add(li.hasher, logEvent.LogAddress)
+		bloom.add(li.hasher, logEvent.Address)
+		bloom.add(li.hasher, logEvent.Identifier)
+		for _, topic := range logEvent.Topics {
+			bloom.add(li.hasher, topic)
+		}
+	}
+
+	return bloom
+}
+
+func getTxHashesWithLogs(logEvents []*LogEvent) [][]byte {
+	txHashes := make([][]byte, 0)
+	for i, logEvent := range logEvents {
+		// the events of the same transaction are consecutive
+		if i > 0 && string(logEvents[i-1].TxHash) == string(logEvent.TxHash) {
+			continue
+		}
+		txHashes = append(txHashes, logEvent.TxHash)
+	}
+
+	return txHashes
+}
+
+// FilterLogs returns the log events, generated in the blocks with the nonces between fromNonce and toNonce
+// inclusive, which match the provided filter. The events are ordered by block nonce and transaction hash
+func (li *logsIndex) FilterLogs(fromNonce uint64, toNonce uint64, filter *LogsFilter) ([]*LogEvent, error) {
+	if filter == nil {
+		return nil, ErrNilLogsFilter
+	}
+	if toNonce < fromNonce {
+		return nil, ErrInvalidBlockRange
+	}
+	if toNonce-fromNonce >= li.maxBlocksPerFilter {
+		return nil, ErrBlockRangeTooLarge
+	}
+
+	li.mutIndex.RLock()
+	defer li.mutIndex.RUnlock()
+
+	result := make([]*LogEvent, 0)
+	for nonce := fromNonce; ; nonce++ {
+		blockLogs, ok := li.getBlockLogs(nonce)
+		if ok && filter.mayMatchBloom(blockLogs.Bloom, li.hasher) {
+			logEvents := li.logEventsReader.ReadLogEvents(blockLogs.BlockHash, blockLogs.BlockNonce, blockLogs.TxHashes)
+			for _, logEvent := range logEvents {
+				if filter.Matches(logEvent) {
+					result = append(result, logEvent)
+				}
+			}
+		}
+
+		if nonce == toNonce {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (li *logsIndex) getBlockLogs(nonce uint64) (*BlockLogs, bool) {
+	buff, err := li.storer.Get(li.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		return nil, false
+	}
+
+	blockLogs := &BlockLogs{}
+	err = li.marshalizer.Unmarshal(blockLogs, buff)
+	if err != nil {
+		log.Warn("logsIndex.getBlockLogs", "nonce", nonce, "error", err.Error())
+		return nil, false
+	}
+
+	return blockLogs, true
+}
+
+// IsEnabled returns true as this index records the log events
+func (li *logsIndex) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (li *logsIndex) IsInterfaceNil() bool {
+	return li == nil
+}
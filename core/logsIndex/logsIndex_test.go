@@ -0,0 +1,270 @@
+package logsIndex
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var scAddress = []byte("smart contract address ........1")
+var otherScAddress = []byte("other smart contract address ..2")
+var userAddress = []byte("user address ..................3")
+
+func createMemStorer() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 10, Shards: 1})
+	persister, _ := memorydb.NewlruDB(1000)
+	storer, _ := storageUnit.NewStorageUnit(cache, persister)
+
+	return storer
+}
+
+func createMockArgsLogsIndex(txLogsStorer storage.Storer) ArgsLogsIndex {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	reader, _ := NewLogEventsReader(ArgsLogEventsReader{
+		TxLogsStorer: txLogsStorer,
+		Marshalizer:  marshalizer,
+	})
+
+	return ArgsLogsIndex{
+		Storer:             createMemStorer(),
+		LogEventsReader:    reader,
+		Marshalizer:        marshalizer,
+		Hasher:             &blake2b.Blake2b{},
+		Uint64Converter:    uint64ByteSlice.NewBigEndianConverter(),
+		MaxBlocksPerFilter: 10,
+	}
+}
+
+func saveTxLog(t *testing.T, txLogsStorer storage.Storer, txHash string, txLog *transaction.Log) {
+	buff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(txLog)
+	require.Nil(t, err)
+	err = txLogsStorer.Put([]byte(txHash), buff)
+	require.Nil(t, err)
+}
+
+func recordBlock(t *testing.T, li *logsIndex, nonce uint64, txHashes ...string) {
+	txPool := make(map[string]data.TransactionHandler)
+	for _, txHash := range txHashes {
+		txPool[txHash] = &transaction.Transaction{}
+	}
+
+	err := li.RecordBlock([]byte(fmt.Sprintf("block%d", nonce)), &block.Header{Nonce: nonce}, txPool)
+	require.Nil(t, err)
+}
+
+func TestNewLogsIndex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		updateArgs  func(args *ArgsLogsIndex)
+		expectedErr error
+	}{
+		{name: "nil storer", updateArgs: func(args *ArgsLogsIndex) { args.Storer = nil }, expectedErr: ErrNilStorer},
+		{name: "nil reader", updateArgs: func(args *ArgsLogsIndex) { args.LogEventsReader = nil }, expectedErr: ErrNilLogEventsReader},
+		{name: "nil marshalizer", updateArgs: func(args *ArgsLogsIndex) { args.Marshalizer = nil }, expectedErr: ErrNilMarshalizer},
+		{name: "nil hasher", updateArgs: func(args *ArgsLogsIndex) { args.Hasher = nil }, expectedErr: ErrNilHasher},
+		{name: "short hasher", updateArgs: func(args *ArgsLogsIndex) { args.Hasher = &blake2b.Blake2b{HashSize: 4} }, expectedErr: ErrInvalidHasherSize},
+		{name: "nil converter", updateArgs: func(args *ArgsLogsIndex) { args.Uint64Converter = nil }, expectedErr: ErrNilUint64Converter},
+		{name: "zero max blocks", updateArgs: func(args *ArgsLogsIndex) { args.MaxBlocksPerFilter = 0 }, expectedErr: ErrInvalidMaxBlocksPerFilter},
+		{name: "should work", updateArgs: func(args *ArgsLogsIndex) {}, expectedErr: nil},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgsLogsIndex(createMemStorer())
+		tt.updateArgs(&args)
+
+		li, err := NewLogsIndex(args)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+		assert.Equal(t, tt.expectedErr != nil, check.IfNil(li), tt.name)
+	}
+}
+
+func TestLogsIndex_RecordBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	li, _ := NewLogsIndex(createMockArgsLogsIndex(createMemStorer()))
+
+	err := li.RecordBlock([]byte("block"), nil, nil)
+	assert.Equal(t, ErrNilHeader, err)
+}
+
+func TestLogsIndex_RecordBlockShouldIndexOnlyTheTransactionsWithLogs(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := createMemStorer()
+	saveTxLog(t, txLogsStorer, "tx2", &transaction.Log{
+		Address: scAddress,
+		Events: []*transaction.Event{
+			{Address: scAddress, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("a")}},
+			{Address: scAddress, Identifier: []byte("mint")},
+		},
+	})
+	args := createMockArgsLogsIndex(txLogsStorer)
+	li, _ := NewLogsIndex(args)
+
+	recordBlock(t, li, 7, "tx1", "tx2")
+
+	blockLogs, ok := li.getBlockLogs(7)
+	require.True(t, ok)
+	assert.Equal(t, []byte("block7"), blockLogs.BlockHash)
+	assert.Equal(t, uint64(7), blockLogs.BlockNonce)
+	assert.Equal(t, [][]byte{[]byte("tx2")}, blockLogs.TxHashes)
+	bloom := logsBloom(blockLogs.Bloom)
+	assert.True(t, bloom.contains(args.Hasher, scAddress))
+	assert.True(t, bloom.contains(args.Hasher, []byte("transfer")))
+	assert.True(t, bloom.contains(args.Hasher, []byte("a")))
+}
+
+func TestLogsIndex_RecordBlockWithoutLogsShouldRemoveThePreviousEntry(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := createMemStorer()
+	saveTxLog(t, txLogsStorer, "tx1", &transaction.Log{
+		Address: scAddress,
+		Events:  []*transaction.Event{{Address: scAddress, Identifier: []byte("transfer")}},
+	})
+	li, _ := NewLogsIndex(createMockArgsLogsIndex(txLogsStorer))
+
+	recordBlock(t, li, 7, "tx1")
+	_, ok := li.getBlockLogs(7)
+	require.True(t, ok)
+
+	// another block with the same nonce is committed after a rollback
+	recordBlock(t, li, 7, "tx2")
+	_, ok = li.getBlockLogs(7)
+	assert.False(t, ok)
+}
+
+func TestLogsIndex_FilterLogsInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	li, _ := NewLogsIndex(createMockArgsLogsIndex(createMemStorer()))
+
+	_, err := li.FilterLogs(1, 2, nil)
+	assert.Equal(t, ErrNilLogsFilter, err)
+
+	_, err = li.FilterLogs(3, 2, &LogsFilter{})
+	assert.Equal(t, ErrInvalidBlockRange, err)
+
+	_, err = li.FilterLogs(1, 11, &LogsFilter{})
+	assert.Equal(t, ErrBlockRangeTooLarge, err)
+
+	events, err := li.FilterLogs(1, 10, &LogsFilter{})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestLogsIndex_FilterLogsShouldReturnTheMatchingEvents(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := createMemStorer()
+	saveTxLog(t, txLogsStorer, "tx1", &transaction.Log{
+		Address: scAddress,
+		Events: []*transaction.Event{
+			{Address: scAddress, Identifier: []byte("transfer"), Topics: [][]byte{userAddress, []byte("100")}, Data: []byte("data")},
+			{Address: scAddress, Identifier: []byte("mint"), Topics: [][]byte{userAddress}},
+		},
+	})
+	saveTxLog(t, txLogsStorer, "tx2", &transaction.Log{
+		Address: otherScAddress,
+		Events: []*transaction.Event{
+			{Address: otherScAddress, Identifier: []byte("transfer"), Topics: [][]byte{userAddress, []byte("5")}},
+		},
+	})
+	li, _ := NewLogsIndex(createMockArgsLogsIndex(txLogsStorer))
+	recordBlock(t, li, 1, "tx1")
+	recordBlock(t, li, 2)
+	recordBlock(t, li, 3, "tx2")
+
+	events, err := li.FilterLogs(1, 3, &LogsFilter{
+		Identifiers: [][]byte{[]byte("transfer")},
+		Topics:      [][][]byte{{userAddress}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, &LogEvent{
+		TxHash:     []byte("tx1"),
+		BlockHash:  []byte("block1"),
+		BlockNonce: 1,
+		LogAddress: scAddress,
+		Address:    scAddress,
+		Identifier: []byte("transfer"),
+		Topics:     [][]byte{userAddress, []byte("100")},
+		Data:       []byte("data"),
+	}, events[0])
+	assert.Equal(t, []byte("tx2"), events[1].TxHash)
+	assert.Equal(t, uint64(3), events[1].BlockNonce)
+
+	events, err = li.FilterLogs(1, 3, &LogsFilter{Addresses: [][]byte{otherScAddress}})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, []byte("tx2"), events[0].TxHash)
+
+	events, err = li.FilterLogs(2, 3, &LogsFilter{Addresses: [][]byte{scAddress}})
+	require.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestLogsIndex_FilterLogsShouldSkipTheBlocksExcludedByTheBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := createMemStorer()
+	saveTxLog(t, txLogsStorer, "tx1", &transaction.Log{
+		Address: scAddress,
+		Events:  []*transaction.Event{{Address: scAddress, Identifier: []byte("transfer")}},
+	})
+	li, _ := NewLogsIndex(createMockArgsLogsIndex(txLogsStorer))
+	recordBlock(t, li, 1, "tx1")
+
+	// the stored log is altered after indexing, so its events are returned only if the block is not skipped
+	saveTxLog(t, txLogsStorer, "tx1", &transaction.Log{
+		Address: scAddress,
+		Events:  []*transaction.Event{{Address: scAddress, Identifier: []byte("burn")}},
+	})
+
+	events, err := li.FilterLogs(1, 1, &LogsFilter{Identifiers: [][]byte{[]byte("burn")}})
+	require.Nil(t, err)
+	assert.Empty(t, events)
+
+	events, err = li.FilterLogs(1, 1, &LogsFilter{Addresses: [][]byte{scAddress}})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, []byte("burn"), events[0].Identifier)
+}
+
+func TestLogsIndex_FilterLogsUpToMaxNonceShouldNotOverflow(t *testing.T) {
+	t.Parallel()
+
+	maxNonce := ^uint64(0)
+	li, _ := NewLogsIndex(createMockArgsLogsIndex(createMemStorer()))
+
+	events, err := li.FilterLogs(maxNonce-1, maxNonce, &LogsFilter{})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestDisabledLogsIndex(t *testing.T) {
+	t.Parallel()
+
+	dli := NewDisabledLogsIndex()
+	assert.False(t, check.IfNil(dli))
+	assert.False(t, dli.IsEnabled())
+	assert.Nil(t, dli.RecordBlock(nil, nil, nil))
+
+	_, err := dli.FilterLogs(0, 1, &LogsFilter{})
+	assert.True(t, errors.Is(err, ErrLogsIndexDisabled))
+}
@@ -38,3 +38,8 @@ func (dwm *DatabaseWriterStub) DoMultiGet(_ map[string]interface{}, _ string) (m
 func (dwm *DatabaseWriterStub) CheckAndCreateIndex(_ string, _ io.Reader) error {
 	return nil
 }
+
+// Close --
+func (dwm *DatabaseWriterStub) Close() error {
+	return nil
+}
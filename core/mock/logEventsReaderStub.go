@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+)
+
+// LogEventsReaderStub -
+type LogEventsReaderStub struct {
+	ReadLogEventsCalled func(blockHash []byte, blockNonce uint64, txHashes [][]byte) []*logsIndex.LogEvent
+}
+
+// ReadLogEvents -
+func (lers *LogEventsReaderStub) ReadLogEvents(blockHash []byte, blockNonce uint64, txHashes [][]byte) []*logsIndex.LogEvent {
+	if lers.ReadLogEventsCalled != nil {
+		return lers.ReadLogEventsCalled(blockHash, blockNonce, txHashes)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (lers *LogEventsReaderStub) IsInterfaceNil() bool {
+	return lers == nil
+}
@@ -1,7 +1,10 @@
 package serviceContainer
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 )
 
@@ -10,5 +13,8 @@ import (
 type Core interface {
 	Indexer() indexer.Indexer
 	TPSBenchmark() statistics.TPSBenchmark
+	HistoryRepository() history.HistoryRepository
+	EventsHub() events.EventsHub
+	LogsIndex() logsIndex.LogsIndex
 	IsInterfaceNil() bool
 }
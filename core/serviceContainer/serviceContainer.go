@@ -1,13 +1,19 @@
 package serviceContainer
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 )
 
 type serviceContainer struct {
-	indexer      indexer.Indexer
-	tpsBenchmark statistics.TPSBenchmark
+	indexer           indexer.Indexer
+	tpsBenchmark      statistics.TPSBenchmark
+	historyRepository history.HistoryRepository
+	eventsHub         events.EventsHub
+	logsIndex         logsIndex.LogsIndex
 }
 
 // Option represents a functional configuration parameter that
@@ -37,6 +43,21 @@ func (sc *serviceContainer) TPSBenchmark() statistics.TPSBenchmark {
 	return sc.tpsBenchmark
 }
 
+// HistoryRepository returns the core package's address transactions history repository
+func (sc *serviceContainer) HistoryRepository() history.HistoryRepository {
+	return sc.historyRepository
+}
+
+// EventsHub returns the core package's events hub
+func (sc *serviceContainer) EventsHub() events.EventsHub {
+	return sc.eventsHub
+}
+
+// LogsIndex returns the core package's log events index
+func (sc *serviceContainer) LogsIndex() logsIndex.LogsIndex {
+	return sc.logsIndex
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *serviceContainer) IsInterfaceNil() bool {
 	return sc == nil
@@ -57,3 +78,27 @@ func WithTPSBenchmark(tpsBenchmark statistics.TPSBenchmark) Option {
 		return nil
 	}
 }
+
+// WithHistoryRepository sets up the address transactions history repository for the core serviceContainer
+func WithHistoryRepository(historyRepository history.HistoryRepository) Option {
+	return func(sc *serviceContainer) error {
+		sc.historyRepository = historyRepository
+		return nil
+	}
+}
+
+// WithEventsHub sets up the events hub for the core serviceContainer
+func WithEventsHub(eventsHub events.EventsHub) Option {
+	return func(sc *serviceContainer) error {
+		sc.eventsHub = eventsHub
+		return nil
+	}
+}
+
+// WithLogsIndex sets up the log events index for the core serviceContainer
+func WithLogsIndex(logsIndex logsIndex.LogsIndex) Option {
+	return func(sc *serviceContainer) error {
+		sc.logsIndex = logsIndex
+		return nil
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	elasticIndexer "github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/stretchr/testify/assert"
@@ -49,3 +52,37 @@ func TestServiceContainer_NewServiceContainerWithNilTPSBenchmark(t *testing.T) {
 	assert.NotNil(t, sc)
 	assert.Nil(t, sc.TPSBenchmark())
 }
+
+func TestServiceContainer_NewServiceContainerWithHistoryRepository(t *testing.T) {
+	historyRepository := history.NewDisabledHistoryRepository()
+
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithHistoryRepository(historyRepository))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sc))
+	assert.Equal(t, historyRepository, sc.HistoryRepository())
+}
+
+func TestServiceContainer_NewServiceContainerWithEventsHub(t *testing.T) {
+	eventsHub, _ := events.NewEventsHub(events.ArgsEventsHub{
+		PubkeyConverter:               mock.NewPubkeyConverterMock(32),
+		LogEventsReader:               &mock.LogEventsReaderStub{},
+		SubscriberBufferSize:          1,
+		MaxSubscribers:                10,
+		MaxSubscriptionsPerSubscriber: 10,
+		MaxAddressesPerSubscription:   10,
+	})
+
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithEventsHub(eventsHub))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sc))
+	assert.Equal(t, eventsHub, sc.EventsHub())
+}
+
+func TestServiceContainer_NewServiceContainerWithLogsIndex(t *testing.T) {
+	logsIdx := logsIndex.NewDisabledLogsIndex()
+
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithLogsIndex(logsIdx))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sc))
+	assert.Equal(t, logsIdx, sc.LogsIndex())
+}
@@ -0,0 +1,24 @@
+package keystore
+
+import "errors"
+
+// ErrEmptyPassword signals that an empty password was provided
+var ErrEmptyPassword = errors.New("empty password")
+
+// ErrUnsupportedVersion signals that the keystore file has an unsupported version
+var ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+// ErrUnknownKDF signals that an unknown key derivation function was provided
+var ErrUnknownKDF = errors.New("unknown key derivation function")
+
+// ErrUnknownCipher signals that the keystore file uses an unknown cipher
+var ErrUnknownCipher = errors.New("unknown cipher")
+
+// ErrInvalidKDFParams signals that the key derivation function parameters are invalid
+var ErrInvalidKDFParams = errors.New("invalid key derivation function parameters")
+
+// ErrWrongPasswordOrCorruptedKeystore signals that the secret key could not be decrypted
+var ErrWrongPasswordOrCorruptedKeystore = errors.New("could not decrypt the secret key: wrong password or corrupted keystore")
+
+// ErrNilKeyFile signals that a nil keystore file was provided
+var ErrNilKeyFile = errors.New("nil keystore file")
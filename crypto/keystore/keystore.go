@@ -0,0 +1,327 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Version is the version of the keystore files written by this package
+const Version = 1
+
+const (
+	// KDFScrypt is the name of the scrypt key derivation function
+	KDFScrypt = "scrypt"
+	// KDFArgon2id is the name of the argon2id key derivation function
+	KDFArgon2id = "argon2id"
+)
+
+const cipherAES256GCM = "aes-256-gcm"
+const derivedKeyLen = 32
+const saltLen = 32
+
+// The default parameters are the recommended interactive ones: deriving a key takes in the order of a second
+const (
+	defaultScryptN        = 1 << 18
+	defaultScryptR        = 8
+	defaultScryptP        = 1
+	defaultArgon2Time     = 3
+	defaultArgon2MemoryKB = 256 * 1024
+	defaultArgon2Threads  = 4
+)
+
+// The upper bounds of the parameters read from a keystore file, so that a crafted file can not exhaust the memory
+const (
+	maxScryptN        = 1 << 22
+	maxScryptR        = 32
+	maxScryptP        = 16
+	maxArgon2Time     = 32
+	maxArgon2MemoryKB = 4 * 1024 * 1024
+)
+
+// KeyFile is the JSON structure of a keystore file, holding one encrypted secret key
+type KeyFile struct {
+	Version   int          `json:"version"`
+	ID        string       `json:"id"`
+	PublicKey string       `json:"publicKey"`
+	Crypto    CryptoParams `json:"crypto"`
+}
+
+// CryptoParams holds the encrypted secret key along with everything needed to decrypt it, except the password
+type CryptoParams struct {
+	Cipher       string       `json:"cipher"`
+	CipherText   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	KDF          string       `json:"kdf"`
+	KDFParams    KDFParams    `json:"kdfparams"`
+}
+
+// CipherParams holds the parameters of the cipher
+type CipherParams struct {
+	Nonce string `json:"nonce"`
+}
+
+// KDFParams holds the parameters of the key derivation function. Only the ones of the used function are set
+type KDFParams struct {
+	Salt    string `json:"salt"`
+	DKLen   int    `json:"dklen"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+func defaultKDFParams(kdf string) (KDFParams, error) {
+	switch kdf {
+	case KDFScrypt:
+		return KDFParams{DKLen: derivedKeyLen, N: defaultScryptN, R: defaultScryptR, P: defaultScryptP}, nil
+	case KDFArgon2id:
+		return KDFParams{DKLen: derivedKeyLen, Time: defaultArgon2Time, Memory: defaultArgon2MemoryKB, Threads: defaultArgon2Threads}, nil
+	default:
+		return KDFParams{}, fmt.Errorf("%w: %s", ErrUnknownKDF, kdf)
+	}
+}
+
+// EncryptKey encrypts the secret key with AES-256-GCM, using a key derived from the password with the given key
+// derivation function. The public key is authenticated along with the secret key, so that it can not be swapped
+func EncryptKey(secretKey []byte, publicKey string, password []byte, kdf string) (*KeyFile, error) {
+	params, err := defaultKDFParams(kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptKey(secretKey, publicKey, password, kdf, params)
+}
+
+func encryptKey(secretKey []byte, publicKey string, password []byte, kdf string, params KDFParams) (*KeyFile, error) {
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	salt, err := randomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	params.Salt = hex.EncodeToString(salt)
+
+	derivedKey, err := deriveKey(password, kdf, params)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomBytes(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText := aead.Seal(nil, nonce, secretKey, []byte(publicKey))
+
+	return &KeyFile{
+		Version:   Version,
+		ID:        id,
+		PublicKey: publicKey,
+		Crypto: CryptoParams{
+			Cipher:     cipherAES256GCM,
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: CipherParams{
+				Nonce: hex.EncodeToString(nonce),
+			},
+			KDF:       kdf,
+			KDFParams: params,
+		},
+	}, nil
+}
+
+// DecryptKey returns the secret key held by the keystore file
+func DecryptKey(keyFile *KeyFile, password []byte) ([]byte, error) {
+	if keyFile == nil {
+		return nil, ErrNilKeyFile
+	}
+	if keyFile.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, keyFile.Version)
+	}
+	if keyFile.Crypto.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCipher, keyFile.Crypto.Cipher)
+	}
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	err := checkKDFParams(keyFile.Crypto.KDF, keyFile.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := hex.DecodeString(keyFile.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the cipher text", err)
+	}
+
+	nonce, err := hex.DecodeString(keyFile.Crypto.CipherParams.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the nonce", err)
+	}
+
+	derivedKey, err := deriveKey(password, keyFile.Crypto.KDF, keyFile.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrWrongPasswordOrCorruptedKeystore
+	}
+
+	secretKey, err := aead.Open(nil, nonce, cipherText, []byte(keyFile.PublicKey))
+	if err != nil {
+		return nil, ErrWrongPasswordOrCorruptedKeystore
+	}
+
+	return secretKey, nil
+}
+
+func checkKDFParams(kdf string, params KDFParams) error {
+	if params.DKLen != derivedKeyLen {
+		return fmt.Errorf("%w: dklen %d", ErrInvalidKDFParams, params.DKLen)
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		isValid := params.N > 1 && params.N <= maxScryptN && params.N&(params.N-1) == 0 &&
+			params.R > 0 && params.R <= maxScryptR &&
+			params.P > 0 && params.P <= maxScryptP
+		if !isValid {
+			return fmt.Errorf("%w: n %d, r %d, p %d", ErrInvalidKDFParams, params.N, params.R, params.P)
+		}
+	case KDFArgon2id:
+		isValid := params.Time > 0 && params.Time <= maxArgon2Time &&
+			params.Memory > 0 && params.Memory <= maxArgon2MemoryKB &&
+			params.Threads > 0
+		if !isValid {
+			return fmt.Errorf("%w: time %d, memory %d, threads %d", ErrInvalidKDFParams, params.Time, params.Memory, params.Threads)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownKDF, kdf)
+	}
+
+	return nil
+}
+
+func deriveKey(password []byte, kdf string, params KDFParams) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the salt", err)
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		return scrypt.Key(password, salt, params.N, params.R, params.P, params.DKLen)
+	case KDFArgon2id:
+		return argon2.IDKey(password, salt, params.Time, params.Memory, params.Threads, uint32(params.DKLen)), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKDF, kdf)
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := io.ReadFull(rand.Reader, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+// newID returns a random (version 4) UUID identifying the keystore file
+func newID() (string, error) {
+	buff, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+	buff[6] = buff[6]&0x0f | 0x40
+	buff[8] = buff[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buff[0:4], buff[4:6], buff[6:8], buff[8:10], buff[10:]), nil
+}
+
+// SaveKeyFile writes the keystore file as indented JSON, readable only by the current user
+func SaveKeyFile(filename string, keyFile *KeyFile) error {
+	if keyFile == nil {
+		return ErrNilKeyFile
+	}
+
+	buff, err := json.MarshalIndent(keyFile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, buff, core.FileModeUserReadWrite)
+}
+
+// LoadKeyFile reads a keystore file
+func LoadKeyFile(filename string) (*KeyFile, error) {
+	keyFile := &KeyFile{}
+	err := core.LoadJsonFile(keyFile, filename)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading %s keystore file", err, filename)
+	}
+
+	return keyFile, nil
+}
+
+// LoadPassword reads the password from the given file or, if no file is given, from the given environment variable.
+// The trailing line ending of the file is not part of the password
+func LoadPassword(passwordFile string, envVariable string) ([]byte, error) {
+	var password string
+	if len(passwordFile) > 0 {
+		buff, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return nil, err
+		}
+		password = strings.TrimRight(string(buff), "\r\n")
+	} else {
+		password = os.Getenv(envVariable)
+	}
+
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	return []byte(password), nil
+}
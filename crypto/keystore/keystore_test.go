@@ -0,0 +1,177 @@
+package keystore
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecretKey = []byte("secret key bytes")
+var testPassword = []byte("password")
+
+const testPublicKey = "public key"
+
+func lightKDFParams(kdf string) KDFParams {
+	if kdf == KDFScrypt {
+		return KDFParams{DKLen: derivedKeyLen, N: 1 << 4, R: 8, P: 1}
+	}
+
+	return KDFParams{DKLen: derivedKeyLen, Time: 1, Memory: 64, Threads: 1}
+}
+
+func TestEncryptKey_UnknownKDFShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := EncryptKey(testSecretKey, testPublicKey, testPassword, "md5")
+	assert.Nil(t, keyFile)
+	assert.True(t, errors.Is(err, ErrUnknownKDF))
+}
+
+func TestEncryptKey_EmptyPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, err := encryptKey(testSecretKey, testPublicKey, nil, KDFScrypt, lightKDFParams(KDFScrypt))
+	assert.Nil(t, keyFile)
+	assert.Equal(t, ErrEmptyPassword, err)
+}
+
+func TestEncryptDecryptKey_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, kdf := range []string{KDFScrypt, KDFArgon2id} {
+		keyFile, err := encryptKey(testSecretKey, testPublicKey, testPassword, kdf, lightKDFParams(kdf))
+		require.Nil(t, err, kdf)
+		assert.Equal(t, Version, keyFile.Version)
+		assert.Equal(t, testPublicKey, keyFile.PublicKey)
+		assert.Equal(t, kdf, keyFile.Crypto.KDF)
+		assert.NotContains(t, keyFile.Crypto.CipherText, string(testSecretKey))
+
+		secretKey, err := DecryptKey(keyFile, testPassword)
+		assert.Nil(t, err, kdf)
+		assert.Equal(t, testSecretKey, secretKey, kdf)
+	}
+}
+
+func TestEncryptKey_ShouldUseRandomSaltAndNonce(t *testing.T) {
+	t.Parallel()
+
+	keyFile1, _ := encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+	keyFile2, _ := encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+
+	assert.NotEqual(t, keyFile1.ID, keyFile2.ID)
+	assert.NotEqual(t, keyFile1.Crypto.KDFParams.Salt, keyFile2.Crypto.KDFParams.Salt)
+	assert.NotEqual(t, keyFile1.Crypto.CipherParams.Nonce, keyFile2.Crypto.CipherParams.Nonce)
+	assert.NotEqual(t, keyFile1.Crypto.CipherText, keyFile2.Crypto.CipherText)
+}
+
+func TestDecryptKey_WrongPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := encryptKey(testSecretKey, testPublicKey, testPassword, KDFArgon2id, lightKDFParams(KDFArgon2id))
+
+	secretKey, err := DecryptKey(keyFile, []byte("wrong password"))
+	assert.Nil(t, secretKey)
+	assert.Equal(t, ErrWrongPasswordOrCorruptedKeystore, err)
+}
+
+func TestDecryptKey_SwappedPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+	keyFile.PublicKey = "another public key"
+
+	secretKey, err := DecryptKey(keyFile, testPassword)
+	assert.Nil(t, secretKey)
+	assert.Equal(t, ErrWrongPasswordOrCorruptedKeystore, err)
+}
+
+func TestDecryptKey_InvalidKeyFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	secretKey, err := DecryptKey(nil, testPassword)
+	assert.Nil(t, secretKey)
+	assert.Equal(t, ErrNilKeyFile, err)
+
+	keyFile, _ := encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+	keyFile.Version = Version + 1
+	_, err = DecryptKey(keyFile, testPassword)
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+
+	keyFile, _ = encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+	keyFile.Crypto.Cipher = "aes-128-ctr"
+	_, err = DecryptKey(keyFile, testPassword)
+	assert.True(t, errors.Is(err, ErrUnknownCipher))
+
+	keyFile, _ = encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+	keyFile.Crypto.KDFParams.N = maxScryptN * 2
+	_, err = DecryptKey(keyFile, testPassword)
+	assert.True(t, errors.Is(err, ErrInvalidKDFParams))
+
+	keyFile, _ = encryptKey(testSecretKey, testPublicKey, testPassword, KDFArgon2id, lightKDFParams(KDFArgon2id))
+	keyFile.Crypto.KDFParams.Memory = maxArgon2MemoryKB + 1
+	_, err = DecryptKey(keyFile, testPassword)
+	assert.True(t, errors.Is(err, ErrInvalidKDFParams))
+
+	keyFile, _ = encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+	keyFile.Crypto.CipherText = keyFile.Crypto.CipherText[:len(keyFile.Crypto.CipherText)-2] + "00"
+	_, err = DecryptKey(keyFile, testPassword)
+	assert.Equal(t, ErrWrongPasswordOrCorruptedKeystore, err)
+}
+
+func TestSaveLoadKeyFile_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filename := filepath.Join(dir, "validatorKey.json")
+	keyFile, _ := encryptKey(testSecretKey, testPublicKey, testPassword, KDFScrypt, lightKDFParams(KDFScrypt))
+	err = SaveKeyFile(filename, keyFile)
+	require.Nil(t, err)
+
+	loadedKeyFile, err := LoadKeyFile(filename)
+	require.Nil(t, err)
+	assert.Equal(t, keyFile, loadedKeyFile)
+
+	secretKey, err := DecryptKey(loadedKeyFile, testPassword)
+	assert.Nil(t, err)
+	assert.Equal(t, testSecretKey, secretKey)
+}
+
+func TestLoadPassword(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	passwordFile := filepath.Join(dir, "password")
+	_ = ioutil.WriteFile(passwordFile, []byte("from file\n"), 0600)
+	password, err := LoadPassword(passwordFile, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("from file"), password)
+
+	envVariable := "KEYSTORE_TEST_PASSWORD"
+	_ = os.Setenv(envVariable, "from env")
+	defer func() {
+		_ = os.Unsetenv(envVariable)
+	}()
+	password, err = LoadPassword("", envVariable)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("from env"), password)
+
+	password, err = LoadPassword("", "KEYSTORE_TEST_MISSING_PASSWORD")
+	assert.Nil(t, password)
+	assert.Equal(t, ErrEmptyPassword, err)
+
+	_, err = LoadPassword(filepath.Join(dir, "missing"), envVariable)
+	assert.NotNil(t, err)
+}
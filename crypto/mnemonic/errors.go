@@ -0,0 +1,12 @@
+package mnemonic
+
+import "errors"
+
+// ErrInvalidMnemonic signals that the provided mnemonic is not a valid BIP39 mnemonic
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// ErrInvalidEntropySize signals that an invalid entropy size was provided
+var ErrInvalidEntropySize = errors.New("invalid entropy size")
+
+// ErrSeedTooShort signals that the provided seed is too short to derive keys from
+var ErrSeedTooShort = errors.New("seed too short")
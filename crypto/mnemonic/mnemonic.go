@@ -0,0 +1,42 @@
+package mnemonic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultEntropySize is the entropy size, in bits, of the generated mnemonics: 256 bits produce 24 words
+const DefaultEntropySize = 256
+
+const minSeedLen = 32
+
+// Generate creates a new random BIP39 mnemonic from the given entropy size, expressed in bits. The entropy size
+// should be a multiple of 32 between 128 and 256
+func Generate(entropySize int) (string, error) {
+	entropy, err := bip39.NewEntropy(entropySize)
+	if err != nil {
+		return "", ErrInvalidEntropySize
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// NewSeed validates the provided mnemonic, including its checksum, and computes the BIP39 seed from it and the optional passphrase
+func NewSeed(mnemonic string, passphrase string) ([]byte, error) {
+	mnemonic = normalize(mnemonic)
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+	_, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMnemonic, err.Error())
+	}
+
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
+
+func normalize(mnemonic string) string {
+	return strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+}
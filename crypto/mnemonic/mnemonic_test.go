@@ -0,0 +1,126 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather " +
+	"prepare woman film husband gravity behind test tiger improve"
+
+func TestGenerate_InvalidEntropySizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := Generate(100)
+
+	assert.Equal(t, ErrInvalidEntropySize, err)
+	assert.Empty(t, mnemonic)
+}
+
+func TestGenerate_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := Generate(DefaultEntropySize)
+	require.Nil(t, err)
+	assert.Equal(t, 24, len(strings.Fields(mnemonic)))
+
+	otherMnemonic, _ := Generate(DefaultEntropySize)
+	assert.NotEqual(t, mnemonic, otherMnemonic)
+
+	_, err = NewSeed(mnemonic, "")
+	assert.Nil(t, err)
+}
+
+func TestNewSeed_InvalidMnemonicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	seed, err := NewSeed("moral volcano peasant", "")
+	assert.Equal(t, ErrInvalidMnemonic, err)
+	assert.Nil(t, seed)
+
+	words := strings.Fields(testMnemonic)
+	words[0], words[1] = words[1], words[0]
+	seed, err = NewSeed(strings.Join(words, " "), "")
+	assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+	assert.Nil(t, seed)
+}
+
+func TestNewSeed_ShouldNormalizeTheMnemonic(t *testing.T) {
+	t.Parallel()
+
+	seed, err := NewSeed(testMnemonic, "")
+	require.Nil(t, err)
+
+	otherSeed, err := NewSeed("  "+strings.ToUpper(strings.Replace(testMnemonic, " ", "\n ", 3))+"\n", "")
+	require.Nil(t, err)
+	assert.Equal(t, seed, otherSeed)
+}
+
+func TestDeriveWalletKey_SeedTooShortShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sk, err := DeriveWalletKey(make([]byte, minSeedLen-1), 0)
+
+	assert.Equal(t, ErrSeedTooShort, err)
+	assert.Nil(t, sk)
+}
+
+func TestDeriveWalletKey_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	seed, err := NewSeed(testMnemonic, "")
+	require.Nil(t, err)
+
+	sk, err := DeriveWalletKey(seed, 0)
+	require.Nil(t, err)
+	assert.Equal(t, "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9", hex.EncodeToString(sk))
+
+	sk, err = DeriveWalletKey(seed, 1)
+	require.Nil(t, err)
+	assert.Equal(t, "b8ca6f8203fb4b545a8e83c5384da033c415db155b53fb5b8eba7ff5a039d639", hex.EncodeToString(sk))
+}
+
+func TestDeriveMasterAndChildSK_EIP2333TestVector(t *testing.T) {
+	t.Parallel()
+
+	seed, err := NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "TREZOR")
+	require.Nil(t, err)
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf1"+
+		"41630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+
+	masterSK, err := deriveMasterSK(seed)
+	require.Nil(t, err)
+	expectedMasterSK, _ := big.NewInt(0).SetString("6083874454709270928345386274498605044986640685124978867557563392430687146096", 10)
+	assert.Equal(t, expectedMasterSK, masterSK)
+
+	childSK, err := deriveChildSK(masterSK, 0)
+	require.Nil(t, err)
+	expectedChildSK, _ := big.NewInt(0).SetString("20397789859736650942317412262472558107875392172444076792671091975210932703118", 10)
+	assert.Equal(t, expectedChildSK, childSK)
+}
+
+func TestDeriveValidatorKey_ShouldBeDeterministicAndDifferentPerIndex(t *testing.T) {
+	t.Parallel()
+
+	seed, err := NewSeed(testMnemonic, "")
+	require.Nil(t, err)
+
+	sk0, err := DeriveValidatorKey(seed, 0)
+	require.Nil(t, err)
+	assert.Equal(t, blsSecretKeyLen, len(sk0))
+
+	sk0Again, _ := DeriveValidatorKey(seed, 0)
+	assert.Equal(t, sk0, sk0Again)
+
+	sk1, _ := DeriveValidatorKey(seed, 1)
+	assert.NotEqual(t, sk0, sk1)
+
+	_, err = DeriveValidatorKey(make([]byte, minSeedLen-1), 0)
+	assert.Equal(t, ErrSeedTooShort, err)
+}
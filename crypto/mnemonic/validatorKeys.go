@@ -0,0 +1,129 @@
+package mnemonic
+
+import (
+	"crypto/sha256"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// BLSPurpose is the purpose of the BLS keys derivation paths, as defined by EIP-2334
+const BLSPurpose = 12381
+
+const blsSecretKeyLen = 32
+const lamportChunks = 255
+const lamportChunkLen = 32
+const hkdfModROutputLen = 48
+const keyGenSalt = "BLS-SIG-KEYGEN-SALT-"
+
+// blsCurveOrder is the order r of the BLS12-381 groups
+var blsCurveOrder, _ = big.NewInt(0).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// DeriveValidatorKey derives, from a BIP39 seed, the BLS12-381 secret key of the validator found on the
+// m/12381/508/index/0/0 path. The derivation follows EIP-2333 and the result is encoded as expected by the BLS
+// key generator, that is, as a 32 bytes little endian scalar
+func DeriveValidatorKey(seed []byte, index uint32) ([]byte, error) {
+	sk, err := deriveMasterSK(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	path := []uint32{BLSPurpose, ElrondCoinType, index, 0, 0}
+	for _, segment := range path {
+		sk, err = deriveChildSK(sk, segment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toLittleEndian(sk), nil
+}
+
+func deriveMasterSK(seed []byte) (*big.Int, error) {
+	if len(seed) < minSeedLen {
+		return nil, ErrSeedTooShort
+	}
+
+	return hkdfModR(seed)
+}
+
+func deriveChildSK(parentSK *big.Int, index uint32) (*big.Int, error) {
+	compressedLamportPK, err := parentSKToLamportPK(parentSK, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return hkdfModR(compressedLamportPK)
+}
+
+func parentSKToLamportPK(parentSK *big.Int, index uint32) ([]byte, error) {
+	salt := uint32ToBytes(index)
+	ikm := toBigEndian(parentSK)
+	notIKM := make([]byte, len(ikm))
+	for i := range ikm {
+		notIKM[i] = ^ikm[i]
+	}
+
+	hasher := sha256.New()
+	for _, keyMaterial := range [][]byte{ikm, notIKM} {
+		lamportSK, err := ikmToLamportSK(keyMaterial, salt)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < lamportChunks; i++ {
+			chunkHash := sha256.Sum256(lamportSK[i*lamportChunkLen : (i+1)*lamportChunkLen])
+			_, _ = hasher.Write(chunkHash[:])
+		}
+	}
+
+	return hasher.Sum(nil), nil
+}
+
+func ikmToLamportSK(ikm []byte, salt []byte) ([]byte, error) {
+	okm := make([]byte, lamportChunks*lamportChunkLen)
+	_, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, nil), okm)
+
+	return okm, err
+}
+
+func hkdfModR(ikm []byte) (*big.Int, error) {
+	salt := []byte(keyGenSalt)
+	sk := big.NewInt(0)
+	okm := make([]byte, hkdfModROutputLen)
+	keyInfo := []byte{0, hkdfModROutputLen}
+	ikmWithSuffix := append(append(make([]byte, 0, len(ikm)+1), ikm...), 0)
+
+	for sk.Sign() == 0 {
+		saltHash := sha256.Sum256(salt)
+		salt = saltHash[:]
+
+		_, err := io.ReadFull(hkdf.New(sha256.New, ikmWithSuffix, salt, keyInfo), okm)
+		if err != nil {
+			return nil, err
+		}
+
+		sk.SetBytes(okm)
+		sk.Mod(sk, blsCurveOrder)
+	}
+
+	return sk, nil
+}
+
+func toLittleEndian(value *big.Int) []byte {
+	buff := toBigEndian(value)
+	for i, j := 0, len(buff)-1; i < j; i, j = i+1, j-1 {
+		buff[i], buff[j] = buff[j], buff[i]
+	}
+
+	return buff
+}
+
+func toBigEndian(value *big.Int) []byte {
+	buff := make([]byte, blsSecretKeyLen)
+	valueBytes := value.Bytes()
+	copy(buff[blsSecretKeyLen-len(valueBytes):], valueBytes)
+
+	return buff
+}
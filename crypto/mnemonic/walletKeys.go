@@ -0,0 +1,51 @@
+package mnemonic
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+)
+
+// ElrondCoinType is the coin type registered for Elrond in SLIP-0044
+const ElrondCoinType = 508
+
+const hardenedOffset = 0x80000000
+const ed25519SeedModifier = "ed25519 seed"
+
+// DeriveWalletKey derives, from a BIP39 seed, the ed25519 secret key of the wallet found on the
+// m/44'/508'/0'/0'/index' path. The derivation follows SLIP-0010, as for any other Elrond wallet, and the
+// result is the 32 bytes seed of the ed25519 secret key
+func DeriveWalletKey(seed []byte, index uint32) ([]byte, error) {
+	if len(seed) < minSeedLen {
+		return nil, ErrSeedTooShort
+	}
+
+	path := []uint32{44, ElrondCoinType, 0, 0, index}
+
+	key, chainCode := hmacSHA512([]byte(ed25519SeedModifier), seed)
+	for _, segment := range path {
+		data := make([]byte, 0, 1+len(key)+4)
+		data = append(data, 0)
+		data = append(data, key...)
+		data = append(data, uint32ToBytes(segment|hardenedOffset)...)
+
+		key, chainCode = hmacSHA512(chainCode, data)
+	}
+
+	return key, nil
+}
+
+func hmacSHA512(key []byte, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+
+	return sum[:32], sum[32:]
+}
+
+func uint32ToBytes(value uint32) []byte {
+	buff := make([]byte, 4)
+	binary.BigEndian.PutUint32(buff, value)
+
+	return buff
+}
@@ -0,0 +1,49 @@
+package block
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// ApiBlock represents the structure of a shard block or of a metablock as it is returned by the API
+type ApiBlock struct {
+	Nonce           uint64               `json:"nonce"`
+	Round           uint64               `json:"round"`
+	Hash            string               `json:"hash"`
+	PrevHash        string               `json:"prevHash"`
+	Epoch           uint32               `json:"epoch"`
+	Shard           uint32               `json:"shard"`
+	NumTxs          uint32               `json:"numTxs"`
+	Timestamp       uint64               `json:"timestamp"`
+	RootHash        string               `json:"rootHash"`
+	RandSeed        string               `json:"randSeed"`
+	PrevRandSeed    string               `json:"prevRandSeed"`
+	PubKeysBitmap   string               `json:"pubKeysBitmap"`
+	Signature       string               `json:"signature"`
+	LeaderSignature string               `json:"leaderSignature"`
+	ChainID         string               `json:"chainID"`
+	SoftwareVersion string               `json:"softwareVersion"`
+	AccumulatedFees string               `json:"accumulatedFees"`
+	DeveloperFees   string               `json:"developerFees"`
+	IsStartOfEpoch  bool                 `json:"isStartOfEpoch"`
+	MiniBlocks      []*ApiMiniBlock      `json:"miniBlocks,omitempty"`
+	NotarizedBlocks []*ApiNotarizedBlock `json:"notarizedBlocks,omitempty"`
+}
+
+// ApiMiniBlock represents the structure of a miniblock as it is returned by the API. The transactions are filled
+// only if they were requested
+type ApiMiniBlock struct {
+	Hash             string                              `json:"hash"`
+	Type             string                              `json:"type"`
+	SourceShard      uint32                              `json:"sourceShard"`
+	DestinationShard uint32                              `json:"destinationShard"`
+	TxCount          uint32                              `json:"txCount"`
+	Transactions     []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
+}
+
+// ApiNotarizedBlock represents the structure of a shard block notarized by a metablock as it is returned by the API
+type ApiNotarizedBlock struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Shard uint32 `json:"shard"`
+}
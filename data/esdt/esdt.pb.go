@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: esdt.proto
 
-package builtInFunctions
+package esdt
 
 import (
 	fmt "fmt"
@@ -29,7 +29,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ESDigitalToken holds the data for a elrond standard digital token transaction
 type ESDigitalToken struct {
-	Value *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	Value  *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	Frozen bool          `protobuf:"varint,2,opt,name=Frozen,proto3" json:"frozen"`
 }
 
 func (m *ESDigitalToken) Reset()      { *m = ESDigitalToken{} }
@@ -67,31 +68,38 @@ func (m *ESDigitalToken) GetValue() *math_big.Int {
 	return nil
 }
 
+func (m *ESDigitalToken) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 func init() {
-	proto.RegisterType((*ESDigitalToken)(nil), "protoBuiltInFunctions.ESDigitalToken")
+	proto.RegisterType((*ESDigitalToken)(nil), "proto.ESDigitalToken")
 }
 
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
+	// 269 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4a, 0x2d, 0x4e, 0x29,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x05, 0x53, 0x4e, 0xa5, 0x99, 0x39, 0x25, 0x9e,
-	0x79, 0x6e, 0xa5, 0x79, 0xc9, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0x65, 0x49, 0xa5,
-	0x69, 0x60, 0x1e, 0x98, 0x03, 0x66, 0x41, 0x4c, 0x51, 0x2a, 0xe3, 0xe2, 0x73, 0x0d, 0x76, 0xc9,
-	0x4c, 0xcf, 0x2c, 0x49, 0xcc, 0x09, 0xc9, 0xcf, 0x4e, 0xcd, 0x13, 0x4a, 0xe1, 0x62, 0x0d, 0x4b,
-	0xcc, 0x29, 0x4d, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x71, 0xf2, 0x7b, 0x75, 0x4f, 0x9e, 0xb5,
-	0x0c, 0x24, 0xb0, 0xea, 0xbe, 0xbc, 0x63, 0x6e, 0x62, 0x49, 0x86, 0x7e, 0x52, 0x66, 0xba, 0x9e,
-	0x67, 0x5e, 0x89, 0x35, 0x92, 0x4d, 0xae, 0x39, 0x45, 0xf9, 0x79, 0x29, 0x7e, 0xa9, 0x25, 0xe5,
-	0xf9, 0x45, 0xd9, 0xfa, 0xa9, 0x60, 0x9e, 0x6e, 0x7a, 0xbe, 0x7e, 0x4a, 0x62, 0x49, 0xa2, 0x9e,
-	0x53, 0x66, 0xba, 0x67, 0x5e, 0x89, 0x73, 0x62, 0x71, 0x49, 0x6a, 0x51, 0x10, 0xc4, 0x70, 0x27,
-	0xaf, 0x0b, 0x0f, 0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50, 0x8e, 0xb1, 0xe1, 0x91,
-	0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0x78, 0xe3,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x09, 0x24, 0xa1,
-	0x79, 0x39, 0x89, 0x0d, 0xec, 0x15, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x85, 0x25,
-	0x21, 0x1e, 0x01, 0x00, 0x00,
+	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19,
+	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2,
+	0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3, 0x20, 0xba, 0x94, 0x66, 0x31, 0x72, 0xf1, 0xb9, 0x06, 0xbb,
+	0x64, 0xa6, 0x67, 0x96, 0x24, 0xe6, 0x84, 0xe4, 0x67, 0xa7, 0xe6, 0x09, 0xa5, 0x70, 0xb1, 0x86,
+	0x25, 0xe6, 0x94, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x38, 0xf9, 0xbd, 0xba, 0x27, 0xcf,
+	0x5a, 0x06, 0x12, 0x58, 0x75, 0x5f, 0xde, 0x31, 0x37, 0xb1, 0x24, 0x43, 0x3f, 0x29, 0x33, 0x5d,
+	0xcf, 0x33, 0xaf, 0xc4, 0x1a, 0xc9, 0x2a, 0xd7, 0x9c, 0xa2, 0xfc, 0xbc, 0x14, 0xbf, 0xd4, 0x92,
+	0xf2, 0xfc, 0xa2, 0x6c, 0xfd, 0x54, 0x30, 0x4f, 0x37, 0x3d, 0x5f, 0x3f, 0x25, 0xb1, 0x24, 0x51,
+	0xcf, 0x29, 0x33, 0xdd, 0x33, 0xaf, 0xc4, 0x39, 0xb1, 0xb8, 0x24, 0xb5, 0x28, 0x08, 0x62, 0xb8,
+	0x90, 0x12, 0x17, 0x9b, 0x5b, 0x51, 0x7e, 0x55, 0x6a, 0x9e, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x87,
+	0x13, 0xd7, 0xab, 0x7b, 0xf2, 0x6c, 0x69, 0x60, 0x91, 0x20, 0xa8, 0x8c, 0x93, 0xdd, 0x85, 0x87,
+	0x72, 0x0c, 0x37, 0x1e, 0xca, 0x31, 0x7c, 0x78, 0x28, 0xc7, 0xd8, 0xf0, 0x48, 0x8e, 0x71, 0xc5,
+	0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0xbc, 0xf1, 0x48, 0x8e, 0xf1,
+	0xc1, 0x23, 0x39, 0xc6, 0x17, 0x8f, 0xe4, 0x18, 0x3e, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
+	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x58, 0x40, 0x01, 0x93, 0xc4, 0x06,
+	0xf6, 0xa3, 0x31, 0x60, 0x00, 0xf8, 0x0b, 0x40, 0x5f, 0x27, 0x01, 0x00, 0x00,
 }
 
 func (this *ESDigitalToken) Equal(that interface{}) bool {
@@ -119,15 +127,19 @@ func (this *ESDigitalToken) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
 	return true
 }
 func (this *ESDigitalToken) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&builtInFunctions.ESDigitalToken{")
+	s := make([]string, 0, 6)
+	s = append(s, "&esdt.ESDigitalToken{")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Frozen: "+fmt.Sprintf("%#v", this.Frozen)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -159,6 +171,16 @@ func (m *ESDigitalToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
 		size := __caster.Size(m.Value)
@@ -195,6 +217,9 @@ func (m *ESDigitalToken) Size() (n int) {
 		l = __caster.Size(m.Value)
 		n += 1 + l + sovEsdt(uint64(l))
 	}
+	if m.Frozen {
+		n += 2
+	}
 	return n
 }
 
@@ -210,6 +235,7 @@ func (this *ESDigitalToken) String() string {
 	}
 	s := strings.Join([]string{`&ESDigitalToken{`,
 		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Frozen:` + fmt.Sprintf("%v", this.Frozen) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -289,6 +315,26 @@ func (m *ESDigitalToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
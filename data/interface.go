@@ -164,6 +164,7 @@ type Trie interface {
 	GetAllLeaves() (map[string][]byte, error)
 	GetAllLeavesOnChannel() chan core.KeyValueHolder
 	GetAllHashes() ([][]byte, error)
+	GetProof(key []byte) ([][]byte, error)
 	IsPruningEnabled() bool
 	EnterSnapshotMode()
 	ExitSnapshotMode()
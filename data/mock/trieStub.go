@@ -28,6 +28,7 @@ type TrieStub struct {
 	GetAllLeavesCalled          func() (map[string][]byte, error)
 	GetAllLeavesOnChannelCalled func() chan core.KeyValueHolder
 	GetAllHashesCalled          func() ([][]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	IsPruningEnabledCalled      func() bool
 	ClosePersisterCalled        func() error
 }
@@ -216,3 +217,12 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 
 	return nil, nil
 }
+
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
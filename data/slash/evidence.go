@@ -0,0 +1,11 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. evidence.proto
+package slash
+
+const (
+	// DoubleSigningEvidence is the type of the evidence holding the signature shares given by a validator in the
+	// signature subround over two different headers proposed in the same round
+	DoubleSigningEvidence uint32 = 1
+	// DoubleProposalEvidence is the type of the evidence holding the leader signatures given by a leader over two
+	// different headers of the same round
+	DoubleProposalEvidence uint32 = 2
+)
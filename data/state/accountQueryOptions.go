@@ -0,0 +1,14 @@
+package state
+
+// AccountQueryOptions specifies the state an account query is answered against: the state at the end of the block
+// with the given nonce or the state with the given root hash. The current state is used when none of them is set
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	RootHash      []byte
+}
+
+// IsHistorical returns true if the query has to be answered against a state other than the current one
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.HasBlockNonce || len(options.RootHash) > 0
+}
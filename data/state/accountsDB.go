@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -617,6 +618,63 @@ func (adb *AccountsDB) JournalLen() int {
 	return length
 }
 
+// GetModifiedAccounts returns the accounts touched by the journal entries recorded since the last commit,
+// in the order they were first modified
+func (adb *AccountsDB) GetModifiedAccounts() []*ModifiedAccount {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	modifiedAccounts := make([]*ModifiedAccount, 0)
+	accountsIndexes := make(map[string]int)
+	dataTrieKeys := make([]map[string]struct{}, 0)
+
+	addModifiedAccount := func(address []byte, keys map[string][]byte) {
+		if len(address) == 0 {
+			return
+		}
+
+		idx, found := accountsIndexes[string(address)]
+		if !found {
+			idx = len(modifiedAccounts)
+			accountsIndexes[string(address)] = idx
+			modifiedAccounts = append(modifiedAccounts, &ModifiedAccount{
+				Address:      address,
+				DataTrieKeys: make([][]byte, 0),
+			})
+			dataTrieKeys = append(dataTrieKeys, make(map[string]struct{}))
+		}
+
+		for key := range keys {
+			dataTrieKeys[idx][key] = struct{}{}
+		}
+	}
+
+	for _, entry := range adb.entries {
+		switch journalEntry := entry.(type) {
+		case *journalEntryAccount:
+			addModifiedAccount(journalEntry.account.AddressBytes(), nil)
+		case *journalEntryAccountCreation:
+			addModifiedAccount(journalEntry.address, nil)
+		case *journalEntryDataTrieUpdates:
+			addModifiedAccount(journalEntry.account.AddressBytes(), journalEntry.trieUpdates)
+		}
+	}
+
+	for idx, modifiedAccount := range modifiedAccounts {
+		keys := make([]string, 0, len(dataTrieKeys[idx]))
+		for key := range dataTrieKeys[idx] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			modifiedAccount.DataTrieKeys = append(modifiedAccount.DataTrieKeys, []byte(key))
+		}
+	}
+
+	return modifiedAccounts
+}
+
 // Commit will persist all data inside the trie
 func (adb *AccountsDB) Commit() ([]byte, error) {
 	adb.mutOp.Lock()
@@ -730,6 +788,12 @@ func (adb *AccountsDB) recreateTrie(rootHash []byte) error {
 	return nil
 }
 
+// GetTrie returns a new trie instance of the accounts DB, recreated from the given root hash. The returned trie is
+// detached from the current state, so it can be read while the state changes
+func (adb *AccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	return adb.mainTrie.Recreate(rootHash)
+}
+
 // RecreateAllTries recreates all the tries from the accounts DB
 func (adb *AccountsDB) RecreateAllTries(rootHash []byte) (map[string]data.Trie, error) {
 	recreatedTrie, err := adb.mainTrie.Recreate(rootHash)
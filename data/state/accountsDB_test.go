@@ -890,6 +890,79 @@ func TestAccountsDB_RecreateTrieInvalidatesJournalEntries(t *testing.T) {
 	assert.Equal(t, 0, adb.JournalLen())
 }
 
+func TestAccountsDB_GetModifiedAccountsShouldReturnTheJournalizedAccounts(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, accFactory)
+
+	address1 := bytes.Repeat([]byte{1}, 32)
+	address2 := bytes.Repeat([]byte{2}, 32)
+	address3 := bytes.Repeat([]byte{3}, 32)
+
+	acc, _ := adb.LoadAccount(address1)
+	_ = adb.SaveAccount(acc)
+	acc, _ = adb.LoadAccount(address2)
+	_ = adb.SaveAccount(acc)
+	_, _ = adb.Commit()
+	assert.Equal(t, 0, len(adb.GetModifiedAccounts()))
+
+	acc, _ = adb.LoadAccount(address2)
+	acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key2"), []byte("value"))
+	acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key1"), []byte("value"))
+	_ = adb.SaveAccount(acc)
+
+	acc, _ = adb.LoadAccount(address3)
+	_ = adb.SaveAccount(acc)
+
+	acc, _ = adb.LoadAccount(address2)
+	acc.(state.UserAccountHandler).IncreaseNonce(1)
+	acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key1"), []byte("new value"))
+	_ = adb.SaveAccount(acc)
+
+	modifiedAccounts := adb.GetModifiedAccounts()
+	assert.Equal(t, 2, len(modifiedAccounts))
+	assert.Equal(t, address2, modifiedAccounts[0].Address)
+	assert.Equal(t, [][]byte{[]byte("key1"), []byte("key2")}, modifiedAccounts[0].DataTrieKeys)
+	assert.Equal(t, address3, modifiedAccounts[1].Address)
+	assert.Equal(t, 0, len(modifiedAccounts[1].DataTrieKeys))
+
+	_, _ = adb.Commit()
+	assert.Equal(t, 0, len(adb.GetModifiedAccounts()))
+}
+
+func TestAccountsDB_GetModifiedAccountsAfterRevertShouldNotReturnRevertedAccounts(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, accFactory)
+
+	address1 := bytes.Repeat([]byte{1}, 32)
+	address2 := bytes.Repeat([]byte{2}, 32)
+
+	acc, _ := adb.LoadAccount(address1)
+	_ = adb.SaveAccount(acc)
+	snapshot := adb.JournalLen()
+
+	acc, _ = adb.LoadAccount(address2)
+	_ = adb.SaveAccount(acc)
+	_ = adb.RevertToSnapshot(snapshot)
+
+	modifiedAccounts := adb.GetModifiedAccounts()
+	assert.Equal(t, 1, len(modifiedAccounts))
+	assert.Equal(t, address1, modifiedAccounts[0].Address)
+}
+
 func TestAccountsDB_RootHash(t *testing.T) {
 	t.Parallel()
 
@@ -1343,3 +1416,34 @@ func TestAccountsDB_RemoveAccountMarksObsoleteHashesForEviction(t *testing.T) {
 	oldHashes := ewl.Cache[string(rootHash)]
 	assert.Equal(t, 5, len(oldHashes))
 }
+
+func TestAccountsDB_GetTrieShouldReturnATrieDetachedFromTheCurrentState(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, accFactory)
+
+	address := make([]byte, 32)
+	acc, _ := adb.LoadAccount(address)
+	acc.(state.UserAccountHandler).SetCode([]byte("code"))
+	_ = adb.SaveAccount(acc)
+	rootHash, _ := adb.Commit()
+
+	otherAcc, _ := adb.LoadAccount([]byte("other address........1234567890"))
+	_ = adb.SaveAccount(otherAcc)
+	_, _ = adb.Commit()
+
+	oldTrie, err := adb.GetTrie(rootHash)
+	assert.Nil(t, err)
+
+	oldTrieRootHash, _ := oldTrie.Root()
+	assert.Equal(t, rootHash, oldTrieRootHash)
+	proof, err := oldTrie.GetProof(address)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(proof))
+}
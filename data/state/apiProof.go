@@ -0,0 +1,29 @@
+package state
+
+// ApiAccountProof holds the Merkle proof of an account against the state root hash of a committed block. The proof
+// holds the hex encoded trie nodes from the root to the leaf of the account, and the value is the hex encoded
+// serialized account, as stored in the leaf
+type ApiAccountProof struct {
+	Address    string   `json:"address"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	RootHash   string   `json:"rootHash"`
+	Proof      []string `json:"proof"`
+	Value      string   `json:"value"`
+}
+
+// ApiStorageProof holds the Merkle proof of a storage value of an account: the proof of the account against the state
+// root hash of a committed block, followed by the proof of the key against the data trie root hash of the account.
+// The value is the hex encoded leaf value, which is the stored value followed by the key and the account address
+type ApiStorageProof struct {
+	Address          string   `json:"address"`
+	Key              string   `json:"key"`
+	BlockNonce       uint64   `json:"blockNonce"`
+	BlockHash        string   `json:"blockHash"`
+	RootHash         string   `json:"rootHash"`
+	AccountProof     []string `json:"accountProof"`
+	AccountValue     string   `json:"accountValue"`
+	DataTrieRootHash string   `json:"dataTrieRootHash"`
+	Proof            []string `json:"proof"`
+	Value            string   `json:"value"`
+}
@@ -115,3 +115,6 @@ var ErrNilMapOfHashes = errors.New("nil map of hashes")
 
 // ErrInvalidRootHash signals that the provided root hash is invalid
 var ErrInvalidRootHash = errors.New("invalid root hash")
+
+// ErrStatePruned signals that the state with the requested root hash is no longer available, as it has been pruned
+var ErrStatePruned = errors.New("state pruned")
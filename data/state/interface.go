@@ -46,6 +46,8 @@ type AccountHandler interface {
 type PeerAccountHandler interface {
 	GetBLSPublicKey() []byte
 	SetBLSPublicKey([]byte) error
+	GetPreviousBLSPublicKey() []byte
+	SetPreviousBLSPublicKey([]byte)
 	GetRewardAddress() []byte
 	SetRewardAddress([]byte) error
 	GetAccumulatedFees() *big.Int
@@ -125,6 +127,7 @@ type AccountsAdapter interface {
 	Commit() ([]byte, error)
 	JournalLen() int
 	RevertToSnapshot(snapshot int) error
+	GetModifiedAccounts() []*ModifiedAccount
 
 	RootHash() ([]byte, error)
 	RecreateTrie(rootHash []byte) error
@@ -135,6 +138,7 @@ type AccountsAdapter interface {
 	IsPruningEnabled() bool
 	GetAllLeaves(rootHash []byte) (map[string][]byte, error)
 	RecreateAllTries(rootHash []byte) (map[string]data.Trie, error)
+	GetTrie(rootHash []byte) (data.Trie, error)
 	IsInterfaceNil() bool
 }
 
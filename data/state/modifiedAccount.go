@@ -0,0 +1,8 @@
+package state
+
+// ModifiedAccount holds the address of an account changed since the last commit, together with the keys
+// written in its data trie
+type ModifiedAccount struct {
+	Address      []byte
+	DataTrieKeys [][]byte
+}
@@ -52,6 +52,11 @@ func (pa *peerAccount) SetBLSPublicKey(pubKey []byte) error {
 	return nil
 }
 
+// SetPreviousBLSPublicKey sets the bls public key the validator used before changing it to the account's key
+func (pa *peerAccount) SetPreviousBLSPublicKey(pubKey []byte) {
+	pa.PreviousBLSPublicKey = pubKey
+}
+
 // SetRewardAddress sets the account's reward address, saving the old address before changing
 func (pa *peerAccount) SetRewardAddress(address []byte) error {
 	if len(address) < 1 {
@@ -231,6 +231,7 @@ type PeerAccountData struct {
 	TotalLeaderSuccessRate     SignRate      `protobuf:"bytes,14,opt,name=TotalLeaderSuccessRate,proto3" json:"TotalLeaderSuccessRate"`
 	Nonce                      uint64        `protobuf:"varint,15,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
 	UnStakedEpoch              uint32        `protobuf:"varint,16,opt,name=UnStakedEpoch,proto3" json:"UnStakedEpoch,omitempty"`
+	PreviousBLSPublicKey       []byte        `protobuf:"bytes,17,opt,name=PreviousBLSPublicKey,proto3" json:"PreviousBLSPublicKey,omitempty"`
 }
 
 func (m *PeerAccountData) Reset()      { *m = PeerAccountData{} }
@@ -373,6 +374,13 @@ func (m *PeerAccountData) GetUnStakedEpoch() uint32 {
 	return 0
 }
 
+func (m *PeerAccountData) GetPreviousBLSPublicKey() []byte {
+	if m != nil {
+		return m.PreviousBLSPublicKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SignRate)(nil), "proto.SignRate")
 	proto.RegisterType((*ValidatorApiResponse)(nil), "proto.ValidatorApiResponse")
@@ -382,58 +390,59 @@ func init() {
 func init() { proto.RegisterFile("peerAccountData.proto", fileDescriptor_26bd0314afcce126) }
 
 var fileDescriptor_26bd0314afcce126 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x2f, 0xf9, 0xb9, 0x9d, 0xfc, 0x95, 0x69, 0x7b, 0x71, 0xaf, 0xd0, 0x38, 0x8a, 0x40,
-	0xea, 0xe6, 0x26, 0x12, 0xec, 0xf8, 0x8f, 0x4b, 0xaf, 0x64, 0x68, 0x43, 0x35, 0x29, 0x08, 0xb1,
-	0x9b, 0xd8, 0x53, 0xc7, 0xaa, 0xe3, 0x89, 0xc6, 0xe3, 0x16, 0x76, 0x3c, 0x02, 0x8f, 0x81, 0x78,
-	0x0c, 0x56, 0x5d, 0x76, 0xd9, 0x95, 0xa1, 0xee, 0x06, 0x79, 0xd5, 0x07, 0x60, 0x71, 0x95, 0x71,
-	0x9c, 0xc6, 0xb1, 0x93, 0x55, 0x3c, 0xe7, 0xfb, 0xce, 0x97, 0xef, 0xcc, 0x9c, 0x73, 0xc0, 0xc1,
-	0x8c, 0x52, 0x3e, 0x30, 0x4d, 0x16, 0x78, 0xe2, 0x5b, 0x22, 0x48, 0x6f, 0xc6, 0x99, 0x60, 0xb0,
-	0x22, 0x7f, 0x5e, 0xbf, 0xb1, 0x1d, 0x31, 0x09, 0xc6, 0x3d, 0x93, 0x4d, 0xfb, 0x36, 0xb3, 0x59,
-	0x5f, 0x86, 0xc7, 0xc1, 0xa5, 0x3c, 0xc9, 0x83, 0xfc, 0x4a, 0xb2, 0xba, 0xdf, 0x81, 0x97, 0x23,
-	0xc7, 0xf6, 0x30, 0x11, 0x14, 0x22, 0x00, 0x86, 0xc1, 0x74, 0x14, 0x98, 0x26, 0xf5, 0x7d, 0x55,
-	0xe9, 0x28, 0x47, 0x4d, 0xbc, 0x12, 0x59, 0xe0, 0x6f, 0x89, 0xe3, 0x06, 0x9c, 0xaa, 0x2f, 0x96,
-	0xf8, 0x22, 0xd2, 0xfd, 0xbf, 0x0a, 0xf6, 0x7f, 0x22, 0xae, 0x63, 0x11, 0xc1, 0xf8, 0x60, 0xe6,
-	0x60, 0xea, 0xcf, 0x98, 0xe7, 0x53, 0xd8, 0x03, 0xe0, 0x82, 0x4e, 0x67, 0x98, 0x08, 0xc7, 0xb3,
-	0xa5, 0xf0, 0x0b, 0xbd, 0x15, 0x87, 0x1a, 0x10, 0xcb, 0x28, 0x5e, 0x61, 0xc0, 0x6f, 0xc0, 0xee,
-	0x30, 0x98, 0x9e, 0x52, 0x62, 0x51, 0x9e, 0xda, 0x91, 0x7f, 0xa7, 0xef, 0xc7, 0xa1, 0xb6, 0xeb,
-	0xad, 0x61, 0x38, 0xc7, 0xce, 0x28, 0xa4, 0x86, 0xdf, 0x2b, 0x50, 0x58, 0x60, 0x38, 0xc7, 0x86,
-	0x06, 0xd8, 0x1b, 0x06, 0xd3, 0x65, 0x39, 0xa9, 0x8d, 0xb2, 0x14, 0xf9, 0x20, 0x0e, 0xb5, 0x3d,
-	0x2f, 0x0f, 0xe3, 0xa2, 0x9c, 0x75, 0xa9, 0xd4, 0x4f, 0xa5, 0x58, 0x2a, 0xb5, 0x54, 0x94, 0x03,
-	0xbb, 0xa0, 0xba, 0xb8, 0xc5, 0xaa, 0xbc, 0x45, 0x10, 0x87, 0x5a, 0x95, 0x27, 0x37, 0xb8, 0x40,
-	0xe0, 0x67, 0xa0, 0x95, 0x7c, 0x9d, 0x31, 0xcb, 0xb9, 0x74, 0x28, 0x57, 0x6b, 0x92, 0x0b, 0xe3,
-	0x50, 0x6b, 0xf1, 0x0c, 0x82, 0xd7, 0x98, 0xf0, 0x07, 0x70, 0x70, 0xc1, 0x04, 0x71, 0x73, 0xd7,
-	0xff, 0x52, 0x9a, 0x3d, 0x8c, 0x43, 0xed, 0x40, 0x14, 0x11, 0x70, 0x71, 0x5e, 0x5e, 0x30, 0xad,
-	0x7e, 0x67, 0x93, 0x60, 0x5a, 0x7f, 0x71, 0x1e, 0xfc, 0x19, 0xa8, 0x29, 0x90, 0x7b, 0x1c, 0x20,
-	0x35, 0x3f, 0x8c, 0x43, 0x4d, 0x15, 0x1b, 0x38, 0x78, 0x63, 0x76, 0xa1, 0x72, 0xea, 0xb6, 0xbe,
-	0x45, 0x39, 0x35, 0xbc, 0x31, 0x1b, 0x7e, 0x0c, 0x6a, 0xa3, 0x09, 0xe1, 0x96, 0x61, 0xa9, 0x0d,
-	0x29, 0x54, 0x8f, 0x43, 0xad, 0xe6, 0x27, 0x21, 0x9c, 0x62, 0xf0, 0x4b, 0xd0, 0x7e, 0x36, 0x25,
-	0x88, 0x08, 0x7c, 0xb5, 0xd9, 0x51, 0x8e, 0x76, 0xf4, 0xbd, 0x38, 0xd4, 0xda, 0xd7, 0x59, 0x08,
-	0xaf, 0x73, 0xbb, 0x7f, 0x57, 0x41, 0xfb, 0x3c, 0xbb, 0x1a, 0x60, 0x17, 0x34, 0xf4, 0xd3, 0xd1,
-	0x79, 0x30, 0x76, 0x1d, 0xf3, 0x7b, 0xfa, 0x9b, 0x9c, 0xbd, 0x06, 0xce, 0xc4, 0xe0, 0x47, 0xa0,
-	0x89, 0xe9, 0x0d, 0xe1, 0xd6, 0xc0, 0xb2, 0x78, 0x3a, 0x6a, 0x0d, 0x9c, 0x0d, 0x42, 0xf5, 0xb9,
-	0x06, 0x39, 0x48, 0xcf, 0xb6, 0x8d, 0x95, 0xa9, 0x4f, 0x6f, 0x99, 0x08, 0x2a, 0x47, 0xa5, 0xfe,
-	0x49, 0x3b, 0x59, 0x34, 0xbd, 0x74, 0xcb, 0xe8, 0xe5, 0xdb, 0x50, 0x2b, 0xe1, 0xc2, 0x14, 0x78,
-	0x0c, 0xde, 0xcf, 0x76, 0xd5, 0x5c, 0xa7, 0xb2, 0x4d, 0x27, 0xcf, 0x87, 0xaf, 0x32, 0x33, 0xd2,
-	0x5c, 0xce, 0x05, 0xca, 0x6c, 0xa1, 0x5a, 0xb2, 0xbe, 0x56, 0xb6, 0x0e, 0x03, 0xed, 0x81, 0x69,
-	0x06, 0xd3, 0xc0, 0x25, 0x82, 0x5a, 0x6f, 0x29, 0x4d, 0xba, 0xbe, 0xa1, 0x9f, 0xfc, 0xf5, 0x8f,
-	0x36, 0x98, 0x12, 0x31, 0xe9, 0x8f, 0x1d, 0xbb, 0x67, 0x78, 0xe2, 0xf3, 0x95, 0x1d, 0x7b, 0xe2,
-	0x72, 0xe6, 0x59, 0x43, 0x2a, 0x6e, 0x18, 0xbf, 0xea, 0x53, 0x79, 0x7a, 0x63, 0xb3, 0xbe, 0x35,
-	0xdf, 0xcc, 0xba, 0x63, 0x1b, 0x9e, 0x38, 0x26, 0xbe, 0xa0, 0x1c, 0xaf, 0xab, 0xc3, 0xaf, 0xc0,
-	0xeb, 0xf9, 0x76, 0xa5, 0x2e, 0x35, 0x05, 0xb5, 0x0c, 0x6f, 0x51, 0x84, 0xee, 0x32, 0xf3, 0xca,
-	0x4f, 0x06, 0x04, 0x6f, 0x61, 0xc0, 0x0e, 0xa8, 0x1b, 0x9e, 0x45, 0x7f, 0x35, 0xbc, 0x53, 0xc7,
-	0x17, 0x49, 0xf7, 0xe3, 0xd5, 0x10, 0x84, 0xa0, 0x2c, 0xa1, 0x79, 0xfb, 0xee, 0x60, 0xf9, 0x0d,
-	0xbf, 0x00, 0x87, 0xc7, 0xf3, 0xad, 0x6c, 0x06, 0xc2, 0xb9, 0xa6, 0xe7, 0x9c, 0xcd, 0x98, 0x4f,
-	0xf9, 0x99, 0xe3, 0xfb, 0xd4, 0x4f, 0xda, 0x13, 0x6f, 0x26, 0xc0, 0x11, 0x38, 0x94, 0x6d, 0x5e,
-	0xf8, 0xe2, 0xcd, 0x6d, 0x2f, 0xb5, 0x39, 0x0f, 0x9e, 0x81, 0x57, 0x12, 0xcc, 0xbf, 0x7d, 0x6b,
-	0x9b, 0xe2, 0x86, 0x24, 0xb8, 0x0f, 0x2a, 0x43, 0xe6, 0x99, 0x54, 0x6d, 0x77, 0x94, 0xa3, 0x32,
-	0x4e, 0x0e, 0xf3, 0x36, 0xff, 0xd1, 0x1b, 0x09, 0x72, 0x45, 0xad, 0x93, 0x19, 0x33, 0x27, 0xea,
-	0xae, 0xac, 0x35, 0x1b, 0xd4, 0xbf, 0xbe, 0x7b, 0x40, 0xa5, 0xfb, 0x07, 0x54, 0x7a, 0x7a, 0x40,
-	0xca, 0xef, 0x11, 0x52, 0xfe, 0x8c, 0x90, 0x72, 0x1b, 0x21, 0xe5, 0x2e, 0x42, 0xca, 0x7d, 0x84,
-	0x94, 0x7f, 0x23, 0xa4, 0xfc, 0x17, 0xa1, 0xd2, 0x53, 0x84, 0x94, 0x3f, 0x1e, 0x51, 0xe9, 0xee,
-	0x11, 0x95, 0xee, 0x1f, 0x51, 0xe9, 0x97, 0x8a, 0x2f, 0x88, 0xa0, 0xe3, 0xaa, 0xb4, 0xfa, 0xe9,
-	0xbb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0xee, 0x1d, 0x40, 0xa6, 0x07, 0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x53, 0x5b, 0x8e, 0xc7, 0x92, 0xe5, 0xac, 0xe5, 0x94, 0x0e, 0x8a, 0xa5, 0x21, 0xb4,
+	0x80, 0x2f, 0x91, 0x80, 0xf4, 0xd6, 0x6f, 0xd1, 0x75, 0x00, 0xb6, 0xb6, 0x6a, 0xac, 0xd2, 0xa2,
+	0xe8, 0x6d, 0x45, 0x6e, 0x68, 0xc2, 0x14, 0x57, 0x58, 0x2e, 0x9d, 0xf6, 0xd6, 0x9f, 0xd0, 0x9f,
+	0x51, 0xf4, 0x97, 0xe4, 0xe8, 0xa3, 0x4f, 0x6c, 0x4d, 0x5f, 0x0a, 0x9e, 0x72, 0xea, 0xa9, 0x87,
+	0x40, 0x4b, 0xd1, 0x16, 0x45, 0xd2, 0x27, 0x71, 0xe7, 0xbd, 0x79, 0x9a, 0xd9, 0x9d, 0x37, 0xb0,
+	0x37, 0x63, 0x4c, 0x0c, 0x6d, 0x9b, 0x47, 0x81, 0xfc, 0x96, 0x4a, 0xda, 0x9f, 0x09, 0x2e, 0x39,
+	0x5a, 0x57, 0x3f, 0xcf, 0x9e, 0xbb, 0x9e, 0x3c, 0x8f, 0x26, 0x7d, 0x9b, 0x4f, 0x07, 0x2e, 0x77,
+	0xf9, 0x40, 0x85, 0x27, 0xd1, 0x6b, 0x75, 0x52, 0x07, 0xf5, 0x95, 0x65, 0xf5, 0xbe, 0x83, 0xc7,
+	0x63, 0xcf, 0x0d, 0x08, 0x95, 0x0c, 0x61, 0x80, 0x51, 0x34, 0x1d, 0x47, 0xb6, 0xcd, 0xc2, 0x50,
+	0xd7, 0x0e, 0xb4, 0xc3, 0x36, 0x59, 0x8a, 0x2c, 0xf0, 0x97, 0xd4, 0xf3, 0x23, 0xc1, 0xf4, 0x47,
+	0x77, 0xf8, 0x22, 0xd2, 0xfb, 0xbf, 0x09, 0xdd, 0x9f, 0xa8, 0xef, 0x39, 0x54, 0x72, 0x31, 0x9c,
+	0x79, 0x84, 0x85, 0x33, 0x1e, 0x84, 0x0c, 0xf5, 0x01, 0x5e, 0xb1, 0xe9, 0x8c, 0x50, 0xe9, 0x05,
+	0xae, 0x12, 0x7e, 0x64, 0x6e, 0xa7, 0xb1, 0x01, 0xf2, 0x2e, 0x4a, 0x96, 0x18, 0xe8, 0x1b, 0xd8,
+	0x19, 0x45, 0xd3, 0x13, 0x46, 0x1d, 0x26, 0xf2, 0x72, 0xd4, 0xdf, 0x99, 0xdd, 0x34, 0x36, 0x76,
+	0x82, 0x15, 0x8c, 0x94, 0xd8, 0x05, 0x85, 0xbc, 0xe0, 0x0f, 0x2a, 0x14, 0x16, 0x18, 0x29, 0xb1,
+	0x91, 0x05, 0xbb, 0xa3, 0x68, 0x7a, 0xd7, 0x4e, 0x5e, 0xc6, 0x9a, 0x12, 0xf9, 0x30, 0x8d, 0x8d,
+	0xdd, 0xa0, 0x0c, 0x93, 0xaa, 0x9c, 0x55, 0xa9, 0xbc, 0x9e, 0xf5, 0x6a, 0xa9, 0xbc, 0xa4, 0xaa,
+	0x1c, 0xd4, 0x83, 0xe6, 0xe2, 0x16, 0x9b, 0xea, 0x16, 0x21, 0x8d, 0x8d, 0xa6, 0xc8, 0x6e, 0x70,
+	0x81, 0xa0, 0xcf, 0x60, 0x3b, 0xfb, 0x3a, 0xe5, 0x8e, 0xf7, 0xda, 0x63, 0x42, 0xdf, 0x50, 0x5c,
+	0x94, 0xc6, 0xc6, 0xb6, 0x28, 0x20, 0x64, 0x85, 0x89, 0x7e, 0x80, 0xbd, 0x57, 0x5c, 0x52, 0xbf,
+	0x74, 0xfd, 0x8f, 0x55, 0xb1, 0xfb, 0x69, 0x6c, 0xec, 0xc9, 0x2a, 0x02, 0xa9, 0xce, 0x2b, 0x0b,
+	0xe6, 0xdd, 0x6f, 0xd6, 0x09, 0xe6, 0xfd, 0x57, 0xe7, 0xa1, 0x9f, 0x41, 0xcf, 0x81, 0xd2, 0xe3,
+	0x80, 0xd2, 0xfc, 0x28, 0x8d, 0x0d, 0x5d, 0xd6, 0x70, 0x48, 0x6d, 0x76, 0xa5, 0x72, 0x5e, 0xed,
+	0xd6, 0x03, 0xca, 0x79, 0xc1, 0xb5, 0xd9, 0xe8, 0x13, 0xd8, 0x18, 0x9f, 0x53, 0xe1, 0x58, 0x8e,
+	0xde, 0x52, 0x42, 0x5b, 0x69, 0x6c, 0x6c, 0x84, 0x59, 0x88, 0xe4, 0x18, 0xfa, 0x12, 0x3a, 0xf7,
+	0x45, 0x49, 0x2a, 0xa3, 0x50, 0x6f, 0x1f, 0x68, 0x87, 0x9b, 0xe6, 0x6e, 0x1a, 0x1b, 0x9d, 0xcb,
+	0x22, 0x44, 0x56, 0xb9, 0xbd, 0xff, 0x9a, 0xd0, 0x39, 0x2b, 0xae, 0x06, 0xd4, 0x83, 0x96, 0x79,
+	0x32, 0x3e, 0x8b, 0x26, 0xbe, 0x67, 0x7f, 0xcf, 0x7e, 0x53, 0xde, 0x6b, 0x91, 0x42, 0x0c, 0x7d,
+	0x0c, 0x6d, 0xc2, 0xde, 0x50, 0xe1, 0x0c, 0x1d, 0x47, 0xe4, 0x56, 0x6b, 0x91, 0x62, 0x10, 0xe9,
+	0xf7, 0x3d, 0x28, 0x23, 0xdd, 0x97, 0x6d, 0x41, 0x77, 0xf5, 0x2e, 0xe7, 0xeb, 0x44, 0x59, 0x65,
+	0xeb, 0x45, 0x27, 0x5b, 0x34, 0xfd, 0x7c, 0xcb, 0x98, 0x6b, 0x6f, 0x63, 0xa3, 0x41, 0x2a, 0x53,
+	0xd0, 0x11, 0x3c, 0x29, 0x4e, 0x15, 0x95, 0x99, 0x4f, 0x6a, 0x75, 0xca, 0x7c, 0xf4, 0xb4, 0xe0,
+	0x91, 0xf6, 0x9d, 0x2f, 0x70, 0x61, 0x0b, 0x6d, 0x28, 0x6c, 0x29, 0x82, 0x38, 0x74, 0x86, 0xb6,
+	0x1d, 0x4d, 0x23, 0x9f, 0x4a, 0xe6, 0xbc, 0x64, 0x2c, 0x9b, 0xfa, 0x96, 0x79, 0xfc, 0xd7, 0xdf,
+	0xc6, 0x70, 0x4a, 0xe5, 0xf9, 0x60, 0xe2, 0xb9, 0x7d, 0x2b, 0x90, 0x9f, 0x2f, 0xed, 0xd8, 0x63,
+	0x5f, 0xf0, 0xc0, 0x19, 0x31, 0xf9, 0x86, 0x8b, 0x8b, 0x01, 0x53, 0xa7, 0xe7, 0x2e, 0x1f, 0x38,
+	0xf3, 0xcd, 0x6c, 0x7a, 0xae, 0x15, 0xc8, 0x23, 0x1a, 0x4a, 0x26, 0xc8, 0xaa, 0x3a, 0xfa, 0x0a,
+	0x9e, 0xcd, 0xb7, 0x2b, 0xf3, 0x99, 0x2d, 0x99, 0x63, 0x05, 0x8b, 0x26, 0x4c, 0x9f, 0xdb, 0x17,
+	0x61, 0x66, 0x10, 0xf2, 0x00, 0x03, 0x1d, 0xc0, 0x96, 0x15, 0x38, 0xec, 0x57, 0x2b, 0x38, 0xf1,
+	0x42, 0x99, 0x4d, 0x3f, 0x59, 0x0e, 0x21, 0x04, 0x6b, 0x0a, 0x9a, 0x8f, 0xef, 0x26, 0x51, 0xdf,
+	0xe8, 0x0b, 0xd8, 0x3f, 0x9a, 0x6f, 0x65, 0x3b, 0x92, 0xde, 0x25, 0x3b, 0x13, 0x7c, 0xc6, 0x43,
+	0x26, 0x4e, 0xbd, 0x30, 0x64, 0x61, 0x36, 0x9e, 0xa4, 0x9e, 0x80, 0xc6, 0xb0, 0xaf, 0xc6, 0xbc,
+	0xf2, 0xc5, 0xdb, 0x0f, 0xbd, 0x54, 0x7d, 0x1e, 0x3a, 0x85, 0xa7, 0x0a, 0x2c, 0xbf, 0xfd, 0xf6,
+	0x43, 0x8a, 0x35, 0x49, 0xa8, 0x0b, 0xeb, 0x23, 0x1e, 0xd8, 0x4c, 0xef, 0x1c, 0x68, 0x87, 0x6b,
+	0x24, 0x3b, 0xcc, 0xc7, 0xfc, 0xc7, 0x60, 0x2c, 0xe9, 0x05, 0x73, 0x8e, 0x67, 0xdc, 0x3e, 0xd7,
+	0x77, 0x54, 0xaf, 0xc5, 0x20, 0x7a, 0x01, 0xdd, 0x33, 0xc1, 0x2e, 0x3d, 0x1e, 0x85, 0x05, 0xe3,
+	0x3c, 0x51, 0x9e, 0xa8, 0xc4, 0xcc, 0xaf, 0xaf, 0x6e, 0x70, 0xe3, 0xfa, 0x06, 0x37, 0xde, 0xdd,
+	0x60, 0xed, 0xf7, 0x04, 0x6b, 0x7f, 0x26, 0x58, 0x7b, 0x9b, 0x60, 0xed, 0x2a, 0xc1, 0xda, 0x75,
+	0x82, 0xb5, 0x7f, 0x12, 0xac, 0xfd, 0x9b, 0xe0, 0xc6, 0xbb, 0x04, 0x6b, 0x7f, 0xdc, 0xe2, 0xc6,
+	0xd5, 0x2d, 0x6e, 0x5c, 0xdf, 0xe2, 0xc6, 0x2f, 0xeb, 0xa1, 0xa4, 0x92, 0x4d, 0x9a, 0xaa, 0xbd,
+	0x4f, 0xdf, 0x0f, 0x00, 0x96, 0x54, 0x03, 0x39, 0xda, 0x07, 0x00, 0x00,
 }
 
 func (this *SignRate) Equal(that interface{}) bool {
@@ -593,6 +602,9 @@ func (this *PeerAccountData) Equal(that interface{}) bool {
 	if this.UnStakedEpoch != that1.UnStakedEpoch {
 		return false
 	}
+	if !bytes.Equal(this.PreviousBLSPublicKey, that1.PreviousBLSPublicKey) {
+		return false
+	}
 	return true
 }
 func (this *SignRate) GoString() string {
@@ -632,7 +644,7 @@ func (this *PeerAccountData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 20)
+	s := make([]string, 0, 21)
 	s = append(s, "&state.PeerAccountData{")
 	s = append(s, "BLSPublicKey: "+fmt.Sprintf("%#v", this.BLSPublicKey)+",\n")
 	s = append(s, "RewardAddress: "+fmt.Sprintf("%#v", this.RewardAddress)+",\n")
@@ -650,6 +662,7 @@ func (this *PeerAccountData) GoString() string {
 	s = append(s, "TotalLeaderSuccessRate: "+strings.Replace(this.TotalLeaderSuccessRate.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "UnStakedEpoch: "+fmt.Sprintf("%#v", this.UnStakedEpoch)+",\n")
+	s = append(s, "PreviousBLSPublicKey: "+fmt.Sprintf("%#v", this.PreviousBLSPublicKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -807,6 +820,15 @@ func (m *PeerAccountData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PreviousBLSPublicKey) > 0 {
+		i -= len(m.PreviousBLSPublicKey)
+		copy(dAtA[i:], m.PreviousBLSPublicKey)
+		i = encodeVarintPeerAccountData(dAtA, i, uint64(len(m.PreviousBLSPublicKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.UnStakedEpoch != 0 {
 		i = encodeVarintPeerAccountData(dAtA, i, uint64(m.UnStakedEpoch))
 		i--
@@ -1054,6 +1076,10 @@ func (m *PeerAccountData) Size() (n int) {
 	if m.UnStakedEpoch != 0 {
 		n += 2 + sovPeerAccountData(uint64(m.UnStakedEpoch))
 	}
+	l = len(m.PreviousBLSPublicKey)
+	if l > 0 {
+		n += 2 + l + sovPeerAccountData(uint64(l))
+	}
 	return n
 }
 
@@ -1117,6 +1143,7 @@ func (this *PeerAccountData) String() string {
 		`TotalLeaderSuccessRate:` + strings.Replace(strings.Replace(this.TotalLeaderSuccessRate.String(), "SignRate", "SignRate", 1), `&`, ``, 1) + `,`,
 		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`UnStakedEpoch:` + fmt.Sprintf("%v", this.UnStakedEpoch) + `,`,
+		`PreviousBLSPublicKey:` + fmt.Sprintf("%v", this.PreviousBLSPublicKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1960,6 +1987,40 @@ func (m *PeerAccountData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBLSPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerAccountData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeerAccountData
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerAccountData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousBLSPublicKey = append(m.PreviousBLSPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousBLSPublicKey == nil {
+				m.PreviousBLSPublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPeerAccountData(dAtA[iNdEx:])
@@ -4,17 +4,83 @@ import "github.com/ElrondNetwork/elrond-go/core"
 
 // ApiTransactionResult is the data transfer object which will be returned on the get transaction by hash endpoint
 type ApiTransactionResult struct {
-	Type      string                 `json:"type"`
-	Nonce     uint64                 `json:"nonce,omitempty"`
-	Round     uint64                 `json:"round,omitempty"`
-	Epoch     uint32                 `json:"epoch,omitempty"`
-	Value     string                 `json:"value,omitempty"`
-	Receiver  string                 `json:"receiver,omitempty"`
-	Sender    string                 `json:"sender,omitempty"`
-	GasPrice  uint64                 `json:"gasPrice,omitempty"`
-	GasLimit  uint64                 `json:"gasLimit,omitempty"`
-	Data      string                 `json:"data,omitempty"`
-	Code      string                 `json:"code,omitempty"`
-	Signature string                 `json:"signature,omitempty"`
-	Status    core.TransactionStatus `json:"status,omitempty"`
+	Type       string                 `json:"type"`
+	Hash       string                 `json:"hash,omitempty"`
+	BlockNonce uint64                 `json:"blockNonce,omitempty"`
+	BlockHash  string                 `json:"blockHash,omitempty"`
+	Timestamp  uint64                 `json:"timestamp,omitempty"`
+	Nonce      uint64                 `json:"nonce,omitempty"`
+	Round      uint64                 `json:"round,omitempty"`
+	Epoch      uint32                 `json:"epoch,omitempty"`
+	Value      string                 `json:"value,omitempty"`
+	Receiver   string                 `json:"receiver,omitempty"`
+	Sender     string                 `json:"sender,omitempty"`
+	GasPrice   uint64                 `json:"gasPrice,omitempty"`
+	GasLimit   uint64                 `json:"gasLimit,omitempty"`
+	Data       string                 `json:"data,omitempty"`
+	Code       string                 `json:"code,omitempty"`
+	Signature  string                 `json:"signature,omitempty"`
+	Status     core.TransactionStatus `json:"status,omitempty"`
+
+	GasUsed              uint64                    `json:"gasUsed,omitempty"`
+	SmartContractResults []*ApiSmartContractResult `json:"smartContractResults,omitempty"`
+	Logs                 *ApiLogs                  `json:"logs,omitempty"`
+	Receipt              *ApiReceipt               `json:"receipt,omitempty"`
+	// ResultsNotAvailable is set when the node does not keep the links between the transactions and their results,
+	// that is, when AddressTxHistory is disabled. The smart contract results, the receipt and the gas used are then
+	// missing and the status does not reflect the outcome of the execution
+	ResultsNotAvailable bool `json:"resultsNotAvailable,omitempty"`
+}
+
+// ApiSmartContractResult is the data transfer object of a smart contract result generated by a transaction
+type ApiSmartContractResult struct {
+	Hash           string `json:"hash"`
+	Nonce          uint64 `json:"nonce"`
+	Value          string `json:"value"`
+	Receiver       string `json:"receiver"`
+	Sender         string `json:"sender"`
+	Data           string `json:"data,omitempty"`
+	PrevTxHash     string `json:"prevTxHash"`
+	OriginalTxHash string `json:"originalTxHash"`
+	GasLimit       uint64 `json:"gasLimit"`
+	GasPrice       uint64 `json:"gasPrice"`
+	CallType       int    `json:"callType"`
+	ReturnMessage  string `json:"returnMessage,omitempty"`
+}
+
+// ApiReceipt is the data transfer object of a receipt generated by a transaction
+type ApiReceipt struct {
+	Value  string `json:"value"`
+	Sender string `json:"sender"`
+	Data   string `json:"data,omitempty"`
+	TxHash string `json:"txHash"`
+}
+
+// ApiLogs is the data transfer object of the logs generated by a smart contract call
+type ApiLogs struct {
+	Address string         `json:"address"`
+	Events  []*ApiLogEvent `json:"events"`
+}
+
+// ApiLogEvent is the data transfer object of an event from the logs generated by a smart contract call. The binary
+// fields are hex encoded
+type ApiLogEvent struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data"`
+}
+
+// ApiFilteredLogEvent is the data transfer object of a log event returned by a logs filter, together with the
+// transaction and the block which generated it. LogAddress is the address of the log holding the event, while the
+// other binary fields are hex encoded
+type ApiFilteredLogEvent struct {
+	TxHash     string   `json:"txHash"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	LogAddress string   `json:"logAddress"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data"`
 }
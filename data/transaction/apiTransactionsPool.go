@@ -0,0 +1,40 @@
+package transaction
+
+// ApiPendingTransaction represents a transaction waiting in the transactions pool
+type ApiPendingTransaction struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	GasPrice uint64 `json:"gasPrice"`
+	GasLimit uint64 `json:"gasLimit"`
+}
+
+// ApiNonceGap represents an interval of nonces, [From, To], missing from the pending transactions of a sender
+type ApiNonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// ApiSenderTransactionsPool is the data transfer object which holds the pending transactions of a sender
+type ApiSenderTransactionsPool struct {
+	Sender              string                   `json:"sender"`
+	AccountNonce        uint64                   `json:"accountNonce"`
+	AccountNonceKnown   bool                     `json:"accountNonceKnown"`
+	Transactions        []*ApiPendingTransaction `json:"transactions"`
+	Nonces              []uint64                 `json:"nonces"`
+	Gaps                []*ApiNonceGap           `json:"gaps"`
+	Score               uint32                   `json:"score"`
+	NumFailedSelections int64                    `json:"numFailedSelections"`
+	IsInGracePeriod     bool                     `json:"isInGracePeriod"`
+	NumBytes            uint64                   `json:"numBytes"`
+}
+
+// ApiTransactionsPoolCache holds the summary statistics of one cache of the transactions pool
+type ApiTransactionsPoolCache struct {
+	CacheID           string `json:"cacheId"`
+	NumTxs            uint64 `json:"numTxs"`
+	NumBytes          uint64 `json:"numBytes"`
+	NumSenders        uint64 `json:"numSenders"`
+	NumEvictedTxs     uint64 `json:"numEvictedTxs"`
+	NumEvictedSenders uint64 `json:"numEvictedSenders"`
+	NumReplacedTxs    uint64 `json:"numReplacedTxs"`
+}
@@ -0,0 +1,15 @@
+package transaction
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// SimulationResults is the data transfer object which will be returned on the transaction simulation endpoint
+type SimulationResults struct {
+	Status     core.TransactionStatus             `json:"status"`
+	ReturnCode string                             `json:"returnCode"`
+	FailReason string                             `json:"failReason,omitempty"`
+	Hash       string                             `json:"hash"`
+	ScResults  map[string]*ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts   map[string]*ApiReceipt             `json:"receipts,omitempty"`
+	Logs       *ApiLogs                           `json:"logs,omitempty"`
+	Balances   map[string]string                  `json:"balances"`
+}
@@ -0,0 +1,203 @@
+package trie
+
+import (
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+const archivePartitionPrefix = "Epoch_"
+
+type archivePartition struct {
+	epoch uint32
+	db    storage.Persister
+}
+
+// archiveDb is the trie database used in archive mode. The trie nodes are written in and read from the main (hot)
+// database, but the nodes removed by pruning are moved in the archive partition of the current epoch instead of being
+// deleted. The reads fall back on the archive partitions, from the newest to the oldest, so that all the historical
+// roots remain reachable while the main database only holds the nodes of the recent states
+type archiveDb struct {
+	hotDb         data.DBWriteCacher
+	archiveDbCfg  config.DBConfig
+	partitions    []*archivePartition
+	currentEpoch  uint32
+	mutPartitions sync.RWMutex
+}
+
+func newArchiveDb(hotDb data.DBWriteCacher, archiveDbCfg config.DBConfig) (*archiveDb, error) {
+	if check.IfNil(hotDb) {
+		return nil, ErrNilDatabase
+	}
+
+	partitions, err := openArchivePartitions(archiveDbCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch := uint32(0)
+	if len(partitions) > 0 {
+		currentEpoch = partitions[len(partitions)-1].epoch
+	}
+
+	return &archiveDb{
+		hotDb:        hotDb,
+		archiveDbCfg: archiveDbCfg,
+		partitions:   partitions,
+		currentEpoch: currentEpoch,
+	}, nil
+}
+
+func openArchivePartitions(archiveDbCfg config.DBConfig) ([]*archivePartition, error) {
+	partitions := make([]*archivePartition, 0)
+	if !directoryExists(archiveDbCfg.FilePath) {
+		return partitions, nil
+	}
+
+	files, err := ioutil.ReadDir(archiveDbCfg.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+		if !f.IsDir() || !strings.HasPrefix(f.Name(), archivePartitionPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(f.Name(), archivePartitionPrefix), 10, 32)
+		if errParse != nil {
+			log.Debug("skipping archive partition", "name", f.Name(), "error", errParse.Error())
+			continue
+		}
+
+		db, errOpen := newArchivePartitionDb(archiveDbCfg, uint32(epoch))
+		if errOpen != nil {
+			closeArchivePartitions(partitions)
+			return nil, errOpen
+		}
+
+		log.Debug("restored trie archive partition", "epoch", epoch)
+		partitions = append(partitions, &archivePartition{epoch: uint32(epoch), db: db})
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].epoch < partitions[j].epoch
+	})
+
+	return partitions, nil
+}
+
+func newArchivePartitionDb(archiveDbCfg config.DBConfig, epoch uint32) (storage.Persister, error) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.DBType(archiveDbCfg.Type),
+		Path:              path.Join(archiveDbCfg.FilePath, archivePartitionPrefix+strconv.Itoa(int(epoch))),
+		BatchDelaySeconds: archiveDbCfg.BatchDelaySeconds,
+		MaxBatchSize:      archiveDbCfg.MaxBatchSize,
+		MaxOpenFiles:      archiveDbCfg.MaxOpenFiles,
+	}
+
+	return storageUnit.NewDB(arg)
+}
+
+func closeArchivePartitions(partitions []*archivePartition) {
+	for _, partition := range partitions {
+		err := partition.db.Close()
+		if err != nil {
+			log.Warn("trie archive: close partition", "epoch", partition.epoch, "error", err.Error())
+		}
+	}
+}
+
+// Put writes the key-value pair in the main database
+func (adb *archiveDb) Put(key, val []byte) error {
+	return adb.hotDb.Put(key, val)
+}
+
+// Get returns the value for the given key from the main database or, if not found there, from the newest archive
+// partition which holds it
+func (adb *archiveDb) Get(key []byte) ([]byte, error) {
+	val, err := adb.hotDb.Get(key)
+	if err == nil {
+		return val, nil
+	}
+
+	adb.mutPartitions.RLock()
+	defer adb.mutPartitions.RUnlock()
+
+	for i := len(adb.partitions) - 1; i >= 0; i-- {
+		archivedVal, errArchive := adb.partitions[i].db.Get(key)
+		if errArchive == nil {
+			return archivedVal, nil
+		}
+	}
+
+	return nil, err
+}
+
+// Remove moves the value of the given key from the main database to the archive partition of the current epoch
+func (adb *archiveDb) Remove(key []byte) error {
+	val, err := adb.hotDb.Get(key)
+	if err == nil {
+		err = adb.archive(key, val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return adb.hotDb.Remove(key)
+}
+
+func (adb *archiveDb) archive(key []byte, val []byte) error {
+	adb.mutPartitions.Lock()
+	defer adb.mutPartitions.Unlock()
+
+	lastIndex := len(adb.partitions) - 1
+	if lastIndex >= 0 && adb.partitions[lastIndex].epoch == adb.currentEpoch {
+		return adb.partitions[lastIndex].db.Put(key, val)
+	}
+
+	db, err := newArchivePartitionDb(adb.archiveDbCfg, adb.currentEpoch)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("created trie archive partition", "epoch", adb.currentEpoch)
+	adb.partitions = append(adb.partitions, &archivePartition{epoch: adb.currentEpoch, db: db})
+
+	return db.Put(key, val)
+}
+
+// SetEpoch sets the epoch whose archive partition will receive the trie nodes removed from now on. The archive
+// partitions only move forward, so an older epoch is ignored
+func (adb *archiveDb) SetEpoch(epoch uint32) {
+	adb.mutPartitions.Lock()
+	defer adb.mutPartitions.Unlock()
+
+	if epoch > adb.currentEpoch {
+		adb.currentEpoch = epoch
+	}
+}
+
+// Close closes the archive partitions and the main database
+func (adb *archiveDb) Close() error {
+	adb.mutPartitions.Lock()
+	closeArchivePartitions(adb.partitions)
+	adb.partitions = make([]*archivePartition, 0)
+	adb.mutPartitions.Unlock()
+
+	return adb.hotDb.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adb *archiveDb) IsInterfaceNil() bool {
+	return adb == nil
+}
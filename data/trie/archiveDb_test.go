@@ -0,0 +1,132 @@
+package trie
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArchiveDbConfig() config.DBConfig {
+	tempDir, _ := ioutil.TempDir("", "trie_archive")
+
+	return config.DBConfig{
+		FilePath:          tempDir,
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func TestNewArchiveDb_NilHotDbShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, err := newArchiveDb(nil, createArchiveDbConfig())
+	assert.Nil(t, adb)
+	assert.Equal(t, ErrNilDatabase, err)
+}
+
+func TestArchiveDb_RemoveMovesTheValueToTheCurrentPartition(t *testing.T) {
+	t.Parallel()
+
+	hotDb := mock.NewMemDbMock()
+	adb, err := newArchiveDb(hotDb, createArchiveDbConfig())
+	require.Nil(t, err)
+	defer func() {
+		_ = adb.Close()
+	}()
+
+	_ = adb.Put([]byte("key"), []byte("value"))
+	err = adb.Remove([]byte("key"))
+	require.Nil(t, err)
+
+	_, err = hotDb.Get([]byte("key"))
+	assert.NotNil(t, err)
+	require.Equal(t, 1, len(adb.partitions))
+	assert.Equal(t, uint32(0), adb.partitions[0].epoch)
+
+	val, err := adb.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+}
+
+func TestArchiveDb_RemoveMissingKeyShouldNotCreatePartition(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := newArchiveDb(mock.NewMemDbMock(), createArchiveDbConfig())
+	defer func() {
+		_ = adb.Close()
+	}()
+
+	err := adb.Remove([]byte("missing key"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(adb.partitions))
+
+	_, err = adb.Get([]byte("missing key"))
+	assert.NotNil(t, err)
+}
+
+func TestArchiveDb_SetEpochShouldPartitionTheArchive(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := newArchiveDb(mock.NewMemDbMock(), createArchiveDbConfig())
+	defer func() {
+		_ = adb.Close()
+	}()
+
+	_ = adb.Put([]byte("key"), []byte("value in epoch 1"))
+	adb.SetEpoch(1)
+	_ = adb.Remove([]byte("key"))
+
+	_ = adb.Put([]byte("key"), []byte("value in epoch 3"))
+	adb.SetEpoch(3)
+	_ = adb.Remove([]byte("key"))
+
+	adb.SetEpoch(2)
+	assert.Equal(t, uint32(3), adb.currentEpoch)
+
+	require.Equal(t, 2, len(adb.partitions))
+	assert.Equal(t, uint32(1), adb.partitions[0].epoch)
+	assert.Equal(t, uint32(3), adb.partitions[1].epoch)
+
+	val, err := adb.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value in epoch 3"), val)
+}
+
+func TestArchiveDb_ReopenShouldRestoreThePartitions(t *testing.T) {
+	t.Parallel()
+
+	archiveDbCfg := createArchiveDbConfig()
+	adb, _ := newArchiveDb(memorydb.New(), archiveDbCfg)
+	_ = adb.Put([]byte("key0"), []byte("value0"))
+	_ = adb.Remove([]byte("key0"))
+	adb.SetEpoch(4)
+	_ = adb.Put([]byte("key4"), []byte("value4"))
+	_ = adb.Remove([]byte("key4"))
+	err := adb.Close()
+	require.Nil(t, err)
+
+	reopenedAdb, err := newArchiveDb(memorydb.New(), archiveDbCfg)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopenedAdb.Close()
+	}()
+
+	assert.Equal(t, uint32(4), reopenedAdb.currentEpoch)
+	require.Equal(t, 2, len(reopenedAdb.partitions))
+
+	val, err := reopenedAdb.Get([]byte("key0"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value0"), val)
+
+	val, err = reopenedAdb.Get([]byte("key4"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value4"), val)
+}
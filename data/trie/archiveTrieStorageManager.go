@@ -0,0 +1,53 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// archiveTrieStorageManager manages the storage operations of the trie in archive mode: it prunes the main database
+// as the trieStorageManager does, but the pruned trie nodes are moved to epoch partitioned archive databases, so
+// every historical root remains reachable
+type archiveTrieStorageManager struct {
+	*trieStorageManager
+	archive *archiveDb
+}
+
+// NewArchiveTrieStorageManager creates a new instance of archiveTrieStorageManager
+func NewArchiveTrieStorageManager(
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	snapshotDbCfg config.DBConfig,
+	archiveDbCfg config.DBConfig,
+	ewl data.DBRemoveCacher,
+	generalConfig config.TrieStorageManagerConfig,
+) (*archiveTrieStorageManager, error) {
+	archive, err := newArchiveDb(db, archiveDbCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tsm, err := NewTrieStorageManager(archive, marshalizer, hasher, snapshotDbCfg, ewl, generalConfig)
+	if err != nil {
+		closeArchivePartitions(archive.partitions)
+		return nil, err
+	}
+
+	return &archiveTrieStorageManager{
+		trieStorageManager: tsm,
+		archive:            archive,
+	}, nil
+}
+
+// SetEpoch sets the epoch of the archive partition which receives the pruned trie nodes
+func (atsm *archiveTrieStorageManager) SetEpoch(epoch uint32) {
+	atsm.archive.SetEpoch(epoch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (atsm *archiveTrieStorageManager) IsInterfaceNil() bool {
+	return atsm == nil
+}
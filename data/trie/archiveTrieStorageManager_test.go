@@ -0,0 +1,90 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewArchiveTrieStorageManager_NilDbShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, err := NewArchiveTrieStorageManager(
+		nil,
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		config.DBConfig{},
+		createArchiveDbConfig(),
+		&mock.EvictionWaitingList{},
+		config.TrieStorageManagerConfig{},
+	)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilDatabase, err)
+}
+
+func TestNewArchiveTrieStorageManager_NilEwlShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, err := NewArchiveTrieStorageManager(
+		mock.NewMemDbMock(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		config.DBConfig{},
+		createArchiveDbConfig(),
+		nil,
+		config.TrieStorageManagerConfig{},
+	)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilEvictionWaitingList, err)
+}
+
+func TestArchiveTrieStorageManager_PrunedRootShouldRemainReachable(t *testing.T) {
+	t.Parallel()
+
+	hotDb := memorydb.New()
+	marshalizer, hasher := getTestMarshAndHasher()
+	ewl, _ := mock.NewEvictionWaitingList(100, mock.NewMemDbMock(), marshalizer)
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+	}
+	ts, err := NewArchiveTrieStorageManager(hotDb, marshalizer, hasher, createArchiveDbConfig(), createArchiveDbConfig(), ewl, generalCfg)
+	require.Nil(t, err)
+	assert.False(t, ts.IsInterfaceNil())
+	assert.True(t, ts.IsPruningEnabled())
+
+	tr, _ := NewTrie(ts, marshalizer, hasher, 5)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("dogglesworth"), []byte("cat"))
+	_ = tr.Commit()
+	oldRootHash, _ := tr.Root()
+
+	ts.SetEpoch(1)
+	_ = tr.Update([]byte("dog"), []byte("value of dog"))
+	_ = tr.Commit()
+
+	tr.CancelPrune(oldRootHash, data.NewRoot)
+	tr.Prune(oldRootHash, data.OldRoot)
+
+	_, err = hotDb.Get(oldRootHash)
+	assert.NotNil(t, err)
+
+	oldTrie, err := tr.Recreate(oldRootHash)
+	require.Nil(t, err)
+	val, err := oldTrie.Get([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("puppy"), val)
+
+	val, err = tr.Get([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value of dog"), val)
+
+	_ = ts.Database().Close()
+}
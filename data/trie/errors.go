@@ -87,3 +87,6 @@ var ErrInvalidIdentifier = errors.New("invalid identifier")
 
 // ErrInvalidLevelValue signals that the given value for maxTrieLevelInMemory is invalid
 var ErrInvalidLevelValue = errors.New("invalid trie level in memory value")
+
+// ErrInvalidProof signals that the given Merkle proof does not link the key to the root hash
+var ErrInvalidProof = errors.New("invalid proof")
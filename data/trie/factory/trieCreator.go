@@ -24,6 +24,8 @@ type trieCreator struct {
 	hasher                   hashing.Hasher
 	pathManager              storage.PathManagerHandler
 	trieStorageManagerConfig config.TrieStorageManagerConfig
+	archiveModeEnabled       bool
+	archiveDbCfg             config.DBConfig
 }
 
 var log = logger.GetOrCreate("trie")
@@ -49,6 +51,8 @@ func NewTrieFactory(
 		hasher:                   args.Hasher,
 		pathManager:              args.PathManager,
 		trieStorageManagerConfig: args.TrieStorageManagerConfig,
+		archiveModeEnabled:       args.ArchiveModeEnabled,
+		archiveDbCfg:             args.ArchiveDbCfg,
 	}, nil
 }
 
@@ -112,14 +116,7 @@ func (tc *trieCreator) Create(
 		MaxOpenFiles:      tc.snapshotDbCfg.MaxOpenFiles,
 	}
 
-	trieStorage, err := trie.NewTrieStorageManager(
-		accountsTrieStorage,
-		tc.marshalizer,
-		tc.hasher,
-		snapshotDbCfg,
-		ewl,
-		tc.trieStorageManagerConfig,
-	)
+	trieStorage, err := tc.createTrieStorageManager(accountsTrieStorage, trieStoragePath, snapshotDbCfg, ewl)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,6 +129,36 @@ func (tc *trieCreator) Create(
 	return trieStorage, newTrie, nil
 }
 
+func (tc *trieCreator) createTrieStorageManager(
+	db data.DBWriteCacher,
+	trieStoragePath string,
+	snapshotDbCfg config.DBConfig,
+	ewl data.DBRemoveCacher,
+) (data.StorageManager, error) {
+	log.Trace("trie archive mode status", "enabled", tc.archiveModeEnabled)
+	if !tc.archiveModeEnabled {
+		return trie.NewTrieStorageManager(db, tc.marshalizer, tc.hasher, snapshotDbCfg, ewl, tc.trieStorageManagerConfig)
+	}
+
+	archiveDbCfg := config.DBConfig{
+		FilePath:          filepath.Join(trieStoragePath, tc.archiveDbCfg.FilePath),
+		Type:              tc.archiveDbCfg.Type,
+		BatchDelaySeconds: tc.archiveDbCfg.BatchDelaySeconds,
+		MaxBatchSize:      tc.archiveDbCfg.MaxBatchSize,
+		MaxOpenFiles:      tc.archiveDbCfg.MaxOpenFiles,
+	}
+
+	return trie.NewArchiveTrieStorageManager(
+		db,
+		tc.marshalizer,
+		tc.hasher,
+		snapshotDbCfg,
+		archiveDbCfg,
+		ewl,
+		tc.trieStorageManagerConfig,
+	)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tc *trieCreator) IsInterfaceNil() bool {
 	return tc == nil
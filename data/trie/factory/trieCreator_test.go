@@ -144,3 +144,25 @@ func TestTrieFactory_CreateWithPRunningShouldWork(t *testing.T) {
 	require.NotNil(t, tr)
 	require.Nil(t, err)
 }
+
+func TestTrieFactory_CreateWithArchiveModeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.EvictionWaitingListCfg = config.EvictionWaitingListConfig{
+		DB:   config.DBConfig{Type: string(storageUnit.MemoryDB)},
+		Size: 100,
+	}
+	args.ArchiveModeEnabled = true
+	args.ArchiveDbCfg = config.DBConfig{Type: string(storageUnit.MemoryDB)}
+	tf, _ := NewTrieFactory(args)
+	trieStorageCfg := createTrieStorageCfg()
+
+	maxTrieLevelInMemory := uint(5)
+	tsm, tr, err := tf.Create(trieStorageCfg, "0", true, maxTrieLevelInMemory)
+	require.NotNil(t, tr)
+	require.Nil(t, err)
+
+	_, ok := tsm.(trie.ArchiveStorageManager)
+	assert.True(t, ok)
+}
@@ -21,4 +21,6 @@ type TrieFactoryArgs struct {
 	Hasher                   hashing.Hasher
 	PathManager              storage.PathManagerHandler
 	TrieStorageManagerConfig config.TrieStorageManagerConfig
+	ArchiveModeEnabled       bool
+	ArchiveDbCfg             config.DBConfig
 }
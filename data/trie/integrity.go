@@ -0,0 +1,132 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// IntegrityReport holds the outcome of a trie integrity check
+type IntegrityReport struct {
+	NumCheckedNodes int
+	NumLeaves       int
+	MissingHashes   [][]byte
+	CorruptedHashes [][]byte
+}
+
+// IsComplete returns true if no missing or corrupted node was found
+func (ir *IntegrityReport) IsComplete() bool {
+	return len(ir.MissingHashes) == 0 && len(ir.CorruptedHashes) == 0
+}
+
+// Merge adds the counters and the hashes of the provided report to the current one
+func (ir *IntegrityReport) Merge(other *IntegrityReport) {
+	ir.NumCheckedNodes += other.NumCheckedNodes
+	ir.NumLeaves += other.NumLeaves
+	ir.MissingHashes = append(ir.MissingHashes, other.MissingHashes...)
+	ir.CorruptedHashes = append(ir.CorruptedHashes, other.CorruptedHashes...)
+}
+
+type nodeToCheck struct {
+	hash   []byte
+	hexKey []byte
+}
+
+// CheckIntegrity walks the trie stored in the given database, starting from the provided root hash, and reports the
+// nodes that are missing or corrupted. A node is corrupted if its stored bytes do not hash to its key or can not be
+// decoded. The walk does not stop at the first problem: the sub-tries below a faulty node are simply not reachable.
+// The leaf handler, if provided, is called for each reached leaf with the full key and the value held by the leaf
+func CheckIntegrity(
+	db data.DBWriteCacher,
+	rootHash []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	leafHandler func(key []byte, value []byte),
+) (*IntegrityReport, error) {
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	report := &IntegrityReport{
+		MissingHashes:   make([][]byte, 0),
+		CorruptedHashes: make([][]byte, 0),
+	}
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return report, nil
+	}
+
+	nodesToCheck := []nodeToCheck{{hash: rootHash, hexKey: make([]byte, 0)}}
+	for len(nodesToCheck) > 0 {
+		current := nodesToCheck[len(nodesToCheck)-1]
+		nodesToCheck = nodesToCheck[:len(nodesToCheck)-1]
+
+		encodedNode, err := db.Get(current.hash)
+		if err != nil {
+			log.Trace("trie integrity: missing node", "hash", current.hash, "error", err.Error())
+			report.MissingHashes = append(report.MissingHashes, current.hash)
+			continue
+		}
+
+		report.NumCheckedNodes++
+		if !bytes.Equal(hasher.Compute(string(encodedNode)), current.hash) {
+			log.Trace("trie integrity: node does not match its hash", "hash", current.hash)
+			report.CorruptedHashes = append(report.CorruptedHashes, current.hash)
+			continue
+		}
+
+		decodedNode, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			log.Trace("trie integrity: node can not be decoded", "hash", current.hash, "error", err.Error())
+			report.CorruptedHashes = append(report.CorruptedHashes, current.hash)
+			continue
+		}
+
+		switch n := decodedNode.(type) {
+		case *leafNode:
+			report.NumLeaves++
+			notifyLeaf(leafHandler, concat(current.hexKey, n.Key...), n.Value)
+		case *extensionNode:
+			nodesToCheck = append(nodesToCheck, nodeToCheck{
+				hash:   n.EncodedChild,
+				hexKey: concat(current.hexKey, n.Key...),
+			})
+		case *branchNode:
+			for i, childHash := range n.EncodedChildren {
+				if len(childHash) == 0 {
+					continue
+				}
+
+				nodesToCheck = append(nodesToCheck, nodeToCheck{
+					hash:   childHash,
+					hexKey: concat(current.hexKey, byte(i)),
+				})
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func notifyLeaf(leafHandler func(key []byte, value []byte), hexKey []byte, value []byte) {
+	if leafHandler == nil {
+		return
+	}
+
+	key, err := hexToKeyBytes(hexKey)
+	if err != nil {
+		log.Debug("trie integrity: invalid leaf key", "hex key", hex.EncodeToString(hexKey), "error", err.Error())
+		return
+	}
+
+	leafHandler(key, value)
+}
@@ -0,0 +1,106 @@
+package trie_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckIntegrity_NilDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	report, err := trie.CheckIntegrity(nil, []byte("root"), &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+	assert.Nil(t, report)
+	assert.Equal(t, trie.ErrNilDatabase, err)
+}
+
+func TestCheckIntegrity_EmptyRootHashShouldReturnEmptyReport(t *testing.T) {
+	t.Parallel()
+
+	report, err := trie.CheckIntegrity(mock.NewMemDbMock(), trie.EmptyTrieHash, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+	require.Nil(t, err)
+	assert.True(t, report.IsComplete())
+	assert.Equal(t, 0, report.NumCheckedNodes)
+}
+
+func TestCheckIntegrity_CompleteTrieShouldReachAllLeaves(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	hashes, _ := tr.GetAllHashes()
+
+	leaves := make(map[string][]byte)
+	report, err := trie.CheckIntegrity(
+		tr.Database(),
+		rootHash,
+		&mock.ProtobufMarshalizerMock{},
+		&mock.KeccakMock{},
+		func(key []byte, value []byte) {
+			leaves[string(key)] = value
+		},
+	)
+	require.Nil(t, err)
+	assert.True(t, report.IsComplete())
+	assert.Equal(t, len(hashes), report.NumCheckedNodes)
+	assert.Equal(t, 3, report.NumLeaves)
+	assert.Equal(t, map[string][]byte{
+		"doe":  []byte("reindeer"),
+		"dog":  []byte("puppy"),
+		"ddog": []byte("cat"),
+	}, leaves)
+}
+
+func TestCheckIntegrity_ShouldReportMissingAndCorruptedNodes(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	hashes, _ := tr.GetAllHashes()
+	require.True(t, len(hashes) > 3)
+
+	childHashes := make([][]byte, 0)
+	for _, hash := range hashes {
+		if !bytes.Equal(hash, rootHash) {
+			childHashes = append(childHashes, hash)
+		}
+	}
+	missingHash := childHashes[0]
+	corruptedHash := childHashes[len(childHashes)-1]
+	_ = tr.Database().Remove(missingHash)
+	_ = tr.Database().Put(corruptedHash, []byte("corrupted node"))
+
+	report, err := trie.CheckIntegrity(tr.Database(), rootHash, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{}, nil)
+	require.Nil(t, err)
+	assert.False(t, report.IsComplete())
+	assert.Contains(t, report.MissingHashes, missingHash)
+	assert.Contains(t, report.CorruptedHashes, corruptedHash)
+	assert.True(t, report.NumLeaves < 3)
+}
+
+func TestIntegrityReport_Merge(t *testing.T) {
+	t.Parallel()
+
+	report := &trie.IntegrityReport{
+		NumCheckedNodes: 2,
+		NumLeaves:       1,
+		MissingHashes:   [][]byte{[]byte("missing1")},
+	}
+	report.Merge(&trie.IntegrityReport{
+		NumCheckedNodes: 3,
+		NumLeaves:       2,
+		MissingHashes:   [][]byte{[]byte("missing2")},
+		CorruptedHashes: [][]byte{[]byte("corrupted")},
+	})
+
+	assert.Equal(t, 5, report.NumCheckedNodes)
+	assert.Equal(t, 3, report.NumLeaves)
+	assert.Equal(t, [][]byte{[]byte("missing1"), []byte("missing2")}, report.MissingHashes)
+	assert.Equal(t, [][]byte{[]byte("corrupted")}, report.CorruptedHashes)
+}
@@ -65,3 +65,10 @@ type RequestHandler interface {
 	RequestInterval() time.Duration
 	IsInterfaceNil() bool
 }
+
+// ArchiveStorageManager is implemented by the trie storage managers running in archive mode, which partition the
+// pruned trie nodes by epoch
+type ArchiveStorageManager interface {
+	SetEpoch(epoch uint32)
+	IsInterfaceNil() bool
+}
@@ -95,6 +95,49 @@ func (tr *patriciaMerkleTrie) Get(key []byte) ([]byte, error) {
 	return val, nil
 }
 
+// GetProof returns the Merkle inclusion proof of the given key: the encoded nodes on the path from the root to
+// the leaf which holds the key, starting with the root node. Each node is encoded in its collapsed form, so that its
+// hash is the hash of the encoding and it references its children only by their hashes
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, ErrNodeNotFound
+	}
+
+	if tr.root.getHash() == nil {
+		err := tr.root.setRootHash()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proof := make([][]byte, 0)
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for {
+		collapsedNode, err := currentNode.getCollapsed()
+		if err != nil {
+			return nil, err
+		}
+
+		encodedNode, err := collapsedNode.getEncodedNode()
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, encodedNode)
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage.Database())
+		if err != nil {
+			return nil, fmt.Errorf("trie get proof error: %w, for key %v", err, hex.EncodeToString(key))
+		}
+		if currentNode == nil {
+			return proof, nil
+		}
+	}
+}
+
 // Update updates the value at the given key.
 // If the key is not in the trie, it will be added.
 // If the value is empty, the key will be removed from the trie
@@ -0,0 +1,75 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// VerifyProof checks that the given proof, as generated by the GetProof method of a trie, links the key to the root
+// hash: each node must hash to the reference held by its parent, starting with the root hash, and the path described
+// by the nodes must follow the key down to its leaf. It does not need access to the trie and returns the value held
+// by the leaf if the proof is valid
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(proof) == 0 {
+		return nil, ErrInvalidProof
+	}
+
+	hexKey := keyBytesToHex(key)
+	expectedHash := rootHash
+	for level, encodedNode := range proof {
+		nodeHash := hasher.Compute(string(encodedNode))
+		if !bytes.Equal(nodeHash, expectedHash) {
+			return nil, fmt.Errorf("%w: hash mismatch for the node on level %d", ErrInvalidProof, level)
+		}
+
+		decodedNode, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s, for the node on level %d", ErrInvalidProof, err.Error(), level)
+		}
+
+		isLastNode := level == len(proof)-1
+		switch n := decodedNode.(type) {
+		case *leafNode:
+			if !isLastNode || !bytes.Equal(n.Key, hexKey) {
+				return nil, fmt.Errorf("%w: the leaf on level %d does not hold the key", ErrInvalidProof, level)
+			}
+			return n.Value, nil
+		case *extensionNode:
+			if len(hexKey) < len(n.Key) || !bytes.Equal(n.Key, hexKey[:len(n.Key)]) {
+				return nil, fmt.Errorf("%w: the extension on level %d is not on the key path", ErrInvalidProof, level)
+			}
+			hexKey = hexKey[len(n.Key):]
+			expectedHash = n.EncodedChild
+		case *branchNode:
+			if len(hexKey) == 0 || int(hexKey[firstByte]) >= len(n.EncodedChildren) {
+				return nil, fmt.Errorf("%w: the key does not match the branch on level %d", ErrInvalidProof, level)
+			}
+			expectedHash = n.EncodedChildren[hexKey[firstByte]]
+			hexKey = hexKey[1:]
+		default:
+			return nil, fmt.Errorf("%w: unknown node type on level %d", ErrInvalidProof, level)
+		}
+
+		if len(expectedHash) == 0 {
+			return nil, fmt.Errorf("%w: the node on level %d has no child on the key path", ErrInvalidProof, level)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: the proof does not end with a leaf", ErrInvalidProof)
+}
@@ -0,0 +1,123 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatriciaMerkleTrie_GetProofEmptyTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, proof)
+	assert.Equal(t, trie.ErrNodeNotFound, err)
+}
+
+func TestPatriciaMerkleTrie_GetProofMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	proof, err := tr.GetProof([]byte("cat"))
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+}
+
+func TestPatriciaMerkleTrie_GetProofShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	for key, expectedValue := range map[string]string{"doe": "reindeer", "dog": "puppy", "ddog": "cat"} {
+		proof, err := tr.GetProof([]byte(key))
+		require.Nil(t, err)
+		assert.True(t, len(proof) > 1)
+
+		value, err := trie.VerifyProof(rootHash, []byte(key), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(expectedValue), value)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofOnUncommittedTrieShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(100)
+	rootHash, _ := tr.Root()
+
+	for _, key := range values {
+		proof, err := tr.GetProof(key)
+		require.Nil(t, err)
+
+		value, err := trie.VerifyProof(rootHash, key, proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		require.Nil(t, err)
+		assert.Equal(t, key, value)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofOnRecreatedTrieShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(50)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	proof, err := recreatedTrie.GetProof(values[10])
+	require.Nil(t, err)
+
+	value, err := trie.VerifyProof(rootHash, values[10], proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Nil(t, err)
+	assert.Equal(t, values[10], value)
+}
+
+func TestVerifyProof_NilMarshalizerOrHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	value, err := trie.VerifyProof([]byte("root"), []byte("key"), [][]byte{[]byte("node")}, nil, &mock.KeccakMock{})
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+	value, err = trie.VerifyProof([]byte("root"), []byte("key"), [][]byte{[]byte("node")}, &mock.ProtobufMarshalizerMock{}, nil)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
+
+func TestVerifyProof_InvalidProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.ProtobufMarshalizerMock{}
+	hasher := &mock.KeccakMock{}
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	_, err := trie.VerifyProof(rootHash, []byte("dog"), nil, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	_, err = trie.VerifyProof([]byte("other root hash"), []byte("dog"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	_, err = trie.VerifyProof(rootHash, []byte("doe"), proof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	_, err = trie.VerifyProof(rootHash, []byte("dog"), proof[:len(proof)-1], marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+
+	tamperedProof := make([][]byte, len(proof))
+	copy(tamperedProof, proof)
+	tamperedProof[len(proof)-1] = append([]byte("x"), proof[len(proof)-1]...)
+	_, err = trie.VerifyProof(rootHash, []byte("dog"), tamperedProof, marshalizer, hasher)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
@@ -73,6 +73,14 @@ const (
 	StatusMetricsUnit UnitType = 10
 	// TxLogsUnit is the status metrics storage unit identifier
 	TxLogsUnit UnitType = 11
+	// AddressTxHistoryUnit is the address transactions history storage unit identifier
+	AddressTxHistoryUnit UnitType = 12
+	// TransactionResultsUnit is the storage unit identifier of the index which links transactions to their results
+	TransactionResultsUnit UnitType = 13
+	// TxPoolJournalUnit is the storage unit identifier of the journal which persists the pending transactions
+	TxPoolJournalUnit UnitType = 14
+	// LogsIndexUnit is the storage unit identifier of the index which keeps the log events bloom filter of each block
+	LogsIndexUnit UnitType = 15
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -213,6 +221,7 @@ type PeerListCreator interface {
 // ShardedDataCacherNotifier defines what a sharded-data structure can perform
 type ShardedDataCacherNotifier interface {
 	RegisterOnAdded(func(key []byte, value interface{}))
+	RegisterOnRemoved(func(key []byte, value interface{}))
 	ShardDataStore(cacheId string) (c storage.Cacher)
 	AddData(key []byte, data interface{}, sizeInBytes int, cacheId string)
 	SearchFirstData(key []byte) (value interface{}, ok bool)
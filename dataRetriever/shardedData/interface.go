@@ -10,4 +10,5 @@ type immunityCache interface {
 	RemoveWithResult(key []byte) bool
 	NumBytes() int
 	Diagnose(deep bool)
+	RegisterOnEvicted(handler func(key []byte, value interface{}))
 }
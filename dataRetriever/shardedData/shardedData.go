@@ -36,6 +36,9 @@ type shardedData struct {
 
 	mutAddedDataHandlers sync.RWMutex
 	addedDataHandlers    []func(key []byte, value interface{})
+
+	mutRemovedDataHandlers sync.RWMutex
+	removedDataHandlers    []func(key []byte, value interface{})
 }
 
 type shardStore struct {
@@ -61,10 +64,11 @@ func NewShardedData(name string, config storageUnit.CacheConfig) (*shardedData,
 	}
 
 	return &shardedData{
-		name:              name,
-		configPrototype:   configPrototype,
-		shardedDataStore:  make(map[string]*shardStore),
-		addedDataHandlers: make([]func(key []byte, value interface{}), 0),
+		name:                name,
+		configPrototype:     configPrototype,
+		shardedDataStore:    make(map[string]*shardStore),
+		addedDataHandlers:   make([]func(key []byte, value interface{}), 0),
+		removedDataHandlers: make([]func(key []byte, value interface{}), 0),
 	}, nil
 }
 
@@ -133,6 +137,8 @@ func (sd *shardedData) newShardStore(cacheID string) (*shardStore, error) {
 		return nil, err
 	}
 
+	cache.RegisterOnEvicted(sd.onEvicted)
+
 	return &shardStore{
 		cacheID: cacheID,
 		cache:   cache,
@@ -256,6 +262,27 @@ func (sd *shardedData) RegisterOnAdded(handler func(key []byte, value interface{
 	sd.mutAddedDataHandlers.Unlock()
 }
 
+// RegisterOnRemoved registers a new handler to be called when a data is evicted from the pool because the pool is full
+func (sd *shardedData) RegisterOnRemoved(handler func(key []byte, value interface{})) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler to a ShardedData object")
+		return
+	}
+
+	sd.mutRemovedDataHandlers.Lock()
+	sd.removedDataHandlers = append(sd.removedDataHandlers, handler)
+	sd.mutRemovedDataHandlers.Unlock()
+}
+
+func (sd *shardedData) onEvicted(key []byte, value interface{}) {
+	sd.mutRemovedDataHandlers.RLock()
+	defer sd.mutRemovedDataHandlers.RUnlock()
+
+	for _, handler := range sd.removedDataHandlers {
+		handler(key, value)
+	}
+}
+
 // GetCounts returns the total number of transactions in the pool
 func (sd *shardedData) GetCounts() counting.CountsWithSize {
 	sd.mutShardedDataStore.RLock()
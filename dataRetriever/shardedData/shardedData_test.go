@@ -81,6 +81,34 @@ func TestShardedData_StorageEvictsData(t *testing.T) {
 		"Transaction pool entries excedes the maximum configured number")
 }
 
+func TestShardedData_StorageEvictsDataShouldCallTheRemovedDataHandlers(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewShardedData("", defaultTestConfig)
+
+	mutEvicted := sync.Mutex{}
+	evicted := make(map[string]struct{})
+	sd.RegisterOnRemoved(func(key []byte, value interface{}) {
+		mutEvicted.Lock()
+		evicted[string(key)] = struct{}{}
+		mutEvicted.Unlock()
+	})
+
+	numAdded := int(defaultTestConfig.Capacity + 100)
+	for i := 1; i <= numAdded; i++ {
+		key := []byte(strconv.Itoa(i))
+		sd.AddData(key, &transaction.Transaction{Nonce: uint64(i)}, 0, "1")
+	}
+
+	mutEvicted.Lock()
+	defer mutEvicted.Unlock()
+
+	assert.Equal(t, numAdded, sd.ShardDataStore("1").Len()+len(evicted))
+	for key := range evicted {
+		assert.False(t, sd.ShardDataStore("1").Has([]byte(key)))
+	}
+}
+
 func TestShardedData_NoDuplicates(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 // ArgShardedTxPool is the argument for ShardedTxPool's constructor
@@ -35,7 +36,7 @@ func (args *ArgShardedTxPool) verify() error {
 	if config.Shards == 0 {
 		return fmt.Errorf("%w: config.Shards (map chunks) is not valid", dataRetriever.ErrCacheConfigInvalidShards)
 	}
-	if args.MinGasPrice == 0 {
+	if args.MinGasPrice == 0 && config.ScoreComputer != txcache.GasPricePercentileScoreComputer {
 		return fmt.Errorf("%w: MinGasPrice is not valid", dataRetriever.ErrCacheConfigInvalidEconomics)
 	}
 	if args.NumberOfShards == 0 {
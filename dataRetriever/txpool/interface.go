@@ -15,4 +15,7 @@ type txCache interface {
 	ForEachTransaction(function txcache.ForEachTransaction)
 	NumBytes() int
 	Diagnose(deep bool)
+	GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool)
+	GetStatistics() *txcache.CacheStatistics
+	RegisterOnDropped(handler func(tx *txcache.WrappedTransaction))
 }
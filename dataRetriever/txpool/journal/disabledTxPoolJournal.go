@@ -0,0 +1,34 @@
+package journal
+
+type disabledTxPoolJournal struct {
+}
+
+// NewDisabledTxPoolJournal returns a journal which does not save nor restore anything. It is used when the
+// transactions pool journal is not enabled
+func NewDisabledTxPoolJournal() *disabledTxPoolJournal {
+	return &disabledTxPoolJournal{}
+}
+
+// Restore does nothing
+func (djournal *disabledTxPoolJournal) Restore() error {
+	return nil
+}
+
+// StartPeriodicSaving does nothing
+func (djournal *disabledTxPoolJournal) StartPeriodicSaving() {
+}
+
+// Save does nothing
+func (djournal *disabledTxPoolJournal) Save() error {
+	return nil
+}
+
+// Close does nothing
+func (djournal *disabledTxPoolJournal) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (djournal *disabledTxPoolJournal) IsInterfaceNil() bool {
+	return djournal == nil
+}
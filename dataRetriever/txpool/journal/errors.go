@@ -0,0 +1,39 @@
+package journal
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilTxPool signals that a nil transactions pool has been provided
+var ErrNilTxPool = errors.New("nil transactions pool")
+
+// ErrNilUnsignedTxPool signals that a nil unsigned transactions pool has been provided
+var ErrNilUnsignedTxPool = errors.New("nil unsigned transactions pool")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilFeeHandler signals that a nil fee handler has been provided
+var ErrNilFeeHandler = errors.New("nil fee handler")
+
+// ErrNonceTooLow signals that the nonce of a journaled transaction is lower than the nonce of its sender
+var ErrNonceTooLow = errors.New("nonce too low")
+
+// ErrInsufficientFunds signals that the sender of a journaled transaction can not pay its fee anymore
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrAlreadyProcessed signals that a journaled transaction has already been included in a block
+var ErrAlreadyProcessed = errors.New("transaction already processed")
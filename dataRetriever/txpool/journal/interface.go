@@ -0,0 +1,10 @@
+package journal
+
+// TxPoolJournal defines the behavior of a component which persists the pending transactions across restarts
+type TxPoolJournal interface {
+	Restore() error
+	StartPeriodicSaving()
+	Save() error
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: journal.proto
+
+package journal
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingTransaction holds a transaction of the pools, serialized with the internal marshalizer
+type PendingTransaction struct {
+	PoolType uint32 `protobuf:"varint,1,opt,name=PoolType,proto3" json:"poolType"`
+	Buff     []byte `protobuf:"bytes,2,opt,name=Buff,proto3" json:"buff"`
+}
+
+func (m *PendingTransaction) Reset()      { *m = PendingTransaction{} }
+func (*PendingTransaction) ProtoMessage() {}
+func (*PendingTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{0}
+}
+func (m *PendingTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTransaction.Merge(m, src)
+}
+func (m *PendingTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTransaction proto.InternalMessageInfo
+
+func (m *PendingTransaction) GetPoolType() uint32 {
+	if m != nil {
+		return m.PoolType
+	}
+	return 0
+}
+
+func (m *PendingTransaction) GetBuff() []byte {
+	if m != nil {
+		return m.Buff
+	}
+	return nil
+}
+
+// JournalChunk holds a part of the pending transactions saved by the journal
+type JournalChunk struct {
+	Transactions []*PendingTransaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"transactions"`
+}
+
+func (m *JournalChunk) Reset()      { *m = JournalChunk{} }
+func (*JournalChunk) ProtoMessage() {}
+func (*JournalChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{1}
+}
+func (m *JournalChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JournalChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalChunk.Merge(m, src)
+}
+func (m *JournalChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalChunk proto.InternalMessageInfo
+
+func (m *JournalChunk) GetTransactions() []*PendingTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// JournalHeader describes the last saved journal
+type JournalHeader struct {
+	NumChunks uint32 `protobuf:"varint,1,opt,name=NumChunks,proto3" json:"numChunks"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"timestamp"`
+}
+
+func (m *JournalHeader) Reset()      { *m = JournalHeader{} }
+func (*JournalHeader) ProtoMessage() {}
+func (*JournalHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{2}
+}
+func (m *JournalHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JournalHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JournalHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JournalHeader.Merge(m, src)
+}
+func (m *JournalHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *JournalHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_JournalHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JournalHeader proto.InternalMessageInfo
+
+func (m *JournalHeader) GetNumChunks() uint32 {
+	if m != nil {
+		return m.NumChunks
+	}
+	return 0
+}
+
+func (m *JournalHeader) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingTransaction)(nil), "proto.PendingTransaction")
+	proto.RegisterType((*JournalChunk)(nil), "proto.JournalChunk")
+	proto.RegisterType((*JournalHeader)(nil), "proto.JournalHeader")
+}
+
+func init() { proto.RegisterFile("journal.proto", fileDescriptor_04fd98cceb1b9191) }
+
+var fileDescriptor_04fd98cceb1b9191 = []byte{
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0x83, 0x40,
+	0x10, 0x86, 0x19, 0x5b, 0xb5, 0x5d, 0x21, 0x31, 0x9c, 0xaa, 0x31, 0x43, 0xd3, 0x13, 0x89, 0xb1,
+	0x4d, 0xf4, 0x09, 0xc4, 0x8b, 0xf1, 0xa0, 0x0d, 0xe9, 0xc9, 0x98, 0x18, 0x68, 0x81, 0xa2, 0x65,
+	0x97, 0x00, 0x7b, 0xf0, 0xe6, 0x23, 0xf8, 0x18, 0x3e, 0x8a, 0xc7, 0x1e, 0x7b, 0x22, 0x76, 0xb9,
+	0x18, 0x4e, 0x7d, 0x04, 0xe3, 0x42, 0x6d, 0x8d, 0xa7, 0xdd, 0xf9, 0xfe, 0x99, 0xfd, 0xff, 0xc9,
+	0x12, 0xed, 0x89, 0xf1, 0x84, 0x3a, 0xb3, 0x7e, 0x9c, 0xb0, 0x8c, 0xe9, 0xbb, 0xf2, 0x38, 0x3e,
+	0x0b, 0xc2, 0x6c, 0xca, 0xdd, 0xfe, 0x98, 0x45, 0x83, 0x80, 0x05, 0x6c, 0x20, 0xb1, 0xcb, 0x7d,
+	0x59, 0xc9, 0x42, 0xde, 0xaa, 0xa9, 0xde, 0x03, 0xd1, 0x87, 0x1e, 0x9d, 0x84, 0x34, 0x18, 0x25,
+	0x0e, 0x4d, 0x9d, 0x71, 0x16, 0x32, 0xaa, 0x9b, 0xa4, 0x35, 0x64, 0x6c, 0x36, 0x7a, 0x89, 0xbd,
+	0x0e, 0x74, 0xc1, 0xd4, 0x2c, 0xb5, 0xcc, 0x8d, 0x56, 0x5c, 0x33, 0xfb, 0x57, 0xd5, 0x4f, 0x48,
+	0xd3, 0xe2, 0xbe, 0xdf, 0xd9, 0xe9, 0x82, 0xa9, 0x5a, 0xad, 0x32, 0x37, 0x9a, 0x2e, 0xf7, 0x7d,
+	0x5b, 0xd2, 0xde, 0x23, 0x51, 0x6f, 0xaa, 0x90, 0x57, 0x53, 0x4e, 0x9f, 0xf5, 0x3b, 0xa2, 0x6e,
+	0xd9, 0xa4, 0x1d, 0xe8, 0x36, 0xcc, 0x83, 0xf3, 0xa3, 0x2a, 0x4b, 0xff, 0x7f, 0x10, 0xeb, 0xb0,
+	0xcc, 0x0d, 0x35, 0xdb, 0x1a, 0xb1, 0xff, 0x3c, 0xd0, 0x0b, 0x89, 0x56, 0x1b, 0x5c, 0x7b, 0xce,
+	0xc4, 0x4b, 0xf4, 0x53, 0xd2, 0xbe, 0xe5, 0x91, 0x74, 0x4b, 0xeb, 0xe8, 0x5a, 0x99, 0x1b, 0x6d,
+	0xba, 0x86, 0xf6, 0x46, 0xff, 0x69, 0x1e, 0x85, 0x91, 0x97, 0x66, 0x4e, 0x14, 0xcb, 0x0d, 0x1a,
+	0x55, 0x73, 0xb6, 0x86, 0xf6, 0x46, 0xb7, 0x2e, 0xe7, 0x4b, 0x54, 0x16, 0x4b, 0x54, 0x56, 0x4b,
+	0x84, 0x57, 0x81, 0xf0, 0x2e, 0x10, 0x3e, 0x04, 0xc2, 0x5c, 0x20, 0x2c, 0x04, 0xc2, 0xa7, 0x40,
+	0xf8, 0x12, 0xa8, 0xac, 0x04, 0xc2, 0x5b, 0x81, 0xca, 0xbc, 0x40, 0x65, 0x51, 0xa0, 0x72, 0xbf,
+	0x5f, 0x7f, 0x94, 0xbb, 0x27, 0xf7, 0xbc, 0xf8, 0x1e, 0x00, 0x59, 0xd6, 0x81, 0x3e, 0xba, 0x01,
+	0x00, 0x00,
+}
+
+func (this *PendingTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingTransaction)
+	if !ok {
+		that2, ok := that.(PendingTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolType != that1.PoolType {
+		return false
+	}
+	if !bytes.Equal(this.Buff, that1.Buff) {
+		return false
+	}
+	return true
+}
+func (this *JournalChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JournalChunk)
+	if !ok {
+		that2, ok := that.(JournalChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *JournalHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JournalHeader)
+	if !ok {
+		that2, ok := that.(JournalHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumChunks != that1.NumChunks {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *PendingTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&journal.PendingTransaction{")
+	s = append(s, "PoolType: "+fmt.Sprintf("%#v", this.PoolType)+",\n")
+	s = append(s, "Buff: "+fmt.Sprintf("%#v", this.Buff)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *JournalChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&journal.JournalChunk{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *JournalHeader) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&journal.JournalHeader{")
+	s = append(s, "NumChunks: "+fmt.Sprintf("%#v", this.NumChunks)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringJournal(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *PendingTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buff) > 0 {
+		i -= len(m.Buff)
+		copy(dAtA[i:], m.Buff)
+		i = encodeVarintJournal(dAtA, i, uint64(len(m.Buff)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintJournal(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JournalChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJournal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JournalHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JournalHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JournalHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintJournal(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumChunks != 0 {
+		i = encodeVarintJournal(dAtA, i, uint64(m.NumChunks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJournal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJournal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolType != 0 {
+		n += 1 + sovJournal(uint64(m.PoolType))
+	}
+	l = len(m.Buff)
+	if l > 0 {
+		n += 1 + l + sovJournal(uint64(l))
+	}
+	return n
+}
+
+func (m *JournalChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovJournal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JournalHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumChunks != 0 {
+		n += 1 + sovJournal(uint64(m.NumChunks))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovJournal(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovJournal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJournal(x uint64) (n int) {
+	return sovJournal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PendingTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingTransaction{`,
+		`PoolType:` + fmt.Sprintf("%v", this.PoolType) + `,`,
+		`Buff:` + fmt.Sprintf("%v", this.Buff) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JournalChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*PendingTransaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "PendingTransaction", "PendingTransaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&JournalChunk{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JournalHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JournalHeader{`,
+		`NumChunks:` + fmt.Sprintf("%v", this.NumChunks) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringJournal(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PendingTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buff", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buff = append(m.Buff[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buff == nil {
+				m.Buff = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJournal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &PendingTransaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JournalHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JournalHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JournalHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChunks", wireType)
+			}
+			m.NumChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumChunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipJournal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowJournal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJournal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthJournal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupJournal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthJournal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthJournal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowJournal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupJournal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,466 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("txpool/journal")
+
+const (
+	txPoolType         = uint32(0)
+	unsignedTxPoolType = uint32(1)
+)
+
+// maxTransactionsPerChunk bounds the size of a value written in the storer
+const maxTransactionsPerChunk = 1000
+
+var journalHeaderKey = []byte("txPoolJournal")
+
+// ArgsTxPoolJournal is the argument structure used to create a new transactions pool journal
+type ArgsTxPoolJournal struct {
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	TxPool           dataRetriever.ShardedDataCacherNotifier
+	UnsignedTxPool   dataRetriever.ShardedDataCacherNotifier
+	Store            dataRetriever.StorageService
+	Accounts         state.AccountsAdapter
+	ShardCoordinator sharding.Coordinator
+	FeeHandler       process.FeeHandler
+	SaveInterval     time.Duration
+}
+
+type journaledPool struct {
+	poolType      uint32
+	pool          dataRetriever.ShardedDataCacherNotifier
+	processedUnit dataRetriever.UnitType
+	createEmptyTx func() data.TransactionHandler
+	checkSender   bool
+}
+
+type txPoolJournal struct {
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	store            dataRetriever.StorageService
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	feeHandler       process.FeeHandler
+	saveInterval     time.Duration
+	pools            map[uint32]*journaledPool
+
+	mutJournal sync.Mutex
+	isRestored bool
+	cancelFunc func()
+}
+
+// NewTxPoolJournal creates a journal which saves the pending transactions and unsigned transactions of the pools in a
+// storer, so that they can be restored after the node restarts
+func NewTxPoolJournal(args ArgsTxPoolJournal) (*txPoolJournal, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.TxPool) {
+		return nil, ErrNilTxPool
+	}
+	if check.IfNil(args.UnsignedTxPool) {
+		return nil, ErrNilUnsignedTxPool
+	}
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.FeeHandler) {
+		return nil, ErrNilFeeHandler
+	}
+
+	journal := &txPoolJournal{
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		store:            args.Store,
+		accounts:         args.Accounts,
+		shardCoordinator: args.ShardCoordinator,
+		feeHandler:       args.FeeHandler,
+		saveInterval:     args.SaveInterval,
+	}
+	journal.pools = map[uint32]*journaledPool{
+		txPoolType: {
+			poolType:      txPoolType,
+			pool:          args.TxPool,
+			processedUnit: dataRetriever.TransactionUnit,
+			createEmptyTx: func() data.TransactionHandler {
+				return &transaction.Transaction{}
+			},
+			checkSender: true,
+		},
+		unsignedTxPoolType: {
+			poolType:      unsignedTxPoolType,
+			pool:          args.UnsignedTxPool,
+			processedUnit: dataRetriever.UnsignedTransactionUnit,
+			createEmptyTx: func() data.TransactionHandler {
+				return &smartContractResult.SmartContractResult{}
+			},
+			checkSender: false,
+		},
+	}
+
+	return journal, nil
+}
+
+// Restore re-validates the transactions of the last saved journal against the current state and adds them back in
+// the pools. It should be called once the state has been loaded from storage and before the node starts proposing
+// blocks. Until then, the journal is never overwritten, so that an early shutdown does not lose it
+func (journal *txPoolJournal) Restore() error {
+	journal.mutJournal.Lock()
+	defer journal.mutJournal.Unlock()
+
+	journal.isRestored = true
+
+	header, err := journal.getHeader()
+	if err != nil {
+		log.Debug("no transactions pool journal to restore", "error", err.Error())
+		return nil
+	}
+
+	numRestored, numDropped := 0, 0
+	for i := uint32(0); i < header.NumChunks; i++ {
+		chunk, errGet := journal.getChunk(i)
+		if errGet != nil {
+			return errGet
+		}
+
+		for _, pendingTx := range chunk.Transactions {
+			errRestore := journal.restoreTransaction(pendingTx)
+			if errRestore != nil {
+				log.Trace("journaled transaction dropped", "error", errRestore.Error())
+				numDropped++
+				continue
+			}
+			numRestored++
+		}
+	}
+
+	log.Info("transactions pool journal restored",
+		"saved at", time.Unix(header.Timestamp, 0),
+		"num restored", numRestored,
+		"num dropped", numDropped,
+	)
+
+	return nil
+}
+
+func (journal *txPoolJournal) restoreTransaction(pendingTx *PendingTransaction) error {
+	jPool, ok := journal.pools[pendingTx.PoolType]
+	if !ok {
+		return fmt.Errorf("unknown pool type %d", pendingTx.PoolType)
+	}
+
+	tx := jPool.createEmptyTx()
+	err := journal.marshalizer.Unmarshal(tx, pendingTx.Buff)
+	if err != nil {
+		return err
+	}
+
+	txHash := journal.hasher.Compute(string(pendingTx.Buff))
+	_, found := jPool.pool.SearchFirstData(txHash)
+	if found {
+		return nil
+	}
+
+	err = journal.store.Has(jPool.processedUnit, txHash)
+	if err == nil {
+		return ErrAlreadyProcessed
+	}
+
+	senderShardID := journal.shardCoordinator.ComputeId(tx.GetSndAddr())
+	receiverShardID := journal.shardCoordinator.ComputeId(tx.GetRcvAddr())
+	if jPool.checkSender && senderShardID == journal.shardCoordinator.SelfId() {
+		err = journal.checkSender(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	cacheID := process.ShardCacherIdentifier(senderShardID, receiverShardID)
+	jPool.pool.AddData(txHash, tx, len(pendingTx.Buff), cacheID)
+
+	return nil
+}
+
+// checkSender applies the same checks as the transactions validator used by the interceptors
+func (journal *txPoolJournal) checkSender(tx data.TransactionHandler) error {
+	account, err := journal.accounts.GetExistingAccount(tx.GetSndAddr())
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	if tx.GetNonce() < userAccount.GetNonce() {
+		return fmt.Errorf("%w: tx nonce %d, account nonce %d", ErrNonceTooLow, tx.GetNonce(), userAccount.GetNonce())
+	}
+
+	txFee := journal.feeHandler.ComputeFee(tx)
+	if userAccount.GetBalance().Cmp(txFee) < 0 {
+		return fmt.Errorf("%w: balance %s, fee %s", ErrInsufficientFunds, userAccount.GetBalance().String(), txFee.String())
+	}
+
+	return nil
+}
+
+// Save writes the pending transactions and unsigned transactions of the pools in the storer. It does nothing until
+// the previous journal has been restored
+func (journal *txPoolJournal) Save() error {
+	journal.mutJournal.Lock()
+	defer journal.mutJournal.Unlock()
+
+	if !journal.isRestored {
+		return nil
+	}
+
+	numOldChunks := uint32(0)
+	oldHeader, err := journal.getHeader()
+	if err == nil {
+		numOldChunks = oldHeader.NumChunks
+	}
+
+	numChunks := uint32(0)
+	numSaved := 0
+	chunk := &JournalChunk{}
+	for _, poolType := range []uint32{txPoolType, unsignedTxPoolType} {
+		for _, pendingTx := range journal.collectPendingTransactions(journal.pools[poolType]) {
+			chunk.Transactions = append(chunk.Transactions, pendingTx)
+			if len(chunk.Transactions) < maxTransactionsPerChunk {
+				continue
+			}
+
+			err = journal.putChunk(numChunks, chunk)
+			if err != nil {
+				return err
+			}
+			numSaved += len(chunk.Transactions)
+			numChunks++
+			chunk = &JournalChunk{}
+		}
+	}
+	if len(chunk.Transactions) > 0 {
+		err = journal.putChunk(numChunks, chunk)
+		if err != nil {
+			return err
+		}
+		numSaved += len(chunk.Transactions)
+		numChunks++
+	}
+
+	err = journal.putHeader(&JournalHeader{
+		NumChunks: numChunks,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := numChunks; i < numOldChunks; i++ {
+		errRemove := journal.storer.Remove(chunkKey(i))
+		if errRemove != nil {
+			log.Debug("cannot remove old journal chunk", "index", i, "error", errRemove.Error())
+		}
+	}
+
+	log.Debug("transactions pool journal saved", "num transactions", numSaved, "num chunks", numChunks)
+
+	return nil
+}
+
+func (journal *txPoolJournal) collectPendingTransactions(jPool *journaledPool) []*PendingTransaction {
+	pendingTxs := make([]*PendingTransaction, 0)
+	seenHashes := make(map[string]struct{})
+
+	for _, cacheID := range journal.cacheIDs() {
+		cacher := jPool.pool.ShardDataStore(cacheID)
+		if check.IfNil(cacher) {
+			continue
+		}
+
+		for _, txHash := range cacher.Keys() {
+			_, seen := seenHashes[string(txHash)]
+			if seen {
+				continue
+			}
+			seenHashes[string(txHash)] = struct{}{}
+
+			tx, ok := cacher.Peek(txHash)
+			if !ok {
+				continue
+			}
+
+			buff, err := journal.marshalizer.Marshal(tx)
+			if err != nil {
+				log.Debug("cannot marshal pending transaction", "hash", txHash, "error", err.Error())
+				continue
+			}
+
+			pendingTxs = append(pendingTxs, &PendingTransaction{
+				PoolType: jPool.poolType,
+				Buff:     buff,
+			})
+		}
+	}
+
+	return pendingTxs
+}
+
+// cacheIDs returns the identifiers of the caches which can hold transactions: the ones having the self shard as
+// source or as destination
+func (journal *txPoolJournal) cacheIDs() []string {
+	selfShardID := journal.shardCoordinator.SelfId()
+	shardIDs := make([]uint32, 0, journal.shardCoordinator.NumberOfShards()+1)
+	for i := uint32(0); i < journal.shardCoordinator.NumberOfShards(); i++ {
+		shardIDs = append(shardIDs, i)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	cacheIDs := make([]string, 0, 2*len(shardIDs))
+	for _, shardID := range shardIDs {
+		cacheIDs = append(cacheIDs, process.ShardCacherIdentifier(selfShardID, shardID))
+		if shardID != selfShardID {
+			cacheIDs = append(cacheIDs, process.ShardCacherIdentifier(shardID, selfShardID))
+		}
+	}
+
+	return cacheIDs
+}
+
+func (journal *txPoolJournal) getHeader() (*JournalHeader, error) {
+	buff, err := journal.storer.Get(journalHeaderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &JournalHeader{}
+	err = journal.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (journal *txPoolJournal) putHeader(header *JournalHeader) error {
+	buff, err := journal.marshalizer.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	return journal.storer.Put(journalHeaderKey, buff)
+}
+
+func (journal *txPoolJournal) getChunk(index uint32) (*JournalChunk, error) {
+	buff, err := journal.storer.Get(chunkKey(index))
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &JournalChunk{}
+	err = journal.marshalizer.Unmarshal(chunk, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (journal *txPoolJournal) putChunk(index uint32, chunk *JournalChunk) error {
+	buff, err := journal.marshalizer.Marshal(chunk)
+	if err != nil {
+		return err
+	}
+
+	return journal.storer.Put(chunkKey(index), buff)
+}
+
+func chunkKey(index uint32) []byte {
+	return []byte(fmt.Sprintf("%s_%d", journalHeaderKey, index))
+}
+
+// StartPeriodicSaving starts saving the journal at the configured interval, until the journal is closed
+func (journal *txPoolJournal) StartPeriodicSaving() {
+	if journal.saveInterval <= 0 {
+		return
+	}
+
+	journal.mutJournal.Lock()
+	defer journal.mutJournal.Unlock()
+
+	if journal.cancelFunc != nil {
+		return
+	}
+
+	var ctx context.Context
+	ctx, journal.cancelFunc = context.WithCancel(context.Background())
+	go journal.saveLoop(ctx)
+}
+
+func (journal *txPoolJournal) saveLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(journal.saveInterval):
+		}
+
+		err := journal.Save()
+		if err != nil {
+			log.Warn("cannot save the transactions pool journal", "error", err.Error())
+		}
+	}
+}
+
+// Close stops the periodic saving and saves the journal one last time
+func (journal *txPoolJournal) Close() error {
+	journal.mutJournal.Lock()
+	if journal.cancelFunc != nil {
+		journal.cancelFunc()
+		journal.cancelFunc = nil
+	}
+	journal.mutJournal.Unlock()
+
+	return journal.Save()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *txPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -0,0 +1,279 @@
+package journal
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGasPrice = 200000000000
+
+func createMemUnit() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 10, Shards: 1})
+	persist, _ := memorydb.NewlruDB(100000)
+	unit, _ := storageUnit.NewStorageUnit(cache, persist)
+
+	return unit
+}
+
+func createPools(t *testing.T) (dataRetriever.ShardedDataCacherNotifier, dataRetriever.ShardedDataCacherNotifier) {
+	txPool, err := testscommon.CreateTxPool(1, 0)
+	require.Nil(t, err)
+
+	unsignedTxPool, err := shardedData.NewShardedData("unsignedTxPool", storageUnit.CacheConfig{
+		Capacity:    1000,
+		SizeInBytes: 1000000,
+		Shards:      1,
+	})
+	require.Nil(t, err)
+
+	return txPool, unsignedTxPool
+}
+
+func createMockArgs(t *testing.T) ArgsTxPoolJournal {
+	txPool, unsignedTxPool := createPools(t)
+
+	return ArgsTxPoolJournal{
+		Storer:         createMemUnit(),
+		Marshalizer:    &testscommon.ProtoMarshalizerMock{},
+		Hasher:         mock.HasherMock{},
+		TxPool:         txPool,
+		UnsignedTxPool: unsignedTxPool,
+		Store:          &mock.ChainStorerMock{},
+		Accounts: &mock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+				account, _ := state.NewUserAccount(address)
+				_ = account.AddToBalance(big.NewInt(1000))
+
+				return account, nil
+			},
+		},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		FeeHandler:       &mock.FeeHandlerStub{},
+	}
+}
+
+func addTx(t *testing.T, args ArgsTxPoolJournal, nonce uint64) []byte {
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("receiver"),
+		GasPrice: testGasPrice,
+		GasLimit: 50000,
+		Value:    big.NewInt(0),
+	}
+	buff, err := args.Marshalizer.Marshal(tx)
+	require.Nil(t, err)
+
+	txHash := args.Hasher.Compute(string(buff))
+	args.TxPool.AddData(txHash, tx, len(buff), process.ShardCacherIdentifier(0, 0))
+
+	return txHash
+}
+
+func addUnsignedTx(t *testing.T, args ArgsTxPoolJournal, nonce uint64) []byte {
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:   nonce,
+		SndAddr: []byte("sender"),
+		RcvAddr: []byte("receiver"),
+		Value:   big.NewInt(0),
+	}
+	buff, err := args.Marshalizer.Marshal(scr)
+	require.Nil(t, err)
+
+	scrHash := args.Hasher.Compute(string(buff))
+	args.UnsignedTxPool.AddData(scrHash, scr, len(buff), process.ShardCacherIdentifier(0, 0))
+
+	return scrHash
+}
+
+// restartWithFreshPools simulates a node restart: the storer is kept, the pools are empty again
+func restartWithFreshPools(t *testing.T, args ArgsTxPoolJournal) ArgsTxPoolJournal {
+	args.TxPool, args.UnsignedTxPool = createPools(t)
+
+	return args
+}
+
+func TestNewTxPoolJournal_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(args *ArgsTxPoolJournal)
+		expectedErr error
+	}{
+		{"nil storer", func(args *ArgsTxPoolJournal) { args.Storer = nil }, ErrNilStorer},
+		{"nil marshalizer", func(args *ArgsTxPoolJournal) { args.Marshalizer = nil }, ErrNilMarshalizer},
+		{"nil hasher", func(args *ArgsTxPoolJournal) { args.Hasher = nil }, ErrNilHasher},
+		{"nil tx pool", func(args *ArgsTxPoolJournal) { args.TxPool = nil }, ErrNilTxPool},
+		{"nil unsigned tx pool", func(args *ArgsTxPoolJournal) { args.UnsignedTxPool = nil }, ErrNilUnsignedTxPool},
+		{"nil store", func(args *ArgsTxPoolJournal) { args.Store = nil }, ErrNilStorageService},
+		{"nil accounts", func(args *ArgsTxPoolJournal) { args.Accounts = nil }, ErrNilAccountsAdapter},
+		{"nil shard coordinator", func(args *ArgsTxPoolJournal) { args.ShardCoordinator = nil }, ErrNilShardCoordinator},
+		{"nil fee handler", func(args *ArgsTxPoolJournal) { args.FeeHandler = nil }, ErrNilFeeHandler},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgs(t)
+		tt.modify(&args)
+
+		journal, err := NewTxPoolJournal(args)
+		assert.Nil(t, journal, tt.name)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+	}
+}
+
+func TestNewTxPoolJournal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	journal, err := NewTxPoolJournal(createMockArgs(t))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(journal))
+}
+
+func TestTxPoolJournal_SaveBeforeRestoreShouldNotOverwrite(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	addTx(t, args, 5)
+	journal, _ := NewTxPoolJournal(args)
+	err := journal.Save()
+	require.Nil(t, err)
+
+	_, err = args.Storer.Get(journalHeaderKey)
+	assert.NotNil(t, err)
+}
+
+func TestTxPoolJournal_SaveAndRestoreShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	txHash := addTx(t, args, 5)
+	scrHash := addUnsignedTx(t, args, 7)
+	journal, _ := NewTxPoolJournal(args)
+	_ = journal.Restore()
+	err := journal.Close()
+	require.Nil(t, err)
+
+	args = restartWithFreshPools(t, args)
+	journal, _ = NewTxPoolJournal(args)
+	err = journal.Restore()
+	require.Nil(t, err)
+
+	restoredTx, ok := args.TxPool.SearchFirstData(txHash)
+	require.True(t, ok)
+	assert.Equal(t, uint64(5), restoredTx.(*transaction.Transaction).Nonce)
+	restoredScr, ok := args.UnsignedTxPool.SearchFirstData(scrHash)
+	require.True(t, ok)
+	assert.Equal(t, uint64(7), restoredScr.(*smartContractResult.SmartContractResult).Nonce)
+}
+
+func TestTxPoolJournal_RestoreWithoutJournalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	journal, _ := NewTxPoolJournal(createMockArgs(t))
+	err := journal.Restore()
+	assert.Nil(t, err)
+}
+
+func TestTxPoolJournal_RestoreShouldDropInvalidTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	lowNonceTxHash := addTx(t, args, 1)
+	expensiveTxHash := addTx(t, args, 6)
+	processedTxHash := addTx(t, args, 7)
+	validTxHash := addTx(t, args, 8)
+	journal, _ := NewTxPoolJournal(args)
+	_ = journal.Restore()
+	_ = journal.Save()
+
+	args = restartWithFreshPools(t, args)
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			account.IncreaseNonce(5)
+			_ = account.AddToBalance(big.NewInt(1000))
+
+			return account, nil
+		},
+	}
+	args.FeeHandler = &mock.FeeHandlerStub{
+		ComputeFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			if tx.(*transaction.Transaction).Nonce == 6 {
+				return big.NewInt(1001)
+			}
+			return big.NewInt(1000)
+		},
+	}
+	args.Store = &mock.ChainStorerMock{
+		HasCalled: func(unitType dataRetriever.UnitType, key []byte) error {
+			if unitType == dataRetriever.TransactionUnit && string(key) == string(processedTxHash) {
+				return nil
+			}
+			return errors.New("key not found")
+		},
+	}
+	journal, _ = NewTxPoolJournal(args)
+	err := journal.Restore()
+	require.Nil(t, err)
+
+	for _, droppedTxHash := range [][]byte{lowNonceTxHash, expensiveTxHash, processedTxHash} {
+		_, ok := args.TxPool.SearchFirstData(droppedTxHash)
+		assert.False(t, ok)
+	}
+	_, ok := args.TxPool.SearchFirstData(validTxHash)
+	assert.True(t, ok)
+}
+
+func TestTxPoolJournal_SaveShouldRemoveStaleChunks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	for nonce := uint64(0); nonce < maxTransactionsPerChunk+1; nonce++ {
+		addTx(t, args, nonce)
+	}
+	journal, _ := NewTxPoolJournal(args)
+	_ = journal.Restore()
+	_ = journal.Save()
+
+	header, err := journal.getHeader()
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), header.NumChunks)
+
+	args.TxPool.Clear()
+	addTx(t, args, 0)
+	_ = journal.Save()
+
+	header, err = journal.getHeader()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), header.NumChunks)
+	_, err = args.Storer.Get(chunkKey(1))
+	assert.NotNil(t, err)
+}
+
+func TestDisabledTxPoolJournal_ShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	journal := NewDisabledTxPoolJournal()
+	assert.False(t, check.IfNil(journal))
+	assert.Nil(t, journal.Restore())
+	journal.StartPeriodicSaving()
+	assert.Nil(t, journal.Save())
+	assert.Nil(t, journal.Close())
+}
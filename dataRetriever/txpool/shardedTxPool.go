@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"sort"
 	"strconv"
 	"sync"
 
@@ -24,6 +25,8 @@ type shardedTxPool struct {
 	backingMap                   map[string]*txPoolShard
 	mutexAddCallbacks            sync.RWMutex
 	onAddCallbacks               []func(key []byte, value interface{})
+	mutexRemoveCallbacks         sync.RWMutex
+	onRemoveCallbacks            []func(key []byte, value interface{})
 	configPrototypeDestinationMe txcache.ConfigDestinationMe
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
@@ -50,14 +53,16 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 	halfOfCapacity := args.Config.Capacity / 2
 
 	configPrototypeSourceMe := txcache.ConfigSourceMe{
-		NumChunks:                     args.Config.Shards,
-		EvictionEnabled:               true,
-		NumBytesThreshold:             uint32(halfOfSizeInBytes),
-		CountThreshold:                halfOfCapacity,
-		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
-		CountPerSenderThreshold:       args.Config.SizePerSender,
-		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
-		MinGasPriceNanoErd:            uint32(args.MinGasPrice / oneBillion),
+		NumChunks:                            args.Config.Shards,
+		EvictionEnabled:                      true,
+		NumBytesThreshold:                    uint32(halfOfSizeInBytes),
+		CountThreshold:                       halfOfCapacity,
+		NumBytesPerSenderThreshold:           args.Config.SizeInBytesPerSender,
+		CountPerSenderThreshold:              args.Config.SizePerSender,
+		NumSendersToPreemptivelyEvict:        dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		MinGasPriceNanoErd:                   uint32(args.MinGasPrice / oneBillion),
+		ScoreComputer:                        args.Config.ScoreComputer,
+		MinGasPriceBumpPercentForReplacement: args.Config.MinGasPriceBumpPercentForReplacement,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -76,6 +81,8 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		backingMap:                   make(map[string]*txPoolShard),
 		mutexAddCallbacks:            sync.RWMutex{},
 		onAddCallbacks:               make([]func(key []byte, value interface{}), 0),
+		mutexRemoveCallbacks:         sync.RWMutex{},
+		onRemoveCallbacks:            make([]func(key []byte, value interface{}), 0),
 		configPrototypeDestinationMe: configPrototypeDestinationMe,
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
@@ -141,6 +148,7 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 			return txcache.NewDisabledCache()
 		}
 
+		cache.RegisterOnDropped(txPool.onDropped)
 		return cache
 	}
 
@@ -152,6 +160,7 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 		return txcache.NewDisabledCache()
 	}
 
+	cache.RegisterOnDropped(txPool.onDropped)
 	return cache
 }
 
@@ -204,6 +213,19 @@ func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
 	}
 }
 
+func (txPool *shardedTxPool) onDropped(tx *txcache.WrappedTransaction) {
+	txPool.onRemoved(tx.TxHash, tx)
+}
+
+func (txPool *shardedTxPool) onRemoved(key []byte, value interface{}) {
+	txPool.mutexRemoveCallbacks.RLock()
+	defer txPool.mutexRemoveCallbacks.RUnlock()
+
+	for _, handler := range txPool.onRemoveCallbacks {
+		handler(key, value)
+	}
+}
+
 // SearchFirstData searches the transaction against all shard data store, retrieving the first found
 func (txPool *shardedTxPool) SearchFirstData(key []byte) (interface{}, bool) {
 	tx, ok := txPool.searchFirstTx(key)
@@ -313,6 +335,19 @@ func (txPool *shardedTxPool) RegisterOnAdded(handler func(key []byte, value inte
 	txPool.mutexAddCallbacks.Unlock()
 }
 
+// RegisterOnRemoved registers a new handler to be called when a pending transaction is dropped from the pool: evicted
+// because the pool is full or replaced by a transaction with the same sender and nonce, but a higher gas price
+func (txPool *shardedTxPool) RegisterOnRemoved(handler func(key []byte, value interface{})) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler")
+		return
+	}
+
+	txPool.mutexRemoveCallbacks.Lock()
+	txPool.onRemoveCallbacks = append(txPool.onRemoveCallbacks, handler)
+	txPool.mutexRemoveCallbacks.Unlock()
+}
+
 // GetCounts returns the total number of transactions in the pool
 func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	txPool.mutexBackingMap.RLock()
@@ -328,6 +363,41 @@ func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	return counts
 }
 
+// GetSenderInfo returns the pending transactions of a sender, along with their nonce gaps. Only the transactions of
+// the senders in the self shard are grouped by sender, so only these can be inspected
+func (txPool *shardedTxPool) GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range txPool.backingMap {
+		info, ok := shard.Cache.GetSenderInfo(sender)
+		if ok {
+			return info, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetCachesStatistics returns the summary statistics of each internal cache, sorted by cache ID
+func (txPool *shardedTxPool) GetCachesStatistics() []*txcache.CacheStatistics {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	statistics := make([]*txcache.CacheStatistics, 0, len(txPool.backingMap))
+	for cacheID, shard := range txPool.backingMap {
+		cacheStatistics := shard.Cache.GetStatistics()
+		cacheStatistics.Name = cacheID
+		statistics = append(statistics, cacheStatistics)
+	}
+
+	sort.Slice(statistics, func(i, j int) bool {
+		return statistics[i].Name < statistics[j].Name
+	})
+
+	return statistics
+}
+
 // Diagnose diagnoses the internal caches
 func (txPool *shardedTxPool) Diagnose(deep bool) {
 	log.Debug("shardedTxPool.Diagnose()", "counts", txPool.GetCounts().String())
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/stretchr/testify/require"
 )
 
@@ -78,6 +79,13 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidEconomics.Error())
 
+	args = goodArgs
+	args.MinGasPrice = 0
+	args.Config.ScoreComputer = txcache.GasPricePercentileScoreComputer
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, err)
+	require.NotNil(t, pool)
+
 	args = goodArgs
 	args.NumberOfShards = 0
 	pool, err = NewShardedTxPool(args)
@@ -302,6 +310,81 @@ func Test_RegisterOnAdded(t *testing.T) {
 	require.Equal(t, 1, len(pool.onAddCallbacks))
 }
 
+func Test_RegisterOnRemoved(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.RegisterOnRemoved(func(key []byte, value interface{}) {})
+	require.Equal(t, 1, len(pool.onRemoveCallbacks))
+
+	pool.RegisterOnRemoved(nil)
+	require.Equal(t, 1, len(pool.onRemoveCallbacks))
+}
+
+func Test_AddData_ReplaceByFeeCallsOnRemoved(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:                             100,
+		SizePerSender:                        10,
+		SizeInBytes:                          409600,
+		SizeInBytesPerSender:                 40960,
+		Shards:                               1,
+		MinGasPriceBumpPercentForReplacement: 10,
+	}
+	args := ArgShardedTxPool{Config: config, MinGasPrice: 200000000000, NumberOfShards: 4, SelfShardID: 0}
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	removedKeys := make([][]byte, 0)
+	pool.RegisterOnRemoved(func(key []byte, value interface{}) {
+		removedKeys = append(removedKeys, key)
+		_, ok := value.(*txcache.WrappedTransaction)
+		require.True(t, ok)
+	})
+
+	pool.AddData([]byte("hash-1"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 200000000000}, 0, "0")
+	pool.AddData([]byte("hash-2"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 210000000000}, 0, "0")
+	require.Len(t, removedKeys, 0)
+
+	pool.AddData([]byte("hash-3"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 300000000000}, 0, "0")
+	require.Equal(t, [][]byte{[]byte("hash-2"), []byte("hash-1")}, removedKeys)
+
+	_, ok := pool.SearchFirstData([]byte("hash-1"))
+	require.False(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-3"))
+	require.True(t, ok)
+	require.Equal(t, int64(1), pool.GetCounts().GetTotal())
+}
+
+func Test_AddData_EvictionCallsOnRemoved(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	removedKeys := make(map[string]struct{})
+	pool.RegisterOnRemoved(func(key []byte, value interface{}) {
+		removedKeys[string(key)] = struct{}{}
+		_, ok := value.(*txcache.WrappedTransaction)
+		require.True(t, ok)
+	})
+
+	numAdded := 500
+	for i := 0; i < numAdded; i++ {
+		sender := fmt.Sprintf("sender-%d", i)
+		pool.AddData([]byte(fmt.Sprintf("hash-intra-%d", i)), createTx(sender, 1), 0, "0")
+		pool.AddData([]byte(fmt.Sprintf("hash-cross-%d", i)), createTx(sender, 1), 0, "1_0")
+	}
+
+	require.NotEmpty(t, removedKeys)
+	require.Equal(t, int64(2*numAdded), pool.GetCounts().GetTotal()+int64(len(removedKeys)))
+	for key := range removedKeys {
+		_, ok := pool.SearchFirstData([]byte(key))
+		require.False(t, ok)
+	}
+
+	numRemoved := len(removedKeys)
+	pool.RemoveDataFromAllShards([]byte("hash-intra-499"))
+	require.Len(t, removedKeys, numRemoved)
+}
+
 func Test_GetCounts(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -317,6 +400,41 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_GetSenderInfo(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 44), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "1_0")
+
+	info, ok := pool.GetSenderInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, 2, len(info.Transactions))
+	require.Equal(t, []txcache.NonceGap{{From: 43, To: 43}}, info.Gaps)
+
+	// transactions from other shards are not grouped by sender
+	_, ok = pool.GetSenderInfo([]byte("bob"))
+	require.False(t, ok)
+}
+
+func Test_GetCachesStatistics(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "1_0")
+
+	statistics := pool.GetCachesStatistics()
+	require.Equal(t, 2, len(statistics))
+	require.Equal(t, "0", statistics[0].Name)
+	require.Equal(t, uint64(2), statistics[0].NumTxs)
+	require.Equal(t, uint64(1), statistics[0].NumSenders)
+	require.Equal(t, "1_0", statistics[1].Name)
+	require.Equal(t, uint64(1), statistics[1].NumTxs)
+}
+
 func Test_IsInterfaceNil(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	require.False(t, check.IfNil(poolAsInterface))
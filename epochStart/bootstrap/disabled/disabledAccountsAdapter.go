@@ -47,6 +47,11 @@ func (a *accountsAdapter) JournalLen() int {
 	return 0
 }
 
+// GetModifiedAccounts -
+func (a *accountsAdapter) GetModifiedAccounts() []*state.ModifiedAccount {
+	return nil
+}
+
 // RevertToSnapshot -
 func (a *accountsAdapter) RevertToSnapshot(_ int) error {
 	return nil
@@ -94,6 +99,11 @@ func (a *accountsAdapter) RecreateAllTries(_ []byte) (map[string]data.Trie, erro
 	return nil, nil
 }
 
+// GetTrie -
+func (a *accountsAdapter) GetTrie(_ []byte) (data.Trie, error) {
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (a *accountsAdapter) IsInterfaceNil() bool {
 	return a == nil
@@ -861,6 +861,8 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 		Hasher:                   e.hasher,
 		PathManager:              e.pathManager,
 		TrieStorageManagerConfig: e.generalConfig.TrieStorageManagerConfig,
+		ArchiveModeEnabled:       e.generalConfig.StateTriesConfig.ArchiveModeEnabled,
+		ArchiveDbCfg:             e.generalConfig.TrieArchiveDB,
 	}
 	trieFactory, err := factory.NewTrieFactory(trieFactoryArgs)
 	if err != nil {
@@ -27,6 +27,7 @@ type TrieStub struct {
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesCalled          func() (map[string][]byte, error)
 	GetAllHashesCalled          func() ([][]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	IsPruningEnabledCalled      func() bool
 	ClosePersisterCalled        func() error
 	GetAllLeavesOnChannelCalled func() chan core.KeyValueHolder
@@ -219,3 +220,12 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 
 	return nil, nil
 }
+
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
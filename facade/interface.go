@@ -4,10 +4,14 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -19,10 +23,10 @@ type NodeHandler interface {
 	StartConsensus() error
 
 	//GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error)
 
 	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error)
 
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
@@ -37,9 +41,39 @@ type NodeHandler interface {
 	//GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactionsByAddress returns a page of the transactions in which the given address was involved
+	GetTransactionsByAddress(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error)
+
+	// GetTransactionsPoolForSender returns the pending transactions of a sender, along with their nonce gaps
+	GetTransactionsPoolForSender(sender string) (*transaction.ApiSenderTransactionsPool, error)
+
+	// GetTransactionsPoolStatistics returns the summary statistics of each cache of the transactions pool
+	GetTransactionsPoolStatistics() ([]*transaction.ApiTransactionsPoolCache, error)
+
+	// NewEventsSubscriber registers a new subscriber for the committed blocks, transactions and start of epoch events
+	NewEventsSubscriber() (events.Subscriber, error)
+
+	// GetBlockByHash returns the block with the given hash
+	GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error)
+
+	// GetBlockByNonce returns the block with the given nonce
+	GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+
+	// FilterLogs returns the log events, generated in the given range of block nonces, which match the filter
+	FilterLogs(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account corelated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
+
+	// GetStateRootHash returns the state root hash selected by the provided options
+	GetStateRootHash(options state.AccountQueryOptions) ([]byte, error)
+
+	// GetAccountProof returns the Merkle proof of an account against the state root hash of the last committed block
+	GetAccountProof(address string) (*state.ApiAccountProof, error)
+
+	// GetAccountStorageProof returns the Merkle proof of a storage value of an account
+	GetAccountStorageProof(address string, key string) (*state.ApiStorageProof, error)
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
@@ -57,12 +91,14 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetManagedKeysMetrics() []keysManagement.ManagedKeyMetrics
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	StatusMetrics() external.StatusMetricsHandler
 	IsInterfaceNil() bool
 }
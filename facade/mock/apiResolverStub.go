@@ -9,9 +9,10 @@ import (
 
 // ApiResolverStub -
 type ApiResolverStub struct {
-	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	StatusMetricsHandler              func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler               func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	StatusMetricsHandler                func() external.StatusMetricsHandler
+	ComputeTransactionGasLimitHandler   func(tx *transaction.Transaction) (uint64, error)
+	SimulateTransactionExecutionHandler func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 }
 
 // ExecuteSCQuery -
@@ -29,6 +30,11 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return ars.ComputeTransactionGasLimitHandler(tx)
 }
 
+// SimulateTransactionExecution -
+func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return ars.SimulateTransactionExecutionHandler(tx)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
@@ -5,10 +5,14 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 )
 
 // NodeStub -
@@ -16,14 +20,24 @@ type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
 	StartConsensusHandler      func() error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options state.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data string, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	GetTransactionHandler                          func(hash string) (*transaction.ApiTransactionResult, error)
+	GetTransactionsByAddressHandler                func(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error)
+	GetTransactionsPoolForSenderHandler            func(sender string) (*transaction.ApiSenderTransactionsPool, error)
+	GetTransactionsPoolStatisticsHandler           func() ([]*transaction.ApiTransactionsPoolCache, error)
+	NewEventsSubscriberHandler                     func() (events.Subscriber, error)
+	GetAccountProofHandler                         func(address string) (*state.ApiAccountProof, error)
+	GetAccountStorageProofHandler                  func(address string, key string) (*state.ApiStorageProof, error)
+	GetBlockByHashHandler                          func(hash string, withTxs bool) (*block.ApiBlock, error)
+	GetBlockByNonceHandler                         func(nonce uint64, withTxs bool) (*block.ApiBlock, error)
+	FilterLogsHandler                              func(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountHandler                              func(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetStateRootHashCalled                         func(options state.AccountQueryOptions) ([]byte, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
@@ -32,14 +46,15 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options state.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetManagedKeysMetricsCalled                    func() []keysManagement.ManagedKeyMetrics
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -61,8 +76,8 @@ func (ns *NodeStub) StartConsensus() error {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -82,14 +97,104 @@ func (ns *NodeStub) GetTransaction(hash string) (*transaction.ApiTransactionResu
 	return ns.GetTransactionHandler(hash)
 }
 
+// GetTransactionsByAddress -
+func (ns *NodeStub) GetTransactionsByAddress(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error) {
+	if ns.GetTransactionsByAddressHandler != nil {
+		return ns.GetTransactionsByAddressHandler(address, page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(sender string) (*transaction.ApiSenderTransactionsPool, error) {
+	if ns.GetTransactionsPoolForSenderHandler != nil {
+		return ns.GetTransactionsPoolForSenderHandler(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStatistics -
+func (ns *NodeStub) GetTransactionsPoolStatistics() ([]*transaction.ApiTransactionsPoolCache, error) {
+	if ns.GetTransactionsPoolStatisticsHandler != nil {
+		return ns.GetTransactionsPoolStatisticsHandler()
+	}
+
+	return nil, nil
+}
+
+// GetAccountProof -
+func (ns *NodeStub) GetAccountProof(address string) (*state.ApiAccountProof, error) {
+	if ns.GetAccountProofHandler != nil {
+		return ns.GetAccountProofHandler(address)
+	}
+
+	return nil, nil
+}
+
+// GetAccountStorageProof -
+func (ns *NodeStub) GetAccountStorageProof(address string, key string) (*state.ApiStorageProof, error) {
+	if ns.GetAccountStorageProofHandler != nil {
+		return ns.GetAccountStorageProofHandler(address, key)
+	}
+
+	return nil, nil
+}
+
+// NewEventsSubscriber -
+func (ns *NodeStub) NewEventsSubscriber() (events.Subscriber, error) {
+	if ns.NewEventsSubscriberHandler != nil {
+		return ns.NewEventsSubscriberHandler()
+	}
+
+	return nil, nil
+}
+
+// GetBlockByHash -
+func (ns *NodeStub) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	if ns.GetBlockByHashHandler != nil {
+		return ns.GetBlockByHashHandler(hash, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetBlockByNonce -
+func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	if ns.GetBlockByNonceHandler != nil {
+		return ns.GetBlockByNonceHandler(nonce, withTxs)
+	}
+
+	return nil, nil
+}
+
+// FilterLogs -
+func (ns *NodeStub) FilterLogs(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error) {
+	if ns.FilterLogsHandler != nil {
+		return ns.FilterLogsHandler(fromNonce, toNonce, request)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ns.SendBulkTransactionsHandler(txs)
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return ns.GetAccountHandler(address, options)
+}
+
+// GetStateRootHash -
+func (ns *NodeStub) GetStateRootHash(options state.AccountQueryOptions) ([]byte, error) {
+	if ns.GetStateRootHashCalled != nil {
+		return ns.GetStateRootHashCalled(options)
+	}
+
+	return options.RootHash, nil
 }
 
 // GetHeartbeats -
@@ -130,6 +235,15 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetManagedKeysMetrics -
+func (ns *NodeStub) GetManagedKeysMetrics() []keysManagement.ManagedKeyMetrics {
+	if ns.GetManagedKeysMetricsCalled != nil {
+		return ns.GetManagedKeysMetricsCalled()
+	}
+
+	return make([]keysManagement.ManagedKeyMetrics, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
@@ -18,12 +18,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -233,14 +237,14 @@ func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
 	}
 }
 
-// GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+// GetBalance gets the balance for a specified address, from the state selected by the provided options
+func (nf *nodeFacade) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
-// GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+// GetValueForKey gets the value for a key in a given address, from the state selected by the provided options
+func (nf *nodeFacade) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // CreateTransaction creates a transaction from all needed fields
@@ -280,15 +284,71 @@ func (nf *nodeFacade) GetTransaction(hash string) (*transaction.ApiTransactionRe
 	return nf.node.GetTransaction(hash)
 }
 
+// GetTransactionsByAddress gets a page of the transactions in which the given address was involved
+func (nf *nodeFacade) GetTransactionsByAddress(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error) {
+	return nf.node.GetTransactionsByAddress(address, page, pageSize)
+}
+
+// GetTransactionsPoolForSender gets the pending transactions of a sender, along with their nonce gaps
+func (nf *nodeFacade) GetTransactionsPoolForSender(sender string) (*transaction.ApiSenderTransactionsPool, error) {
+	return nf.node.GetTransactionsPoolForSender(sender)
+}
+
+// GetTransactionsPoolStatistics gets the summary statistics of each cache of the transactions pool
+func (nf *nodeFacade) GetTransactionsPoolStatistics() ([]*transaction.ApiTransactionsPoolCache, error) {
+	return nf.node.GetTransactionsPoolStatistics()
+}
+
+// NewEventsSubscriber registers a new subscriber for the committed blocks, transactions and start of epoch events
+func (nf *nodeFacade) NewEventsSubscriber() (events.Subscriber, error) {
+	return nf.node.NewEventsSubscriber()
+}
+
+// GetBlockByHash gets the block with the given hash
+func (nf *nodeFacade) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	return nf.node.GetBlockByHash(hash, withTxs)
+}
+
+// GetBlockByNonce gets the block with the given nonce
+func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	return nf.node.GetBlockByNonce(nonce, withTxs)
+}
+
+// FilterLogs gets the log events, generated in the given range of block nonces, which match the filter
+func (nf *nodeFacade) FilterLogs(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error) {
+	return nf.node.FilterLogs(fromNonce, toNonce, request)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
+// SimulateTransactionExecution will simulate a transaction's execution against the last committed state and will
+// return the results without persisting them
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return nf.apiResolver.SimulateTransactionExecution(tx)
+}
+
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
+}
+
+// GetStateRootHash returns the state root hash selected by the provided options
+func (nf *nodeFacade) GetStateRootHash(options state.AccountQueryOptions) ([]byte, error) {
+	return nf.node.GetStateRootHash(options)
+}
+
+// GetAccountProof gets the Merkle proof of an account against the state root hash of the last committed block
+func (nf *nodeFacade) GetAccountProof(address string) (*state.ApiAccountProof, error) {
+	return nf.node.GetAccountProof(address)
+}
+
+// GetAccountStorageProof gets the Merkle proof of a storage value of an account
+func (nf *nodeFacade) GetAccountStorageProof(address string, key string) (*state.ApiStorageProof, error) {
+	return nf.node.GetAccountStorageProof(address, key)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
@@ -346,6 +406,11 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetManagedKeysMetrics returns the metrics of each BLS key hosted by the node
+func (nf *nodeFacade) GetManagedKeysMetrics() []keysManagement.ManagedKeyMetrics {
+	return nf.node.GetManagedKeysMetrics()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -11,7 +11,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -135,7 +138,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -147,7 +150,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -162,7 +165,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -174,7 +177,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, state.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -186,7 +189,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.AccountQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -195,7 +198,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -274,7 +277,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	called := 0
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (state.UserAccountHandler, error) {
+	node.GetAccountHandler = func(address string, _ state.AccountQueryOptions) (state.UserAccountHandler, error) {
 		called++
 		return nil, nil
 	}
@@ -283,7 +286,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", state.AccountQueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
@@ -627,3 +630,173 @@ func TestNodeFacade_GetThrottlerForEndpointShouldFindAndReturn(t *testing.T) {
 	assert.NotNil(t, thr)
 	assert.True(t, ok)
 }
+
+func TestNodeFacade_GetBlockByNonce(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := &block.ApiBlock{Nonce: 37}
+	node := &mock.NodeStub{
+		GetBlockByNonceHandler: func(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+			assert.Equal(t, uint64(37), nonce)
+			assert.True(t, withTxs)
+			return expectedBlock, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	apiBlock, err := nf.GetBlockByNonce(37, true)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBlock, apiBlock)
+}
+
+func TestNodeFacade_GetBlockByHash(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := &block.ApiBlock{Hash: "aabb"}
+	node := &mock.NodeStub{
+		GetBlockByHashHandler: func(hash string, withTxs bool) (*block.ApiBlock, error) {
+			assert.Equal(t, "aabb", hash)
+			assert.False(t, withTxs)
+			return expectedBlock, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	apiBlock, err := nf.GetBlockByHash("aabb", false)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBlock, apiBlock)
+}
+
+func TestNodeFacade_FilterLogs(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := &logsIndex.FilterRequest{Addresses: []string{"address"}}
+	expectedEvents := []*transaction.ApiFilteredLogEvent{{TxHash: "aabb"}}
+	node := &mock.NodeStub{
+		FilterLogsHandler: func(fromNonce uint64, toNonce uint64, request *logsIndex.FilterRequest) ([]*transaction.ApiFilteredLogEvent, error) {
+			assert.Equal(t, uint64(2), fromNonce)
+			assert.Equal(t, uint64(4), toNonce)
+			assert.True(t, request == expectedRequest)
+			return expectedEvents, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	logEvents, err := nf.FilterLogs(2, 4, expectedRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEvents, logEvents)
+}
+
+func TestNodeFacade_SimulateTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	expectedResults := &transaction.SimulationResults{Hash: "hash"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+			return expectedResults, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	results, err := nf.SimulateTransactionExecution(&transaction.Transaction{})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResults, results)
+}
+
+func TestNodeFacade_GetTransactionsPoolForSenderAndStatistics(t *testing.T) {
+	t.Parallel()
+
+	expectedSenderPool := &transaction.ApiSenderTransactionsPool{Sender: "sender"}
+	expectedCaches := []*transaction.ApiTransactionsPoolCache{{CacheID: "0"}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetTransactionsPoolForSenderHandler: func(sender string) (*transaction.ApiSenderTransactionsPool, error) {
+			return expectedSenderPool, nil
+		},
+		GetTransactionsPoolStatisticsHandler: func() ([]*transaction.ApiTransactionsPoolCache, error) {
+			return expectedCaches, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	senderPool, err := nf.GetTransactionsPoolForSender("sender")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSenderPool, senderPool)
+
+	caches, err := nf.GetTransactionsPoolStatistics()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedCaches, caches)
+}
+
+func TestNodeFacade_GetAccountProofAndStorageProof(t *testing.T) {
+	t.Parallel()
+
+	expectedAccountProof := &state.ApiAccountProof{Address: "address", Proof: []string{"root", "leaf"}}
+	expectedStorageProof := &state.ApiStorageProof{Address: "address", Key: "key"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetAccountProofHandler: func(address string) (*state.ApiAccountProof, error) {
+			assert.Equal(t, "address", address)
+			return expectedAccountProof, nil
+		},
+		GetAccountStorageProofHandler: func(address string, key string) (*state.ApiStorageProof, error) {
+			assert.Equal(t, "address", address)
+			assert.Equal(t, "key", key)
+			return expectedStorageProof, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	accountProof, err := nf.GetAccountProof("address")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedAccountProof, accountProof)
+
+	storageProof, err := nf.GetAccountStorageProof("address", "key")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStorageProof, storageProof)
+}
+
+func TestNodeFacade_NewEventsSubscriber(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		NewEventsSubscriberHandler: func() (events.Subscriber, error) {
+			return nil, expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	subscriber, err := nf.NewEventsSubscriber()
+	assert.Nil(t, subscriber)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNodeFacade_GetStateRootHash(t *testing.T) {
+	t.Parallel()
+
+	options := state.AccountQueryOptions{BlockNonce: 37, HasBlockNonce: true}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStateRootHashCalled: func(opts state.AccountQueryOptions) ([]byte, error) {
+			assert.Equal(t, options, opts)
+			return []byte("root hash"), nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	rootHash, err := nf.GetStateRootHash(options)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("root hash"), rootHash)
+}
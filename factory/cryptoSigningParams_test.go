@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -122,3 +123,37 @@ func TestCryptoSigningParamsLoader_GetSkPk_PathNotFound(t *testing.T) {
 	require.Nil(t, sk)
 	require.Nil(t, pk)
 }
+
+func TestNewCryptoSigningParamsLoaderFromKeystore_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cspf, err := NewCryptoSigningParamsLoaderFromKeystore(nil, "name", []byte("password"), &mock.SuiteStub{})
+	require.Nil(t, cspf)
+	require.Equal(t, ErrNilPubKeyConverter, err)
+
+	cspf, err = NewCryptoSigningParamsLoaderFromKeystore(&mock.PubkeyConverterStub{}, "name", []byte("password"), nil)
+	require.Nil(t, cspf)
+	require.Equal(t, ErrNilSuite, err)
+
+	cspf, err = NewCryptoSigningParamsLoaderFromKeystore(&mock.PubkeyConverterStub{}, "name", nil, &mock.SuiteStub{})
+	require.Nil(t, cspf)
+	require.Equal(t, keystore.ErrEmptyPassword, err)
+}
+
+func TestNewCryptoSigningParamsLoaderFromKeystore_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cspf, err := NewCryptoSigningParamsLoaderFromKeystore(&mock.PubkeyConverterStub{}, "name", []byte("password"), &mock.SuiteStub{})
+	require.NoError(t, err)
+	require.NotNil(t, cspf)
+}
+
+func TestCryptoSigningParamsLoader_GetSkPkFromKeystore_PathNotFound(t *testing.T) {
+	t.Parallel()
+
+	cspf, _ := NewCryptoSigningParamsLoaderFromKeystore(&mock.PubkeyConverterStub{}, "name", []byte("password"), &mock.SuiteStub{})
+	sk, pk, err := cspf.GetSkPkFromKeystore()
+	require.Error(t, err)
+	require.Nil(t, sk)
+	require.Nil(t, pk)
+}
@@ -10,6 +10,11 @@ func (cspf *cryptoSigningParamsLoader) GetSkPk() ([]byte, []byte, error) {
 	return cspf.getSkPk()
 }
 
+// GetSkPkFromKeystore will call the inner function
+func (cspf *cryptoSigningParamsLoader) GetSkPkFromKeystore() ([]byte, []byte, error) {
+	return cspf.getSkPkFromKeystore()
+}
+
 // SetListenAddress will update the listen address for testing reasons
 func (ncf *networkComponentsFactory) SetListenAddress(address string) {
 	ncf.listenAddress = address
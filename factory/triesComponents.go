@@ -66,6 +66,8 @@ func (tcf *triesComponentsFactory) Create() (*TriesComponents, error) {
 		Hasher:                   tcf.hasher,
 		PathManager:              tcf.pathManager,
 		TrieStorageManagerConfig: tcf.config.TrieStorageManagerConfig,
+		ArchiveModeEnabled:       tcf.config.StateTriesConfig.ArchiveModeEnabled,
+		ArchiveDbCfg:             tcf.config.TrieArchiveDB,
 	}
 	shardIDString := convertShardIDToString(tcf.shardCoordinator.SelfId())
 
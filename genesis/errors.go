@@ -154,3 +154,6 @@ var ErrTooManyDNSContracts = errors.New("too many DNS contracts")
 
 // ErrSmartContractWasNotDeployed signals that smart contract was not deployed
 var ErrSmartContractWasNotDeployed = errors.New("smart contract was not deployed")
+
+// ErrEvidenceVerificationDisabled signals that the evidences can not be verified while creating the genesis block
+var ErrEvidenceVerificationDisabled = errors.New("evidence verification is disabled")
@@ -0,0 +1,20 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/genesis"
+)
+
+// EvidenceVerifier represents a disabled evidence verifier implementation which rejects any evidence
+type EvidenceVerifier struct {
+}
+
+// Verify returns ErrEvidenceVerificationDisabled
+func (ev *EvidenceVerifier) Verify(_ *slash.Evidence) error {
+	return genesis.ErrEvidenceVerificationDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ev *EvidenceVerifier) IsInterfaceNil() bool {
+	return ev == nil
+}
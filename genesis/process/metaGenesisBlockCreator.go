@@ -204,6 +204,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator) (*genesisP
 		arg.Marshalizer,
 		&arg.SystemSCConfig,
 		arg.ValidatorAccounts,
+		&disabled.EvidenceVerifier{},
 	)
 	if err != nil {
 		return nil, err
@@ -38,6 +38,7 @@ type ArgHeartbeat struct {
 	PeerSignatureHandler     crypto.PeerSignatureHandler
 	PrivKey                  crypto.PrivateKey
 	HardforkTrigger          heartbeat.HardforkTrigger
+	KeysHandler              heartbeat.KeysHandler
 	AntifloodHandler         heartbeat.P2PAntifloodHandler
 	ValidatorPubkeyConverter core.PubkeyConverter
 	EpochStartTrigger        sharding.EpochHandler
@@ -122,6 +123,7 @@ func (hbh *HeartbeatHandler) create() error {
 		NodeDisplayName:      arg.PrefsConfig.NodeDisplayName,
 		KeyBaseIdentity:      arg.PrefsConfig.Identity,
 		HardforkTrigger:      arg.HardforkTrigger,
+		KeysHandler:          arg.KeysHandler,
 	}
 
 	hbh.sender, err = process.NewSender(argSender)
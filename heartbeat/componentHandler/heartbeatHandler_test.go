@@ -37,6 +37,7 @@ func createMockArgument() ArgHeartbeat {
 		PeerSignatureHandler:     &mock.PeerSignatureHandler{},
 		PrivKey:                  &mock.PrivateKeyStub{},
 		HardforkTrigger:          &mock.HardforkTriggerStub{},
+		KeysHandler:              &mock.KeysHandlerStub{},
 		AntifloodHandler:         &mock.P2PAntifloodHandlerStub{},
 		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
 		EpochStartTrigger:        &mock.EpochStartTriggerStub{},
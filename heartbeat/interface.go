@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -91,6 +92,15 @@ type HardforkTrigger interface {
 	IsInterfaceNil() bool
 }
 
+// KeysHandler defines the BLS keys hosted by the current node, for which heartbeats are sent
+type KeysHandler interface {
+	MainPublicKey() []byte
+	ManagedPublicKeys() [][]byte
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	IncrementHeartbeatsSent(pkBytes []byte)
+	IsInterfaceNil() bool
+}
+
 // PeerBlackListHandler can determine if a certain peer ID is or not blacklisted
 type PeerBlackListHandler interface {
 	Add(pid core.PeerID) error
@@ -0,0 +1,49 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+// KeysHandlerStub -
+type KeysHandlerStub struct {
+	MainPublicKeyCalled           func() []byte
+	ManagedPublicKeysCalled       func() [][]byte
+	GetPrivateKeyCalled           func(pkBytes []byte) (crypto.PrivateKey, error)
+	IncrementHeartbeatsSentCalled func(pkBytes []byte)
+}
+
+// MainPublicKey -
+func (khs *KeysHandlerStub) MainPublicKey() []byte {
+	if khs.MainPublicKeyCalled != nil {
+		return khs.MainPublicKeyCalled()
+	}
+	return nil
+}
+
+// ManagedPublicKeys -
+func (khs *KeysHandlerStub) ManagedPublicKeys() [][]byte {
+	if khs.ManagedPublicKeysCalled != nil {
+		return khs.ManagedPublicKeysCalled()
+	}
+	return nil
+}
+
+// GetPrivateKey -
+func (khs *KeysHandlerStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if khs.GetPrivateKeyCalled != nil {
+		return khs.GetPrivateKeyCalled(pkBytes)
+	}
+	return nil, nil
+}
+
+// IncrementHeartbeatsSent -
+func (khs *KeysHandlerStub) IncrementHeartbeatsSent(pkBytes []byte) {
+	if khs.IncrementHeartbeatsSentCalled != nil {
+		khs.IncrementHeartbeatsSentCalled(pkBytes)
+	}
+}
+
+// IsInterfaceNil -
+func (khs *KeysHandlerStub) IsInterfaceNil() bool {
+	return khs == nil
+}
@@ -9,6 +9,14 @@ import (
 
 const MaxSizeInBytes = maxSizeInBytes
 
+// MaxHeartbeatsPerSecond -
+const MaxHeartbeatsPerSecond = maxHeartbeatsPerSecond
+
+// SetSleepHandler -
+func (s *Sender) SetSleepHandler(handler func(duration time.Duration)) {
+	s.sleepHandler = handler
+}
+
 // GetMessages -
 func (m *Monitor) GetMessages() map[string]*heartbeatMessageInfo {
 	return m.heartbeatMessages
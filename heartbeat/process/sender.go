@@ -1,7 +1,9 @@
 package process
 
 import (
+	"bytes"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -13,6 +15,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
+// maxHeartbeatsPerSecond is the maximum number of heartbeat messages sent by a node in one second. It is kept below
+// the antiflood limit of the heartbeat topic, so that a node managing many keys is not throttled or blacklisted
+const maxHeartbeatsPerSecond = 20
+
 // ArgHeartbeatSender represents the arguments for the heartbeat sender
 type ArgHeartbeatSender struct {
 	PeerMessenger        heartbeat.P2PMessenger
@@ -27,6 +33,7 @@ type ArgHeartbeatSender struct {
 	NodeDisplayName      string
 	KeyBaseIdentity      string
 	HardforkTrigger      heartbeat.HardforkTrigger
+	KeysHandler          heartbeat.KeysHandler
 }
 
 // Sender periodically sends heartbeat messages on a pubsub topic
@@ -43,6 +50,10 @@ type Sender struct {
 	nodeDisplayName      string
 	keyBaseIdentity      string
 	hardforkTrigger      heartbeat.HardforkTrigger
+	keysHandler          heartbeat.KeysHandler
+	mutSend              sync.Mutex
+	numSentInSecond      int
+	sleepHandler         func(duration time.Duration)
 }
 
 // NewSender will create a new sender instance
@@ -71,6 +82,9 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.HardforkTrigger) {
 		return nil, heartbeat.ErrNilHardforkTrigger
 	}
+	if check.IfNil(arg.KeysHandler) {
+		return nil, heartbeat.ErrNilKeysHandler
+	}
 	err := VerifyHeartbeatProperyLen("application version string", []byte(arg.VersionNumber))
 	if err != nil {
 		return nil, err
@@ -89,13 +103,21 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 		nodeDisplayName:      arg.NodeDisplayName,
 		keyBaseIdentity:      arg.KeyBaseIdentity,
 		hardforkTrigger:      arg.HardforkTrigger,
+		keysHandler:          arg.KeysHandler,
+		sleepHandler:         time.Sleep,
 	}
 
 	return sender, nil
 }
 
-// SendHeartbeat broadcasts a new heartbeat message
+// SendHeartbeat broadcasts a new heartbeat message for the main key and one for each of the other keys hosted by
+// the current node. The messages are sent in batches of at most maxHeartbeatsPerSecond, one second apart
 func (s *Sender) SendHeartbeat() error {
+	s.mutSend.Lock()
+	defer s.mutSend.Unlock()
+
+	s.numSentInSecond = 0
+
 	hb := &data.Heartbeat{
 		Payload:         []byte(fmt.Sprintf("%v", time.Now())),
 		ShardID:         s.shardCoordinator.SelfId(),
@@ -111,27 +133,56 @@ func (s *Sender) SendHeartbeat() error {
 		if isPayloadRecorder {
 			//beside sending the regular heartbeat message, send also the initial payload hardfork trigger message
 			// so that will be spread in an epidemic manner
-			s.peerMessenger.Broadcast(s.topic, triggerMessage)
+			s.broadcast(triggerMessage)
 		} else {
 			hb.Payload = s.hardforkTrigger.CreateData()
 		}
 	}
 
-	var err error
-	hb.Pubkey, err = s.privKey.GeneratePublic().ToByteArray()
+	mainPubKey, err := s.privKey.GeneratePublic().ToByteArray()
 	if err != nil {
 		return err
 	}
 
-	s.updateMetrics(hb)
+	mainHb := *hb
+	mainHb.Pubkey = mainPubKey
+	s.updateMetrics(&mainHb)
+
+	err = s.sendHeartbeatForKey(&mainHb, s.privKey)
+	if err != nil {
+		return err
+	}
+
+	for _, pubKey := range s.keysHandler.ManagedPublicKeys() {
+		if bytes.Equal(pubKey, mainPubKey) {
+			continue
+		}
+
+		privKey, errGet := s.keysHandler.GetPrivateKey(pubKey)
+		if errGet != nil {
+			log.Warn("sender: get managed private key", "error", errGet)
+			continue
+		}
+
+		managedHb := *hb
+		managedHb.Pubkey = pubKey
+		err = s.sendHeartbeatForKey(&managedHb, privKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-	err = verifyLengths(hb)
+func (s *Sender) sendHeartbeatForKey(hb *data.Heartbeat, privKey crypto.PrivateKey) error {
+	err := verifyLengths(hb)
 	if err != nil {
 		log.Warn("verify hb length", "error", err.Error())
 		trimLengths(hb)
 	}
 
-	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(s.privKey, hb.Pid)
+	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(privKey, hb.Pid)
 	if err != nil {
 		return err
 	}
@@ -141,11 +192,22 @@ func (s *Sender) SendHeartbeat() error {
 		return err
 	}
 
-	s.peerMessenger.Broadcast(s.topic, buffToSend)
+	s.broadcast(buffToSend)
+	s.keysHandler.IncrementHeartbeatsSent(hb.Pubkey)
 
 	return nil
 }
 
+func (s *Sender) broadcast(buff []byte) {
+	if s.numSentInSecond >= maxHeartbeatsPerSecond {
+		s.sleepHandler(time.Second)
+		s.numSentInSecond = 0
+	}
+
+	s.peerMessenger.Broadcast(s.topic, buff)
+	s.numSentInSecond++
+}
+
 func (s *Sender) updateMetrics(hb *data.Heartbeat) {
 	result := s.computePeerList(hb.Pubkey)
 
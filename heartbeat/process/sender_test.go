@@ -3,9 +3,12 @@ package process_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -35,6 +38,7 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 		VersionNumber:    "v0.1",
 		NodeDisplayName:  "undefined",
 		HardforkTrigger:  &mock.HardforkTriggerStub{},
+		KeysHandler:      &mock.KeysHandlerStub{},
 	}
 }
 
@@ -126,6 +130,17 @@ func TestNewSender_NilHardforkTriggerShouldErr(t *testing.T) {
 	assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
 }
 
+func TestNewSender_NilKeysHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.KeysHandler = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilKeysHandler, err)
+}
+
 func TestNewSender_PropertyTooLongShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -280,6 +295,132 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 	assert.True(t, marshalCalled)
 }
 
+func TestSender_SendHeartbeatWithManagedKeysShouldSendOneForEachKey(t *testing.T) {
+	t.Parallel()
+
+	mainPubKey := []byte("main pub key")
+	managedPubKey := []byte("managed pub key")
+	managedPrivKey := &mock.PrivateKeyStub{}
+
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() (i []byte, e error) {
+					return mainPubKey, nil
+				},
+			}
+		},
+	}
+	numPeerTypeComputations := 0
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			numPeerTypeComputations++
+			return core.EligibleList, 0, nil
+		},
+	}
+	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{
+		Signer: &mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				if private == managedPrivKey {
+					return []byte("managed signature"), nil
+				}
+				return []byte("main signature"), nil
+			},
+		},
+	}
+	sentHeartbeats := make(map[string]*data.Heartbeat)
+	arg.Marshalizer = &mock.MarshalizerStub{
+		MarshalHandler: func(obj interface{}) (i []byte, e error) {
+			hb := obj.(*data.Heartbeat)
+			sentHeartbeats[string(hb.Pubkey)] = hb
+			return hb.Pubkey, nil
+		},
+	}
+	numBroadcasts := 0
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	incrementedKeys := make([][]byte, 0)
+	arg.KeysHandler = &mock.KeysHandlerStub{
+		ManagedPublicKeysCalled: func() [][]byte {
+			return [][]byte{managedPubKey, mainPubKey}
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return managedPrivKey, nil
+		},
+		IncrementHeartbeatsSentCalled: func(pkBytes []byte) {
+			incrementedKeys = append(incrementedKeys, pkBytes)
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numBroadcasts)
+	assert.Equal(t, 1, numPeerTypeComputations)
+	assert.Equal(t, [][]byte{mainPubKey, managedPubKey}, incrementedKeys)
+	assert.Equal(t, []byte("main signature"), sentHeartbeats[string(mainPubKey)].Signature)
+	assert.Equal(t, []byte("managed signature"), sentHeartbeats[string(managedPubKey)].Signature)
+	assert.Equal(t, sentHeartbeats[string(mainPubKey)].Pid, sentHeartbeats[string(managedPubKey)].Pid)
+}
+
+func TestSender_SendHeartbeatWithManyManagedKeysShouldSpreadTheMessages(t *testing.T) {
+	t.Parallel()
+
+	numManagedKeys := 2*process.MaxHeartbeatsPerSecond + 5
+	managedPubKeys := make([][]byte, 0, numManagedKeys)
+	for i := 0; i < numManagedKeys; i++ {
+		managedPubKeys = append(managedPubKeys, []byte(fmt.Sprintf("managed pub key %d", i)))
+	}
+
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() (i []byte, e error) {
+					return []byte("main pub key"), nil
+				},
+			}
+		},
+	}
+	arg.PeerSignatureHandler = &mock.PeerSignatureHandler{
+		Signer: &mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				return []byte("signature"), nil
+			},
+		},
+	}
+	numBroadcastsInSecond := make([]int, 1)
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcastsInSecond[len(numBroadcastsInSecond)-1]++
+		},
+	}
+	arg.KeysHandler = &mock.KeysHandlerStub{
+		ManagedPublicKeysCalled: func() [][]byte {
+			return managedPubKeys
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return &mock.PrivateKeyStub{}, nil
+		},
+	}
+	sender, _ := process.NewSender(arg)
+	sender.SetSleepHandler(func(duration time.Duration) {
+		assert.Equal(t, time.Second, duration)
+		numBroadcastsInSecond = append(numBroadcastsInSecond, 0)
+	})
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	maxPerSecond := process.MaxHeartbeatsPerSecond
+	assert.Equal(t, []int{maxPerSecond, maxPerSecond, 6}, numBroadcastsInSecond)
+}
+
 func TestSender_SendHeartbeatAfterTriggerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1,14 +1,20 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 )
 
 // ServiceContainerMock is a mock implementation of the Core interface
 type ServiceContainerMock struct {
-	IndexerCalled      func() indexer.Indexer
-	TPSBenchmarkCalled func() statistics.TPSBenchmark
+	IndexerCalled           func() indexer.Indexer
+	TPSBenchmarkCalled      func() statistics.TPSBenchmark
+	HistoryRepositoryCalled func() history.HistoryRepository
+	EventsHubCalled         func() events.EventsHub
+	LogsIndexCalled         func() logsIndex.LogsIndex
 }
 
 // Indexer returns a mock implementation for core.Indexer
@@ -27,6 +33,30 @@ func (scm *ServiceContainerMock) TPSBenchmark() statistics.TPSBenchmark {
 	return nil
 }
 
+// HistoryRepository returns a mock implementation for history.HistoryRepository
+func (scm *ServiceContainerMock) HistoryRepository() history.HistoryRepository {
+	if scm.HistoryRepositoryCalled != nil {
+		return scm.HistoryRepositoryCalled()
+	}
+	return nil
+}
+
+// EventsHub returns a mock implementation for events.EventsHub
+func (scm *ServiceContainerMock) EventsHub() events.EventsHub {
+	if scm.EventsHubCalled != nil {
+		return scm.EventsHubCalled()
+	}
+	return nil
+}
+
+// LogsIndex returns a mock implementation for logsIndex.LogsIndex
+func (scm *ServiceContainerMock) LogsIndex() logsIndex.LogsIndex {
+	if scm.LogsIndexCalled != nil {
+		return scm.LogsIndexCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (scm *ServiceContainerMock) IsInterfaceNil() bool {
 	return scm == nil
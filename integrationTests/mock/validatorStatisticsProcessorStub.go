@@ -14,6 +14,7 @@ type ValidatorStatisticsProcessorStub struct {
 	ResetValidatorStatisticsAtNewEpochCalled func(vInfos map[uint32][]*state.ValidatorInfo) error
 	GetValidatorInfoForRootHashCalled        func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error)
 	ProcessRatingsEndOfEpochCalled           func(validatorInfos map[uint32][]*state.ValidatorInfo, epoch uint32) error
+	ProcessChangedKeysEndOfEpochCalled       func(validatorInfos map[uint32][]*state.ValidatorInfo) error
 	ProcessCalled                            func(validatorInfo data.ShardValidatorInfoHandler) error
 	CommitCalled                             func() ([]byte, error)
 }
@@ -36,6 +37,14 @@ func (pm *ValidatorStatisticsProcessorStub) Commit() ([]byte, error) {
 	return nil, nil
 }
 
+// ProcessChangedKeysEndOfEpoch -
+func (vsp *ValidatorStatisticsProcessorStub) ProcessChangedKeysEndOfEpoch(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	if vsp.ProcessChangedKeysEndOfEpochCalled != nil {
+		return vsp.ProcessChangedKeysEndOfEpochCalled(validatorInfos)
+	}
+	return nil
+}
+
 // ProcessRatingsEndOfEpoch -
 func (vsp *ValidatorStatisticsProcessorStub) ProcessRatingsEndOfEpoch(validatorInfos map[uint32][]*state.ValidatorInfo, epoch uint32) error {
 	if vsp.ProcessRatingsEndOfEpochCalled != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -211,6 +212,7 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 	keyGen := signing.NewKeyGenerator(suite)
 	sk, pk := keyGen.GeneratePair()
 	version := "v01"
+	keysHandler, _ := keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{MainPrivateKey: sk})
 
 	argSender := process.ArgHeartbeatSender{
 		PeerMessenger:        messenger,
@@ -224,6 +226,7 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 		VersionNumber:        version,
 		NodeDisplayName:      nodeName,
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
+		KeysHandler:          keysHandler,
 	}
 
 	sender, _ := process.NewSender(argSender)
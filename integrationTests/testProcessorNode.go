@@ -37,6 +37,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -412,6 +413,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		createKeysHandler(tpn.OwnAccount.SkTxSign),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -542,6 +544,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		createKeysHandler(tpn.OwnAccount.SkTxSign),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -565,6 +568,7 @@ func (tpn *TestProcessorNode) InitializeProcessors() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		createKeysHandler(tpn.OwnAccount.SkTxSign),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -962,9 +966,11 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 	gasSchedule := arwenConfig.MakeGasMapForTests()
 	defaults.FillGasMapInternal(gasSchedule, 1)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     TestMarshalizer,
+		GasMap:           gasSchedule,
+		MapDNSAddresses:  mapDNSAddresses,
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -1136,6 +1142,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 			},
 		},
 		tpn.PeerState,
+		&disabled.EvidenceVerifier{},
 	)
 
 	tpn.VMContainer, _ = vmFactory.Create()
@@ -1895,3 +1902,11 @@ func (tpn *TestProcessorNode) initHeaderValidator() {
 
 	tpn.HeaderValidator, _ = block.NewHeaderValidator(argsHeaderValidator)
 }
+
+func createKeysHandler(privateKey crypto.PrivateKey) consensus.KeysHandler {
+	keysHandler, _ := keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		MainPrivateKey: privateKey,
+	})
+
+	return keysHandler
+}
@@ -116,6 +116,7 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		createKeysHandler(tpn.OwnAccount.SkTxSign),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
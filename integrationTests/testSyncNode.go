@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
@@ -121,6 +122,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		createKeysHandler(tpn.OwnAccount.SkTxSign),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -239,6 +241,7 @@ func (tpn *TestProcessorNode) createShardBootstrapper() (TestBootstrapper, error
 		EpochHandler:        tpn.EpochStartTrigger,
 		MiniblocksProvider:  tpn.MiniblocksProvider,
 		Uint64Converter:     TestUint64Converter,
+		Indexer:             indexer.NewNilIndexer(),
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -276,6 +279,7 @@ func (tpn *TestProcessorNode) createMetaChainBootstrapper() (TestBootstrapper, e
 		EpochHandler:        tpn.EpochStartTrigger,
 		MiniblocksProvider:  tpn.MiniblocksProvider,
 		Uint64Converter:     TestUint64Converter,
+		Indexer:             indexer.NewNilIndexer(),
 	}
 
 	argsMetaBootstrapper := sync.ArgMetaBootstrapper{
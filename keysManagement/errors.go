@@ -0,0 +1,12 @@
+package keysManagement
+
+import "errors"
+
+// ErrNilPrivateKey signals that a nil private key was provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrDuplicatedKey signals that the same key was provided more than once
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMissingKey signals that the key is not managed by the current node
+var ErrMissingKey = errors.New("key not managed by the current node")
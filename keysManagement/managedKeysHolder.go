@@ -0,0 +1,203 @@
+package keysManagement
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var log = logger.GetOrCreate("keysManagement")
+
+// ArgsManagedKeysHolder holds the arguments needed to create a managed keys holder
+type ArgsManagedKeysHolder struct {
+	MainPrivateKey     crypto.PrivateKey
+	ManagedPrivateKeys []crypto.PrivateKey
+}
+
+// ManagedKeyMetrics holds the metrics of a BLS key hosted by the current node
+type ManagedKeyMetrics struct {
+	PublicKey                 string `json:"publicKey"`
+	IsMainKey                 bool   `json:"isMainKey"`
+	NumRoundsInConsensusGroup uint64 `json:"numRoundsInConsensusGroup"`
+	NumRoundsAsLeader         uint64 `json:"numRoundsAsLeader"`
+	NumBlocksProposed         uint64 `json:"numBlocksProposed"`
+	NumSignaturesSent         uint64 `json:"numSignaturesSent"`
+	NumHeartbeatsSent         uint64 `json:"numHeartbeatsSent"`
+}
+
+type managedKey struct {
+	privateKey                crypto.PrivateKey
+	publicKey                 []byte
+	numRoundsInConsensusGroup uint64
+	numRoundsAsLeader         uint64
+	numBlocksProposed         uint64
+	numSignaturesSent         uint64
+	numHeartbeatsSent         uint64
+}
+
+// managedKeysHolder holds the BLS keys for which the current node takes part in consensus and sends heartbeats. The
+// set of keys is fixed at construction, so only the metrics are updated afterwards
+type managedKeysHolder struct {
+	mainPublicKey []byte
+	keys          map[string]*managedKey
+	publicKeys    [][]byte
+}
+
+// NewManagedKeysHolder creates a holder of the main key of the node and of the additional keys it hosts
+func NewManagedKeysHolder(args ArgsManagedKeysHolder) (*managedKeysHolder, error) {
+	if check.IfNil(args.MainPrivateKey) {
+		return nil, ErrNilPrivateKey
+	}
+
+	mainPublicKey, err := args.MainPrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	holder := &managedKeysHolder{
+		mainPublicKey: mainPublicKey,
+		keys:          make(map[string]*managedKey),
+	}
+	_ = holder.addKey(args.MainPrivateKey, mainPublicKey)
+
+	for _, privateKey := range args.ManagedPrivateKeys {
+		if check.IfNil(privateKey) {
+			return nil, ErrNilPrivateKey
+		}
+
+		publicKey, errPublic := privateKey.GeneratePublic().ToByteArray()
+		if errPublic != nil {
+			return nil, errPublic
+		}
+
+		if bytes.Equal(publicKey, mainPublicKey) {
+			continue
+		}
+
+		err = holder.addKey(privateKey, publicKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(holder.publicKeys, func(i, j int) bool {
+		return bytes.Compare(holder.publicKeys[i], holder.publicKeys[j]) < 0
+	})
+
+	log.Debug("managed keys", "num keys", len(holder.publicKeys), "main key", hex.EncodeToString(mainPublicKey))
+
+	return holder, nil
+}
+
+func (holder *managedKeysHolder) addKey(privateKey crypto.PrivateKey, publicKey []byte) error {
+	_, exists := holder.keys[string(publicKey)]
+	if exists {
+		return fmt.Errorf("%w: %s", ErrDuplicatedKey, hex.EncodeToString(publicKey))
+	}
+
+	holder.keys[string(publicKey)] = &managedKey{
+		privateKey: privateKey,
+		publicKey:  publicKey,
+	}
+	holder.publicKeys = append(holder.publicKeys, publicKey)
+
+	return nil
+}
+
+// MainPublicKey returns the public key the node was started with
+func (holder *managedKeysHolder) MainPublicKey() []byte {
+	return holder.mainPublicKey
+}
+
+// ManagedPublicKeys returns all the hosted public keys, including the main one, sorted
+func (holder *managedKeysHolder) ManagedPublicKeys() [][]byte {
+	publicKeys := make([][]byte, len(holder.publicKeys))
+	copy(publicKeys, holder.publicKeys)
+
+	return publicKeys
+}
+
+// IsMultiKeyMode returns true if the node hosts other keys besides the main one
+func (holder *managedKeysHolder) IsMultiKeyMode() bool {
+	return len(holder.keys) > 1
+}
+
+// IsKeyManagedByCurrentNode returns true if the given public key is hosted by the current node
+func (holder *managedKeysHolder) IsKeyManagedByCurrentNode(pkBytes []byte) bool {
+	_, ok := holder.keys[string(pkBytes)]
+	return ok
+}
+
+// GetPrivateKey returns the private key of the given hosted public key
+func (holder *managedKeysHolder) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	key, ok := holder.keys[string(pkBytes)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingKey, hex.EncodeToString(pkBytes))
+	}
+
+	return key.privateKey, nil
+}
+
+// IncrementRoundsInConsensusGroup increments the number of rounds in which the key was selected in the consensus group
+func (holder *managedKeysHolder) IncrementRoundsInConsensusGroup(pkBytes []byte) {
+	holder.increment(pkBytes, func(key *managedKey) *uint64 { return &key.numRoundsInConsensusGroup })
+}
+
+// IncrementRoundsAsLeader increments the number of rounds in which the key was the leader
+func (holder *managedKeysHolder) IncrementRoundsAsLeader(pkBytes []byte) {
+	holder.increment(pkBytes, func(key *managedKey) *uint64 { return &key.numRoundsAsLeader })
+}
+
+// IncrementBlocksProposed increments the number of blocks proposed by the key
+func (holder *managedKeysHolder) IncrementBlocksProposed(pkBytes []byte) {
+	holder.increment(pkBytes, func(key *managedKey) *uint64 { return &key.numBlocksProposed })
+}
+
+// IncrementSignaturesSent increments the number of block signatures created by the key
+func (holder *managedKeysHolder) IncrementSignaturesSent(pkBytes []byte) {
+	holder.increment(pkBytes, func(key *managedKey) *uint64 { return &key.numSignaturesSent })
+}
+
+// IncrementHeartbeatsSent increments the number of heartbeats sent for the key
+func (holder *managedKeysHolder) IncrementHeartbeatsSent(pkBytes []byte) {
+	holder.increment(pkBytes, func(key *managedKey) *uint64 { return &key.numHeartbeatsSent })
+}
+
+func (holder *managedKeysHolder) increment(pkBytes []byte, counter func(key *managedKey) *uint64) {
+	key, ok := holder.keys[string(pkBytes)]
+	if !ok {
+		return
+	}
+
+	atomic.AddUint64(counter(key), 1)
+}
+
+// GetManagedKeysMetrics returns the metrics of all the hosted keys, the public keys being hex encoded
+func (holder *managedKeysHolder) GetManagedKeysMetrics() []ManagedKeyMetrics {
+	metrics := make([]ManagedKeyMetrics, 0, len(holder.publicKeys))
+	for _, publicKey := range holder.publicKeys {
+		key := holder.keys[string(publicKey)]
+		metrics = append(metrics, ManagedKeyMetrics{
+			PublicKey:                 hex.EncodeToString(publicKey),
+			IsMainKey:                 bytes.Equal(publicKey, holder.mainPublicKey),
+			NumRoundsInConsensusGroup: atomic.LoadUint64(&key.numRoundsInConsensusGroup),
+			NumRoundsAsLeader:         atomic.LoadUint64(&key.numRoundsAsLeader),
+			NumBlocksProposed:         atomic.LoadUint64(&key.numBlocksProposed),
+			NumSignaturesSent:         atomic.LoadUint64(&key.numSignaturesSent),
+			NumHeartbeatsSent:         atomic.LoadUint64(&key.numHeartbeatsSent),
+		})
+	}
+
+	return metrics
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedKeysHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,134 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var keyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+func generateKey() (crypto.PrivateKey, []byte) {
+	sk, pk := keyGen.GeneratePair()
+	pkBytes, _ := pk.ToByteArray()
+
+	return sk, pkBytes
+}
+
+func TestNewManagedKeysHolder_NilKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	holder, err := NewManagedKeysHolder(ArgsManagedKeysHolder{})
+	assert.True(t, check.IfNil(holder))
+	assert.Equal(t, ErrNilPrivateKey, err)
+
+	mainSk, _ := generateKey()
+	holder, err = NewManagedKeysHolder(ArgsManagedKeysHolder{
+		MainPrivateKey:     mainSk,
+		ManagedPrivateKeys: []crypto.PrivateKey{nil},
+	})
+	assert.True(t, check.IfNil(holder))
+	assert.Equal(t, ErrNilPrivateKey, err)
+}
+
+func TestNewManagedKeysHolder_DuplicatedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mainSk, _ := generateKey()
+	sk, _ := generateKey()
+	holder, err := NewManagedKeysHolder(ArgsManagedKeysHolder{
+		MainPrivateKey:     mainSk,
+		ManagedPrivateKeys: []crypto.PrivateKey{sk, sk},
+	})
+	assert.True(t, check.IfNil(holder))
+	assert.True(t, errors.Is(err, ErrDuplicatedKey))
+}
+
+func TestNewManagedKeysHolder_SingleKeyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mainSk, mainPk := generateKey()
+	holder, err := NewManagedKeysHolder(ArgsManagedKeysHolder{
+		MainPrivateKey:     mainSk,
+		ManagedPrivateKeys: []crypto.PrivateKey{mainSk},
+	})
+	require.Nil(t, err)
+	assert.False(t, holder.IsMultiKeyMode())
+	assert.Equal(t, mainPk, holder.MainPublicKey())
+	assert.Equal(t, [][]byte{mainPk}, holder.ManagedPublicKeys())
+}
+
+func TestManagedKeysHolder_MultipleKeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mainSk, mainPk := generateKey()
+	sk1, pk1 := generateKey()
+	sk2, pk2 := generateKey()
+	_, notManagedPk := generateKey()
+	holder, err := NewManagedKeysHolder(ArgsManagedKeysHolder{
+		MainPrivateKey:     mainSk,
+		ManagedPrivateKeys: []crypto.PrivateKey{sk1, mainSk, sk2},
+	})
+	require.Nil(t, err)
+
+	assert.True(t, holder.IsMultiKeyMode())
+	assert.Equal(t, 3, len(holder.ManagedPublicKeys()))
+	for _, pk := range [][]byte{mainPk, pk1, pk2} {
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pk))
+	}
+	assert.False(t, holder.IsKeyManagedByCurrentNode(notManagedPk))
+
+	sk, err := holder.GetPrivateKey(pk2)
+	assert.Nil(t, err)
+	assert.True(t, sk == sk2)
+
+	sk, err = holder.GetPrivateKey(notManagedPk)
+	assert.Nil(t, sk)
+	assert.True(t, errors.Is(err, ErrMissingKey))
+}
+
+func TestManagedKeysHolder_MetricsShouldBeCountedPerKey(t *testing.T) {
+	t.Parallel()
+
+	mainSk, mainPk := generateKey()
+	sk1, pk1 := generateKey()
+	_, notManagedPk := generateKey()
+	holder, _ := NewManagedKeysHolder(ArgsManagedKeysHolder{
+		MainPrivateKey:     mainSk,
+		ManagedPrivateKeys: []crypto.PrivateKey{sk1},
+	})
+
+	holder.IncrementRoundsInConsensusGroup(pk1)
+	holder.IncrementRoundsInConsensusGroup(pk1)
+	holder.IncrementRoundsAsLeader(pk1)
+	holder.IncrementBlocksProposed(pk1)
+	holder.IncrementSignaturesSent(mainPk)
+	holder.IncrementHeartbeatsSent(mainPk)
+	holder.IncrementHeartbeatsSent(notManagedPk)
+
+	metricsByKey := make(map[string]ManagedKeyMetrics)
+	for _, metrics := range holder.GetManagedKeysMetrics() {
+		metricsByKey[metrics.PublicKey] = metrics
+	}
+	require.Equal(t, 2, len(metricsByKey))
+
+	assert.Equal(t, ManagedKeyMetrics{
+		PublicKey:                 hex.EncodeToString(pk1),
+		NumRoundsInConsensusGroup: 2,
+		NumRoundsAsLeader:         1,
+		NumBlocksProposed:         1,
+	}, metricsByKey[hex.EncodeToString(pk1)])
+	assert.Equal(t, ManagedKeyMetrics{
+		PublicKey:         hex.EncodeToString(mainPk),
+		IsMainKey:         true,
+		NumSignaturesSent: 1,
+		NumHeartbeatsSent: 1,
+	}, metricsByKey[hex.EncodeToString(mainPk)])
+}
@@ -177,3 +177,27 @@ var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
 
 // ErrNilPeerSignatureHandler signals that a nil peerSignatureHandler object has been provided
 var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHandler")
+
+// ErrNilHistoryRepository signals that a nil history repository has been provided
+var ErrNilHistoryRepository = errors.New("nil history repository")
+
+// ErrNilLogsIndex signals that a nil log events index has been provided
+var ErrNilLogsIndex = errors.New("nil log events index")
+
+// ErrTransactionsPoolInspectionNotSupported signals that the transactions pool does not expose its internal state
+var ErrTransactionsPoolInspectionNotSupported = errors.New("transactions pool inspection not supported")
+
+// ErrNilEventsHub signals that a nil events hub has been provided
+var ErrNilEventsHub = errors.New("nil events hub")
+
+// ErrNilTxPoolJournal signals that a nil transactions pool journal has been provided
+var ErrNilTxPoolJournal = errors.New("nil transactions pool journal")
+
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrNoCommittedBlock signals that the node has neither a committed block nor a genesis block to work with
+var ErrNoCommittedBlock = errors.New("no committed block")
+
+// ErrEmptyDataTrie signals that the account has no data trie, so it holds no storage values
+var ErrEmptyDataTrie = errors.New("the account has an empty data trie")
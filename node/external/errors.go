@@ -10,3 +10,6 @@ var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 
 // ErrNilTransactionCostHandler signals that a nil transaction cost handler was provided
 var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
+
+// ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor was provided
+var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
@@ -3,14 +3,11 @@ package external
 import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
-	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
-	IsInterfaceNil() bool
+	process.SCQueryServiceHandler
 }
 
 // StatusMetricsHandler is the interface that defines what a node details handler/provider should do
@@ -22,6 +19,12 @@ type StatusMetricsHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TransactionSimulatorProcessor defines the actions which should be handled by a transaction simulator
+type TransactionSimulatorProcessor interface {
+	ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
 // TransactionCostHandler defines the actions which should be handler by a transaction cost estimator
 type TransactionCostHandler interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
@@ -12,6 +12,7 @@ type NodeApiResolver struct {
 	scQueryService       SCQueryService
 	statusMetricsHandler StatusMetricsHandler
 	txCostHandler        TransactionCostHandler
+	txSimulatorProcessor TransactionSimulatorProcessor
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -19,6 +20,7 @@ func NewNodeApiResolver(
 	scQueryService SCQueryService,
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
+	txSimulatorProcessor TransactionSimulatorProcessor,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -29,11 +31,15 @@ func NewNodeApiResolver(
 	if check.IfNil(txCostHandler) {
 		return nil, ErrNilTransactionCostHandler
 	}
+	if check.IfNil(txSimulatorProcessor) {
+		return nil, ErrNilTransactionSimulatorProcessor
+	}
 
 	return &NodeApiResolver{
 		scQueryService:       scQueryService,
 		statusMetricsHandler: statusMetricsHandler,
 		txCostHandler:        txCostHandler,
+		txSimulatorProcessor: txSimulatorProcessor,
 	}, nil
 }
 
@@ -52,6 +58,11 @@ func (nar *NodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
 }
 
+// SimulateTransactionExecution will simulate the execution of a transaction without persisting its effects
+func (nar *NodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return nar.txSimulatorProcessor.ProcessTx(tx)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
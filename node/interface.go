@@ -4,8 +4,11 @@ import (
 	"io"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -75,3 +78,25 @@ type Throttler interface {
 	EndProcessing()
 	IsInterfaceNil() bool
 }
+
+// TxPoolJournal defines the behavior of a component which persists the pending transactions across restarts
+type TxPoolJournal interface {
+	Restore() error
+	StartPeriodicSaving()
+	IsInterfaceNil() bool
+}
+
+// KeysHandler defines the BLS keys hosted by the current node, used by both the consensus and the heartbeat
+// subsystems, along with their metrics
+type KeysHandler interface {
+	consensus.KeysHandler
+	ManagedPublicKeys() [][]byte
+	IncrementHeartbeatsSent(pkBytes []byte)
+	GetManagedKeysMetrics() []keysManagement.ManagedKeyMetrics
+}
+
+// TransactionsPoolInspector defines the behavior of a transactions pool able to expose its internal state
+type TransactionsPoolInspector interface {
+	GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool)
+	GetCachesStatistics() []*txcache.CacheStatistics
+}
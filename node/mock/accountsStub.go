@@ -9,22 +9,24 @@ import (
 
 // AccountsStub -
 type AccountsStub struct {
-	GetExistingAccountCalled func(addressContainer []byte) (state.AccountHandler, error)
-	LoadAccountCalled        func(container []byte) (state.AccountHandler, error)
-	SaveAccountCalled        func(account state.AccountHandler) error
-	RemoveAccountCalled      func(addressContainer []byte) error
-	CommitCalled             func() ([]byte, error)
-	JournalLenCalled         func() int
-	RevertToSnapshotCalled   func(snapshot int) error
-	RootHashCalled           func() ([]byte, error)
-	RecreateTrieCalled       func(rootHash []byte) error
-	PruneTrieCalled          func(rootHash []byte, identifier data.TriePruningIdentifier)
-	CancelPruneCalled        func(rootHash []byte, identifier data.TriePruningIdentifier)
-	SnapshotStateCalled      func(rootHash []byte)
-	SetStateCheckpointCalled func(rootHash []byte)
-	IsPruningEnabledCalled   func() bool
-	GetAllLeavesCalled       func(rootHash []byte) (map[string][]byte, error)
-	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
+	GetExistingAccountCalled  func(addressContainer []byte) (state.AccountHandler, error)
+	LoadAccountCalled         func(container []byte) (state.AccountHandler, error)
+	SaveAccountCalled         func(account state.AccountHandler) error
+	RemoveAccountCalled       func(addressContainer []byte) error
+	CommitCalled              func() ([]byte, error)
+	JournalLenCalled          func() int
+	GetModifiedAccountsCalled func() []*state.ModifiedAccount
+	RevertToSnapshotCalled    func(snapshot int) error
+	RootHashCalled            func() ([]byte, error)
+	RecreateTrieCalled        func(rootHash []byte) error
+	PruneTrieCalled           func(rootHash []byte, identifier data.TriePruningIdentifier)
+	CancelPruneCalled         func(rootHash []byte, identifier data.TriePruningIdentifier)
+	SnapshotStateCalled       func(rootHash []byte)
+	SetStateCheckpointCalled  func(rootHash []byte)
+	IsPruningEnabledCalled    func() bool
+	GetAllLeavesCalled        func(rootHash []byte) (map[string][]byte, error)
+	RecreateAllTriesCalled    func(rootHash []byte) (map[string]data.Trie, error)
+	GetTrieCalled             func(rootHash []byte) (data.Trie, error)
 }
 
 // RecreateAllTries -
@@ -35,6 +37,14 @@ func (as *AccountsStub) RecreateAllTries(rootHash []byte) (map[string]data.Trie,
 	return nil, nil
 }
 
+// GetTrie -
+func (as *AccountsStub) GetTrie(rootHash []byte) (data.Trie, error) {
+	if as.GetTrieCalled != nil {
+		return as.GetTrieCalled(rootHash)
+	}
+	return nil, errNotImplemented
+}
+
 // LoadAccount -
 func (as *AccountsStub) LoadAccount(address []byte) (state.AccountHandler, error) {
 	if as.LoadAccountCalled != nil {
@@ -88,6 +98,15 @@ func (as *AccountsStub) JournalLen() int {
 	return 0
 }
 
+// GetModifiedAccounts -
+func (as *AccountsStub) GetModifiedAccounts() []*state.ModifiedAccount {
+	if as.GetModifiedAccountsCalled != nil {
+		return as.GetModifiedAccountsCalled()
+	}
+
+	return nil
+}
+
 // RemoveAccount -
 func (as *AccountsStub) RemoveAccount(addressContainer []byte) error {
 	if as.RemoveAccountCalled != nil {
@@ -0,0 +1,51 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/history"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// HistoryRepositoryStub -
+type HistoryRepositoryStub struct {
+	RecordBlockCalled           func(blockHeaderHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler) error
+	GetTransactionsCalled       func(address []byte, page uint32, pageSize uint32) ([]*history.TransactionEntry, error)
+	GetTransactionResultsCalled func(txHash []byte) (*history.TransactionResults, error)
+	IsEnabledCalled             func() bool
+}
+
+// RecordBlock -
+func (hrs *HistoryRepositoryStub) RecordBlock(blockHeaderHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler) error {
+	if hrs.RecordBlockCalled != nil {
+		return hrs.RecordBlockCalled(blockHeaderHash, header, txPool)
+	}
+	return nil
+}
+
+// GetTransactions -
+func (hrs *HistoryRepositoryStub) GetTransactions(address []byte, page uint32, pageSize uint32) ([]*history.TransactionEntry, error) {
+	if hrs.GetTransactionsCalled != nil {
+		return hrs.GetTransactionsCalled(address, page, pageSize)
+	}
+	return nil, nil
+}
+
+// GetTransactionResults -
+func (hrs *HistoryRepositoryStub) GetTransactionResults(txHash []byte) (*history.TransactionResults, error) {
+	if hrs.GetTransactionResultsCalled != nil {
+		return hrs.GetTransactionResultsCalled(txHash)
+	}
+	return nil, nil
+}
+
+// IsEnabled -
+func (hrs *HistoryRepositoryStub) IsEnabled() bool {
+	if hrs.IsEnabledCalled != nil {
+		return hrs.IsEnabledCalled()
+	}
+	return true
+}
+
+// IsInterfaceNil -
+func (hrs *HistoryRepositoryStub) IsInterfaceNil() bool {
+	return hrs == nil
+}
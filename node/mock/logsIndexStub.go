@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// LogsIndexStub -
+type LogsIndexStub struct {
+	RecordBlockCalled func(blockHeaderHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler) error
+	FilterLogsCalled  func(fromNonce uint64, toNonce uint64, filter *logsIndex.LogsFilter) ([]*logsIndex.LogEvent, error)
+	IsEnabledCalled   func() bool
+}
+
+// RecordBlock -
+func (lis *LogsIndexStub) RecordBlock(blockHeaderHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler) error {
+	if lis.RecordBlockCalled != nil {
+		return lis.RecordBlockCalled(blockHeaderHash, header, txPool)
+	}
+	return nil
+}
+
+// FilterLogs -
+func (lis *LogsIndexStub) FilterLogs(fromNonce uint64, toNonce uint64, filter *logsIndex.LogsFilter) ([]*logsIndex.LogEvent, error) {
+	if lis.FilterLogsCalled != nil {
+		return lis.FilterLogsCalled(fromNonce, toNonce, filter)
+	}
+	return nil, nil
+}
+
+// IsEnabled -
+func (lis *LogsIndexStub) IsEnabled() bool {
+	if lis.IsEnabledCalled != nil {
+		return lis.IsEnabledCalled()
+	}
+	return true
+}
+
+// IsInterfaceNil -
+func (lis *LogsIndexStub) IsInterfaceNil() bool {
+	return lis == nil
+}
@@ -18,6 +18,7 @@ type ValidatorStatisticsProcessorMock struct {
 	ProcessCalled                            func(validatorInfo data.ShardValidatorInfoHandler) error
 	CommitCalled                             func() ([]byte, error)
 	ProcessRatingsEndOfEpochCalled           func(validatorInfos map[uint32][]*state.ValidatorInfo, epoch uint32) error
+	ProcessChangedKeysEndOfEpochCalled       func(validatorInfos map[uint32][]*state.ValidatorInfo) error
 }
 
 // UpdatePeerState -
@@ -46,6 +47,14 @@ func (pm *ValidatorStatisticsProcessorMock) Commit() ([]byte, error) {
 	return nil, nil
 }
 
+// ProcessChangedKeysEndOfEpoch -
+func (vsp *ValidatorStatisticsProcessorMock) ProcessChangedKeysEndOfEpoch(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	if vsp.ProcessChangedKeysEndOfEpochCalled != nil {
+		return vsp.ProcessChangedKeysEndOfEpochCalled(validatorInfos)
+	}
+	return nil
+}
+
 // ProcessRatingsEndOfEpoch -
 func (vsp *ValidatorStatisticsProcessorMock) ProcessRatingsEndOfEpoch(validatorInfos map[uint32][]*state.ValidatorInfo, epoch uint32) error {
 	if vsp.ProcessRatingsEndOfEpochCalled != nil {
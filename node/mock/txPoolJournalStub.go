@@ -0,0 +1,27 @@
+package mock
+
+// TxPoolJournalStub -
+type TxPoolJournalStub struct {
+	RestoreCalled             func() error
+	StartPeriodicSavingCalled func()
+}
+
+// Restore -
+func (tpjs *TxPoolJournalStub) Restore() error {
+	if tpjs.RestoreCalled != nil {
+		return tpjs.RestoreCalled()
+	}
+	return nil
+}
+
+// StartPeriodicSaving -
+func (tpjs *TxPoolJournalStub) StartPeriodicSaving() {
+	if tpjs.StartPeriodicSavingCalled != nil {
+		tpjs.StartPeriodicSavingCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (tpjs *TxPoolJournalStub) IsInterfaceNil() bool {
+	return tpjs == nil
+}
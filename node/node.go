@@ -20,7 +20,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -37,12 +40,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/componentHandler"
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	heartbeatProcess "github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
@@ -50,6 +55,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/update"
+	systemVM "github.com/ElrondNetwork/elrond-go/vm/process"
 )
 
 // SendTransactionsPipe is the pipe used for sending new transactions
@@ -123,6 +129,12 @@ type Node struct {
 	bootstrapRoundIndex      uint64
 
 	indexer                 indexer.Indexer
+	historyRepository       history.HistoryRepository
+	logsIndex               logsIndex.LogsIndex
+	eventsHub               events.EventsHub
+	txPoolJournal           TxPoolJournal
+	mutKeysHandler          syncGo.Mutex
+	keysHandler             KeysHandler
 	blocksBlackListHandler  process.TimeCacher
 	bootStorer              process.BootStorer
 	requestedItemsHandler   dataRetriever.RequestedItemsHandler
@@ -214,6 +226,21 @@ func (n *Node) CreateShardedStores() error {
 	return nil
 }
 
+// restoreTxPoolJournal adds back in the pools the pending transactions saved before the last shutdown. The state has
+// been loaded from storage by the bootstrapper, so the transactions are checked against it before proposing blocks
+func (n *Node) restoreTxPoolJournal() {
+	if check.IfNil(n.txPoolJournal) {
+		return
+	}
+
+	err := n.txPoolJournal.Restore()
+	if err != nil {
+		log.Warn("cannot restore the transactions pool journal", "error", err.Error())
+	}
+
+	n.txPoolJournal.StartPeriodicSaving()
+}
+
 // StartConsensus will start the consensus service for the current node
 func (n *Node) StartConsensus() error {
 	isGenesisBlockNotInitialized := len(n.blkc.GetGenesisHeaderHash()) == 0 ||
@@ -242,6 +269,7 @@ func (n *Node) StartConsensus() error {
 	}
 
 	bootstrapper.StartSyncingBlocks()
+	n.restoreTxPoolJournal()
 
 	epoch := n.blkc.GetGenesisHeader().GetEpoch()
 	crtBlockHeader := n.blkc.GetCurrentBlockHeader()
@@ -260,6 +288,11 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	keysHandler, err := n.getKeysHandler()
+	if err != nil {
+		return err
+	}
+
 	broadcastMessenger, err := sposFactory.GetBroadcastMessenger(
 		n.internalMarshalizer,
 		n.hasher,
@@ -267,6 +300,7 @@ func (n *Node) StartConsensus() error {
 		n.shardCoordinator,
 		n.privKey,
 		n.peerSigHandler,
+		keysHandler,
 		n.dataPool.Headers(),
 		n.interceptorsContainer,
 	)
@@ -275,6 +309,11 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	doubleSigningDetector, err := n.createDoubleSigningDetector()
+	if err != nil {
+		return err
+	}
+
 	netInputMarshalizer := n.internalMarshalizer
 	if n.sizeCheckDelta > 0 {
 		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(n.internalMarshalizer, n.sizeCheckDelta)
@@ -300,6 +339,7 @@ func (n *Node) StartConsensus() error {
 		NetworkShardingCollector: n.networkShardingCollector,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		PoolAdder:                n.dataPool.MiniBlocks(),
+		SlashingDetector:         doubleSigningDetector,
 		SignatureSize:            n.signatureSize,
 		PublicKeySize:            n.publicKeySize,
 	}
@@ -338,6 +378,7 @@ func (n *Node) StartConsensus() error {
 		EpochStartRegistrationHandler: n.epochStartRegistrationHandler,
 		AntifloodHandler:              n.inputAntifloodHandler,
 		PeerHonestyHandler:            n.peerHonestyHandler,
+		KeysHandler:                   keysHandler,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -382,8 +423,8 @@ func (n *Node) addCloserInstances(closers ...update.Closer) error {
 	return nil
 }
 
-// GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
+// GetBalance gets the balance for a specific address, from the state selected by the provided options
+func (n *Node) GetBalance(address string, options state.AccountQueryOptions) (*big.Int, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -392,9 +433,9 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accWrp, err := n.getExistingAccount(addr, options)
 	if err != nil {
-		return nil, errors.New("could not fetch sender address from provided param: " + err.Error())
+		return nil, fmt.Errorf("could not fetch sender address from provided param: %w", err)
 	}
 
 	if check.IfNil(accWrp) {
@@ -409,8 +450,8 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 	return account.GetBalance(), nil
 }
 
-// GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+// GetValueForKey will return the value for a key from a given account, from the state selected by the provided options
+func (n *Node) GetValueForKey(address string, key string, options state.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
@@ -424,7 +465,7 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("invalid address, could not decode from: %w", err)
 	}
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accWrp, err := n.getExistingAccount(addr, options)
 	if err != nil {
 		return "", fmt.Errorf("could not fetch sender address from provided param: %w", err)
 	}
@@ -535,6 +576,7 @@ func (n *Node) createShardBootstrapper(rounder consensus.Rounder) (process.Boots
 		EpochHandler:        n.epochStartTrigger,
 		MiniblocksProvider:  n.miniblocksProvider,
 		Uint64Converter:     n.uint64ByteSliceConverter,
+		Indexer:             n.indexerOrNilIndexer(),
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -596,6 +638,7 @@ func (n *Node) createMetaChainBootstrapper(rounder consensus.Rounder) (process.B
 		EpochHandler:        n.epochStartTrigger,
 		MiniblocksProvider:  n.miniblocksProvider,
 		Uint64Converter:     n.uint64ByteSliceConverter,
+		Indexer:             n.indexerOrNilIndexer(),
 	}
 
 	argsMetaBootstrapper := sync.ArgMetaBootstrapper{
@@ -611,6 +654,15 @@ func (n *Node) createMetaChainBootstrapper(rounder consensus.Rounder) (process.B
 	return bootstrap, nil
 }
 
+// indexerOrNilIndexer returns the configured indexer or a nil indexer if the node does not index its blocks
+func (n *Node) indexerOrNilIndexer() indexer.Indexer {
+	if check.IfNil(n.indexer) {
+		return indexer.NewNilIndexer()
+	}
+
+	return n.indexer
+}
+
 func (n *Node) createMiniblocksProvider() (process.MiniBlockProvider, error) {
 	if check.IfNil(n.dataPool) {
 		return nil, process.ErrNilPoolsHolder
@@ -684,6 +736,76 @@ func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor) error
 	return n.messenger.RegisterMessageProcessor(n.consensusTopic, messageProcessor)
 }
 
+func (n *Node) createDoubleSigningDetector() (spos.SlashingDetector, error) {
+	sigVerifier, err := systemVM.NewMessageSigVerifier(n.keyGen, n.singleSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	evidenceVerifier, err := slash.NewEvidenceVerifier(slash.ArgsEvidenceVerifier{
+		Marshalizer:      n.internalMarshalizer,
+		Hasher:           n.hasher,
+		SigVerifier:      sigVerifier,
+		NodesCoordinator: n.nodesCoordinator,
+		ChainID:          n.chainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	detector, err := slash.NewDoubleSigningDetector(slash.ArgsDoubleSigningDetector{
+		Marshalizer:      n.internalMarshalizer,
+		Hasher:           n.hasher,
+		NodesCoordinator: n.nodesCoordinator,
+		EvidenceVerifier: evidenceVerifier,
+		Broadcaster:      n.messenger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headersTopic := factory.ShardBlocksTopic + n.shardCoordinator.CommunicationIdentifier(core.MetachainShardId)
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		headersTopic = factory.MetachainBlocksTopic
+	}
+	headersInterceptor, err := n.interceptorsContainer.Get(headersTopic)
+	if err != nil {
+		return nil, err
+	}
+	headersInterceptor.RegisterHandler(detector.InterceptedHeader)
+
+	evidenceProcessor, err := slash.NewEvidenceProcessor(slash.ArgsEvidenceProcessor{
+		Marshalizer:      n.internalMarshalizer,
+		EvidenceVerifier: evidenceVerifier,
+		AntifloodHandler: n.inputAntifloodHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.createSlashingEvidenceTopic(evidenceProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	return detector, nil
+}
+
+func (n *Node) createSlashingEvidenceTopic(messageProcessor p2p.MessageProcessor) error {
+	if !n.messenger.HasTopic(core.SlashingEvidenceTopic) {
+		err := n.messenger.CreateTopic(core.SlashingEvidenceTopic, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.messenger.HasTopicValidator(core.SlashingEvidenceTopic) {
+		return ErrValidatorAlreadySet
+	}
+
+	return n.messenger.RegisterMessageProcessor(core.SlashingEvidenceTopic, messageProcessor)
+}
+
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
 func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	if len(txs) == 0 {
@@ -954,8 +1076,8 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
-// GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+// GetAccount will return account details for a given address, from the state selected by the provided options
+func (n *Node) GetAccount(address string, options state.AccountQueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -968,12 +1090,12 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accWrp, err := n.getExistingAccount(addr, options)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
 		}
-		return nil, errors.New("could not fetch sender address from provided param: " + err.Error())
+		return nil, fmt.Errorf("could not fetch sender address from provided param: %w", err)
 	}
 
 	account, ok := accWrp.(state.UserAccountHandler)
@@ -987,6 +1109,11 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 // StartHeartbeat starts the node's heartbeat processing/signaling module
 //TODO(next PR) remove the instantiation of the heartbeat component from here
 func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber string, prefsConfig config.PreferencesConfig) error {
+	keysHandler, err := n.getKeysHandler()
+	if err != nil {
+		return err
+	}
+
 	arg := componentHandler.ArgHeartbeat{
 		HeartbeatConfig:          hbConfig,
 		PrefsConfig:              prefsConfig,
@@ -1000,6 +1127,7 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		PeerSignatureHandler:     n.peerSigHandler,
 		PrivKey:                  n.privKey,
 		HardforkTrigger:          n.hardforkTrigger,
+		KeysHandler:              keysHandler,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		ValidatorPubkeyConverter: n.validatorPubkeyConverter,
 		EpochStartTrigger:        n.epochStartTrigger,
@@ -1012,12 +1140,41 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		ValidatorsProvider:       n.validatorsProvider,
 	}
 
-	var err error
 	n.heartbeatHandler, err = componentHandler.NewHeartbeatHandler(arg)
 
 	return err
 }
 
+// getKeysHandler returns the configured keys handler or, if none was set, one holding only the node's own key
+func (n *Node) getKeysHandler() (KeysHandler, error) {
+	n.mutKeysHandler.Lock()
+	defer n.mutKeysHandler.Unlock()
+
+	if !check.IfNil(n.keysHandler) {
+		return n.keysHandler, nil
+	}
+
+	keysHandler, err := keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		MainPrivateKey: n.privKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	n.keysHandler = keysHandler
+
+	return n.keysHandler, nil
+}
+
+// GetManagedKeysMetrics returns the metrics of each BLS key hosted by the current node
+func (n *Node) GetManagedKeysMetrics() []keysManagement.ManagedKeyMetrics {
+	keysHandler, err := n.getKeysHandler()
+	if err != nil {
+		return make([]keysManagement.ManagedKeyMetrics, 0)
+	}
+
+	return keysHandler.GetManagedKeysMetrics()
+}
+
 // GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 func (n *Node) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
 	if check.IfNil(n.heartbeatHandler) {
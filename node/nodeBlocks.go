@@ -0,0 +1,213 @@
+package node
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetBlockByHash returns the block (shard block or metablock, depending on the node's shard) with the given hash.
+// The transactions of the miniblocks are fetched only if withTxs is true
+func (n *Node) GetBlockByHash(hash string, withTxs bool) (*block.ApiBlock, error) {
+	blockHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getBlockByHash(blockHash, withTxs)
+}
+
+// GetBlockByNonce returns the block (shard block or metablock, depending on the node's shard) with the given nonce.
+// The transactions of the miniblocks are fetched only if withTxs is true
+func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*block.ApiBlock, error) {
+	blockHash, err := n.getBlockHashByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getBlockByHash(blockHash, withTxs)
+}
+
+func (n *Node) getBlockHashByNonce(nonce uint64) ([]byte, error) {
+	nonceToHashUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(n.shardCoordinator.SelfId())
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		nonceToHashUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	nonceBytes := n.uint64ByteSliceConverter.ToByteSlice(nonce)
+
+	return n.store.GetStorer(nonceToHashUnit).SearchFirst(nonceBytes)
+}
+
+func (n *Node) getBlockByHash(hash []byte, withTxs bool) (*block.ApiBlock, error) {
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		return n.getMetaBlock(hash, withTxs)
+	}
+
+	return n.getShardBlock(hash, withTxs)
+}
+
+func (n *Node) getShardBlock(hash []byte, withTxs bool) (*block.ApiBlock, error) {
+	headerBytes, err := n.store.GetStorer(dataRetriever.BlockHeaderUnit).SearchFirst(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &block.Header{}
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBlock := prepareApiBlock(hash, header)
+	apiBlock.MiniBlocks, err = n.prepareApiMiniBlocks(header.MiniBlockHeaders, withTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBlock, nil
+}
+
+func (n *Node) getMetaBlock(hash []byte, withTxs bool) (*block.ApiBlock, error) {
+	headerBytes, err := n.store.GetStorer(dataRetriever.MetaBlockUnit).SearchFirst(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &block.MetaBlock{}
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBlock := prepareApiBlock(hash, header)
+	apiBlock.MiniBlocks, err = n.prepareApiMiniBlocks(header.MiniBlockHeaders, withTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBlock.NotarizedBlocks = make([]*block.ApiNotarizedBlock, 0, len(header.ShardInfo))
+	for _, shardData := range header.ShardInfo {
+		apiBlock.NotarizedBlocks = append(apiBlock.NotarizedBlocks, &block.ApiNotarizedBlock{
+			Hash:  hex.EncodeToString(shardData.HeaderHash),
+			Nonce: shardData.Nonce,
+			Round: shardData.Round,
+			Shard: shardData.ShardID,
+		})
+	}
+
+	return apiBlock, nil
+}
+
+func prepareApiBlock(hash []byte, header data.HeaderHandler) *block.ApiBlock {
+	return &block.ApiBlock{
+		Nonce:           header.GetNonce(),
+		Round:           header.GetRound(),
+		Hash:            hex.EncodeToString(hash),
+		PrevHash:        hex.EncodeToString(header.GetPrevHash()),
+		Epoch:           header.GetEpoch(),
+		Shard:           header.GetShardID(),
+		NumTxs:          header.GetTxCount(),
+		Timestamp:       header.GetTimeStamp(),
+		RootHash:        hex.EncodeToString(header.GetRootHash()),
+		RandSeed:        hex.EncodeToString(header.GetRandSeed()),
+		PrevRandSeed:    hex.EncodeToString(header.GetPrevRandSeed()),
+		PubKeysBitmap:   hex.EncodeToString(header.GetPubKeysBitmap()),
+		Signature:       hex.EncodeToString(header.GetSignature()),
+		LeaderSignature: hex.EncodeToString(header.GetLeaderSignature()),
+		ChainID:         string(header.GetChainID()),
+		SoftwareVersion: string(header.GetSoftwareVersion()),
+		AccumulatedFees: bigIntToString(header.GetAccumulatedFees()),
+		DeveloperFees:   bigIntToString(header.GetDeveloperFees()),
+		IsStartOfEpoch:  header.IsStartOfEpochBlock(),
+	}
+}
+
+func (n *Node) prepareApiMiniBlocks(miniBlockHeaders []block.MiniBlockHeader, withTxs bool) ([]*block.ApiMiniBlock, error) {
+	apiMiniBlocks := make([]*block.ApiMiniBlock, 0, len(miniBlockHeaders))
+	for _, miniBlockHeader := range miniBlockHeaders {
+		apiMiniBlock := &block.ApiMiniBlock{
+			Hash:             hex.EncodeToString(miniBlockHeader.Hash),
+			Type:             miniBlockHeader.Type.String(),
+			SourceShard:      miniBlockHeader.SenderShardID,
+			DestinationShard: miniBlockHeader.ReceiverShardID,
+			TxCount:          miniBlockHeader.TxCount,
+		}
+
+		if withTxs {
+			txs, err := n.getMiniBlockTransactions(miniBlockHeader.Hash, miniBlockHeader.Type)
+			if err != nil {
+				return nil, err
+			}
+			apiMiniBlock.Transactions = txs
+		}
+
+		apiMiniBlocks = append(apiMiniBlocks, apiMiniBlock)
+	}
+
+	return apiMiniBlocks, nil
+}
+
+func (n *Node) getMiniBlockTransactions(miniBlockHash []byte, miniBlockType block.Type) ([]*transaction.ApiTransactionResult, error) {
+	txType, ok := getTransactionTypeOfMiniBlock(miniBlockType)
+	if !ok {
+		// peer changes and receipts are not transactions
+		return nil, nil
+	}
+
+	miniBlockBytes, err := n.store.GetStorer(dataRetriever.MiniBlockUnit).SearchFirst(miniBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = n.internalMarshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	txStorer := n.getStorerForTxType(txType)
+	txs := make([]*transaction.ApiTransactionResult, 0, len(miniBlock.TxHashes))
+	for _, txHash := range miniBlock.TxHashes {
+		txBytes, errGet := txStorer.SearchFirst(txHash)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		tx, errUnmarshal := n.unmarshalTransaction(txBytes, txType)
+		if errUnmarshal != nil {
+			return nil, errUnmarshal
+		}
+
+		tx.Hash = hex.EncodeToString(txHash)
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func getTransactionTypeOfMiniBlock(miniBlockType block.Type) (transactionType, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return normalTx, true
+	case block.SmartContractResultBlock:
+		return unsignedTx, true
+	case block.RewardsBlock:
+		return rewardTx, true
+	default:
+		return invalidTx, false
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,189 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMapStorerStub(data map[string][]byte) storage.Storer {
+	return &mock.StorerStub{
+		SearchFirstCalled: func(key []byte) ([]byte, error) {
+			value, ok := data[string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+			return value, nil
+		},
+	}
+}
+
+func createBlocksChainStorer(units map[dataRetriever.UnitType]map[string][]byte) dataRetriever.StorageService {
+	return &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return createMapStorerStub(units[unitType])
+		},
+	}
+}
+
+func TestNode_GetBlockByHash_InvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+	apiBlock, err := n.GetBlockByHash("zz", false)
+	assert.Nil(t, apiBlock)
+	assert.Error(t, err)
+}
+
+func TestNode_GetBlockByNonce_NotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithDataStore(createBlocksChainStorer(nil)),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+		node.WithUint64ByteSliceConverter(uint64ByteSlice.NewBigEndianConverter()),
+	)
+	apiBlock, err := n.GetBlockByNonce(1, false)
+	assert.Nil(t, apiBlock)
+	assert.Error(t, err)
+}
+
+func TestNode_GetBlockByNonce_ShardBlockWithTxsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	tx := &transaction.Transaction{Nonce: 7, Value: big.NewInt(10), SndAddr: []byte("snd"), RcvAddr: []byte("rcv")}
+	txBytes, _ := marshalizer.Marshal(tx)
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("txHash")}, Type: block.TxBlock}
+	miniBlockBytes, _ := marshalizer.Marshal(miniBlock)
+	header := &block.Header{
+		Nonce:           5,
+		Round:           6,
+		ShardID:         0,
+		TxCount:         1,
+		PrevHash:        []byte("prevHash"),
+		AccumulatedFees: big.NewInt(100),
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("mbHash"), SenderShardID: 0, ReceiverShardID: 1, TxCount: 1, Type: block.TxBlock},
+			{Hash: []byte("peerMbHash"), Type: block.PeerBlock},
+		},
+	}
+	headerBytes, _ := marshalizer.Marshal(header)
+
+	store := createBlocksChainStorer(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.ShardHdrNonceHashDataUnit: {string(converter.ToByteSlice(5)): []byte("blockHash")},
+		dataRetriever.BlockHeaderUnit:           {"blockHash": headerBytes},
+		dataRetriever.MiniBlockUnit:             {"mbHash": miniBlockBytes},
+		dataRetriever.TransactionUnit:           {"txHash": txBytes},
+	})
+	n, _ := node.NewNode(
+		node.WithDataStore(store),
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+		node.WithUint64ByteSliceConverter(converter),
+	)
+
+	apiBlock, err := n.GetBlockByNonce(5, true)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), apiBlock.Nonce)
+	assert.Equal(t, uint64(6), apiBlock.Round)
+	assert.Equal(t, hex.EncodeToString([]byte("blockHash")), apiBlock.Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("prevHash")), apiBlock.PrevHash)
+	assert.Equal(t, "100", apiBlock.AccumulatedFees)
+	assert.Equal(t, "0", apiBlock.DeveloperFees)
+	require.Equal(t, 2, len(apiBlock.MiniBlocks))
+
+	apiMiniBlock := apiBlock.MiniBlocks[0]
+	assert.Equal(t, hex.EncodeToString([]byte("mbHash")), apiMiniBlock.Hash)
+	assert.Equal(t, block.TxBlock.String(), apiMiniBlock.Type)
+	assert.Equal(t, uint32(1), apiMiniBlock.DestinationShard)
+	require.Equal(t, 1, len(apiMiniBlock.Transactions))
+	assert.Equal(t, uint64(7), apiMiniBlock.Transactions[0].Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("txHash")), apiMiniBlock.Transactions[0].Hash)
+	assert.Nil(t, apiBlock.MiniBlocks[1].Transactions)
+
+	apiBlock, err = n.GetBlockByHash(hex.EncodeToString([]byte("blockHash")), false)
+	require.Nil(t, err)
+	assert.Nil(t, apiBlock.MiniBlocks[0].Transactions)
+}
+
+func TestNode_GetBlockByHash_MetaBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	rwdTx := &rewardTx.RewardTx{Value: big.NewInt(5), RcvAddr: []byte("rcv"), Epoch: 1}
+	rwdTxBytes, _ := marshalizer.Marshal(rwdTx)
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("rwdHash")}, Type: block.RewardsBlock}
+	miniBlockBytes, _ := marshalizer.Marshal(miniBlock)
+	header := &block.MetaBlock{
+		Nonce:            3,
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash"), Type: block.RewardsBlock, TxCount: 1}},
+		ShardInfo: []block.ShardData{
+			{HeaderHash: []byte("shardHash"), Nonce: 2, Round: 4, ShardID: 1},
+		},
+	}
+	headerBytes, _ := marshalizer.Marshal(header)
+
+	store := createBlocksChainStorer(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.MetaBlockUnit:         {"metaHash": headerBytes},
+		dataRetriever.MiniBlockUnit:         {"mbHash": miniBlockBytes},
+		dataRetriever.RewardTransactionUnit: {"rwdHash": rwdTxBytes},
+	})
+	n, _ := node.NewNode(
+		node.WithDataStore(store),
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+	)
+
+	apiBlock, err := n.GetBlockByHash(hex.EncodeToString([]byte("metaHash")), true)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), apiBlock.Nonce)
+	require.Equal(t, 1, len(apiBlock.NotarizedBlocks))
+	assert.Equal(t, &block.ApiNotarizedBlock{Hash: hex.EncodeToString([]byte("shardHash")), Nonce: 2, Round: 4, Shard: 1}, apiBlock.NotarizedBlocks[0])
+	require.Equal(t, 1, len(apiBlock.MiniBlocks[0].Transactions))
+	assert.Equal(t, "rewardTx", apiBlock.MiniBlocks[0].Transactions[0].Type)
+	assert.Equal(t, "5", apiBlock.MiniBlocks[0].Transactions[0].Value)
+}
+
+func TestNode_GetBlockByHash_MissingTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("txHash")}, Type: block.TxBlock}
+	miniBlockBytes, _ := marshalizer.Marshal(miniBlock)
+	header := &block.Header{
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash"), Type: block.TxBlock, TxCount: 1}},
+	}
+	headerBytes, _ := marshalizer.Marshal(header)
+
+	store := createBlocksChainStorer(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.BlockHeaderUnit: {"blockHash": headerBytes},
+		dataRetriever.MiniBlockUnit:   {"mbHash": miniBlockBytes},
+	})
+	n, _ := node.NewNode(
+		node.WithDataStore(store),
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+	)
+
+	apiBlock, err := n.GetBlockByHash(hex.EncodeToString([]byte("blockHash")), true)
+	assert.Nil(t, apiBlock)
+	assert.Error(t, err)
+}
@@ -0,0 +1,16 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+)
+
+// NewEventsSubscriber registers a new subscriber for the committed blocks, the transactions of given addresses and
+// the start of epoch events. The caller must close the subscriber when it is no longer used
+func (n *Node) NewEventsSubscriber() (events.Subscriber, error) {
+	if check.IfNil(n.eventsHub) {
+		return nil, ErrNilEventsHub
+	}
+
+	return n.eventsHub.NewSubscriber()
+}
@@ -0,0 +1,42 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_NewEventsSubscriberWithoutEventsHubShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	subscriber, err := n.NewEventsSubscriber()
+	assert.Nil(t, subscriber)
+	assert.Equal(t, node.ErrNilEventsHub, err)
+}
+
+func TestNode_NewEventsSubscriberShouldWork(t *testing.T) {
+	t.Parallel()
+
+	eventsHub, _ := events.NewEventsHub(events.ArgsEventsHub{
+		PubkeyConverter:               mock.NewPubkeyConverterMock(32),
+		LogEventsReader:               &mock.LogEventsReaderStub{},
+		SubscriberBufferSize:          1,
+		MaxSubscribers:                10,
+		MaxSubscriptionsPerSubscriber: 10,
+		MaxAddressesPerSubscription:   10,
+	})
+	n, _ := node.NewNode(node.WithEventsHub(eventsHub))
+
+	subscriber, err := n.NewEventsSubscriber()
+	require.Nil(t, err)
+	assert.True(t, eventsHub.HasSubscribers())
+
+	subscriber.Close()
+	assert.False(t, eventsHub.HasSubscribers())
+}
@@ -0,0 +1,163 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetStateRootHash returns the state root hash selected by the provided options: the root hash itself, if provided,
+// or the state root hash of the block with the provided nonce. If none of them is provided, the state root hash of
+// the last committed block is returned
+func (n *Node) GetStateRootHash(options state.AccountQueryOptions) ([]byte, error) {
+	if len(options.RootHash) > 0 {
+		return options.RootHash, nil
+	}
+
+	if !options.HasBlockNonce {
+		if check.IfNil(n.blkc) {
+			return nil, ErrNilBlockchain
+		}
+
+		header := n.blkc.GetCurrentBlockHeader()
+		if check.IfNil(header) {
+			header = n.blkc.GetGenesisHeader()
+		}
+		if check.IfNil(header) {
+			return nil, ErrNoCommittedBlock
+		}
+
+		return header.GetRootHash(), nil
+	}
+
+	header, err := n.getHeaderByNonce(options.BlockNonce)
+	if err != nil {
+		return nil, fmt.Errorf("block with nonce %d not found: %w", options.BlockNonce, err)
+	}
+
+	return header.GetRootHash(), nil
+}
+
+func (n *Node) getHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	blockHash, err := n.getBlockHashByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	var header data.HeaderHandler = &block.Header{}
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		header = &block.MetaBlock{}
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	headerBytes, err := n.store.GetStorer(headerUnit).SearchFirst(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// getExistingAccount returns the account with the given address, along with its code and data trie, from the state
+// selected by the provided options. As for the accounts adapter, state.ErrAccNotFound is returned if the account does
+// not exist in that state
+func (n *Node) getExistingAccount(address []byte, options state.AccountQueryOptions) (state.AccountHandler, error) {
+	if !options.IsHistorical() {
+		return n.accounts.GetExistingAccount(address)
+	}
+
+	rootHash, err := n.GetStateRootHash(options)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.accounts.GetTrie(rootHash)
+	if err != nil {
+		return nil, wrapRecreateTrieError(rootHash, err)
+	}
+
+	value, err := tr.Get(address)
+	if err != nil {
+		return nil, newStatePrunedError(rootHash, err)
+	}
+	if len(value) == 0 {
+		return nil, state.ErrAccNotFound
+	}
+
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.internalMarshalizer.Unmarshal(account, value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.loadHistoricalCode(tr, rootHash, account)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieRootHash := account.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return account, nil
+	}
+
+	dataTrie, err := tr.Recreate(dataTrieRootHash)
+	if err != nil {
+		return nil, wrapRecreateTrieError(dataTrieRootHash, err)
+	}
+	account.SetDataTrie(dataTrie)
+
+	return account, nil
+}
+
+// loadHistoricalCode sets the code of the account, which is kept in the state trie under the code hash
+func (n *Node) loadHistoricalCode(tr data.Trie, rootHash []byte, account state.UserAccountHandler) error {
+	codeHash := account.GetCodeHash()
+	if len(codeHash) == 0 {
+		return nil
+	}
+
+	value, err := tr.Get(codeHash)
+	if err != nil {
+		return newStatePrunedError(rootHash, err)
+	}
+
+	codeEntry := &state.CodeEntry{}
+	err = n.internalMarshalizer.Unmarshal(codeEntry, value)
+	if err != nil {
+		return err
+	}
+	account.SetCode(codeEntry.Code)
+
+	return nil
+}
+
+// wrapRecreateTrieError signals that the state is pruned only if the root node of the trie is missing
+func wrapRecreateTrieError(rootHash []byte, err error) error {
+	if errors.Is(err, trie.ErrHashNotFound) {
+		return newStatePrunedError(rootHash, err)
+	}
+
+	return err
+}
+
+func newStatePrunedError(rootHash []byte, err error) error {
+	return fmt.Errorf("%w: root hash %s: %s", state.ErrStatePruned, hex.EncodeToString(rootHash), err.Error())
+}
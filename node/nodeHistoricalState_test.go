@@ -0,0 +1,169 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var historicalAddress = []byte("address used for history........")
+
+// createNodeWithTwoStates creates a node whose state went through two blocks: after the block with nonce 1 the
+// account had a balance of 10, the value "old" under the key "key" and the code "code", after the block with nonce 2
+// it has a balance of 20 and the value "new"
+func createNodeWithTwoStates(t *testing.T) (*node.Node, []byte) {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := sha256.Sha256{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	accounts, _ := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+
+	account, _ := accounts.LoadAccount(historicalAddress)
+	userAccount := account.(state.UserAccountHandler)
+	_ = userAccount.AddToBalance(big.NewInt(10))
+	userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("old"))
+	userAccount.SetCode([]byte("code"))
+	_ = accounts.SaveAccount(userAccount)
+	firstRootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	account, _ = accounts.LoadAccount(historicalAddress)
+	userAccount = account.(state.UserAccountHandler)
+	_ = userAccount.AddToBalance(big.NewInt(10))
+	userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("new"))
+	_ = accounts.SaveAccount(userAccount)
+	secondRootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	firstHeader := &block.Header{Nonce: 1, RootHash: firstRootHash}
+	firstHeaderBytes, _ := marshalizer.Marshal(firstHeader)
+	secondHeader := &block.Header{Nonce: 2, RootHash: secondRootHash}
+	store := createBlocksChainStorer(map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.ShardHdrNonceHashDataUnit: {string(converter.ToByteSlice(1)): []byte("first block hash")},
+		dataRetriever.BlockHeaderUnit:           {"first block hash": firstHeaderBytes},
+	})
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return secondHeader
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithHasher(hasher),
+		node.WithAccountsAdapter(accounts),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithBlockChain(blkc),
+		node.WithDataStore(store),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+		node.WithUint64ByteSliceConverter(converter),
+	)
+
+	return n, firstRootHash
+}
+
+func TestNode_GetBalanceOnHistoricalStateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n, firstRootHash := createNodeWithTwoStates(t)
+	address := hex.EncodeToString(historicalAddress)
+
+	balance, err := n.GetBalance(address, state.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(20), balance)
+
+	balance, err = n.GetBalance(address, state.AccountQueryOptions{RootHash: firstRootHash})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), balance)
+
+	balance, err = n.GetBalance(address, state.AccountQueryOptions{BlockNonce: 1, HasBlockNonce: true})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), balance)
+}
+
+func TestNode_GetValueForKeyOnHistoricalStateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n, firstRootHash := createNodeWithTwoStates(t)
+	address := hex.EncodeToString(historicalAddress)
+	key := hex.EncodeToString([]byte("key"))
+
+	value, err := n.GetValueForKey(address, key, state.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("new")), value)
+
+	value, err = n.GetValueForKey(address, key, state.AccountQueryOptions{RootHash: firstRootHash})
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("old")), value)
+}
+
+func TestNode_GetAccountOnHistoricalStateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithTwoStates(t)
+
+	account, err := n.GetAccount(hex.EncodeToString(historicalAddress), state.AccountQueryOptions{BlockNonce: 1, HasBlockNonce: true})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), account.GetBalance())
+	assert.Equal(t, []byte("code"), account.GetCode())
+
+	account, err = n.GetAccount(hex.EncodeToString([]byte("missing address.................")), state.AccountQueryOptions{BlockNonce: 1, HasBlockNonce: true})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), account.GetBalance())
+}
+
+func TestNode_GetBalanceOnPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithTwoStates(t)
+
+	balance, err := n.GetBalance(hex.EncodeToString(historicalAddress), state.AccountQueryOptions{RootHash: []byte("pruned root hash................")})
+	assert.Nil(t, balance)
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, state.ErrStatePruned))
+}
+
+func TestNode_GetBalanceOnUnknownBlockNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithTwoStates(t)
+
+	balance, err := n.GetBalance(hex.EncodeToString(historicalAddress), state.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true})
+	assert.Nil(t, balance)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetStateRootHash(t *testing.T) {
+	t.Parallel()
+
+	n, firstRootHash := createNodeWithTwoStates(t)
+
+	rootHash, err := n.GetStateRootHash(state.AccountQueryOptions{BlockNonce: 1, HasBlockNonce: true})
+	require.Nil(t, err)
+	assert.Equal(t, firstRootHash, rootHash)
+
+	rootHash, err = n.GetStateRootHash(state.AccountQueryOptions{RootHash: []byte("root hash")})
+	require.Nil(t, err)
+	assert.Equal(t, []byte("root hash"), rootHash)
+
+	rootHash, err = n.GetStateRootHash(state.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.NotEqual(t, firstRootHash, rootHash)
+}
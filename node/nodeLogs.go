@@ -0,0 +1,52 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// FilterLogs returns the smart contract log events, generated in the blocks with the nonces between fromNonce and
+// toNonce inclusive, which match the given filter. The events are fetched from the local log events index
+func (n *Node) FilterLogs(
+	fromNonce uint64,
+	toNonce uint64,
+	request *logsIndex.FilterRequest,
+) ([]*transaction.ApiFilteredLogEvent, error) {
+	if check.IfNil(n.logsIndex) || !n.logsIndex.IsEnabled() {
+		return nil, logsIndex.ErrLogsIndexDisabled
+	}
+
+	filter, err := logsIndex.NewLogsFilter(request, n.addressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	logEvents, err := n.logsIndex.FilterLogs(fromNonce, toNonce, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	apiLogEvents := make([]*transaction.ApiFilteredLogEvent, 0, len(logEvents))
+	for _, logEvent := range logEvents {
+		topics := make([]string, 0, len(logEvent.Topics))
+		for _, topic := range logEvent.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		apiLogEvents = append(apiLogEvents, &transaction.ApiFilteredLogEvent{
+			TxHash:     hex.EncodeToString(logEvent.TxHash),
+			BlockHash:  hex.EncodeToString(logEvent.BlockHash),
+			BlockNonce: logEvent.BlockNonce,
+			LogAddress: n.addressPubkeyConverter.Encode(logEvent.LogAddress),
+			Address:    hex.EncodeToString(logEvent.Address),
+			Identifier: hex.EncodeToString(logEvent.Identifier),
+			Topics:     topics,
+			Data:       hex.EncodeToString(logEvent.Data),
+		})
+	}
+
+	return apiLogEvents, nil
+}
@@ -0,0 +1,111 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_FilterLogs_IndexDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithLogsIndex(logsIndex.NewDisabledLogsIndex()),
+	)
+	logEvents, err := n.FilterLogs(1, 2, &logsIndex.FilterRequest{})
+	assert.Nil(t, logEvents)
+	assert.Equal(t, logsIndex.ErrLogsIndexDisabled, err)
+}
+
+func TestNode_FilterLogs_InvalidFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithLogsIndex(&mock.LogsIndexStub{
+			FilterLogsCalled: func(_ uint64, _ uint64, _ *logsIndex.LogsFilter) ([]*logsIndex.LogEvent, error) {
+				assert.Fail(t, "should have not filtered the logs")
+				return nil, nil
+			},
+		}),
+	)
+	logEvents, err := n.FilterLogs(1, 2, &logsIndex.FilterRequest{Topics: [][]string{{"zz"}}})
+	assert.Nil(t, logEvents)
+	assert.NotNil(t, err)
+}
+
+func TestNode_FilterLogs_IndexErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithLogsIndex(&mock.LogsIndexStub{
+			FilterLogsCalled: func(_ uint64, _ uint64, _ *logsIndex.LogsFilter) ([]*logsIndex.LogEvent, error) {
+				return nil, expectedErr
+			},
+		}),
+	)
+	logEvents, err := n.FilterLogs(1, 2, &logsIndex.FilterRequest{})
+	assert.Nil(t, logEvents)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_FilterLogs_ShouldReturnTheEncodedEvents(t *testing.T) {
+	t.Parallel()
+
+	logsIdx := &mock.LogsIndexStub{
+		FilterLogsCalled: func(fromNonce uint64, toNonce uint64, filter *logsIndex.LogsFilter) ([]*logsIndex.LogEvent, error) {
+			assert.Equal(t, uint64(3), fromNonce)
+			assert.Equal(t, uint64(5), toNonce)
+			assert.Equal(t, &logsIndex.LogsFilter{
+				Addresses:   [][]byte{{0xaa, 0xaa}},
+				Identifiers: [][]byte{[]byte("transfer")},
+				Topics:      [][][]byte{{}, {{0xbb}}},
+			}, filter)
+
+			return []*logsIndex.LogEvent{
+				{
+					TxHash:     []byte("tx"),
+					BlockHash:  []byte("block"),
+					BlockNonce: 4,
+					LogAddress: []byte{0xaa, 0xaa},
+					Address:    []byte{0xcc},
+					Identifier: []byte("transfer"),
+					Topics:     [][]byte{{0xdd}, {0xbb}},
+					Data:       []byte("data"),
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithLogsIndex(logsIdx),
+	)
+
+	logEvents, err := n.FilterLogs(3, 5, &logsIndex.FilterRequest{
+		Addresses:   []string{"aaaa"},
+		Identifiers: []string{hex.EncodeToString([]byte("transfer"))},
+		Topics:      [][]string{{}, {"bb"}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(logEvents))
+	assert.Equal(t, &transaction.ApiFilteredLogEvent{
+		TxHash:     hex.EncodeToString([]byte("tx")),
+		BlockHash:  hex.EncodeToString([]byte("block")),
+		BlockNonce: 4,
+		LogAddress: "aaaa",
+		Address:    "cc",
+		Identifier: hex.EncodeToString([]byte("transfer")),
+		Topics:     []string{"dd", "bb"},
+		Data:       hex.EncodeToString([]byte("data")),
+	}, logEvents[0])
+}
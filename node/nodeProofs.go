@@ -0,0 +1,192 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+type committedState struct {
+	blockNonce uint64
+	blockHash  []byte
+	rootHash   []byte
+	trie       data.Trie
+}
+
+type accountProof struct {
+	proof   [][]byte
+	value   []byte
+	account state.UserAccountHandler
+}
+
+// GetAccountProof returns the Merkle proof of the given account against the state root hash of the last committed
+// block, so that it can be verified by anyone holding that block header
+func (n *Node) GetAccountProof(address string) (*state.ApiAccountProof, error) {
+	addressBytes, err := n.decodeAddressForProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := n.getCommittedState()
+	if err != nil {
+		return nil, err
+	}
+
+	accProof, err := n.getAccountProof(cs.trie, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state.ApiAccountProof{
+		Address:    address,
+		BlockNonce: cs.blockNonce,
+		BlockHash:  hex.EncodeToString(cs.blockHash),
+		RootHash:   hex.EncodeToString(cs.rootHash),
+		Proof:      encodeProof(accProof.proof),
+		Value:      hex.EncodeToString(accProof.value),
+	}, nil
+}
+
+// GetAccountStorageProof returns the Merkle proof of the value held by the given account under the hex encoded key:
+// the proof of the account against the state root hash of the last committed block and the proof of the key against
+// the data trie root hash of the account
+func (n *Node) GetAccountStorageProof(address string, key string) (*state.ApiStorageProof, error) {
+	addressBytes, err := n.decodeAddressForProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	cs, err := n.getCommittedState()
+	if err != nil {
+		return nil, err
+	}
+
+	accProof, err := n.getAccountProof(cs.trie, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieRootHash := accProof.account.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return nil, ErrEmptyDataTrie
+	}
+
+	dataTrie, err := cs.trie.Recreate(dataTrieRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := dataTrie.GetProof(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := dataTrie.Get(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &state.ApiStorageProof{
+		Address:          address,
+		Key:              key,
+		BlockNonce:       cs.blockNonce,
+		BlockHash:        hex.EncodeToString(cs.blockHash),
+		RootHash:         hex.EncodeToString(cs.rootHash),
+		AccountProof:     encodeProof(accProof.proof),
+		AccountValue:     hex.EncodeToString(accProof.value),
+		DataTrieRootHash: hex.EncodeToString(dataTrieRootHash),
+		Proof:            encodeProof(proof),
+		Value:            hex.EncodeToString(value),
+	}, nil
+}
+
+func (n *Node) decodeAddressForProof(address string) ([]byte, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address, could not decode from: %w", err)
+	}
+
+	return addressBytes, nil
+}
+
+// getCommittedState returns the state trie of the last committed block. The live state is not used, as it might hold
+// the changes of a block which is still being processed
+func (n *Node) getCommittedState() (*committedState, error) {
+	if check.IfNil(n.blkc) {
+		return nil, ErrNilBlockchain
+	}
+
+	header := n.blkc.GetCurrentBlockHeader()
+	headerHash := n.blkc.GetCurrentBlockHeaderHash()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+		headerHash = n.blkc.GetGenesisHeaderHash()
+	}
+	if check.IfNil(header) {
+		return nil, ErrNoCommittedBlock
+	}
+
+	tr, err := n.accounts.GetTrie(header.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return &committedState{
+		blockNonce: header.GetNonce(),
+		blockHash:  headerHash,
+		rootHash:   header.GetRootHash(),
+		trie:       tr,
+	}, nil
+}
+
+func (n *Node) getAccountProof(tr data.Trie, address []byte) (*accountProof, error) {
+	proof, err := tr.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := tr.Get(address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.internalMarshalizer.Unmarshal(account, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountProof{
+		proof:   proof,
+		value:   value,
+		account: account,
+	}, nil
+}
+
+func encodeProof(proof [][]byte) []string {
+	encodedProof := make([]string, 0, len(proof))
+	for _, encodedNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(encodedNode))
+	}
+
+	return encodedProof
+}
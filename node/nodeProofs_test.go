@@ -0,0 +1,164 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var proofAddress = []byte("address used for proofs.........")
+
+func createNodeWithCommittedState(t *testing.T) *node.Node {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := sha256.Sha256{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	accounts, _ := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+
+	account, _ := accounts.LoadAccount(proofAddress)
+	userAccount := account.(state.UserAccountHandler)
+	_ = userAccount.AddToBalance(big.NewInt(37))
+	userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = accounts.SaveAccount(userAccount)
+	otherAccount, _ := accounts.LoadAccount([]byte("other address...................."))
+	_ = accounts.SaveAccount(otherAccount)
+	rootHash, err := accounts.Commit()
+	require.Nil(t, err)
+
+	header := &block.Header{Nonce: 7, RootHash: rootHash}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return header
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte("block hash")
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithHasher(hasher),
+		node.WithAccountsAdapter(accounts),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithBlockChain(blkc),
+	)
+
+	return n
+}
+
+func decodeProof(t *testing.T, encodedProof []string) [][]byte {
+	proof := make([][]byte, 0, len(encodedProof))
+	for _, encodedNode := range encodedProof {
+		decodedNode, err := hex.DecodeString(encodedNode)
+		require.Nil(t, err)
+		proof = append(proof, decodedNode)
+	}
+
+	return proof
+}
+
+func TestNode_GetAccountProofInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithCommittedState(t)
+
+	accountProof, err := n.GetAccountProof("not hex")
+	assert.Nil(t, accountProof)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetAccountProofNoCommittedBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithBlockChain(&mock.BlockChainMock{}),
+	)
+
+	accountProof, err := n.GetAccountProof(hex.EncodeToString(proofAddress))
+	assert.Nil(t, accountProof)
+	assert.Equal(t, node.ErrNoCommittedBlock, err)
+}
+
+func TestNode_GetAccountProofShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithCommittedState(t)
+
+	accountProof, err := n.GetAccountProof(hex.EncodeToString(proofAddress))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), accountProof.BlockNonce)
+	assert.Equal(t, hex.EncodeToString([]byte("block hash")), accountProof.BlockHash)
+
+	rootHash, _ := hex.DecodeString(accountProof.RootHash)
+	value, err := trie.VerifyProof(
+		rootHash,
+		proofAddress,
+		decodeProof(t, accountProof.Proof),
+		&marshal.GogoProtoMarshalizer{},
+		sha256.Sha256{},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, accountProof.Value, hex.EncodeToString(value))
+
+	account, _ := state.NewUserAccount(proofAddress)
+	_ = (&marshal.GogoProtoMarshalizer{}).Unmarshal(account, value)
+	assert.Equal(t, big.NewInt(37), account.GetBalance())
+}
+
+func TestNode_GetAccountStorageProofShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithCommittedState(t)
+	key := hex.EncodeToString([]byte("key"))
+
+	storageProof, err := n.GetAccountStorageProof(hex.EncodeToString(proofAddress), key)
+	require.Nil(t, err)
+	assert.Equal(t, key, storageProof.Key)
+
+	rootHash, _ := hex.DecodeString(storageProof.RootHash)
+	_, err = trie.VerifyProof(
+		rootHash,
+		proofAddress,
+		decodeProof(t, storageProof.AccountProof),
+		&marshal.GogoProtoMarshalizer{},
+		sha256.Sha256{},
+	)
+	require.Nil(t, err)
+
+	dataTrieRootHash, _ := hex.DecodeString(storageProof.DataTrieRootHash)
+	value, err := trie.VerifyProof(
+		dataTrieRootHash,
+		[]byte("key"),
+		decodeProof(t, storageProof.Proof),
+		&marshal.GogoProtoMarshalizer{},
+		sha256.Sha256{},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, append(append([]byte("value"), []byte("key")...), proofAddress...), value)
+}
+
+func TestNode_GetAccountStorageProofMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithCommittedState(t)
+
+	storageProof, err := n.GetAccountStorageProof(hex.EncodeToString(proofAddress), hex.EncodeToString([]byte("missing")))
+	assert.Nil(t, storageProof)
+	assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+}
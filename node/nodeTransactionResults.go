@@ -0,0 +1,188 @@
+package node
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var okReturnCodeHex = hex.EncodeToString([]byte("ok"))
+
+// putResultsInTransaction adds to an executed transaction the logs, the smart contract results and the receipt it
+// generated. The gas used and the final status are computed from these results. The storers are keyed by the hashes
+// of the results, so these can be found only through the links kept by the history repository: when it is disabled,
+// the transaction is flagged as having its results not available
+func (n *Node) putResultsInTransaction(txHash []byte, tx *transaction.ApiTransactionResult) {
+	tx.Logs = n.getTransactionLogs(txHash)
+
+	if check.IfNil(n.historyRepository) || !n.historyRepository.IsEnabled() {
+		tx.ResultsNotAvailable = true
+		return
+	}
+
+	results, err := n.historyRepository.GetTransactionResults(txHash)
+	if err != nil || results == nil {
+		log.Trace("putResultsInTransaction", "txHash", txHash, "error", err)
+		return
+	}
+
+	tx.GasUsed = tx.GasLimit
+
+	for _, scrHash := range results.ScResultsHashes {
+		scr, errGet := n.getSmartContractResult(scrHash)
+		if errGet != nil {
+			log.Trace("putResultsInTransaction: smart contract result not found", "hash", scrHash, "error", errGet)
+			continue
+		}
+
+		apiScr := n.prepareApiSmartContractResult(scrHash, scr)
+		tx.SmartContractResults = append(tx.SmartContractResults, apiScr)
+
+		if isFailedSmartContractResult(scr) {
+			tx.Status = core.TxStatusFailed
+			continue
+		}
+		isRefundForSender := apiScr.Receiver == tx.Sender && scr.GasLimit > 0 && scr.GasLimit <= tx.GasLimit
+		if isRefundForSender {
+			tx.GasUsed = tx.GasLimit - scr.GasLimit
+		}
+	}
+
+	if len(results.ReceiptHash) == 0 {
+		return
+	}
+
+	rpt, err := n.getReceipt(results.ReceiptHash)
+	if err != nil {
+		log.Trace("putResultsInTransaction: receipt not found", "hash", results.ReceiptHash, "error", err)
+		return
+	}
+
+	tx.Receipt = n.prepareApiReceipt(rpt)
+	if tx.GasPrice == 0 {
+		return
+	}
+
+	gasFromReceiptValue := big.NewInt(0).Div(rpt.Value, big.NewInt(0).SetUint64(tx.GasPrice)).Uint64()
+	if string(rpt.Data) != core.RefundedGasReceiptData {
+		// the receipt of an invalid transaction holds the consumed fee and the reason the transaction is invalid
+		tx.Status = core.TxStatusInvalid
+		tx.GasUsed = gasFromReceiptValue
+		return
+	}
+	if gasFromReceiptValue <= tx.GasLimit {
+		tx.GasUsed = tx.GasLimit - gasFromReceiptValue
+	}
+}
+
+// isFailedSmartContractResult returns true if the smart contract result was generated because the execution of its
+// parent transaction failed. Such a result has the data field @hex(returnCode)@hex(parentTxHash)
+func isFailedSmartContractResult(scr *smartContractResult.SmartContractResult) bool {
+	tokens := strings.Split(string(scr.Data), "@")
+	if len(tokens) != 3 || len(tokens[0]) > 0 {
+		return false
+	}
+
+	return tokens[1] != okReturnCodeHex && tokens[2] == hex.EncodeToString(scr.PrevTxHash)
+}
+
+func (n *Node) getTransactionLogs(txHash []byte) *transaction.ApiLogs {
+	logsBytes, err := n.store.GetStorer(dataRetriever.TxLogsUnit).SearchFirst(txHash)
+	if err != nil {
+		return nil
+	}
+
+	txLog := &transaction.Log{}
+	err = n.internalMarshalizer.Unmarshal(txLog, logsBytes)
+	if err != nil {
+		log.Warn("getTransactionLogs", "txHash", txHash, "error", err.Error())
+		return nil
+	}
+
+	apiLogs := &transaction.ApiLogs{
+		Address: n.addressPubkeyConverter.Encode(txLog.Address),
+		Events:  make([]*transaction.ApiLogEvent, 0, len(txLog.Events)),
+	}
+	for _, event := range txLog.Events {
+		topics := make([]string, 0, len(event.Topics))
+		for _, topic := range event.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		apiLogs.Events = append(apiLogs.Events, &transaction.ApiLogEvent{
+			Address:    hex.EncodeToString(event.Address),
+			Identifier: hex.EncodeToString(event.Identifier),
+			Topics:     topics,
+			Data:       hex.EncodeToString(event.Data),
+		})
+	}
+
+	return apiLogs
+}
+
+func (n *Node) getSmartContractResult(scrHash []byte) (*smartContractResult.SmartContractResult, error) {
+	scrBytes, err := n.store.GetStorer(dataRetriever.UnsignedTransactionUnit).SearchFirst(scrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	scr := &smartContractResult.SmartContractResult{}
+	err = n.internalMarshalizer.Unmarshal(scr, scrBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return scr, nil
+}
+
+// getReceipt fetches a receipt from the storage. The receipts are saved in the same unit as the smart contract results
+func (n *Node) getReceipt(receiptHash []byte) (*receipt.Receipt, error) {
+	receiptBytes, err := n.store.GetStorer(dataRetriever.UnsignedTransactionUnit).SearchFirst(receiptHash)
+	if err != nil {
+		return nil, err
+	}
+
+	rpt := &receipt.Receipt{}
+	err = n.internalMarshalizer.Unmarshal(rpt, receiptBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpt, nil
+}
+
+func (n *Node) prepareApiSmartContractResult(
+	scrHash []byte,
+	scr *smartContractResult.SmartContractResult,
+) *transaction.ApiSmartContractResult {
+	return &transaction.ApiSmartContractResult{
+		Hash:           hex.EncodeToString(scrHash),
+		Nonce:          scr.Nonce,
+		Value:          bigIntToString(scr.Value),
+		Receiver:       n.addressPubkeyConverter.Encode(scr.RcvAddr),
+		Sender:         n.addressPubkeyConverter.Encode(scr.SndAddr),
+		Data:           string(scr.Data),
+		PrevTxHash:     hex.EncodeToString(scr.PrevTxHash),
+		OriginalTxHash: hex.EncodeToString(scr.OriginalTxHash),
+		GasLimit:       scr.GasLimit,
+		GasPrice:       scr.GasPrice,
+		CallType:       int(scr.CallType),
+		ReturnMessage:  string(scr.ReturnMessage),
+	}
+}
+
+func (n *Node) prepareApiReceipt(rpt *receipt.Receipt) *transaction.ApiReceipt {
+	return &transaction.ApiReceipt{
+		Value:  bigIntToString(rpt.Value),
+		Sender: n.addressPubkeyConverter.Encode(rpt.SndAddr),
+		Data:   string(rpt.Data),
+		TxHash: hex.EncodeToString(rpt.TxHash),
+	}
+}
@@ -0,0 +1,202 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/history"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var txHashWithResults = []byte("tx hash")
+var senderWithResults = []byte("sender")
+
+func createNodeWithTransactionResults(
+	t *testing.T,
+	results *history.TransactionResults,
+	units map[dataRetriever.UnitType]map[string][]byte,
+) *node.Node {
+	marshalizer := &mock.MarshalizerFake{}
+	tx := &transaction.Transaction{
+		Nonce:    7,
+		Value:    big.NewInt(0),
+		SndAddr:  senderWithResults,
+		RcvAddr:  []byte("receiver"),
+		GasPrice: 10,
+		GasLimit: 1000,
+	}
+	txBytes, _ := marshalizer.Marshal(tx)
+	units[dataRetriever.TransactionUnit] = map[string][]byte{string(txHashWithResults): txBytes}
+
+	historyRepository := &mock.HistoryRepositoryStub{
+		GetTransactionResultsCalled: func(txHash []byte) (*history.TransactionResults, error) {
+			require.Equal(t, txHashWithResults, txHash)
+			return results, nil
+		},
+	}
+	dataPool := &testscommon.PoolsHolderStub{
+		TransactionsCalled:         getCacherHandler(false, ""),
+		RewardTransactionsCalled:   getCacherHandler(false, ""),
+		UnsignedTransactionsCalled: getCacherHandler(false, ""),
+	}
+
+	n, _ := node.NewNode(
+		node.WithDataPool(dataPool),
+		node.WithDataStore(createBlocksChainStorer(units)),
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+		node.WithHistoryRepository(historyRepository),
+	)
+
+	return n
+}
+
+func marshalForTest(obj interface{}) []byte {
+	buff, _ := (&mock.MarshalizerFake{}).Marshal(obj)
+	return buff
+}
+
+func TestNode_GetTransaction_FailedSmartContractCallShouldReturnResultsAndLogs(t *testing.T) {
+	t.Parallel()
+
+	errorScr := &smartContractResult.SmartContractResult{
+		Value:          big.NewInt(0),
+		RcvAddr:        senderWithResults,
+		SndAddr:        []byte("receiver"),
+		Data:           []byte("@" + hex.EncodeToString([]byte("user error")) + "@" + hex.EncodeToString(txHashWithResults)),
+		PrevTxHash:     txHashWithResults,
+		OriginalTxHash: txHashWithResults,
+		ReturnMessage:  []byte("out of funds"),
+	}
+	txLog := &transaction.Log{
+		Address: []byte("receiver"),
+		Events:  []*transaction.Event{{Identifier: []byte("id"), Topics: [][]byte{[]byte("topic")}}},
+	}
+	units := map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.UnsignedTransactionUnit: {"scr": marshalForTest(errorScr)},
+		dataRetriever.TxLogsUnit:              {string(txHashWithResults): marshalForTest(txLog)},
+	}
+	results := &history.TransactionResults{ScResultsHashes: [][]byte{[]byte("scr"), []byte("missing scr")}}
+	n := createNodeWithTransactionResults(t, results, units)
+
+	tx, err := n.GetTransaction(hex.EncodeToString(txHashWithResults))
+	require.Nil(t, err)
+
+	assert.False(t, tx.ResultsNotAvailable)
+	assert.Equal(t, core.TxStatusFailed, tx.Status)
+	assert.Equal(t, uint64(1000), tx.GasUsed)
+	require.Equal(t, 1, len(tx.SmartContractResults))
+	assert.Equal(t, hex.EncodeToString([]byte("scr")), tx.SmartContractResults[0].Hash)
+	assert.Equal(t, "out of funds", tx.SmartContractResults[0].ReturnMessage)
+	require.NotNil(t, tx.Logs)
+	require.Equal(t, 1, len(tx.Logs.Events))
+	assert.Equal(t, hex.EncodeToString([]byte("id")), tx.Logs.Events[0].Identifier)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("topic"))}, tx.Logs.Events[0].Topics)
+	assert.Nil(t, tx.Receipt)
+}
+
+func TestNode_GetTransaction_SuccessfulSmartContractCallShouldComputeGasUsedFromRefund(t *testing.T) {
+	t.Parallel()
+
+	refundScr := &smartContractResult.SmartContractResult{
+		Value:          big.NewInt(4000),
+		RcvAddr:        senderWithResults,
+		SndAddr:        []byte("receiver"),
+		Data:           []byte("@" + hex.EncodeToString([]byte("ok"))),
+		PrevTxHash:     txHashWithResults,
+		OriginalTxHash: txHashWithResults,
+		GasLimit:       400,
+		GasPrice:       10,
+	}
+	units := map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.UnsignedTransactionUnit: {"scr": marshalForTest(refundScr)},
+	}
+	results := &history.TransactionResults{ScResultsHashes: [][]byte{[]byte("scr")}}
+	n := createNodeWithTransactionResults(t, results, units)
+
+	tx, err := n.GetTransaction(hex.EncodeToString(txHashWithResults))
+	require.Nil(t, err)
+
+	assert.Equal(t, core.TxStatusExecuted, tx.Status)
+	assert.Equal(t, uint64(600), tx.GasUsed)
+	assert.Equal(t, 1, len(tx.SmartContractResults))
+	assert.Nil(t, tx.Logs)
+}
+
+func TestNode_GetTransaction_InvalidTransactionShouldReturnReceipt(t *testing.T) {
+	t.Parallel()
+
+	rpt := &receipt.Receipt{
+		Value:   big.NewInt(500),
+		SndAddr: senderWithResults,
+		Data:    []byte("insufficient funds"),
+		TxHash:  txHashWithResults,
+	}
+	units := map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.UnsignedTransactionUnit: {"receipt": marshalForTest(rpt)},
+	}
+	results := &history.TransactionResults{ReceiptHash: []byte("receipt")}
+	n := createNodeWithTransactionResults(t, results, units)
+
+	tx, err := n.GetTransaction(hex.EncodeToString(txHashWithResults))
+	require.Nil(t, err)
+
+	assert.Equal(t, core.TxStatusInvalid, tx.Status)
+	assert.Equal(t, uint64(50), tx.GasUsed)
+	require.NotNil(t, tx.Receipt)
+	assert.Equal(t, "500", tx.Receipt.Value)
+	assert.Equal(t, "insufficient funds", tx.Receipt.Data)
+	assert.Equal(t, hex.EncodeToString(txHashWithResults), tx.Receipt.TxHash)
+}
+
+func TestNode_GetTransaction_MoveBalanceWithRefundReceiptShouldComputeGasUsed(t *testing.T) {
+	t.Parallel()
+
+	rpt := &receipt.Receipt{
+		Value:   big.NewInt(3000),
+		SndAddr: senderWithResults,
+		Data:    []byte(core.RefundedGasReceiptData),
+		TxHash:  txHashWithResults,
+	}
+	units := map[dataRetriever.UnitType]map[string][]byte{
+		dataRetriever.UnsignedTransactionUnit: {"receipt": marshalForTest(rpt)},
+	}
+	results := &history.TransactionResults{ReceiptHash: []byte("receipt")}
+	n := createNodeWithTransactionResults(t, results, units)
+
+	tx, err := n.GetTransaction(hex.EncodeToString(txHashWithResults))
+	require.Nil(t, err)
+
+	assert.Equal(t, core.TxStatusExecuted, tx.Status)
+	assert.Equal(t, uint64(700), tx.GasUsed)
+	require.NotNil(t, tx.Receipt)
+	assert.Equal(t, core.RefundedGasReceiptData, tx.Receipt.Data)
+}
+
+func TestNode_GetTransaction_HistoryDisabledShouldNotReturnResults(t *testing.T) {
+	t.Parallel()
+
+	units := make(map[dataRetriever.UnitType]map[string][]byte)
+	n := createNodeWithTransactionResults(t, nil, units)
+	_ = n.ApplyOptions(node.WithHistoryRepository(history.NewDisabledHistoryRepository()))
+
+	tx, err := n.GetTransaction(hex.EncodeToString(txHashWithResults))
+	require.Nil(t, err)
+
+	assert.Equal(t, core.TxStatusExecuted, tx.Status)
+	assert.Zero(t, tx.GasUsed)
+	assert.Nil(t, tx.SmartContractResults)
+	assert.Nil(t, tx.Receipt)
+	assert.True(t, tx.ResultsNotAvailable)
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/data"
 	rewardTxData "github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 type transactionType string
@@ -22,7 +25,8 @@ const (
 )
 
 // GetTransaction gets the transaction based on the given hash. It will search in the cache and the storage and
-// will return the transaction in a format which can be respected by all types of transactions (normal, reward or unsigned)
+// will return the transaction in a format which can be respected by all types of transactions (normal, reward or unsigned).
+// An executed normal transaction also contains its logs, smart contract results, receipt and gas used
 func (n *Node) GetTransaction(txHash string) (*transaction.ApiTransactionResult, error) {
 	hash, err := hex.DecodeString(txHash)
 	if err != nil {
@@ -35,11 +39,84 @@ func (n *Node) GetTransaction(txHash string) (*transaction.ApiTransactionResult,
 	}
 
 	txBytes, txType, found := n.getTxBytesFromStorage(hash)
-	if found {
-		return n.unmarshalTransaction(txBytes, txType)
+	if !found {
+		return nil, fmt.Errorf("transaction not found")
+	}
+
+	tx, err := n.unmarshalTransaction(txBytes, txType)
+	if err != nil {
+		return nil, err
+	}
+	if txType == normalTx {
+		n.putResultsInTransaction(hash, tx)
+	}
+
+	return tx, nil
+}
+
+// GetTransactionsByAddress returns a page of the transactions in which the given address was involved, from the
+// newest to the oldest. The transactions are fetched from the local address transactions history
+func (n *Node) GetTransactionsByAddress(address string, page uint32, pageSize uint32) ([]*transaction.ApiTransactionResult, error) {
+	if check.IfNil(n.historyRepository) || !n.historyRepository.IsEnabled() {
+		return nil, history.ErrHistoryRepositoryDisabled
 	}
 
-	return nil, fmt.Errorf("transaction not found")
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := n.historyRepository.GetTransactions(addressBytes, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*transaction.ApiTransactionResult, 0, len(entries))
+	for _, entry := range entries {
+		tx, errGet := n.getHistoryTransaction(entry)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		transactions = append(transactions, tx)
+	}
+
+	return transactions, nil
+}
+
+func (n *Node) getHistoryTransaction(entry *history.TransactionEntry) (*transaction.ApiTransactionResult, error) {
+	txType := transactionType(entry.TxType)
+	tx := &transaction.ApiTransactionResult{Type: string(txType)}
+
+	// the transaction might have been removed from the storage by the pruning mechanism, in which case only the
+	// history data is returned
+	txBytes, err := n.getStorerForTxType(txType).SearchFirst(entry.TxHash)
+	if err == nil {
+		tx, err = n.unmarshalTransaction(txBytes, txType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx.Hash = hex.EncodeToString(entry.TxHash)
+	tx.BlockNonce = entry.BlockNonce
+	tx.BlockHash = hex.EncodeToString(entry.BlockHash)
+	tx.Round = entry.Round
+	tx.Epoch = entry.Epoch
+	tx.Timestamp = entry.Timestamp
+
+	return tx, nil
+}
+
+func (n *Node) getStorerForTxType(txType transactionType) storage.Storer {
+	switch txType {
+	case rewardTx:
+		return n.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	case unsignedTx:
+		return n.store.GetStorer(dataRetriever.UnsignedTransactionUnit)
+	default:
+		return n.store.GetStorer(dataRetriever.TransactionUnit)
+	}
 }
 
 func (n *Node) getTxObjFromDataPool(hash []byte) (interface{}, transactionType, bool) {
@@ -0,0 +1,90 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// GetTransactionsPoolForSender returns the pending transactions of a sender, sorted by nonce, along with the nonce
+// gaps and the score of the sender. Only the senders from the self shard can be inspected
+func (n *Node) GetTransactionsPoolForSender(sender string) (*transaction.ApiSenderTransactionsPool, error) {
+	senderBytes, err := n.addressPubkeyConverter.Decode(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	txPool, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	senderPool := &transaction.ApiSenderTransactionsPool{
+		Sender:       sender,
+		Transactions: make([]*transaction.ApiPendingTransaction, 0),
+		Nonces:       make([]uint64, 0),
+		Gaps:         make([]*transaction.ApiNonceGap, 0),
+	}
+
+	info, found := txPool.GetSenderInfo(senderBytes)
+	if !found {
+		return senderPool, nil
+	}
+
+	senderPool.AccountNonce = info.AccountNonce
+	senderPool.AccountNonceKnown = info.AccountNonceKnown
+	senderPool.Score = info.Score
+	senderPool.NumFailedSelections = info.NumFailedSelections
+	senderPool.IsInGracePeriod = info.IsInGracePeriod
+	senderPool.NumBytes = info.NumBytes
+	for _, tx := range info.Transactions {
+		senderPool.Transactions = append(senderPool.Transactions, &transaction.ApiPendingTransaction{
+			Hash:     hex.EncodeToString(tx.TxHash),
+			Nonce:    tx.Nonce,
+			GasPrice: tx.GasPrice,
+			GasLimit: tx.GasLimit,
+		})
+		senderPool.Nonces = append(senderPool.Nonces, tx.Nonce)
+	}
+	for _, gap := range info.Gaps {
+		senderPool.Gaps = append(senderPool.Gaps, &transaction.ApiNonceGap{
+			From: gap.From,
+			To:   gap.To,
+		})
+	}
+
+	return senderPool, nil
+}
+
+// GetTransactionsPoolStatistics returns the summary statistics of each cache of the transactions pool
+func (n *Node) GetTransactionsPoolStatistics() ([]*transaction.ApiTransactionsPoolCache, error) {
+	txPool, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	cachesStatistics := txPool.GetCachesStatistics()
+	caches := make([]*transaction.ApiTransactionsPoolCache, 0, len(cachesStatistics))
+	for _, statistics := range cachesStatistics {
+		caches = append(caches, &transaction.ApiTransactionsPoolCache{
+			CacheID:           statistics.Name,
+			NumTxs:            statistics.NumTxs,
+			NumBytes:          statistics.NumBytes,
+			NumSenders:        statistics.NumSenders,
+			NumEvictedTxs:     statistics.NumEvictedTxs,
+			NumEvictedSenders: statistics.NumEvictedSenders,
+			NumReplacedTxs:    statistics.NumReplacedTxs,
+		})
+	}
+
+	return caches, nil
+}
+
+func (n *Node) getTransactionsPoolInspector() (TransactionsPoolInspector, error) {
+	txPool, ok := n.dataPool.Transactions().(TransactionsPoolInspector)
+	if !ok {
+		return nil, ErrTransactionsPoolInspectionNotSupported
+	}
+
+	return txPool, nil
+}
@@ -0,0 +1,118 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithTransactionsPool(t *testing.T) (*node.Node, dataRetriever.ShardedDataCacherNotifier) {
+	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		MinGasPrice:    200000000000,
+		NumberOfShards: 2,
+		SelfShardID:    0,
+	})
+	require.Nil(t, err)
+
+	dataPool := &testscommon.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return txPool
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithDataPool(dataPool),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+	)
+
+	return n, txPool
+}
+
+func TestNode_GetTransactionsPoolForSenderShouldReturnNoncesAndGaps(t *testing.T) {
+	t.Parallel()
+
+	n, txPool := createNodeWithTransactionsPool(t)
+	sender := []byte("sender")
+	txPool.AddData([]byte("hash-5"), &transaction.Transaction{SndAddr: sender, Nonce: 5, GasPrice: 10, GasLimit: 50000}, 100, "0")
+	txPool.AddData([]byte("hash-8"), &transaction.Transaction{SndAddr: sender, Nonce: 8, GasPrice: 10, GasLimit: 50000}, 100, "0_1")
+
+	senderPool, err := n.GetTransactionsPoolForSender(hex.EncodeToString(sender))
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString(sender), senderPool.Sender)
+	assert.Equal(t, []uint64{5, 8}, senderPool.Nonces)
+	require.Equal(t, 2, len(senderPool.Transactions))
+	assert.Equal(t, hex.EncodeToString([]byte("hash-5")), senderPool.Transactions[0].Hash)
+	assert.Equal(t, uint64(50000), senderPool.Transactions[0].GasLimit)
+	assert.Equal(t, []*transaction.ApiNonceGap{{From: 6, To: 7}}, senderPool.Gaps)
+	assert.Equal(t, uint64(200), senderPool.NumBytes)
+}
+
+func TestNode_GetTransactionsPoolForSenderUnknownSenderShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithTransactionsPool(t)
+
+	senderPool, err := n.GetTransactionsPoolForSender(hex.EncodeToString([]byte("unknown")))
+	require.Nil(t, err)
+
+	assert.Empty(t, senderPool.Transactions)
+	assert.Empty(t, senderPool.Nonces)
+	assert.Empty(t, senderPool.Gaps)
+}
+
+func TestNode_GetTransactionsPoolForSenderInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithTransactionsPool(t)
+
+	senderPool, err := n.GetTransactionsPoolForSender("not hex")
+	assert.Nil(t, senderPool)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetTransactionsPoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	n, txPool := createNodeWithTransactionsPool(t)
+	txPool.AddData([]byte("hash-a"), &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 1}, 100, "0")
+	txPool.AddData([]byte("hash-b"), &transaction.Transaction{SndAddr: []byte("bob"), Nonce: 1}, 100, "0")
+	txPool.AddData([]byte("hash-c"), &transaction.Transaction{SndAddr: []byte("carol"), Nonce: 1}, 100, "1_0")
+
+	caches, err := n.GetTransactionsPoolStatistics()
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(caches))
+	assert.Equal(t, &transaction.ApiTransactionsPoolCache{CacheID: "0", NumTxs: 2, NumBytes: 200, NumSenders: 2}, caches[0])
+	assert.Equal(t, &transaction.ApiTransactionsPoolCache{CacheID: "1_0", NumTxs: 1, NumBytes: 100}, caches[1])
+}
+
+func TestNode_GetTransactionsPoolStatisticsNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dataPool := &testscommon.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return testscommon.NewShardedDataStub()
+		},
+	}
+	n, _ := node.NewNode(node.WithDataPool(dataPool))
+
+	caches, err := n.GetTransactionsPoolStatistics()
+	assert.Nil(t, caches)
+	assert.Equal(t, node.ErrTransactionsPoolInspectionNotSupported, err)
+}
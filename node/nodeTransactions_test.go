@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -16,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNode_GetTransaction_InvalidHashShouldErr(t *testing.T) {
@@ -227,6 +229,64 @@ func TestNode_GetTransaction_ShouldNotFindAndReturnUnknown(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestNode_GetTransactionsByAddress_HistoryDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithHistoryRepository(history.NewDisabledHistoryRepository()),
+	)
+	txs, err := n.GetTransactionsByAddress("aaaa", 0, 10)
+	assert.Nil(t, txs)
+	assert.Equal(t, history.ErrHistoryRepositoryDisabled, err)
+}
+
+func TestNode_GetTransactionsByAddress_ShouldReturnTransactionsWithHistoryData(t *testing.T) {
+	t.Parallel()
+
+	historyRepository := &mock.HistoryRepositoryStub{
+		GetTransactionsCalled: func(address []byte, page uint32, pageSize uint32) ([]*history.TransactionEntry, error) {
+			assert.Equal(t, []byte{0xaa, 0xaa}, address)
+			assert.Equal(t, uint32(1), page)
+			assert.Equal(t, uint32(10), pageSize)
+
+			return []*history.TransactionEntry{
+				{TxHash: []byte("tx"), TxType: history.NormalTx, BlockHash: []byte("block"), BlockNonce: 7, Round: 8, Epoch: 1, Timestamp: 9},
+				{TxHash: []byte("reward"), TxType: history.RewardTx, BlockNonce: 6},
+			}, nil
+		},
+	}
+	storer := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return getStorerStub(unitType == dataRetriever.TransactionUnit)
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithDataStore(storer),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 0),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
+		node.WithHistoryRepository(historyRepository),
+	)
+
+	txs, err := n.GetTransactionsByAddress("aaaa", 1, 10)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(txs))
+
+	expectedTx, _ := getDummyNormalTx()
+	assert.Equal(t, expectedTx.Nonce, txs[0].Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("tx")), txs[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("block")), txs[0].BlockHash)
+	assert.Equal(t, uint64(7), txs[0].BlockNonce)
+	assert.Equal(t, uint64(8), txs[0].Round)
+	assert.Equal(t, uint32(1), txs[0].Epoch)
+	assert.Equal(t, uint64(9), txs[0].Timestamp)
+
+	assert.Equal(t, history.RewardTx, txs[1].Type)
+	assert.Equal(t, hex.EncodeToString([]byte("reward")), txs[1].Hash)
+	assert.Equal(t, uint64(6), txs[1].BlockNonce)
+}
+
 func TestNode_ComputeTransactionStatus(t *testing.T) {
 	t.Parallel()
 
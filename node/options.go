@@ -8,7 +8,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -429,6 +432,61 @@ func WithIndexer(indexer indexer.Indexer) Option {
 	}
 }
 
+// WithHistoryRepository sets up the address transactions history repository for the Node
+func WithHistoryRepository(historyRepository history.HistoryRepository) Option {
+	return func(n *Node) error {
+		if check.IfNil(historyRepository) {
+			return ErrNilHistoryRepository
+		}
+		n.historyRepository = historyRepository
+		return nil
+	}
+}
+
+// WithLogsIndex sets up the log events index for the Node
+func WithLogsIndex(logsIdx logsIndex.LogsIndex) Option {
+	return func(n *Node) error {
+		if check.IfNil(logsIdx) {
+			return ErrNilLogsIndex
+		}
+		n.logsIndex = logsIdx
+		return nil
+	}
+}
+
+// WithEventsHub sets up the events hub which pushes the chain events to the websocket subscribers
+func WithEventsHub(eventsHub events.EventsHub) Option {
+	return func(n *Node) error {
+		if check.IfNil(eventsHub) {
+			return ErrNilEventsHub
+		}
+		n.eventsHub = eventsHub
+		return nil
+	}
+}
+
+// WithTxPoolJournal sets up the journal which persists the pending transactions across restarts
+func WithTxPoolJournal(txPoolJournal TxPoolJournal) Option {
+	return func(n *Node) error {
+		if check.IfNil(txPoolJournal) {
+			return ErrNilTxPoolJournal
+		}
+		n.txPoolJournal = txPoolJournal
+		return nil
+	}
+}
+
+// WithKeysHandler sets up the handler of the BLS keys hosted by the Node
+func WithKeysHandler(keysHandler KeysHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(keysHandler) {
+			return ErrNilKeysHandler
+		}
+		n.keysHandler = keysHandler
+		return nil
+	}
+}
+
 // WithBlockBlackListHandler sets up a block black list handler for the Node
 func WithBlockBlackListHandler(blackListHandler process.TimeCacher) Option {
 	return func(n *Node) error {
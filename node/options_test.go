@@ -1127,6 +1127,78 @@ func TestWithBlockBlackListHandler_OkHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithHistoryRepository_NilHistoryRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithHistoryRepository(nil)
+	err := opt(node)
+
+	assert.True(t, errors.Is(err, ErrNilHistoryRepository))
+}
+
+func TestWithHistoryRepository_OkRepositoryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	historyRepository := &mock.HistoryRepositoryStub{}
+	opt := WithHistoryRepository(historyRepository)
+	err := opt(node)
+
+	assert.True(t, node.historyRepository == historyRepository)
+	assert.Nil(t, err)
+}
+
+func TestWithLogsIndex_NilLogsIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithLogsIndex(nil)
+	err := opt(node)
+
+	assert.True(t, errors.Is(err, ErrNilLogsIndex))
+}
+
+func TestWithLogsIndex_OkIndexShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	logsIdx := &mock.LogsIndexStub{}
+	opt := WithLogsIndex(logsIdx)
+	err := opt(node)
+
+	assert.True(t, node.logsIndex == logsIdx)
+	assert.Nil(t, err)
+}
+
+func TestWithEventsHub_NilEventsHubShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEventsHub(nil)
+	err := opt(node)
+
+	assert.True(t, errors.Is(err, ErrNilEventsHub))
+}
+
+func TestWithEventsHub_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	eventsHub := &mock.EventsHubStub{}
+	opt := WithEventsHub(eventsHub)
+	err := opt(node)
+
+	assert.True(t, node.eventsHub == eventsHub)
+	assert.Nil(t, err)
+}
+
 func TestWithPeerDenialEvaluator_NilBlackListHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1401,3 +1473,51 @@ func TestWithPeerSignatureHandler_OkPeerSignatureHandlerShouldWork(t *testing.T)
 	assert.Equal(t, peerSigHandler, node.peerSigHandler)
 	assert.Nil(t, err)
 }
+
+func TestWithTxPoolJournal_NilTxPoolJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTxPoolJournal(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilTxPoolJournal, err)
+}
+
+func TestWithTxPoolJournal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	txPoolJournal := &mock.TxPoolJournalStub{}
+	opt := WithTxPoolJournal(txPoolJournal)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.txPoolJournal == txPoolJournal)
+}
+
+func TestWithKeysHandler_NilKeysHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithKeysHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilKeysHandler, err)
+}
+
+func TestWithKeysHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	keysHandler := &mock.KeysHandlerStub{}
+	opt := WithKeysHandler(keysHandler)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.True(t, node.keysHandler == keysHandler)
+}
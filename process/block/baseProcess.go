@@ -11,6 +11,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/history"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
+	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -1077,6 +1082,128 @@ func (bp *baseProcessor) commitAll() error {
 	return nil
 }
 
+// getModifiedAccountsForIndexer returns the user accounts modified by the block which is about to be committed. It
+// has to be called before the commit, as the commit clears the journal the modified accounts are read from
+func (bp *baseProcessor) getModifiedAccountsForIndexer(coreServices serviceContainer.Core) []*state.ModifiedAccount {
+	if check.IfNil(coreServices) || check.IfNil(coreServices.Indexer()) || coreServices.Indexer().IsNilIndexer() {
+		return nil
+	}
+
+	userAccountsDB, ok := bp.accountsDB[state.UserAccountsState]
+	if !ok || check.IfNil(userAccountsDB) {
+		return nil
+	}
+
+	return userAccountsDB.GetModifiedAccounts()
+}
+
+// indexModifiedAccounts hands the modified accounts to the indexer, which reads their state from the committed root
+// hash on its own go routine, after the block commit has finished
+func (bp *baseProcessor) indexModifiedAccounts(
+	coreServices serviceContainer.Core,
+	blockNonce uint64,
+	rootHash []byte,
+	modifiedAccounts []*state.ModifiedAccount,
+) {
+	if len(modifiedAccounts) == 0 {
+		return
+	}
+
+	userAccountsDB := bp.accountsDB[state.UserAccountsState]
+	coreServices.Indexer().SaveAccounts(blockNonce, func() []*indexer.UpdatedAccount {
+		return bp.loadUpdatedAccounts(userAccountsDB, rootHash, modifiedAccounts)
+	})
+}
+
+func (bp *baseProcessor) loadUpdatedAccounts(
+	userAccountsDB state.AccountsAdapter,
+	rootHash []byte,
+	modifiedAccounts []*state.ModifiedAccount,
+) []*indexer.UpdatedAccount {
+	tr, err := userAccountsDB.GetTrie(rootHash)
+	if err != nil {
+		log.Debug("indexModifiedAccounts: cannot recreate the state trie",
+			"root hash", rootHash,
+			"error", err.Error())
+		return nil
+	}
+
+	esdtKeyPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	updatedAccounts := make([]*indexer.UpdatedAccount, 0, len(modifiedAccounts))
+	for _, modifiedAccount := range modifiedAccounts {
+		userAccount, errLoad := bp.loadUserAccount(tr, modifiedAccount.Address)
+		if errLoad != nil {
+			log.Trace("indexModifiedAccounts: cannot load account",
+				"address", modifiedAccount.Address,
+				"error", errLoad.Error())
+			continue
+		}
+
+		updatedAccount := &indexer.UpdatedAccount{
+			Address:  modifiedAccount.Address,
+			Balance:  userAccount.GetBalance(),
+			Nonce:    userAccount.GetNonce(),
+			ESDTData: make(map[string][]byte),
+		}
+
+		for _, key := range modifiedAccount.DataTrieKeys {
+			if !bytes.HasPrefix(key, esdtKeyPrefix) || len(key) == len(esdtKeyPrefix) {
+				continue
+			}
+
+			value, errRetrieve := userAccount.DataTrieTracker().RetrieveValue(key)
+			if errRetrieve != nil {
+				log.Trace("indexModifiedAccounts: cannot read ESDT data",
+					"address", modifiedAccount.Address,
+					"key", key,
+					"error", errRetrieve.Error())
+				continue
+			}
+
+			updatedAccount.ESDTData[string(key[len(esdtKeyPrefix):])] = value
+		}
+
+		updatedAccounts = append(updatedAccounts, updatedAccount)
+	}
+
+	return updatedAccounts
+}
+
+// loadUserAccount reads the user account with the provided address, along with its data trie, from the provided
+// state trie
+func (bp *baseProcessor) loadUserAccount(tr data.Trie, address []byte) (state.UserAccountHandler, error) {
+	value, err := tr.Get(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, state.ErrAccNotFound
+	}
+
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bp.marshalizer.Unmarshal(account, value)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieRootHash := account.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return account, nil
+	}
+
+	dataTrie, err := tr.Recreate(dataTrieRootHash)
+	if err != nil {
+		return nil, err
+	}
+	account.SetDataTrie(dataTrie)
+
+	return account, nil
+}
+
 // PruneStateOnRollback recreates the state tries to the root hashes indicated by the provided header
 func (bp *baseProcessor) PruneStateOnRollback(currHeader data.HeaderHandler, prevHeader data.HeaderHandler) {
 	for key := range bp.accountsDB {
@@ -1186,3 +1313,65 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 
 	bp.txCoordinator.RequestMiniBlocks(headerHandler)
 }
+
+// recordBlockInHistory saves the transactions of the given block types, used by the committed block, in the
+// address transactions history
+func (bp *baseProcessor) recordBlockInHistory(
+	historyRepository history.HistoryRepository,
+	headerHash []byte,
+	header data.HeaderHandler,
+	blockTypes ...block.Type,
+) {
+	if check.IfNil(historyRepository) || !historyRepository.IsEnabled() {
+		return
+	}
+
+	err := historyRepository.RecordBlock(headerHash, header, bp.getAllCurrentUsedTxs(blockTypes...))
+	if err != nil {
+		log.Warn("recordBlockInHistory", "nonce", header.GetNonce(), "error", err.Error())
+	}
+}
+
+// recordBlockLogs indexes the log events generated by the transactions of the given block types, used by the
+// committed block
+func (bp *baseProcessor) recordBlockLogs(
+	logsIdx logsIndex.LogsIndex,
+	headerHash []byte,
+	header data.HeaderHandler,
+	blockTypes ...block.Type,
+) {
+	if check.IfNil(logsIdx) || !logsIdx.IsEnabled() {
+		return
+	}
+
+	err := logsIdx.RecordBlock(headerHash, header, bp.getAllCurrentUsedTxs(blockTypes...))
+	if err != nil {
+		log.Warn("recordBlockLogs", "nonce", header.GetNonce(), "error", err.Error())
+	}
+}
+
+// notifyBlockCommitted pushes the committed block, together with its transactions of the given block types, to the
+// events hub subscribers
+func (bp *baseProcessor) notifyBlockCommitted(
+	eventsHub events.EventsHub,
+	headerHash []byte,
+	header data.HeaderHandler,
+	blockTypes ...block.Type,
+) {
+	if check.IfNil(eventsHub) || !eventsHub.HasSubscribers() {
+		return
+	}
+
+	eventsHub.NotifyBlockCommitted(headerHash, header, bp.getAllCurrentUsedTxs(blockTypes...))
+}
+
+func (bp *baseProcessor) getAllCurrentUsedTxs(blockTypes ...block.Type) map[string]data.TransactionHandler {
+	txPool := make(map[string]data.TransactionHandler)
+	for _, blockType := range blockTypes {
+		for hash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txPool[hash] = tx
+		}
+	}
+
+	return txPool
+}
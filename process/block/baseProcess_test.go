@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -29,6 +30,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func haveTime() time.Duration {
@@ -1110,3 +1112,125 @@ func TestBlocProcessor_RequestHeadersIfMissingShouldWork(t *testing.T) {
 	expectedNonces = []uint64{6, 7, 9, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}
 	assert.Equal(t, expectedNonces, requestedNonces)
 }
+
+func TestBlockProcessor_NotifyBlockCommittedShouldNotCollectTxsWithoutSubscribers(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			assert.Fail(t, "should have not collected the used transactions")
+			return nil
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	eventsHub := &mock.EventsHubStub{
+		NotifyBlockCommittedCalled: func(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) {
+			assert.Fail(t, "should have not notified")
+		},
+	}
+
+	bp.NotifyBlockCommitted(nil, []byte("hash"), &block.Header{}, block.TxBlock)
+	bp.NotifyBlockCommitted(eventsHub, []byte("hash"), &block.Header{}, block.TxBlock)
+}
+
+func TestBlockProcessor_NotifyBlockCommittedShouldPushTheUsedTxsOfTheGivenTypes(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			switch blockType {
+			case block.TxBlock:
+				return map[string]data.TransactionHandler{"tx": &transaction.Transaction{Nonce: 1}}
+			case block.SmartContractResultBlock:
+				return map[string]data.TransactionHandler{"scr": &smartContractResult.SmartContractResult{Nonce: 2}}
+			default:
+				return map[string]data.TransactionHandler{"other": &transaction.Transaction{Nonce: 3}}
+			}
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	header := &block.Header{Nonce: 5}
+	var notifiedTxPool map[string]data.TransactionHandler
+	eventsHub := &mock.EventsHubStub{
+		HasSubscribersCalled: func() bool {
+			return true
+		},
+		NotifyBlockCommittedCalled: func(headerHash []byte, hdr data.HeaderHandler, txPool map[string]data.TransactionHandler) {
+			assert.Equal(t, []byte("hash"), headerHash)
+			assert.Equal(t, header, hdr)
+			notifiedTxPool = txPool
+		},
+	}
+
+	bp.NotifyBlockCommitted(eventsHub, []byte("hash"), header, block.TxBlock, block.SmartContractResultBlock)
+
+	require.Equal(t, 2, len(notifiedTxPool))
+	assert.NotNil(t, notifiedTxPool["tx"])
+	assert.NotNil(t, notifiedTxPool["scr"])
+}
+
+func TestBlockProcessor_RecordBlockLogsShouldNotCollectTxsIfTheIndexIsDisabled(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			assert.Fail(t, "should have not collected the used transactions")
+			return nil
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	logsIdx := &mock.LogsIndexStub{
+		RecordBlockCalled: func(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) error {
+			assert.Fail(t, "should have not recorded the block")
+			return nil
+		},
+		IsEnabledCalled: func() bool {
+			return false
+		},
+	}
+
+	bp.RecordBlockLogs(nil, []byte("hash"), &block.Header{}, block.TxBlock)
+	bp.RecordBlockLogs(logsIdx, []byte("hash"), &block.Header{}, block.TxBlock)
+}
+
+func TestBlockProcessor_RecordBlockLogsShouldRecordTheUsedTxsOfTheGivenTypes(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			switch blockType {
+			case block.TxBlock:
+				return map[string]data.TransactionHandler{"tx": &transaction.Transaction{Nonce: 1}}
+			case block.SmartContractResultBlock:
+				return map[string]data.TransactionHandler{"scr": &smartContractResult.SmartContractResult{Nonce: 2}}
+			default:
+				return map[string]data.TransactionHandler{"other": &transaction.Transaction{Nonce: 3}}
+			}
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	header := &block.Header{Nonce: 5}
+	var recordedTxPool map[string]data.TransactionHandler
+	logsIdx := &mock.LogsIndexStub{
+		RecordBlockCalled: func(headerHash []byte, hdr data.HeaderHandler, txPool map[string]data.TransactionHandler) error {
+			assert.Equal(t, []byte("hash"), headerHash)
+			assert.Equal(t, header, hdr)
+			recordedTxPool = txPool
+			return errors.New("should only be logged")
+		},
+	}
+
+	bp.RecordBlockLogs(logsIdx, []byte("hash"), header, block.TxBlock, block.SmartContractResultBlock)
+
+	require.Equal(t, 2, len(recordedTxPool))
+	assert.NotNil(t, recordedTxPool["tx"])
+	assert.NotNil(t, recordedTxPool["scr"])
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/core/logsIndex"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -353,3 +355,21 @@ func (sp *shardProcessor) GetBootstrapHeadersInfo(
 ) []bootstrapStorage.BootstrapHeaderInfo {
 	return sp.getBootstrapHeadersInfo(selfNotarizedHeaders, selfNotarizedHeadersHashes)
 }
+
+func (bp *baseProcessor) NotifyBlockCommitted(
+	eventsHub events.EventsHub,
+	headerHash []byte,
+	header data.HeaderHandler,
+	blockTypes ...block.Type,
+) {
+	bp.notifyBlockCommitted(eventsHub, headerHash, header, blockTypes...)
+}
+
+func (bp *baseProcessor) RecordBlockLogs(
+	logsIdx logsIndex.LogsIndex,
+	headerHash []byte,
+	header data.HeaderHandler,
+	blockTypes ...block.Type,
+) {
+	bp.recordBlockLogs(logsIdx, headerHash, header, blockTypes...)
+}
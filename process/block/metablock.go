@@ -365,6 +365,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.validatorStatisticsProcessor.ProcessChangedKeysEndOfEpoch(allValidatorsInfo)
+	if err != nil {
+		return err
+	}
+
 	err = mp.validatorInfoCreator.VerifyValidatorInfoMiniBlocks(body.MiniBlocks, allValidatorsInfo)
 	if err != nil {
 		return err
@@ -736,6 +741,11 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 		return nil, err
 	}
 
+	err = mp.validatorStatisticsProcessor.ProcessChangedKeysEndOfEpoch(allValidatorsInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	validatorMiniBlocks, err := mp.validatorInfoCreator.CreateValidatorInfoMiniBlocks(allValidatorsInfo)
 	if err != nil {
 		return nil, err
@@ -1027,11 +1037,14 @@ func (mp *metaProcessor) CommitBlock(
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body)
 
+	modifiedAccounts := mp.getModifiedAccountsForIndexer(mp.core)
 	err = mp.commitAll()
 	if err != nil {
 		return err
 	}
 
+	mp.indexModifiedAccounts(mp.core, header.GetNonce(), header.GetRootHash(), modifiedAccounts)
+
 	mp.validatorStatisticsProcessor.DisplayRatings(header.GetEpoch())
 
 	err = mp.saveLastNotarizedHeader(header)
@@ -1091,6 +1104,11 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	mp.indexBlock(header, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
+	if !check.IfNil(mp.core) {
+		mp.recordBlockInHistory(mp.core.HistoryRepository(), headerHash, header, block.TxBlock, block.SmartContractResultBlock)
+		mp.recordBlockLogs(mp.core.LogsIndex(), headerHash, header, block.TxBlock, block.SmartContractResultBlock)
+		mp.notifyBlockCommitted(mp.core.EventsHub(), headerHash, header, block.TxBlock, block.SmartContractResultBlock)
+	}
 
 	saveMetachainCommitBlockMetrics(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator)
 
@@ -96,6 +96,10 @@ func NewTxsPoolsCleaner(
 	tpc.rewardTransactionsPool.RegisterOnAdded(tpc.receivedRewardTx)
 	tpc.unsignedTransactionsPool.RegisterOnAdded(tpc.receivedUnsignedTx)
 
+	tpc.blockTransactionsPool.RegisterOnRemoved(tpc.removedTx)
+	tpc.rewardTransactionsPool.RegisterOnRemoved(tpc.removedTx)
+	tpc.unsignedTransactionsPool.RegisterOnRemoved(tpc.removedTx)
+
 	tpc.emptyAddress = make([]byte, tpc.addressPubkeyConverter.Len())
 
 	return &tpc, nil
@@ -177,6 +181,18 @@ func (tpc *txsPoolsCleaner) receivedUnsignedTx(key []byte, value interface{}) {
 	tpc.processReceivedTx(key, senderShardID, receiverShardID, unsignedTx)
 }
 
+func (tpc *txsPoolsCleaner) removedTx(key []byte, _ interface{}) {
+	if key == nil {
+		return
+	}
+
+	log.Trace("txsPoolsCleaner.removedTx", "hash", key)
+
+	tpc.mutMapTxsRounds.Lock()
+	delete(tpc.mapTxsRounds, string(key))
+	tpc.mutMapTxsRounds.Unlock()
+}
+
 func (tpc *txsPoolsCleaner) processReceivedTx(
 	key []byte,
 	senderShardID uint32,
@@ -263,6 +263,40 @@ func TestReceivedUnsignedTx_ShouldBeAddedInMapTxsRounds(t *testing.T) {
 	assert.NotNil(t, txsPoolsCleaner.mapTxsRounds[string(txKey)])
 }
 
+func TestRemovedTx_ShouldBeRemovedFromMapTxsRounds(t *testing.T) {
+	t.Parallel()
+
+	var onRemoved func(key []byte, value interface{})
+	txsPoolsCleaner, _ := NewTxsPoolsCleaner(
+		&mock.PubkeyConverterStub{},
+		&testscommon.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{
+					ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+						return testscommon.NewCacherMock()
+					},
+					RegisterOnRemovedCalled: func(handler func(key []byte, value interface{})) {
+						onRemoved = handler
+					},
+				}
+			},
+		},
+		&mock.RounderMock{},
+		&mock.CoordinatorStub{},
+	)
+
+	txWrap := &txcache.WrappedTransaction{
+		Tx:            &transaction.Transaction{},
+		SenderShardID: 2,
+	}
+	txBlockKey := []byte("key")
+	txsPoolsCleaner.receivedBlockTx(txBlockKey, txWrap)
+	assert.NotNil(t, txsPoolsCleaner.mapTxsRounds[string(txBlockKey)])
+
+	onRemoved(txBlockKey, txWrap)
+	assert.Nil(t, txsPoolsCleaner.mapTxsRounds[string(txBlockKey)])
+}
+
 func TestCleanTxsPoolsIfNeeded_CannotFindTxInPoolShouldBeRemovedFromMap(t *testing.T) {
 	t.Parallel()
 
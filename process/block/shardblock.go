@@ -806,11 +806,14 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
+	modifiedAccounts := sp.getModifiedAccountsForIndexer(sp.core)
 	err = sp.commitAll()
 	if err != nil {
 		return err
 	}
 
+	sp.indexModifiedAccounts(sp.core, header.GetNonce(), header.GetRootHash(), modifiedAccounts)
+
 	log.Info("shard block has been committed successfully",
 		"epoch", header.Epoch,
 		"round", header.Round,
@@ -852,6 +855,27 @@ func (sp *shardProcessor) CommitBlock(
 
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
 	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
+	if !check.IfNil(sp.core) {
+		sp.recordBlockInHistory(
+			sp.core.HistoryRepository(),
+			headerHash,
+			headerHandler,
+			block.TxBlock,
+			block.SmartContractResultBlock,
+			block.RewardsBlock,
+			block.InvalidBlock,
+			block.ReceiptBlock,
+		)
+		sp.recordBlockLogs(sp.core.LogsIndex(), headerHash, headerHandler, block.TxBlock, block.SmartContractResultBlock)
+		sp.notifyBlockCommitted(
+			sp.core.EventsHub(),
+			headerHash,
+			headerHandler,
+			block.TxBlock,
+			block.SmartContractResultBlock,
+			block.RewardsBlock,
+		)
+	}
 
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
 	if err != nil {
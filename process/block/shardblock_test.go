@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/history"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -1774,6 +1775,108 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	time.Sleep(time.Second)
 }
 
+func TestShardProcessor_CommitBlockShouldRecordBlockInHistory(t *testing.T) {
+	t.Parallel()
+	tdp := initDataPool([]byte("tx_hash1"))
+	txHash := []byte("tx_hash1")
+
+	rootHash := []byte("root hash")
+	hdrHash := []byte("header hash")
+	randSeed := []byte("rand seed")
+
+	prevHdr := &block.Header{
+		Nonce:         0,
+		Round:         0,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		RandSeed:      randSeed,
+	}
+
+	hdr := &block.Header{
+		Nonce:           1,
+		Round:           1,
+		PubKeysBitmap:   rootHash,
+		PrevHash:        hdrHash,
+		Signature:       rootHash,
+		RootHash:        rootHash,
+		PrevRandSeed:    randSeed,
+		AccumulatedFees: big.NewInt(0),
+		DeveloperFees:   big.NewInt(0),
+	}
+	mb := block.MiniBlock{
+		TxHashes: [][]byte{txHash},
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{&mb}}
+	hdr.MiniBlockHeaders = []block.MiniBlockHeader{{TxCount: uint32(len(mb.TxHashes)), Hash: hdrHash}}
+
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() (i []byte, e error) {
+			return rootHash, nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+	}
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hdrHash
+	}
+
+	recordBlockCalled := false
+	historyRepository := &mock.HistoryRepositoryStub{
+		RecordBlockCalled: func(blockHeaderHash []byte, header data.HeaderHandler, _ map[string]data.TransactionHandler) error {
+			recordBlockCalled = true
+			assert.Equal(t, hdrHash, blockHeaderHash)
+			assert.True(t, header == hdr)
+			return nil
+		},
+	}
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = tdp
+	arguments.Store = initStore()
+	arguments.Hasher = hasher
+	arguments.AccountsDB[state.UserAccountsState] = accounts
+	arguments.ForkDetector = &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+		GetHighestFinalBlockHashCalled: func() []byte {
+			return nil
+		},
+	}
+	arguments.Core = &mock.ServiceContainerMock{
+		HistoryRepositoryCalled: func() history.HistoryRepository {
+			return historyRepository
+		},
+	}
+	blockTrackerMock := mock.NewBlockTrackerMock(mock.NewOneShardCoordinatorMock(), createGenesisBlocks(mock.NewOneShardCoordinatorMock()))
+	blockTrackerMock.GetCrossNotarizedHeaderCalled = func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error) {
+		return &block.MetaBlock{}, []byte("hash"), nil
+	}
+	arguments.BlockTracker = blockTrackerMock
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return prevHdr
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return hdrHash
+	}
+	arguments.BlockChain = blkc
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.ProcessBlock(hdr, body, haveTime)
+	assert.Nil(t, err)
+	err = sp.CommitBlock(hdr, body)
+	assert.Nil(t, err)
+	assert.True(t, recordBlockCalled)
+}
+
 func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -1817,6 +1920,35 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	mbHdrs = append(mbHdrs, mbHdr)
 	hdr.MiniBlockHeaders = mbHdrs
 
+	address := []byte("address")
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-01")
+	dataTrieRootHash := []byte("data trie root hash")
+	userAccount, _ := state.NewUserAccount(address)
+	_ = userAccount.AddToBalance(big.NewInt(100))
+	userAccount.SetRootHash(dataTrieRootHash)
+	marshalizedAccount, _ := (&mock.MarshalizerMock{}).Marshal(userAccount)
+
+	dataTrie := &mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			if bytes.Equal(key, esdtKey) {
+				return append(append([]byte("esdt data"), esdtKey...), address...), nil
+			}
+			return nil, nil
+		},
+	}
+	stateTrie := &mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			if bytes.Equal(key, address) {
+				return marshalizedAccount, nil
+			}
+			return nil, nil
+		},
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, dataTrieRootHash, root)
+			return dataTrie, nil
+		},
+	}
+
 	accounts := &mock.AccountsStub{
 		CommitCalled: func() (i []byte, e error) {
 			return rootHash, nil
@@ -1824,6 +1956,16 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 		RootHashCalled: func() ([]byte, error) {
 			return rootHash, nil
 		},
+		GetModifiedAccountsCalled: func() []*state.ModifiedAccount {
+			return []*state.ModifiedAccount{
+				{Address: address, DataTrieKeys: [][]byte{esdtKey, []byte("key")}},
+				{Address: []byte("removed address")},
+			}
+		},
+		GetTrieCalled: func(root []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, root)
+			return stateTrie, nil
+		},
 	}
 	fd := &mock.ForkDetectorMock{
 		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
@@ -1843,6 +1985,7 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	store := initStore()
 
 	var saveBlockCalled map[string]data.TransactionHandler
+	var savedAccounts []*indexer.UpdatedAccount
 	saveBlockCalledMutex := sync.Mutex{}
 
 	arguments := CreateMockArgumentsMultiShard()
@@ -1854,6 +1997,12 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 					saveBlockCalled = txPool
 					saveBlockCalledMutex.Unlock()
 				},
+				SaveAccountsCalled: func(_ uint64, loadAccounts indexer.AccountsLoader) {
+					accounts := loadAccounts()
+					saveBlockCalledMutex.Lock()
+					savedAccounts = accounts
+					saveBlockCalledMutex.Unlock()
+				},
 			}
 		},
 	}
@@ -1905,9 +2054,18 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 
 	saveBlockCalledMutex.Lock()
 	wasCalled := saveBlockCalled
+	accountsSaved := savedAccounts
 	saveBlockCalledMutex.Unlock()
 
 	assert.Equal(t, 4, len(wasCalled))
+	expectedAccounts := []*indexer.UpdatedAccount{
+		{
+			Address:  address,
+			Balance:  big.NewInt(100),
+			ESDTData: map[string][]byte{"TKN-01": []byte("esdt data")},
+		},
+	}
+	assert.Equal(t, expectedAccounts, accountsSaved)
 }
 
 func TestShardProcessor_CreateTxBlockBodyWithDirtyAccStateShouldReturnEmptyBody(t *testing.T) {
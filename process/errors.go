@@ -776,6 +776,30 @@ var ErrNilRatingsInfoHandler = errors.New("nil ratings info handler")
 // ErrNilDebugger signals that a nil debug handler has been provided
 var ErrNilDebugger = errors.New("nil debug handler")
 
+// ErrNilESDTSCAddress signals that a nil esdt system smart contract address has been provided
+var ErrNilESDTSCAddress = errors.New("nil esdt system smart contract address")
+
+// ErrAddressIsNotESDTSystemSC signals that the destination address is not the esdt system smart contract
+var ErrAddressIsNotESDTSystemSC = errors.New("destination address is not the esdt system smart contract")
+
+// ErrCallerIsNotESDTSystemSC signals that the built-in function can only be called by the esdt system smart contract
+var ErrCallerIsNotESDTSystemSC = errors.New("caller is not the esdt system smart contract")
+
+// ErrInvalidRcvAddr signals that an invalid receiver address was provided
+var ErrInvalidRcvAddr = errors.New("invalid receiver address")
+
+// ErrNilESDTPauseHandler signals that a nil esdt pause handler has been provided
+var ErrNilESDTPauseHandler = errors.New("nil esdt pause handler")
+
+// ErrESDTTokenIsPaused signals that the esdt token is paused
+var ErrESDTTokenIsPaused = errors.New("esdt token is paused")
+
+// ErrESDTIsFrozenForAccount signals that the esdt balance of the account is frozen
+var ErrESDTIsFrozenForAccount = errors.New("esdt is frozen for account")
+
+// ErrESDTIsNotFrozenForAccount signals that the esdt balance of the account is not frozen
+var ErrESDTIsNotFrozenForAccount = errors.New("esdt is not frozen for account")
+
 // ErrBuiltInFunctionCalledWithValue signals that builtin function was called with value that is not allowed
 var ErrBuiltInFunctionCalledWithValue = errors.New("built in function called with tx value is not allowed")
 
@@ -856,3 +880,9 @@ var ErrTransactionIsNotWhitelisted = errors.New("transaction is not whitelisted"
 
 // ErrInterceptedDataNotForCurrentShard signals that intercepted data is not for current shard
 var ErrInterceptedDataNotForCurrentShard = errors.New("intercepted data not for current shard")
+
+// ErrNilSCQueryService signals that a nil SC query service has been provided
+var ErrNilSCQueryService = errors.New("nil SC query service")
+
+// ErrNilIndexer signals that a nil indexer has been provided
+var ErrNilIndexer = errors.New("nil indexer")
@@ -28,11 +28,13 @@ type vmContainerFactory struct {
 	systemContracts     vm.SystemSCContainer
 	economics           *economics.EconomicsData
 	messageSigVerifier  vm.MessageSignVerifier
+	evidenceVerifier    vm.EvidenceVerifier
 	nodesConfigProvider vm.NodesConfigProvider
 	gasSchedule         map[string]map[string]uint64
 	hasher              hashing.Hasher
 	marshalizer         marshal.Marshalizer
 	systemSCConfig      *config.SystemSmartContractsConfig
+	numOfShards         uint32
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -46,6 +48,7 @@ func NewVMContainerFactory(
 	marshalizer marshal.Marshalizer,
 	systemSCConfig *config.SystemSmartContractsConfig,
 	validatorAccountsDB state.AccountsAdapter,
+	evidenceVerifier vm.EvidenceVerifier,
 ) (*vmContainerFactory, error) {
 	if economics == nil {
 		return nil, process.ErrNilEconomicsData
@@ -68,6 +71,9 @@ func NewVMContainerFactory(
 	if check.IfNil(validatorAccountsDB) {
 		return nil, vm.ErrNilValidatorAccountsDB
 	}
+	if check.IfNil(evidenceVerifier) {
+		return nil, vm.ErrNilEvidenceVerifier
+	}
 
 	blockChainHookImpl, err := hooks.NewBlockChainHookImpl(argBlockChainHook)
 	if err != nil {
@@ -80,12 +86,14 @@ func NewVMContainerFactory(
 		cryptoHook:          cryptoHook,
 		economics:           economics,
 		messageSigVerifier:  messageSignVerifier,
+		evidenceVerifier:    evidenceVerifier,
 		gasSchedule:         gasSchedule,
 		nodesConfigProvider: nodesConfigProvider,
 		hasher:              hasher,
 		marshalizer:         marshalizer,
 		systemSCConfig:      systemSCConfig,
 		validatorAccountsDB: validatorAccountsDB,
+		numOfShards:         argBlockChainHook.ShardCoordinator.NumberOfShards(),
 	}, nil
 }
 
@@ -122,11 +130,13 @@ func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, er
 		SystemEI:            systemEI,
 		ValidatorSettings:   vmf.economics,
 		SigVerifier:         vmf.messageSigVerifier,
+		EvidenceVerifier:    vmf.evidenceVerifier,
 		GasMap:              vmf.gasSchedule,
 		NodesConfigProvider: vmf.nodesConfigProvider,
 		Hasher:              vmf.hasher,
 		Marshalizer:         vmf.marshalizer,
 		SystemSCConfig:      vmf.systemSCConfig,
+		NumOfShards:         vmf.numOfShards,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
@@ -59,6 +59,7 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 			},
 		},
 		&mock.AccountsStub{},
+		&mock.EvidenceVerifierStub{},
 	)
 
 	assert.NotNil(t, vmf)
@@ -131,6 +132,7 @@ func TestVmContainerFactory_Create(t *testing.T) {
 			},
 		},
 		&mock.AccountsStub{},
+		&mock.EvidenceVerifierStub{},
 	)
 	assert.NotNil(t, vmf)
 	assert.Nil(t, err)
@@ -194,6 +196,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["DelegateVote"] = value
 	gasMap["RevokeVote"] = value
 	gasMap["CloseProposal"] = value
+	gasMap["ReportDoubleSigning"] = value
 
 	return gasMap
 }
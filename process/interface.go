@@ -231,6 +231,7 @@ type ValidatorStatisticsProcessor interface {
 	ResetValidatorStatisticsAtNewEpoch(vInfos map[uint32][]*state.ValidatorInfo) error
 	GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error)
 	ProcessRatingsEndOfEpoch(validatorInfos map[uint32][]*state.ValidatorInfo, epoch uint32) error
+	ProcessChangedKeysEndOfEpoch(validatorInfos map[uint32][]*state.ValidatorInfo) error
 	Commit() ([]byte, error)
 	DisplayRatings(epoch uint32)
 	SetLastFinalizedRootHash([]byte)
@@ -620,11 +621,13 @@ type NetworkConnectionWatcher interface {
 	IsInterfaceNil() bool
 }
 
-// SCQuery represents a prepared query for executing a function of the smart contract
+// SCQuery represents a prepared query for executing a function of the smart contract. If the root hash is set, the
+// query is executed against the state with that root hash instead of the current one
 type SCQuery struct {
 	ScAddress []byte
 	FuncName  string
 	Arguments [][]byte
+	RootHash  []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -752,6 +755,14 @@ type SCQueryService interface {
 	IsInterfaceNil() bool
 }
 
+// SCQueryServiceHandler defines how data should be get from a SC account and how the gas limit of a SC call is
+// estimated
+type SCQueryServiceHandler interface {
+	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
+	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
+	IsInterfaceNil() bool
+}
+
 // EpochStartDataCreator defines the functionality for node to create epoch start data
 type EpochStartDataCreator interface {
 	CreateEpochStartData() (*block.EpochStart, error)
@@ -802,6 +813,12 @@ type BuiltinFunction interface {
 	IsInterfaceNil() bool
 }
 
+// ESDTPauseHandler provides IsPaused function for an esdt token
+type ESDTPauseHandler interface {
+	IsPaused(tokenName []byte) (bool, error)
+	IsInterfaceNil() bool
+}
+
 // BuiltInFunctionContainer defines the methods for the built-in protocol container
 type BuiltInFunctionContainer interface {
 	Get(key string) (BuiltinFunction, error)
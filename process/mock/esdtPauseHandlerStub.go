@@ -0,0 +1,20 @@
+package mock
+
+// ESDTPauseHandlerStub -
+type ESDTPauseHandlerStub struct {
+	IsPausedCalled func(tokenName []byte) (bool, error)
+}
+
+// IsPaused -
+func (e *ESDTPauseHandlerStub) IsPaused(tokenName []byte) (bool, error) {
+	if e.IsPausedCalled != nil {
+		return e.IsPausedCalled(tokenName)
+	}
+
+	return false, nil
+}
+
+// IsInterfaceNil -
+func (e *ESDTPauseHandlerStub) IsInterfaceNil() bool {
+	return e == nil
+}
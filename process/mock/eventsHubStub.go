@@ -0,0 +1,51 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/events"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+// EventsHubStub -
+type EventsHubStub struct {
+	NewSubscriberCalled          func() (events.Subscriber, error)
+	HasSubscribersCalled         func() bool
+	NotifyBlockCommittedCalled   func(headerHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	EpochStartEventHandlerCalled func() epochStart.ActionHandler
+}
+
+// NewSubscriber -
+func (ehs *EventsHubStub) NewSubscriber() (events.Subscriber, error) {
+	if ehs.NewSubscriberCalled != nil {
+		return ehs.NewSubscriberCalled()
+	}
+	return nil, nil
+}
+
+// HasSubscribers -
+func (ehs *EventsHubStub) HasSubscribers() bool {
+	if ehs.HasSubscribersCalled != nil {
+		return ehs.HasSubscribersCalled()
+	}
+	return false
+}
+
+// NotifyBlockCommitted -
+func (ehs *EventsHubStub) NotifyBlockCommitted(headerHash []byte, header data.HeaderHandler, txPool map[string]data.TransactionHandler) {
+	if ehs.NotifyBlockCommittedCalled != nil {
+		ehs.NotifyBlockCommittedCalled(headerHash, header, txPool)
+	}
+}
+
+// EpochStartEventHandler -
+func (ehs *EventsHubStub) EpochStartEventHandler() epochStart.ActionHandler {
+	if ehs.EpochStartEventHandlerCalled != nil {
+		return ehs.EpochStartEventHandlerCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (ehs *EventsHubStub) IsInterfaceNil() bool {
+	return ehs == nil
+}
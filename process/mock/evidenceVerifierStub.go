@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/slash"
+)
+
+// EvidenceVerifierStub -
+type EvidenceVerifierStub struct {
+	VerifyCalled func(evidence *slash.Evidence) error
+}
+
+// Verify -
+func (evs *EvidenceVerifierStub) Verify(evidence *slash.Evidence) error {
+	if evs.VerifyCalled != nil {
+		return evs.VerifyCalled(evidence)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (evs *EvidenceVerifierStub) IsInterfaceNil() bool {
+	return evs == nil
+}
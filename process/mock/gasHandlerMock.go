@@ -22,7 +22,9 @@ type GasHandlerMock struct {
 
 // Init -
 func (ghm *GasHandlerMock) Init() {
-	ghm.InitCalled()
+	if ghm.InitCalled != nil {
+		ghm.InitCalled()
+	}
 }
 
 // SetGasConsumed -
@@ -9,7 +9,9 @@ import (
 
 // IndexerMock is a mock implementation fot the Indexer interface
 type IndexerMock struct {
-	SaveBlockCalled func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	SaveBlockCalled          func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	RevertIndexedBlockCalled func(header data.HeaderHandler, body data.BodyHandler)
+	SaveAccountsCalled       func(blockNonce uint64, loadAccounts indexer.AccountsLoader)
 }
 
 // SaveBlock -
@@ -46,6 +48,25 @@ func (im *IndexerMock) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) {
 	panic("implement me")
 }
 
+// SaveAccounts -
+func (im *IndexerMock) SaveAccounts(blockNonce uint64, loadAccounts indexer.AccountsLoader) {
+	if im.SaveAccountsCalled != nil {
+		im.SaveAccountsCalled(blockNonce, loadAccounts)
+	}
+}
+
+// RevertIndexedBlock -
+func (im *IndexerMock) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	if im.RevertIndexedBlockCalled != nil {
+		im.RevertIndexedBlockCalled(header, body)
+	}
+}
+
+// Close -
+func (im *IndexerMock) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (im *IndexerMock) IsInterfaceNil() bool {
 	return im == nil
@@ -53,5 +74,5 @@ func (im *IndexerMock) IsInterfaceNil() bool {
 
 // IsNilIndexer -
 func (im *IndexerMock) IsNilIndexer() bool {
-	return true
+	return false
 }
@@ -65,6 +65,15 @@ func (p *PeerAccountHandlerMock) SetBLSPublicKey([]byte) error {
 	return nil
 }
 
+// GetPreviousBLSPublicKey -
+func (p *PeerAccountHandlerMock) GetPreviousBLSPublicKey() []byte {
+	return nil
+}
+
+// SetPreviousBLSPublicKey -
+func (p *PeerAccountHandlerMock) SetPreviousBLSPublicKey([]byte) {
+}
+
 // GetRewardAddress -
 func (p *PeerAccountHandlerMock) GetRewardAddress() []byte {
 	return nil
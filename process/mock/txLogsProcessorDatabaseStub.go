@@ -0,0 +1,38 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// TxLogsProcessorDatabaseStub -
+type TxLogsProcessorDatabaseStub struct {
+	GetLogFromCacheCalled           func(txHash []byte) (data.LogHandler, bool)
+	EnableLogToBeSavedInCacheCalled func()
+	CleanCalled                     func()
+}
+
+// GetLogFromCache -
+func (tlpds *TxLogsProcessorDatabaseStub) GetLogFromCache(txHash []byte) (data.LogHandler, bool) {
+	if tlpds.GetLogFromCacheCalled != nil {
+		return tlpds.GetLogFromCacheCalled(txHash)
+	}
+
+	return nil, false
+}
+
+// EnableLogToBeSavedInCache -
+func (tlpds *TxLogsProcessorDatabaseStub) EnableLogToBeSavedInCache() {
+	if tlpds.EnableLogToBeSavedInCacheCalled != nil {
+		tlpds.EnableLogToBeSavedInCacheCalled()
+	}
+}
+
+// Clean -
+func (tlpds *TxLogsProcessorDatabaseStub) Clean() {
+	if tlpds.CleanCalled != nil {
+		tlpds.CleanCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (tlpds *TxLogsProcessorDatabaseStub) IsInterfaceNil() bool {
+	return tlpds == nil
+}
@@ -580,6 +580,101 @@ func (vs *validatorStatistics) verifySignaturesBelowSignedThreshold(
 	return nil
 }
 
+// ProcessChangedKeysEndOfEpoch swaps the bls keys changed during the epoch: the shard, list, index and rating of the
+// validator are moved from the peer account of the previous key to the one of the new key and the previous account
+// is removed. The validator infos are updated so that the nodes coordinator uses the new keys from the next epoch
+func (vs *validatorStatistics) ProcessChangedKeysEndOfEpoch(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	if len(validatorInfos) == 0 {
+		return process.ErrNilValidatorInfos
+	}
+
+	changedKeys := make([]state.PeerAccountHandler, 0)
+	for _, validators := range validatorInfos {
+		for _, validator := range validators {
+			peerAccount, err := vs.GetPeerAccount(validator.PublicKey)
+			if err != nil {
+				return err
+			}
+
+			if len(peerAccount.GetPreviousBLSPublicKey()) > 0 {
+				changedKeys = append(changedKeys, peerAccount)
+			}
+		}
+	}
+
+	sort.Slice(changedKeys, func(i, j int) bool {
+		return bytes.Compare(changedKeys[i].GetBLSPublicKey(), changedKeys[j].GetBLSPublicKey()) < 0
+	})
+
+	for _, peerAccount := range changedKeys {
+		err := vs.swapChangedKey(validatorInfos, peerAccount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (vs *validatorStatistics) swapChangedKey(
+	validatorInfos map[uint32][]*state.ValidatorInfo,
+	peerAccount state.PeerAccountHandler,
+) error {
+	previousKey := peerAccount.GetPreviousBLSPublicKey()
+	previousInfo := removeValidatorInfo(validatorInfos, previousKey)
+	if previousInfo != nil {
+		previousAccount, err := vs.GetPeerAccount(previousKey)
+		if err != nil {
+			return err
+		}
+
+		list := previousAccount.GetList()
+		if len(peerAccount.GetList()) > 0 {
+			list = peerAccount.GetList()
+		}
+		peerAccount.SetListAndIndex(previousAccount.GetShardId(), list, previousAccount.GetIndexInList())
+		peerAccount.SetRating(previousAccount.GetRating())
+		peerAccount.SetTempRating(previousAccount.GetTempRating())
+		if peerAccount.GetUnStakedEpoch() == core.DefaultUnstakedEpoch {
+			peerAccount.SetUnStakedEpoch(previousAccount.GetUnStakedEpoch())
+		}
+
+		err = vs.peerAdapter.RemoveAccount(previousKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("bls key changed", "previous key", previousKey, "new key", peerAccount.GetBLSPublicKey())
+
+	peerAccount.SetPreviousBLSPublicKey(nil)
+	err := vs.peerAdapter.SaveAccount(peerAccount)
+	if err != nil {
+		return err
+	}
+
+	removeValidatorInfo(validatorInfos, peerAccount.GetBLSPublicKey())
+	shardID := peerAccount.GetShardId()
+	validatorInfos[shardID] = append(validatorInfos[shardID], vs.peerAccountToValidatorInfo(peerAccount))
+
+	return nil
+}
+
+func removeValidatorInfo(validatorInfos map[uint32][]*state.ValidatorInfo, publicKey []byte) *state.ValidatorInfo {
+	for shardID, validators := range validatorInfos {
+		for i, validator := range validators {
+			if !bytes.Equal(validator.PublicKey, publicKey) {
+				continue
+			}
+
+			validatorInfos[shardID] = append(validators[:i:i], validators[i+1:]...)
+			return validator
+		}
+	}
+
+	return nil
+}
+
 // ResetValidatorStatisticsAtNewEpoch resets the validator info at the start of a new epoch
 func (vs *validatorStatistics) ResetValidatorStatisticsAtNewEpoch(vInfos map[uint32][]*state.ValidatorInfo) error {
 	sw := core.NewStopWatch()
@@ -1858,6 +1858,87 @@ func TestValidatorStatistics_ResetValidatorStatisticsAtNewEpoch(t *testing.T) {
 	assert.Equal(t, pa0.GetTempRating(), pa0.GetRating())
 }
 
+func TestValidatorStatistics_ProcessChangedKeysEndOfEpochShouldSwapTheKeys(t *testing.T) {
+	arguments := createMockArguments()
+
+	oldKey := []byte("oldK")
+	newKey := []byte("newK")
+	otherKey := []byte("othK")
+
+	oldAccount, _ := state.NewPeerAccount(oldKey)
+	_ = oldAccount.SetBLSPublicKey(oldKey)
+	oldAccount.SetListAndIndex(1, string(core.EligibleList), 3)
+	oldAccount.SetRating(70)
+	oldAccount.SetTempRating(80)
+	newAccount, _ := state.NewPeerAccount(newKey)
+	_ = newAccount.SetBLSPublicKey(newKey)
+	newAccount.SetPreviousBLSPublicKey(oldKey)
+	otherAccount, _ := state.NewPeerAccount(otherKey)
+	_ = otherAccount.SetBLSPublicKey(otherKey)
+	otherAccount.SetListAndIndex(1, string(core.WaitingList), 0)
+	accounts := map[string]state.PeerAccountHandler{
+		string(oldKey):   oldAccount,
+		string(newKey):   newAccount,
+		string(otherKey): otherAccount,
+	}
+
+	removedKeys := make([][]byte, 0)
+	peerAdapter := getAccountsMock()
+	peerAdapter.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, err error) {
+		return accounts[string(address)], nil
+	}
+	peerAdapter.SaveAccountCalled = func(account state.AccountHandler) error {
+		return nil
+	}
+	peerAdapter.RemoveAccountCalled = func(address []byte) error {
+		removedKeys = append(removedKeys, address)
+		return nil
+	}
+	arguments.PeerAdapter = peerAdapter
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	validatorInfos := map[uint32][]*state.ValidatorInfo{
+		0: {{PublicKey: newKey, ShardId: 0}},
+		1: {
+			{PublicKey: oldKey, ShardId: 1, List: string(core.EligibleList)},
+			{PublicKey: otherKey, ShardId: 1, List: string(core.WaitingList)},
+		},
+	}
+	err := validatorStatistics.ProcessChangedKeysEndOfEpoch(validatorInfos)
+	assert.Nil(t, err)
+
+	assert.Equal(t, [][]byte{oldKey}, removedKeys)
+	assert.Equal(t, string(core.EligibleList), newAccount.GetList())
+	assert.Equal(t, uint32(1), newAccount.GetShardId())
+	assert.Equal(t, uint32(3), newAccount.GetIndexInList())
+	assert.Equal(t, uint32(70), newAccount.GetRating())
+	assert.Equal(t, uint32(80), newAccount.GetTempRating())
+	assert.Nil(t, newAccount.GetPreviousBLSPublicKey())
+
+	assert.Len(t, validatorInfos[0], 0)
+	assert.Len(t, validatorInfos[1], 2)
+	assert.Equal(t, otherKey, validatorInfos[1][0].PublicKey)
+	assert.Equal(t, newKey, validatorInfos[1][1].PublicKey)
+	assert.Equal(t, string(core.EligibleList), validatorInfos[1][1].List)
+	assert.Equal(t, uint32(3), validatorInfos[1][1].Index)
+}
+
+func TestValidatorStatistics_ProcessChangedKeysEndOfEpochWithoutChangedKeysShouldNotChangeTheInfos(t *testing.T) {
+	arguments := createMockArguments()
+	arguments.PeerAdapter = getAccountsMock()
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	validatorInfos := map[uint32][]*state.ValidatorInfo{
+		0: {{PublicKey: []byte("key0"), List: string(core.EligibleList)}},
+	}
+	err := validatorStatistics.ProcessChangedKeysEndOfEpoch(validatorInfos)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("key0"), validatorInfos[0][0].PublicKey)
+
+	err = validatorStatistics.ProcessChangedKeysEndOfEpoch(nil)
+	assert.Equal(t, process.ErrNilValidatorInfos, err)
+}
+
 func TestValidatorStatistics_Process(t *testing.T) {
 	hash := []byte("correctRootHash")
 	expectedErr := errors.New("error rootHash")
@@ -132,10 +132,17 @@ func (stp *stakingToPeer) UpdateProtocol(body *block.Body, nonce uint64) error {
 		return err
 	}
 
+	keysToRemove := make([][]byte, 0)
+	changedKeys := make(map[string]struct{})
+	processedKeys := make(map[string]struct{})
 	for _, key := range affectedStates {
 		if len(key) != stp.pubkeyConv.Len() {
 			continue
 		}
+		if _, ok := processedKeys[key]; ok {
+			continue
+		}
+		processedKeys[key] = struct{}{}
 
 		blsPubKey := []byte(key)
 		log.Trace("get on StakingScAddress called", "blsKey", blsPubKey)
@@ -157,9 +164,7 @@ func (stp *stakingToPeer) UpdateProtocol(body *block.Body, nonce uint64) error {
 		}
 		// no data under key -> peer can be deleted from trie
 		if len(data) == 0 {
-			err = stp.peerState.RemoveAccount(blsPubKey)
-			log.LogIfError(err, "staking to protocol RemoveAccount blsPubKey", blsPubKey)
-
+			keysToRemove = append(keysToRemove, blsPubKey)
 			continue
 		}
 
@@ -169,12 +174,27 @@ func (stp *stakingToPeer) UpdateProtocol(body *block.Body, nonce uint64) error {
 			return err
 		}
 
+		if stakingData.ChangedKeyNonce == nonce && len(stakingData.PreviousBlsKey) > 0 {
+			changedKeys[string(stakingData.PreviousBlsKey)] = struct{}{}
+		}
+
 		err = stp.updatePeerState(stakingData, blsPubKey, nonce)
 		if err != nil {
 			return err
 		}
 	}
 
+	for _, blsPubKey := range keysToRemove {
+		// the peer account of the previous key of a changed validator key is still used until the epoch ends, it is
+		// removed by the validator statistics processor at the epoch start
+		if _, ok := changedKeys[string(blsPubKey)]; ok {
+			continue
+		}
+
+		err = stp.peerState.RemoveAccount(blsPubKey)
+		log.LogIfError(err, "staking to protocol RemoveAccount blsPubKey", blsPubKey)
+	}
+
 	return nil
 }
 
@@ -206,6 +226,13 @@ func (stp *stakingToPeer) updatePeerState(
 		}
 	}
 
+	if stakingData.ChangedKeyNonce == nonce && len(stakingData.PreviousBlsKey) > 0 {
+		err = stp.setPreviousKey(account, stakingData.PreviousBlsKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	isValidator := account.GetList() == string(core.EligibleList) || account.GetList() == string(core.WaitingList)
 	isJailed := stakingData.JailedNonce >= stakingData.UnJailedNonce && stakingData.JailedNonce > 0
 
@@ -245,6 +272,32 @@ func (stp *stakingToPeer) updatePeerState(
 	return nil
 }
 
+// setPreviousKey records the previous bls key of the validator on the peer account of its new key. The nodes
+// coordinator uses the previous key until the epoch ends, so its peer account is left untouched: the validator
+// statistics processor moves the list, shard and rating to the new key at the epoch start
+func (stp *stakingToPeer) setPreviousKey(account state.PeerAccountHandler, previousBlsKey []byte) error {
+	existingAccount, err := stp.peerState.GetExistingAccount(previousBlsKey)
+	if err == nil {
+		previousAccount, ok := existingAccount.(state.PeerAccountHandler)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		// a key changed again in the same epoch was never used by the protocol, the swap is done from the key before it
+		if len(previousAccount.GetPreviousBLSPublicKey()) > 0 {
+			previousBlsKey = previousAccount.GetPreviousBLSPublicKey()
+			err = stp.peerState.RemoveAccount(previousAccount.AddressBytes())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	account.SetPreviousBLSPublicKey(previousBlsKey)
+
+	return nil
+}
+
 func (stp *stakingToPeer) getAllModifiedStates(body *block.Body) ([]string, error) {
 	affectedStates := make([]string, 0)
 
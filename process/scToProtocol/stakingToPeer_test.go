@@ -624,3 +624,122 @@ func TestStakingToPeer_UpdatePeerState(t *testing.T) {
 	_ = stp.updatePeerState(stakingData, blsPubKey, stakingData.UnStakedNonce)
 	assert.Equal(t, string(core.LeavingList), peerAccount.GetList())
 }
+
+func TestStakingToPeer_UpdateProtocolChangedValidatorKeyShouldDeferTheSwapToTheEpochStart(t *testing.T) {
+	t.Parallel()
+
+	currTx := &mock.TxForCurrentBlockStub{}
+	currTx.GetTxCalled = func(txHash []byte) (handler data.TransactionHandler, e error) {
+		return &smartContractResult.SmartContractResult{
+			RcvAddr: factory.StakingSCAddress,
+		}, nil
+	}
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	oldBlsKey := bytes.Repeat([]byte("o"), arguments.PubkeyConv.Len())
+	newBlsKey := bytes.Repeat([]byte("n"), arguments.PubkeyConv.Len())
+
+	argParser := &mock.ArgumentParserMock{}
+	argParser.GetStorageUpdatesCalled = func(data string) (updates []*vmcommon.StorageUpdate, e error) {
+		return []*vmcommon.StorageUpdate{
+			{Offset: oldBlsKey, Data: nil},
+			{Offset: newBlsKey, Data: []byte("data")},
+		}, nil
+	}
+
+	nonce := uint64(10)
+	stakingData := systemSmartContracts.StakedData{
+		RegisterNonce:   1,
+		UnStakedEpoch:   core.DefaultUnstakedEpoch,
+		RewardAddress:   []byte("rwd"),
+		StakeValue:      big.NewInt(100),
+		StakedNonce:     1,
+		Staked:          true,
+		PreviousBlsKey:  oldBlsKey,
+		ChangedKeyNonce: nonce,
+	}
+	scDataGetter := &mock.ScQueryStub{}
+	scDataGetter.ExecuteQueryCalled = func(query *process.SCQuery) (output *vmcommon.VMOutput, e error) {
+		if bytes.Equal(query.Arguments[0], oldBlsKey) {
+			return &vmcommon.VMOutput{}, nil
+		}
+
+		retData, _ := json.Marshal(&stakingData)
+		return &vmcommon.VMOutput{ReturnData: [][]byte{retData}}, nil
+	}
+
+	oldPeerAccount, _ := state.NewPeerAccount(oldBlsKey)
+	oldPeerAccount.SetListAndIndex(1, string(core.EligibleList), 3)
+	oldPeerAccount.SetRating(70)
+	oldPeerAccount.SetTempRating(80)
+	newPeerAccount, _ := state.NewPeerAccount(newBlsKey)
+	removeCalled := false
+	peerState := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			if bytes.Equal(address, oldBlsKey) {
+				return oldPeerAccount, nil
+			}
+			return nil, state.ErrAccNotFound
+		},
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return newPeerAccount, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			return nil
+		},
+		RemoveAccountCalled: func(address []byte) error {
+			removeCalled = true
+			return nil
+		},
+	}
+
+	arguments.ArgParser = argParser
+	arguments.CurrTxs = currTx
+	arguments.PeerState = peerState
+	arguments.ScQuery = scDataGetter
+	arguments.Marshalizer = &mock.MarshalizerMock{}
+	stp, _ := NewStakingToPeer(arguments)
+
+	err := stp.UpdateProtocol(createBlockBody(), nonce)
+	assert.Nil(t, err)
+	assert.False(t, removeCalled)
+
+	assert.Equal(t, "", newPeerAccount.GetList())
+	assert.Equal(t, newBlsKey, newPeerAccount.GetBLSPublicKey())
+	assert.Equal(t, oldBlsKey, newPeerAccount.GetPreviousBLSPublicKey())
+	assert.Equal(t, string(core.EligibleList), oldPeerAccount.GetList())
+	assert.Equal(t, uint32(1), oldPeerAccount.GetShardId())
+	assert.Equal(t, uint32(70), oldPeerAccount.GetRating())
+}
+
+func TestStakingToPeer_SetPreviousKeyOfAKeyChangedTwiceShouldKeepTheFirstKey(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsNewStakingToPeer()
+	firstBlsKey := bytes.Repeat([]byte("f"), arguments.PubkeyConv.Len())
+	secondBlsKey := bytes.Repeat([]byte("s"), arguments.PubkeyConv.Len())
+	thirdBlsKey := bytes.Repeat([]byte("t"), arguments.PubkeyConv.Len())
+
+	secondPeerAccount, _ := state.NewPeerAccount(secondBlsKey)
+	secondPeerAccount.SetPreviousBLSPublicKey(firstBlsKey)
+	removedKeys := make([][]byte, 0)
+	arguments.PeerState = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			if bytes.Equal(address, secondBlsKey) {
+				return secondPeerAccount, nil
+			}
+			return nil, state.ErrAccNotFound
+		},
+		RemoveAccountCalled: func(address []byte) error {
+			removedKeys = append(removedKeys, address)
+			return nil
+		},
+	}
+	stp, _ := NewStakingToPeer(arguments)
+
+	thirdPeerAccount, _ := state.NewPeerAccount(thirdBlsKey)
+	err := stp.setPreviousKey(thirdPeerAccount, secondBlsKey)
+	assert.Nil(t, err)
+	assert.Equal(t, firstBlsKey, thirdPeerAccount.GetPreviousBLSPublicKey())
+	assert.Equal(t, [][]byte{secondBlsKey}, removedKeys)
+}
@@ -0,0 +1,415 @@
+package slash
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataSlash "github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("process/slash")
+
+// roundsToKeep is the number of rounds, behind the highest seen one, for which the signed headers are kept
+const roundsToKeep = 10
+
+// ArgsDoubleSigningDetector holds the arguments needed to create a double signing detector
+type ArgsDoubleSigningDetector struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	NodesCoordinator sharding.NodesCoordinator
+	EvidenceVerifier EvidenceVerifier
+	Broadcaster      Broadcaster
+}
+
+type signedHeaderInfo struct {
+	evidenceType uint32
+	pubKey       []byte
+	shardID      uint32
+	round        uint64
+	headerHash   []byte
+	signedHeader dataSlash.SignedHeader
+}
+
+type pendingSignatureShare struct {
+	pubKey         []byte
+	signatureShare []byte
+}
+
+type proposalInfo struct {
+	round      uint64
+	headerHash []byte
+}
+
+type doubleSigningDetector struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	nodesCoordinator sharding.NodesCoordinator
+	evidenceVerifier EvidenceVerifier
+	broadcaster      Broadcaster
+
+	mut             sync.Mutex
+	highestRound    uint64
+	proposedHeaders map[string]data.HeaderHandler
+	pendingShares   map[string][]*pendingSignatureShare
+	proposals       map[string][]*proposalInfo
+	signedHeaders   map[string][]*signedHeaderInfo
+	reported        map[string]uint64
+}
+
+// NewDoubleSigningDetector creates a component which collects the headers signed by the validators, either in the
+// consensus or in the intercepted headers, and broadcasts an evidence each time a validator signs two different
+// headers for the same shard and round
+func NewDoubleSigningDetector(args ArgsDoubleSigningDetector) (*doubleSigningDetector, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.EvidenceVerifier) {
+		return nil, ErrNilEvidenceVerifier
+	}
+	if check.IfNil(args.Broadcaster) {
+		return nil, ErrNilBroadcaster
+	}
+
+	return &doubleSigningDetector{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		nodesCoordinator: args.NodesCoordinator,
+		evidenceVerifier: args.EvidenceVerifier,
+		broadcaster:      args.Broadcaster,
+		proposedHeaders:  make(map[string]data.HeaderHandler),
+		pendingShares:    make(map[string][]*pendingSignatureShare),
+		proposals:        make(map[string][]*proposalInfo),
+		signedHeaders:    make(map[string][]*signedHeaderInfo),
+		reported:         make(map[string]uint64),
+	}, nil
+}
+
+// AddProposedHeader records a header proposed in the consensus by the provided leader. As the proposal itself is
+// not signed, two different proposals of the same leader are only logged, the evidence being built from the
+// signatures given later over them
+func (dsd *doubleSigningDetector) AddProposedHeader(pubKey []byte, header data.HeaderHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	headerHash, err := ComputeUnsignedHeaderHash(dsd.marshalizer, dsd.hasher, header)
+	if err != nil {
+		log.Debug("doubleSigningDetector.AddProposedHeader", "error", err.Error())
+		return
+	}
+
+	dsd.mut.Lock()
+	dsd.updateHighestRound(header.GetRound())
+	dsd.proposedHeaders[string(headerHash)] = header
+	dsd.checkProposal(pubKey, header.GetRound(), headerHash)
+
+	pendingShares := dsd.pendingShares[string(headerHash)]
+	delete(dsd.pendingShares, string(headerHash))
+	evidences := make([]*dataSlash.Evidence, 0)
+	for _, pendingShare := range pendingShares {
+		evidences = dsd.addSignatureShare(evidences, pendingShare.pubKey, headerHash, pendingShare.signatureShare)
+	}
+	dsd.mut.Unlock()
+
+	dsd.broadcastEvidences(evidences)
+}
+
+// AddSignatureShare records the signature share given by a validator in the signature subround over the header
+// with the provided hash
+func (dsd *doubleSigningDetector) AddSignatureShare(pubKey []byte, headerHash []byte, signatureShare []byte) {
+	dsd.mut.Lock()
+	evidences := dsd.addSignatureShare(make([]*dataSlash.Evidence, 0), pubKey, headerHash, signatureShare)
+	dsd.mut.Unlock()
+
+	dsd.broadcastEvidences(evidences)
+}
+
+// AddLeaderSignature records the leader signature given over the header with the provided hash, completed with the
+// aggregated signature and the public keys bitmap
+func (dsd *doubleSigningDetector) AddLeaderSignature(
+	pubKey []byte,
+	headerHash []byte,
+	aggregatedSignature []byte,
+	pubKeysBitmap []byte,
+	leaderSignature []byte,
+) {
+	dsd.mut.Lock()
+	evidences := make([]*dataSlash.Evidence, 0)
+	header, ok := dsd.proposedHeaders[string(headerHash)]
+	if ok {
+		headerCopy := header.Clone()
+		headerCopy.SetSignature(aggregatedSignature)
+		headerCopy.SetPubKeysBitmap(pubKeysBitmap)
+		headerCopy.SetLeaderSignature(nil)
+		evidences = dsd.addSignedHeader(evidences, dataSlash.DoubleProposalEvidence, pubKey, headerCopy, headerHash, leaderSignature)
+	}
+	dsd.mut.Unlock()
+
+	dsd.broadcastEvidences(evidences)
+}
+
+// InterceptedHeader records the leader signature of an intercepted header. It has the signature of the handlers
+// registered on the headers interceptors
+func (dsd *doubleSigningDetector) InterceptedHeader(_ string, _ []byte, headerData interface{}) {
+	header, ok := headerData.(data.HeaderHandler)
+	if !ok || check.IfNil(header) {
+		return
+	}
+
+	leaderPubKey, err := dsd.getLeaderPubKey(header)
+	if err != nil {
+		log.Debug("doubleSigningDetector.InterceptedHeader: leader not found", "error", err.Error())
+		return
+	}
+
+	headerHash, err := ComputeUnsignedHeaderHash(dsd.marshalizer, dsd.hasher, header)
+	if err != nil {
+		log.Debug("doubleSigningDetector.InterceptedHeader", "error", err.Error())
+		return
+	}
+
+	headerCopy := header.Clone()
+	headerCopy.SetLeaderSignature(nil)
+
+	dsd.mut.Lock()
+	dsd.updateHighestRound(header.GetRound())
+	evidences := dsd.addSignedHeader(make([]*dataSlash.Evidence, 0), dataSlash.DoubleProposalEvidence, leaderPubKey, headerCopy, headerHash, header.GetLeaderSignature())
+	dsd.mut.Unlock()
+
+	dsd.broadcastEvidences(evidences)
+}
+
+func (dsd *doubleSigningDetector) addSignatureShare(evidences []*dataSlash.Evidence, pubKey []byte, headerHash []byte, signatureShare []byte) []*dataSlash.Evidence {
+	header, ok := dsd.proposedHeaders[string(headerHash)]
+	if !ok {
+		dsd.pendingShares[string(headerHash)] = append(dsd.pendingShares[string(headerHash)], &pendingSignatureShare{
+			pubKey:         pubKey,
+			signatureShare: signatureShare,
+		})
+		return evidences
+	}
+
+	return dsd.addSignedHeader(evidences, dataSlash.DoubleSigningEvidence, pubKey, header, headerHash, signatureShare)
+}
+
+// addSignedHeader stores the signed header and, if the same validator signed a different header of the same shard
+// and round, appends the resulting evidence to the provided slice
+func (dsd *doubleSigningDetector) addSignedHeader(
+	evidences []*dataSlash.Evidence,
+	evidenceType uint32,
+	pubKey []byte,
+	header data.HeaderHandler,
+	headerHash []byte,
+	signature []byte,
+) []*dataSlash.Evidence {
+	headerBytes, err := dsd.marshalizer.Marshal(header)
+	if err != nil {
+		log.Debug("doubleSigningDetector.addSignedHeader", "error", err.Error())
+		return evidences
+	}
+
+	info := &signedHeaderInfo{
+		evidenceType: evidenceType,
+		pubKey:       pubKey,
+		shardID:      header.GetShardID(),
+		round:        header.GetRound(),
+		headerHash:   headerHash,
+		signedHeader: dataSlash.SignedHeader{
+			Header:    headerBytes,
+			Signature: signature,
+		},
+	}
+
+	key := string(pubKey)
+	for _, existing := range dsd.signedHeaders[key] {
+		isSameSlot := existing.evidenceType == info.evidenceType &&
+			existing.shardID == info.shardID &&
+			existing.round == info.round
+		if !isSameSlot {
+			continue
+		}
+		if bytes.Equal(existing.headerHash, info.headerHash) {
+			return evidences
+		}
+
+		evidence := dsd.createEvidence(existing, info)
+		if evidence != nil {
+			evidences = append(evidences, evidence)
+		}
+
+		return evidences
+	}
+
+	dsd.signedHeaders[key] = append(dsd.signedHeaders[key], info)
+
+	return evidences
+}
+
+func (dsd *doubleSigningDetector) createEvidence(first *signedHeaderInfo, second *signedHeaderInfo) *dataSlash.Evidence {
+	reportKey := fmt.Sprintf("%s_%d_%d_%d", first.pubKey, first.evidenceType, first.shardID, first.round)
+	_, alreadyReported := dsd.reported[reportKey]
+	if alreadyReported {
+		return nil
+	}
+
+	evidence := &dataSlash.Evidence{
+		Type:    first.evidenceType,
+		PubKey:  first.pubKey,
+		ShardID: first.shardID,
+		Round:   first.round,
+		First:   first.signedHeader,
+		Second:  second.signedHeader,
+	}
+
+	err := dsd.evidenceVerifier.Verify(evidence)
+	if err != nil {
+		log.Debug("doubleSigningDetector: conflicting signatures could not be verified",
+			"pk", first.pubKey,
+			"round", first.round,
+			"error", err.Error())
+		return nil
+	}
+
+	dsd.reported[reportKey] = first.round
+
+	return evidence
+}
+
+func (dsd *doubleSigningDetector) checkProposal(pubKey []byte, round uint64, headerHash []byte) {
+	key := string(pubKey)
+	for _, proposal := range dsd.proposals[key] {
+		if proposal.round != round || bytes.Equal(proposal.headerHash, headerHash) {
+			continue
+		}
+
+		log.Warn("conflicting block proposals",
+			"leader", core.GetTrimmedPk(hex.EncodeToString(pubKey)),
+			"round", round,
+			"first hash", proposal.headerHash,
+			"second hash", headerHash)
+		return
+	}
+
+	dsd.proposals[key] = append(dsd.proposals[key], &proposalInfo{
+		round:      round,
+		headerHash: headerHash,
+	})
+}
+
+func (dsd *doubleSigningDetector) getLeaderPubKey(header data.HeaderHandler) ([]byte, error) {
+	consensusGroup, err := computeConsensusGroup(dsd.nodesCoordinator, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return consensusGroup[0].PubKey(), nil
+}
+
+func (dsd *doubleSigningDetector) updateHighestRound(round uint64) {
+	if round <= dsd.highestRound {
+		return
+	}
+
+	dsd.highestRound = round
+	if dsd.highestRound < roundsToKeep {
+		return
+	}
+
+	dsd.removeOlderThan(dsd.highestRound - roundsToKeep)
+}
+
+func (dsd *doubleSigningDetector) removeOlderThan(round uint64) {
+	for hash, header := range dsd.proposedHeaders {
+		if header.GetRound() < round {
+			delete(dsd.proposedHeaders, hash)
+			delete(dsd.pendingShares, hash)
+		}
+	}
+
+	for hash := range dsd.pendingShares {
+		_, isProposed := dsd.proposedHeaders[hash]
+		if !isProposed {
+			delete(dsd.pendingShares, hash)
+		}
+	}
+
+	for key, proposals := range dsd.proposals {
+		dsd.proposals[key] = removeOldProposals(proposals, round)
+		if len(dsd.proposals[key]) == 0 {
+			delete(dsd.proposals, key)
+		}
+	}
+
+	for key, infos := range dsd.signedHeaders {
+		dsd.signedHeaders[key] = removeOldSignedHeaders(infos, round)
+		if len(dsd.signedHeaders[key]) == 0 {
+			delete(dsd.signedHeaders, key)
+		}
+	}
+
+	for key, reportedRound := range dsd.reported {
+		if reportedRound < round {
+			delete(dsd.reported, key)
+		}
+	}
+}
+
+func removeOldProposals(proposals []*proposalInfo, round uint64) []*proposalInfo {
+	kept := proposals[:0]
+	for _, proposal := range proposals {
+		if proposal.round >= round {
+			kept = append(kept, proposal)
+		}
+	}
+
+	return kept
+}
+
+func removeOldSignedHeaders(infos []*signedHeaderInfo, round uint64) []*signedHeaderInfo {
+	kept := infos[:0]
+	for _, info := range infos {
+		if info.round >= round {
+			kept = append(kept, info)
+		}
+	}
+
+	return kept
+}
+
+func (dsd *doubleSigningDetector) broadcastEvidences(evidences []*dataSlash.Evidence) {
+	for _, evidence := range evidences {
+		buff, err := dsd.marshalizer.Marshal(evidence)
+		if err != nil {
+			log.Debug("doubleSigningDetector.broadcastEvidences", "error", err.Error())
+			continue
+		}
+
+		log.Warn("double signing detected, broadcasting the evidence",
+			"pk", core.GetTrimmedPk(hex.EncodeToString(evidence.PubKey)),
+			"type", evidence.Type,
+			"shard", evidence.ShardID,
+			"round", evidence.Round)
+
+		dsd.broadcaster.Broadcast(core.SlashingEvidenceTopic, buff)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsd *doubleSigningDetector) IsInterfaceNil() bool {
+	return dsd == nil
+}
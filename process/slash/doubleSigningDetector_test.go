@@ -0,0 +1,291 @@
+package slash_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	dataSlash "github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type evidencesCollector struct {
+	mut       sync.Mutex
+	evidences []*dataSlash.Evidence
+}
+
+func (ec *evidencesCollector) broadcaster(t *testing.T) *mock.MessengerStub {
+	return &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Equal(t, core.SlashingEvidenceTopic, topic)
+
+			evidence := &dataSlash.Evidence{}
+			err := (&mock.MarshalizerMock{}).Unmarshal(evidence, buff)
+			require.Nil(t, err)
+
+			ec.mut.Lock()
+			ec.evidences = append(ec.evidences, evidence)
+			ec.mut.Unlock()
+		},
+	}
+}
+
+func (ec *evidencesCollector) numEvidences() int {
+	ec.mut.Lock()
+	defer ec.mut.Unlock()
+
+	return len(ec.evidences)
+}
+
+func createMockDoubleSigningDetectorArgs(t *testing.T, collector *evidencesCollector) slash.ArgsDoubleSigningDetector {
+	consensusGroup := createMockNodesCoordinator([]byte("leader"), []byte("validator"))
+	evidenceVerifierArgs := createMockEvidenceVerifierArgs()
+	evidenceVerifierArgs.NodesCoordinator = consensusGroup
+	evidenceVerifier, _ := slash.NewEvidenceVerifier(evidenceVerifierArgs)
+
+	return slash.ArgsDoubleSigningDetector{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           mock.HasherMock{},
+		NodesCoordinator: consensusGroup,
+		EvidenceVerifier: evidenceVerifier,
+		Broadcaster:      collector.broadcaster(t),
+	}
+}
+
+func unsignedHeaderHash(header data.HeaderHandler) []byte {
+	hash, _ := slash.ComputeUnsignedHeaderHash(&mock.MarshalizerMock{}, mock.HasherMock{}, header)
+	return hash
+}
+
+func TestNewDoubleSigningDetector_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+
+	args := createMockDoubleSigningDetectorArgs(t, collector)
+	args.Marshalizer = nil
+	dsd, err := slash.NewDoubleSigningDetector(args)
+	assert.Nil(t, dsd)
+	assert.Equal(t, slash.ErrNilMarshalizer, err)
+
+	args = createMockDoubleSigningDetectorArgs(t, collector)
+	args.Hasher = nil
+	dsd, err = slash.NewDoubleSigningDetector(args)
+	assert.Nil(t, dsd)
+	assert.Equal(t, slash.ErrNilHasher, err)
+
+	args = createMockDoubleSigningDetectorArgs(t, collector)
+	args.NodesCoordinator = nil
+	dsd, err = slash.NewDoubleSigningDetector(args)
+	assert.Nil(t, dsd)
+	assert.Equal(t, slash.ErrNilNodesCoordinator, err)
+
+	args = createMockDoubleSigningDetectorArgs(t, collector)
+	args.EvidenceVerifier = nil
+	dsd, err = slash.NewDoubleSigningDetector(args)
+	assert.Nil(t, dsd)
+	assert.Equal(t, slash.ErrNilEvidenceVerifier, err)
+
+	args = createMockDoubleSigningDetectorArgs(t, collector)
+	args.Broadcaster = nil
+	dsd, err = slash.NewDoubleSigningDetector(args)
+	assert.Nil(t, dsd)
+	assert.Equal(t, slash.ErrNilBroadcaster, err)
+}
+
+func TestNewDoubleSigningDetector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dsd, err := slash.NewDoubleSigningDetector(createMockDoubleSigningDetectorArgs(t, &evidencesCollector{}))
+
+	assert.Nil(t, err)
+	assert.False(t, dsd.IsInterfaceNil())
+}
+
+func TestDoubleSigningDetector_AddSignatureShareOnSameHeaderShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+	dsd, _ := slash.NewDoubleSigningDetector(createMockDoubleSigningDetectorArgs(t, collector))
+
+	leader := []byte("leader")
+	validator := []byte("validator")
+	header := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash")}
+	hash := unsignedHeaderHash(header)
+
+	dsd.AddProposedHeader(leader, header)
+	dsd.AddSignatureShare(validator, hash, sign(validator, hash))
+	dsd.AddSignatureShare(validator, hash, sign(validator, hash))
+
+	assert.Equal(t, 0, collector.numEvidences())
+}
+
+func TestDoubleSigningDetector_AddSignatureShareOnConflictingHeadersShouldBroadcastOnce(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+	dsd, _ := slash.NewDoubleSigningDetector(createMockDoubleSigningDetectorArgs(t, collector))
+
+	leader := []byte("leader")
+	validator := []byte("validator")
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+	third := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 3")}
+	firstHash := unsignedHeaderHash(first)
+	secondHash := unsignedHeaderHash(second)
+	thirdHash := unsignedHeaderHash(third)
+
+	dsd.AddProposedHeader(leader, first)
+	dsd.AddProposedHeader(leader, second)
+	dsd.AddProposedHeader(leader, third)
+	dsd.AddSignatureShare(validator, firstHash, sign(validator, firstHash))
+	dsd.AddSignatureShare(validator, secondHash, sign(validator, secondHash))
+	dsd.AddSignatureShare(validator, thirdHash, sign(validator, thirdHash))
+
+	require.Equal(t, 1, collector.numEvidences())
+	evidence := collector.evidences[0]
+	assert.Equal(t, dataSlash.DoubleSigningEvidence, evidence.Type)
+	assert.Equal(t, validator, evidence.PubKey)
+	assert.Equal(t, uint64(5), evidence.Round)
+
+	ev, _ := slash.NewEvidenceVerifier(createMockEvidenceVerifierArgs())
+	assert.Nil(t, ev.Verify(evidence))
+}
+
+func TestDoubleSigningDetector_AddSignatureShareBeforeProposalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+	dsd, _ := slash.NewDoubleSigningDetector(createMockDoubleSigningDetectorArgs(t, collector))
+
+	leader := []byte("leader")
+	validator := []byte("validator")
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+	firstHash := unsignedHeaderHash(first)
+	secondHash := unsignedHeaderHash(second)
+
+	dsd.AddSignatureShare(validator, firstHash, sign(validator, firstHash))
+	dsd.AddSignatureShare(validator, secondHash, sign(validator, secondHash))
+	assert.Equal(t, 0, collector.numEvidences())
+
+	dsd.AddProposedHeader(leader, first)
+	dsd.AddProposedHeader(leader, second)
+	assert.Equal(t, 1, collector.numEvidences())
+}
+
+func TestDoubleSigningDetector_AddSignatureShareWithInvalidSignatureShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+	dsd, _ := slash.NewDoubleSigningDetector(createMockDoubleSigningDetectorArgs(t, collector))
+
+	leader := []byte("leader")
+	validator := []byte("validator")
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+	firstHash := unsignedHeaderHash(first)
+	secondHash := unsignedHeaderHash(second)
+
+	dsd.AddProposedHeader(leader, first)
+	dsd.AddProposedHeader(leader, second)
+	dsd.AddSignatureShare(validator, firstHash, sign(validator, firstHash))
+	dsd.AddSignatureShare(validator, secondHash, []byte("invalid signature"))
+
+	assert.Equal(t, 0, collector.numEvidences())
+}
+
+func TestDoubleSigningDetector_AddLeaderSignatureOnConflictingHeadersShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+	dsd, _ := slash.NewDoubleSigningDetector(createMockDoubleSigningDetectorArgs(t, collector))
+
+	leader := []byte("leader")
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+
+	addLeaderSignature := func(header *block.Header) {
+		signedHeader := header.Clone()
+		signedHeader.SetSignature([]byte("aggregated signature"))
+		signedHeader.SetPubKeysBitmap([]byte{1})
+		leaderSignedData, _ := (&mock.MarshalizerMock{}).Marshal(signedHeader)
+
+		dsd.AddProposedHeader(leader, header)
+		dsd.AddLeaderSignature(leader, unsignedHeaderHash(header), []byte("aggregated signature"), []byte{1}, sign(leader, leaderSignedData))
+	}
+
+	addLeaderSignature(first)
+	assert.Equal(t, 0, collector.numEvidences())
+	addLeaderSignature(second)
+
+	require.Equal(t, 1, collector.numEvidences())
+	assert.Equal(t, dataSlash.DoubleProposalEvidence, collector.evidences[0].Type)
+	assert.Equal(t, leader, collector.evidences[0].PubKey)
+}
+
+func TestDoubleSigningDetector_InterceptedHeaderOnConflictingHeadersShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+	leader := []byte("leader")
+	args := createMockDoubleSigningDetectorArgs(t, collector)
+	args.NodesCoordinator = createMockNodesCoordinator(leader)
+	dsd, _ := slash.NewDoubleSigningDetector(args)
+
+	createLeaderSignedHeader := func(rootHash []byte) *block.Header {
+		header := &block.Header{
+			ChainID:       testChainID,
+			Round:         5,
+			RootHash:      rootHash,
+			PrevRandSeed:  []byte("prev rand seed"),
+			Signature:     []byte("aggregated signature"),
+			PubKeysBitmap: []byte{1},
+		}
+		leaderSignedData, _ := (&mock.MarshalizerMock{}).Marshal(header)
+		header.LeaderSignature = sign(leader, leaderSignedData)
+
+		return header
+	}
+
+	first := createLeaderSignedHeader([]byte("root hash 1"))
+	second := createLeaderSignedHeader([]byte("root hash 2"))
+
+	dsd.InterceptedHeader("topic", []byte("hash"), first)
+	dsd.InterceptedHeader("topic", []byte("hash"), first)
+	assert.Equal(t, 0, collector.numEvidences())
+
+	dsd.InterceptedHeader("topic", []byte("hash"), "not a header")
+	dsd.InterceptedHeader("topic", []byte("hash"), second)
+
+	require.Equal(t, 1, collector.numEvidences())
+	assert.Equal(t, dataSlash.DoubleProposalEvidence, collector.evidences[0].Type)
+	assert.Equal(t, leader, collector.evidences[0].PubKey)
+}
+
+func TestDoubleSigningDetector_OldRoundsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	collector := &evidencesCollector{}
+	dsd, _ := slash.NewDoubleSigningDetector(createMockDoubleSigningDetectorArgs(t, collector))
+
+	leader := []byte("leader")
+	validator := []byte("validator")
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+	firstHash := unsignedHeaderHash(first)
+	secondHash := unsignedHeaderHash(second)
+
+	dsd.AddProposedHeader(leader, first)
+	dsd.AddSignatureShare(validator, firstHash, sign(validator, firstHash))
+	dsd.AddProposedHeader(leader, &block.Header{ChainID: testChainID, Round: 100})
+	dsd.AddProposedHeader(leader, second)
+	dsd.AddSignatureShare(validator, secondHash, sign(validator, secondHash))
+
+	assert.Equal(t, 0, collector.numEvidences())
+}
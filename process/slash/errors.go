@@ -0,0 +1,54 @@
+package slash
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMessageSignVerifier signals that a nil message sign verifier has been provided
+var ErrNilMessageSignVerifier = errors.New("nil message sign verifier")
+
+// ErrNilEvidenceVerifier signals that a nil evidence verifier has been provided
+var ErrNilEvidenceVerifier = errors.New("nil evidence verifier")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilBroadcaster signals that a nil broadcaster has been provided
+var ErrNilBroadcaster = errors.New("nil broadcaster")
+
+// ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
+var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrNilEvidence signals that a nil evidence has been provided
+var ErrNilEvidence = errors.New("nil evidence")
+
+// ErrEmptyPublicKey signals that the evidence does not hold the public key of the offending validator
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrInvalidEvidenceType signals that the evidence has an unknown type
+var ErrInvalidEvidenceType = errors.New("invalid evidence type")
+
+// ErrHeaderMismatch signals that a header of the evidence does not belong to the evidence shard and round
+var ErrHeaderMismatch = errors.New("header does not match the evidence shard and round")
+
+// ErrHeadersNotConflicting signals that the evidence holds the same header twice
+var ErrHeadersNotConflicting = errors.New("the evidence headers are not conflicting")
+
+// ErrEmptyConsensusGroup signals that an empty consensus group was computed
+var ErrEmptyConsensusGroup = errors.New("empty consensus group")
+
+// ErrInvalidChainID signals that an invalid chain ID has been provided or that an evidence header belongs to another chain
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrNotLeader signals that the public key of a double proposal evidence is not the leader of the evidence round
+var ErrNotLeader = errors.New("the public key is not the leader of the round")
+
+// ErrNotInConsensusGroup signals that the public key of a double signing evidence was not in the consensus group of the evidence round
+var ErrNotInConsensusGroup = errors.New("the public key is not in the consensus group of the round")
@@ -0,0 +1,102 @@
+package slash
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	dataSlash "github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const receivedEvidencesCacheSize = 1000
+
+// ArgsEvidenceProcessor holds the arguments needed to create an evidence processor
+type ArgsEvidenceProcessor struct {
+	Marshalizer      marshal.Marshalizer
+	EvidenceVerifier EvidenceVerifier
+	AntifloodHandler P2PAntifloodHandler
+}
+
+type evidenceProcessor struct {
+	marshalizer       marshal.Marshalizer
+	evidenceVerifier  EvidenceVerifier
+	antifloodHandler  P2PAntifloodHandler
+	receivedEvidences storage.Cacher
+}
+
+// NewEvidenceProcessor creates the processor of the evidences received on the slashing evidence topic
+func NewEvidenceProcessor(args ArgsEvidenceProcessor) (*evidenceProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.EvidenceVerifier) {
+		return nil, ErrNilEvidenceVerifier
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return nil, ErrNilAntifloodHandler
+	}
+
+	cache, err := lrucache.NewCache(receivedEvidencesCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &evidenceProcessor{
+		marshalizer:       args.Marshalizer,
+		evidenceVerifier:  args.EvidenceVerifier,
+		antifloodHandler:  args.AntifloodHandler,
+		receivedEvidences: cache,
+	}, nil
+}
+
+// ProcessReceivedMessage verifies the received evidence. A valid evidence is propagated further while the peers
+// sending invalid evidences are blacklisted
+func (ep *evidenceProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+
+	err := ep.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = ep.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, core.SlashingEvidenceTopic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	evidence := &dataSlash.Evidence{}
+	err = ep.marshalizer.Unmarshal(evidence, message.Data())
+	if err == nil {
+		err = ep.evidenceVerifier.Verify(evidence)
+	}
+	if err != nil {
+		reason := "blacklisted due to invalid slashing evidence"
+		ep.antifloodHandler.BlacklistPeer(message.Peer(), reason, core.InvalidMessageBlacklistDuration)
+		ep.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+
+		return err
+	}
+
+	key := []byte(fmt.Sprintf("%s_%d_%d_%d", evidence.PubKey, evidence.Type, evidence.ShardID, evidence.Round))
+	has, _ := ep.receivedEvidences.HasOrAdd(key, struct{}{}, 0)
+	if !has {
+		log.Warn("received double signing evidence",
+			"pk", core.GetTrimmedPk(hex.EncodeToString(evidence.PubKey)),
+			"type", evidence.Type,
+			"shard", evidence.ShardID,
+			"round", evidence.Round)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ep *evidenceProcessor) IsInterfaceNil() bool {
+	return ep == nil
+}
@@ -0,0 +1,148 @@
+package slash_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	dataSlash "github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockEvidenceProcessorArgs() slash.ArgsEvidenceProcessor {
+	return slash.ArgsEvidenceProcessor{
+		Marshalizer:      &mock.MarshalizerMock{},
+		EvidenceVerifier: &mock.EvidenceVerifierStub{},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+	}
+}
+
+func createEvidenceMessage() *mock.P2PMessageMock {
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+	evidence := createEvidence(dataSlash.DoubleSigningEvidence, []byte("pubKey"), first, second)
+	buff, _ := (&mock.MarshalizerMock{}).Marshal(evidence)
+
+	return &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: "originator",
+	}
+}
+
+func TestNewEvidenceProcessor_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEvidenceProcessorArgs()
+	args.Marshalizer = nil
+	ep, err := slash.NewEvidenceProcessor(args)
+	assert.Nil(t, ep)
+	assert.Equal(t, slash.ErrNilMarshalizer, err)
+
+	args = createMockEvidenceProcessorArgs()
+	args.EvidenceVerifier = nil
+	ep, err = slash.NewEvidenceProcessor(args)
+	assert.Nil(t, ep)
+	assert.Equal(t, slash.ErrNilEvidenceVerifier, err)
+
+	args = createMockEvidenceProcessorArgs()
+	args.AntifloodHandler = nil
+	ep, err = slash.NewEvidenceProcessor(args)
+	assert.Nil(t, ep)
+	assert.Equal(t, slash.ErrNilAntifloodHandler, err)
+}
+
+func TestEvidenceProcessor_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := slash.NewEvidenceProcessor(createMockEvidenceProcessorArgs())
+
+	assert.Equal(t, slash.ErrNilMessage, ep.ProcessReceivedMessage(nil, "peer"))
+}
+
+func TestEvidenceProcessor_ProcessReceivedMessageFloodedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("flooded")
+	args := createMockEvidenceProcessorArgs()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+			assert.Equal(t, core.SlashingEvidenceTopic, topic)
+			return expectedErr
+		},
+	}
+	args.EvidenceVerifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence *dataSlash.Evidence) error {
+			assert.Fail(t, "should have not verified the evidence")
+			return nil
+		},
+	}
+	ep, _ := slash.NewEvidenceProcessor(args)
+
+	assert.Equal(t, expectedErr, ep.ProcessReceivedMessage(createEvidenceMessage(), "peer"))
+}
+
+func TestEvidenceProcessor_ProcessReceivedMessageInvalidEvidenceShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid evidence")
+	blacklisted := make(map[core.PeerID]struct{})
+	args := createMockEvidenceProcessorArgs()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			blacklisted[peer] = struct{}{}
+		},
+	}
+	args.EvidenceVerifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence *dataSlash.Evidence) error {
+			return expectedErr
+		},
+	}
+	ep, _ := slash.NewEvidenceProcessor(args)
+
+	err := ep.ProcessReceivedMessage(createEvidenceMessage(), "peer")
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, len(blacklisted))
+	assert.Contains(t, blacklisted, core.PeerID("peer"))
+	assert.Contains(t, blacklisted, core.PeerID("originator"))
+}
+
+func TestEvidenceProcessor_ProcessReceivedMessageNotAnEvidenceShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	numBlacklisted := 0
+	args := createMockEvidenceProcessorArgs()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			numBlacklisted++
+		},
+	}
+	ep, _ := slash.NewEvidenceProcessor(args)
+
+	var msg p2p.MessageP2P = &mock.P2PMessageMock{DataField: []byte("not an evidence")}
+	err := ep.ProcessReceivedMessage(msg, "peer")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, numBlacklisted)
+}
+
+func TestEvidenceProcessor_ProcessReceivedMessageValidEvidenceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEvidenceProcessorArgs()
+	args.EvidenceVerifier, _ = slash.NewEvidenceVerifier(createMockEvidenceVerifierArgs())
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			assert.Fail(t, "should have not blacklisted the peer")
+		},
+	}
+	ep, _ := slash.NewEvidenceProcessor(args)
+
+	assert.Nil(t, ep.ProcessReceivedMessage(createEvidenceMessage(), "peer"))
+	assert.Nil(t, ep.ProcessReceivedMessage(createEvidenceMessage(), "peer"))
+}
@@ -0,0 +1,201 @@
+package slash
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	dataSlash "github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsEvidenceVerifier holds the arguments needed to create an evidence verifier
+type ArgsEvidenceVerifier struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	SigVerifier      MessageSignVerifier
+	NodesCoordinator sharding.NodesCoordinator
+	ChainID          []byte
+}
+
+type evidenceVerifier struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	sigVerifier      MessageSignVerifier
+	nodesCoordinator sharding.NodesCoordinator
+	chainID          []byte
+}
+
+// NewEvidenceVerifier creates a component able to verify the double signing evidences
+func NewEvidenceVerifier(args ArgsEvidenceVerifier) (*evidenceVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.SigVerifier) {
+		return nil, ErrNilMessageSignVerifier
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if len(args.ChainID) == 0 {
+		return nil, ErrInvalidChainID
+	}
+
+	return &evidenceVerifier{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		sigVerifier:      args.SigVerifier,
+		nodesCoordinator: args.NodesCoordinator,
+		chainID:          args.ChainID,
+	}, nil
+}
+
+// Verify checks that the evidence holds two different headers of the evidence chain, shard and round, both of them
+// signed with the public key of the evidence by a member of the consensus group of that round (by its leader for the
+// double proposal evidences)
+func (ev *evidenceVerifier) Verify(evidence *dataSlash.Evidence) error {
+	if evidence == nil {
+		return ErrNilEvidence
+	}
+	if len(evidence.PubKey) == 0 {
+		return ErrEmptyPublicKey
+	}
+	if evidence.Type != dataSlash.DoubleSigningEvidence && evidence.Type != dataSlash.DoubleProposalEvidence {
+		return fmt.Errorf("%w: %d", ErrInvalidEvidenceType, evidence.Type)
+	}
+
+	firstHash, err := ev.verifySignedHeader(evidence, &evidence.First)
+	if err != nil {
+		return fmt.Errorf("%w for the first header", err)
+	}
+
+	secondHash, err := ev.verifySignedHeader(evidence, &evidence.Second)
+	if err != nil {
+		return fmt.Errorf("%w for the second header", err)
+	}
+
+	if bytes.Equal(firstHash, secondHash) {
+		return ErrHeadersNotConflicting
+	}
+
+	return nil
+}
+
+// verifySignedHeader checks the signature of the header and returns the hash of the header without any signature
+func (ev *evidenceVerifier) verifySignedHeader(evidence *dataSlash.Evidence, signedHeader *dataSlash.SignedHeader) ([]byte, error) {
+	header, err := ev.decodeHeader(evidence.ShardID, signedHeader.Header)
+	if err != nil {
+		return nil, err
+	}
+	if header.GetShardID() != evidence.ShardID || header.GetRound() != evidence.Round {
+		return nil, fmt.Errorf("%w: header shard %d, round %d", ErrHeaderMismatch, header.GetShardID(), header.GetRound())
+	}
+	if !bytes.Equal(header.GetChainID(), ev.chainID) {
+		return nil, fmt.Errorf("%w: header chain ID %s", ErrInvalidChainID, header.GetChainID())
+	}
+
+	err = ev.checkConsensusGroupMembership(evidence, header)
+	if err != nil {
+		return nil, err
+	}
+
+	headerHash, err := ComputeUnsignedHeaderHash(ev.marshalizer, ev.hasher, header)
+	if err != nil {
+		return nil, err
+	}
+
+	signedData := headerHash
+	if evidence.Type == dataSlash.DoubleProposalEvidence {
+		headerCopy := header.Clone()
+		headerCopy.SetLeaderSignature(nil)
+		signedData, err = ev.marshalizer.Marshal(headerCopy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = ev.sigVerifier.Verify(signedData, signedHeader.Signature, evidence.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return headerHash, nil
+}
+
+func (ev *evidenceVerifier) checkConsensusGroupMembership(evidence *dataSlash.Evidence, header data.HeaderHandler) error {
+	consensusGroup, err := computeConsensusGroup(ev.nodesCoordinator, header)
+	if err != nil {
+		return err
+	}
+
+	if evidence.Type == dataSlash.DoubleProposalEvidence {
+		if !bytes.Equal(consensusGroup[0].PubKey(), evidence.PubKey) {
+			return ErrNotLeader
+		}
+		return nil
+	}
+
+	for _, validator := range consensusGroup {
+		if bytes.Equal(validator.PubKey(), evidence.PubKey) {
+			return nil
+		}
+	}
+
+	return ErrNotInConsensusGroup
+}
+
+func (ev *evidenceVerifier) decodeHeader(shardID uint32, buff []byte) (data.HeaderHandler, error) {
+	var header data.HeaderHandler = &block.Header{}
+	if shardID == core.MetachainShardId {
+		header = &block.MetaBlock{}
+	}
+
+	err := ev.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ev *evidenceVerifier) IsInterfaceNil() bool {
+	return ev == nil
+}
+
+// ComputeUnsignedHeaderHash computes the hash of the header without the aggregated and the leader signatures, that
+// is, the hash the consensus group members sign in the signature subround
+func ComputeUnsignedHeaderHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher, header data.HeaderHandler) ([]byte, error) {
+	headerCopy := header.Clone()
+	headerCopy.SetSignature(nil)
+	headerCopy.SetPubKeysBitmap(nil)
+	headerCopy.SetLeaderSignature(nil)
+
+	return core.CalculateHash(marshalizer, hasher, headerCopy)
+}
+
+func computeConsensusGroup(nodesCoordinator sharding.NodesCoordinator, header data.HeaderHandler) ([]sharding.Validator, error) {
+	// the start of epoch blocks are validated by the nodes of the previous epoch
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	consensusGroup, err := nodesCoordinator.ComputeConsensusGroup(header.GetPrevRandSeed(), header.GetRound(), header.GetShardID(), epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) == 0 {
+		return nil, ErrEmptyConsensusGroup
+	}
+
+	return consensusGroup, nil
+}
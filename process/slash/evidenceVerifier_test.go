@@ -0,0 +1,230 @@
+package slash_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	dataSlash "github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+var errSignatureMismatch = errors.New("signature mismatch")
+
+var testChainID = []byte("chain ID")
+
+func sign(pubKey []byte, message []byte) []byte {
+	return append(append([]byte{}, pubKey...), mock.HasherMock{}.Compute(string(message))...)
+}
+
+func createMockSigVerifier() *mock.MessageSignVerifierMock {
+	return &mock.MessageSignVerifierMock{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			if !bytes.Equal(signedMessage, sign(pubKey, message)) {
+				return errSignatureMismatch
+			}
+			return nil
+		},
+	}
+}
+
+func createMockNodesCoordinator(consensusGroup ...[]byte) *mock.NodesCoordinatorMock {
+	return &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+			validators := make([]sharding.Validator, 0, len(consensusGroup))
+			for _, pubKey := range consensusGroup {
+				validators = append(validators, mock.NewValidatorMock(pubKey))
+			}
+			return validators, nil
+		},
+	}
+}
+
+func createMockEvidenceVerifierArgs() slash.ArgsEvidenceVerifier {
+	return slash.ArgsEvidenceVerifier{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           mock.HasherMock{},
+		SigVerifier:      createMockSigVerifier(),
+		NodesCoordinator: createMockNodesCoordinator([]byte("pubKey"), []byte("validator")),
+		ChainID:          testChainID,
+	}
+}
+
+func createSignedHeader(evidenceType uint32, pubKey []byte, header data.HeaderHandler) dataSlash.SignedHeader {
+	marshalizer := &mock.MarshalizerMock{}
+	headerBytes, _ := marshalizer.Marshal(header)
+
+	signedData, _ := slash.ComputeUnsignedHeaderHash(marshalizer, mock.HasherMock{}, header)
+	if evidenceType == dataSlash.DoubleProposalEvidence {
+		headerCopy := header.Clone()
+		headerCopy.SetLeaderSignature(nil)
+		signedData, _ = marshalizer.Marshal(headerCopy)
+	}
+
+	return dataSlash.SignedHeader{
+		Header:    headerBytes,
+		Signature: sign(pubKey, signedData),
+	}
+}
+
+func createEvidence(evidenceType uint32, pubKey []byte, first data.HeaderHandler, second data.HeaderHandler) *dataSlash.Evidence {
+	return &dataSlash.Evidence{
+		Type:    evidenceType,
+		PubKey:  pubKey,
+		ShardID: first.GetShardID(),
+		Round:   first.GetRound(),
+		First:   createSignedHeader(evidenceType, pubKey, first),
+		Second:  createSignedHeader(evidenceType, pubKey, second),
+	}
+}
+
+func TestNewEvidenceVerifier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEvidenceVerifierArgs()
+	args.Marshalizer = nil
+	ev, err := slash.NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev)
+	assert.Equal(t, slash.ErrNilMarshalizer, err)
+}
+
+func TestNewEvidenceVerifier_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEvidenceVerifierArgs()
+	args.Hasher = nil
+	ev, err := slash.NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev)
+	assert.Equal(t, slash.ErrNilHasher, err)
+}
+
+func TestNewEvidenceVerifier_NilSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEvidenceVerifierArgs()
+	args.SigVerifier = nil
+	ev, err := slash.NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev)
+	assert.Equal(t, slash.ErrNilMessageSignVerifier, err)
+}
+
+func TestNewEvidenceVerifier_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEvidenceVerifierArgs()
+	args.NodesCoordinator = nil
+	ev, err := slash.NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev)
+	assert.Equal(t, slash.ErrNilNodesCoordinator, err)
+}
+
+func TestNewEvidenceVerifier_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEvidenceVerifierArgs()
+	args.ChainID = nil
+	ev, err := slash.NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev)
+	assert.Equal(t, slash.ErrInvalidChainID, err)
+}
+
+func TestEvidenceVerifier_VerifyInvalidEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockEvidenceVerifierArgs())
+	pubKey := []byte("pubKey")
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+
+	assert.Equal(t, slash.ErrNilEvidence, ev.Verify(nil))
+
+	evidence := createEvidence(dataSlash.DoubleSigningEvidence, pubKey, first, second)
+	evidence.PubKey = nil
+	assert.Equal(t, slash.ErrEmptyPublicKey, ev.Verify(evidence))
+
+	evidence = createEvidence(dataSlash.DoubleSigningEvidence, pubKey, first, second)
+	evidence.Type = 0
+	assert.True(t, errors.Is(ev.Verify(evidence), slash.ErrInvalidEvidenceType))
+
+	evidence = createEvidence(dataSlash.DoubleSigningEvidence, pubKey, first, &block.Header{ChainID: testChainID, Round: 6})
+	assert.True(t, errors.Is(ev.Verify(evidence), slash.ErrHeaderMismatch))
+
+	evidence = createEvidence(dataSlash.DoubleSigningEvidence, pubKey, first, second)
+	evidence.Second.Signature = []byte("invalid signature")
+	assert.True(t, errors.Is(ev.Verify(evidence), errSignatureMismatch))
+
+	evidence = createEvidence(dataSlash.DoubleSigningEvidence, pubKey, first, first)
+	assert.Equal(t, slash.ErrHeadersNotConflicting, ev.Verify(evidence))
+
+	evidence = createEvidence(dataSlash.DoubleSigningEvidence, pubKey, first, &block.Header{ChainID: []byte("other chain ID"), Round: 5})
+	assert.True(t, errors.Is(ev.Verify(evidence), slash.ErrInvalidChainID))
+}
+
+func TestEvidenceVerifier_VerifyKeyOutsideTheConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockEvidenceVerifierArgs())
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+
+	evidence := createEvidence(dataSlash.DoubleSigningEvidence, []byte("other pubKey"), first, second)
+	assert.True(t, errors.Is(ev.Verify(evidence), slash.ErrNotInConsensusGroup))
+
+	evidence = createEvidence(dataSlash.DoubleSigningEvidence, []byte("validator"), first, second)
+	assert.Nil(t, ev.Verify(evidence))
+
+	evidence = createEvidence(dataSlash.DoubleProposalEvidence, []byte("validator"), first, second)
+	assert.True(t, errors.Is(ev.Verify(evidence), slash.ErrNotLeader))
+}
+
+func TestEvidenceVerifier_VerifySameHeaderWithDifferentSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockEvidenceVerifierArgs())
+	pubKey := []byte("pubKey")
+	first := &block.Header{ChainID: testChainID, Round: 5, RootHash: []byte("root hash")}
+	second := first.Clone()
+	second.SetSignature([]byte("aggregated signature"))
+	second.SetPubKeysBitmap([]byte{1})
+
+	evidence := createEvidence(dataSlash.DoubleSigningEvidence, pubKey, first, second)
+
+	assert.Equal(t, slash.ErrHeadersNotConflicting, ev.Verify(evidence))
+}
+
+func TestEvidenceVerifier_VerifyDoubleSigningShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockEvidenceVerifierArgs())
+	first := &block.Header{ShardID: 1, ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1")}
+	second := &block.Header{ShardID: 1, ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2")}
+	evidence := createEvidence(dataSlash.DoubleSigningEvidence, []byte("pubKey"), first, second)
+
+	assert.Nil(t, ev.Verify(evidence))
+}
+
+func TestEvidenceVerifier_VerifyDoubleProposalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockEvidenceVerifierArgs())
+	first := &block.MetaBlock{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 1"), Signature: []byte("signature 1"), LeaderSignature: []byte("leader signature 1")}
+	second := &block.MetaBlock{ChainID: testChainID, Round: 5, RootHash: []byte("root hash 2"), Signature: []byte("signature 2"), LeaderSignature: []byte("leader signature 2")}
+	evidence := createEvidence(dataSlash.DoubleProposalEvidence, []byte("pubKey"), first, second)
+	assert.Equal(t, core.MetachainShardId, evidence.ShardID)
+
+	assert.Nil(t, ev.Verify(evidence))
+
+	evidence.Type = dataSlash.DoubleSigningEvidence
+	assert.True(t, errors.Is(ev.Verify(evidence), errSignatureMismatch))
+}
@@ -0,0 +1,36 @@
+package slash
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	dataSlash "github.com/ElrondNetwork/elrond-go/data/slash"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// MessageSignVerifier is used to verify if a message was signed with the given public key
+type MessageSignVerifier interface {
+	Verify(message []byte, signedMessage []byte, pubKey []byte) error
+	IsInterfaceNil() bool
+}
+
+// EvidenceVerifier checks if an evidence proves that a validator signed two conflicting headers
+type EvidenceVerifier interface {
+	Verify(evidence *dataSlash.Evidence) error
+	IsInterfaceNil() bool
+}
+
+// Broadcaster is able to broadcast a message on a topic
+type Broadcaster interface {
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
+
+// P2PAntifloodHandler defines the behavior of a component able to signal that the system is too busy (or flooded)
+// processing p2p messages
+type P2PAntifloodHandler interface {
+	CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error
+	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,94 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtBurn)(nil)
+
+type esdtBurn struct {
+	funcGasCost   uint64
+	marshalizer   marshal.Marshalizer
+	keyPrefix     []byte
+	esdtSCAddress []byte
+}
+
+// NewESDTBurnFunc returns the esdt burn built-in function component
+func NewESDTBurnFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	esdtSCAddress []byte,
+) (*esdtBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if len(esdtSCAddress) == 0 {
+		return nil, process.ErrNilESDTSCAddress
+	}
+
+	e := &esdtBurn{
+		funcGasCost:   funcGasCost,
+		marshalizer:   marshalizer,
+		keyPrefix:     []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		esdtSCAddress: esdtSCAddress,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction will subtract the burnt value from the sender's esdt balance. The transaction then reaches the
+// esdt system smart contract which updates the token supply or sends the value back if the burn is not allowed
+func (e *esdtBurn) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.RecipientAddr, e.esdtSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	log.Trace("esdtBurn", "sender", vmInput.CallerAddr, "value", value, "token", esdtTokenKey)
+
+	if check.IfNil(acntSnd) {
+		return &vmcommon.VMOutput{}, nil
+	}
+
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	err := addToESDTBalance(e.marshalizer, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), true)
+	if err != nil {
+		return nil, err
+	}
+
+	// the remaining gas is not refunded here as it is used by the esdt system smart contract call on the metachain
+	return &vmcommon.VMOutput{GasRemaining: 0}, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtBurn) IsInterfaceNil() bool {
+	return e == nil
+}
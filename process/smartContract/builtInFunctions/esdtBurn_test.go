@@ -0,0 +1,125 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTBurnFunc(t *testing.T) {
+	t.Parallel()
+
+	burnFunc, err := NewESDTBurnFunc(10, nil, []byte("esdtSC"))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, burnFunc)
+
+	burnFunc, err = NewESDTBurnFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.Equal(t, process.ErrNilESDTSCAddress, err)
+	assert.Nil(t, burnFunc)
+
+	burnFunc, err = NewESDTBurnFunc(10, &mock.MarshalizerMock{}, []byte("esdtSC"))
+	assert.Nil(t, err)
+	assert.False(t, burnFunc.IsInterfaceNil())
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	esdtSCAddress := []byte("esdtSC")
+	burnFunc, _ := NewESDTBurnFunc(10, &mock.MarshalizerMock{}, esdtSCAddress)
+	_, err := burnFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{}
+	_, err = burnFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(1),
+			Arguments:   [][]byte{[]byte("token"), big.NewInt(10).Bytes()},
+		},
+		RecipientAddr: esdtSCAddress,
+	}
+	_, err = burnFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.RecipientAddr = []byte("other")
+	_, err = burnFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.RecipientAddr = esdtSCAddress
+	input.Arguments[1] = big.NewInt(0).Bytes()
+	_, err = burnFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input.Arguments[1] = big.NewInt(10).Bytes()
+	input.GasProvided = burnFunc.funcGasCost - 1
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	_, err = burnFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input.GasProvided = 50
+	_, err = burnFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionSenderShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdtSCAddress := []byte("esdtSC")
+	burnFunc, _ := NewESDTBurnFunc(10, marshalizer, esdtSCAddress)
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{key, big.NewInt(10).Bytes()},
+		},
+		RecipientAddr: esdtSCAddress,
+	}
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtKey := append(burnFunc.keyPrefix, key...)
+	esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(100)}
+	marshalledData, _ := marshalizer.Marshal(esdtToken)
+	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
+
+	vmOutput, err := burnFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+
+	marshalledData, _ = accSnd.DataTrieTracker().RetrieveValue(esdtKey)
+	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
+	assert.True(t, esdtToken.Value.Cmp(big.NewInt(90)) == 0)
+}
+
+func TestESDTBurn_ProcessBuiltInFunctionDestinationShardDoesNothing(t *testing.T) {
+	t.Parallel()
+
+	esdtSCAddress := []byte("esdtSC")
+	burnFunc, _ := NewESDTBurnFunc(10, &mock.MarshalizerMock{}, esdtSCAddress)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+			Arguments: [][]byte{[]byte("key"), big.NewInt(10).Bytes()},
+		},
+		RecipientAddr: esdtSCAddress,
+	}
+
+	accDst, _ := state.NewUserAccount(esdtSCAddress)
+	vmOutput, err := burnFunc.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+}
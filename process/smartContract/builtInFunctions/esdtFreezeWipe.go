@@ -0,0 +1,114 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtFreezeWipe)(nil)
+
+type esdtFreezeWipe struct {
+	marshalizer   marshal.Marshalizer
+	keyPrefix     []byte
+	esdtSCAddress []byte
+	wipe          bool
+	freeze        bool
+}
+
+// NewESDTFreezeWipeFunc returns the esdt freeze/un-freeze/wipe built-in function component
+func NewESDTFreezeWipeFunc(
+	marshalizer marshal.Marshalizer,
+	esdtSCAddress []byte,
+	freeze bool,
+	wipe bool,
+) (*esdtFreezeWipe, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if len(esdtSCAddress) == 0 {
+		return nil, process.ErrNilESDTSCAddress
+	}
+
+	e := &esdtFreezeWipe{
+		marshalizer:   marshalizer,
+		keyPrefix:     []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		esdtSCAddress: esdtSCAddress,
+		freeze:        freeze,
+		wipe:          wipe,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction changes the frozen flag of the destination's esdt balance or wipes it if it is frozen. The
+// wiped balance is reported back to the esdt system smart contract, which keeps the supply of the token
+func (e *esdtFreezeWipe) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, e.esdtSCAddress) {
+		return nil, process.ErrCallerIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilSCDestAccount
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	esdtData, err := getESDTDataFromKey(e.marshalizer, acntDst, esdtTokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wipedValue := big.NewInt(0)
+	if e.wipe {
+		if !esdtData.Frozen {
+			return nil, process.ErrESDTIsNotFrozenForAccount
+		}
+
+		log.Trace("esdtWipe", "addr", acntDst.AddressBytes(), "value", esdtData.Value, "tokenKey", esdtTokenKey)
+		wipedValue = esdtData.Value
+		esdtData.Value = big.NewInt(0)
+	} else {
+		esdtData.Frozen = e.freeze
+	}
+
+	err = saveESDTData(e.marshalizer, acntDst, esdtTokenKey, esdtData)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{}
+	if wipedValue.Cmp(zero) > 0 {
+		esdtWipeTxData := core.BuiltInFunctionESDTWipe + "@" + hex.EncodeToString(vmInput.Arguments[0]) + "@" + hex.EncodeToString(wipedValue.Bytes())
+		vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+		vmOutput.OutputAccounts[string(e.esdtSCAddress)] = &vmcommon.OutputAccount{
+			Address:  e.esdtSCAddress,
+			Data:     []byte(esdtWipeTxData),
+			GasLimit: vmInput.GasProvided,
+		}
+	}
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtFreezeWipe) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,121 @@
+package builtInFunctions
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTFreezeWipeFunc(t *testing.T) {
+	t.Parallel()
+
+	freezeFunc, err := NewESDTFreezeWipeFunc(nil, []byte("esdtSC"), true, false)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, freezeFunc)
+
+	freezeFunc, err = NewESDTFreezeWipeFunc(&mock.MarshalizerMock{}, nil, true, false)
+	assert.Equal(t, process.ErrNilESDTSCAddress, err)
+	assert.Nil(t, freezeFunc)
+
+	freezeFunc, err = NewESDTFreezeWipeFunc(&mock.MarshalizerMock{}, []byte("esdtSC"), true, false)
+	assert.Nil(t, err)
+	assert.False(t, freezeFunc.IsInterfaceNil())
+}
+
+func TestESDTFreezeWipe_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	esdtSCAddress := []byte("esdtSC")
+	freezeFunc, _ := NewESDTFreezeWipeFunc(&mock.MarshalizerMock{}, esdtSCAddress, true, false)
+
+	_, err := freezeFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+		},
+	}
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token")}
+	input.CallValue = big.NewInt(1)
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.CallerAddr = []byte("caller")
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrCallerIsNotESDTSystemSC, err)
+
+	input.CallerAddr = esdtSCAddress
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilSCDestAccount, err)
+}
+
+func TestESDTFreezeWipe_ProcessBuiltInFunctionFreezeUnFreezeAndWipe(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdtSCAddress := []byte("esdtSC")
+	freezeFunc, _ := NewESDTFreezeWipeFunc(marshalizer, esdtSCAddress, true, false)
+	unFreezeFunc, _ := NewESDTFreezeWipeFunc(marshalizer, esdtSCAddress, false, false)
+	wipeFunc, _ := NewESDTFreezeWipeFunc(marshalizer, esdtSCAddress, false, true)
+
+	key := []byte("key")
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey := append(freezeFunc.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, acnt, esdtKey, &esdt.ESDigitalToken{Value: big.NewInt(100)})
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: esdtSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{key},
+		},
+	}
+
+	_, err := wipeFunc.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Equal(t, process.ErrESDTIsNotFrozenForAccount, err)
+
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	esdtToken, _ := getESDTDataFromKey(marshalizer, acnt, esdtKey)
+	assert.True(t, esdtToken.Frozen)
+	assert.Equal(t, big.NewInt(100), esdtToken.Value)
+
+	_, err = unFreezeFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	esdtToken, _ = getESDTDataFromKey(marshalizer, acnt, esdtKey)
+	assert.False(t, esdtToken.Frozen)
+
+	_, err = freezeFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	input.GasProvided = 1000
+	vmOutput, err := wipeFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	esdtToken, _ = getESDTDataFromKey(marshalizer, acnt, esdtKey)
+	assert.True(t, esdtToken.Frozen)
+	assert.Equal(t, big.NewInt(0), esdtToken.Value)
+
+	require.Equal(t, 1, len(vmOutput.OutputAccounts))
+	outAcc := vmOutput.OutputAccounts[string(esdtSCAddress)]
+	require.NotNil(t, outAcc)
+	expectedData := core.BuiltInFunctionESDTWipe + "@" + hex.EncodeToString(key) + "@" + hex.EncodeToString(big.NewInt(100).Bytes())
+	assert.Equal(t, []byte(expectedData), outAcc.Data)
+	assert.Equal(t, uint64(1000), outAcc.GasLimit)
+
+	vmOutput, err = wipeFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+}
@@ -0,0 +1,127 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const esdtPauseKeyIdentifier = "pause"
+
+var _ process.BuiltinFunction = (*esdtPause)(nil)
+var _ process.ESDTPauseHandler = (*esdtPause)(nil)
+
+type esdtPause struct {
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	keyPrefix        []byte
+	esdtSCAddress    []byte
+	pause            bool
+}
+
+// NewESDTPauseFunc returns the esdt pause/un-pause built-in function component
+func NewESDTPauseFunc(
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	esdtSCAddress []byte,
+	pause bool,
+) (*esdtPause, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if len(esdtSCAddress) == 0 {
+		return nil, process.ErrNilESDTSCAddress
+	}
+
+	e := &esdtPause{
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + esdtPauseKeyIdentifier),
+		esdtSCAddress:    esdtSCAddress,
+		pause:            pause,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction saves the paused flag of the token inside the esdt global settings account of this shard
+func (e *esdtPause) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, e.esdtSCAddress) {
+		return nil, process.ErrCallerIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilSCDestAccount
+	}
+	if !bytes.Equal(acntDst.AddressBytes(), e.globalSettingsAddress()) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	log.Trace("esdtPause", "token", vmInput.Arguments[0], "pause", e.pause)
+
+	value := make([]byte, 0)
+	if e.pause {
+		value = []byte{1}
+	}
+	acntDst.DataTrieTracker().SaveKeyValue(esdtTokenKey, value)
+
+	return &vmcommon.VMOutput{}, nil
+}
+
+// IsPaused returns true if the token was paused in this shard. An error is returned if the paused flag could not be
+// read, as the token must not be considered unpaused in that case
+func (e *esdtPause) IsPaused(tokenName []byte) (bool, error) {
+	account, err := e.accounts.GetExistingAccount(e.globalSettingsAddress())
+	if errors.Is(err, state.ErrAccNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return false, process.ErrWrongTypeAssertion
+	}
+
+	esdtTokenKey := append(e.keyPrefix, tokenName...)
+	value, err := userAccount.DataTrieTracker().RetrieveValue(esdtTokenKey)
+	if errors.Is(err, state.ErrNilTrie) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return len(value) > 0, nil
+}
+
+func (e *esdtPause) globalSettingsAddress() []byte {
+	return core.ESDTGlobalSettingsAddress(e.shardCoordinator.SelfId())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtPause) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,143 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTPauseFunc(t *testing.T) {
+	t.Parallel()
+
+	esdtSCAddress := []byte("esdtSC")
+	pauseFunc, err := NewESDTPauseFunc(nil, mock.NewOneShardCoordinatorMock(), esdtSCAddress, true)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+	assert.Nil(t, pauseFunc)
+
+	pauseFunc, err = NewESDTPauseFunc(&mock.AccountsStub{}, nil, esdtSCAddress, true)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.Nil(t, pauseFunc)
+
+	pauseFunc, err = NewESDTPauseFunc(&mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), nil, true)
+	assert.Equal(t, process.ErrNilESDTSCAddress, err)
+	assert.Nil(t, pauseFunc)
+
+	pauseFunc, err = NewESDTPauseFunc(&mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), esdtSCAddress, true)
+	assert.Nil(t, err)
+	assert.False(t, pauseFunc.IsInterfaceNil())
+}
+
+func TestESDTPause_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	esdtSCAddress := []byte("esdtSC")
+	pauseFunc, _ := NewESDTPauseFunc(&mock.AccountsStub{}, mock.NewOneShardCoordinatorMock(), esdtSCAddress, true)
+
+	_, err := pauseFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(0),
+		},
+	}
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token")}
+	input.CallValue = big.NewInt(1)
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.CallerAddr = []byte("caller")
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrCallerIsNotESDTSystemSC, err)
+
+	input.CallerAddr = esdtSCAddress
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilSCDestAccount, err)
+
+	acntDst, _ := state.NewUserAccount([]byte("dst"))
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, acntDst, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+}
+
+func TestESDTPause_ProcessBuiltInFunctionPauseAndUnPause(t *testing.T) {
+	t.Parallel()
+
+	esdtSCAddress := []byte("esdtSC")
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	globalSettings, _ := state.NewUserAccount(core.ESDTGlobalSettingsAddress(shardCoordinator.SelfId()))
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return globalSettings, nil
+		},
+	}
+	pauseFunc, _ := NewESDTPauseFunc(accounts, shardCoordinator, esdtSCAddress, true)
+	unPauseFunc, _ := NewESDTPauseFunc(accounts, shardCoordinator, esdtSCAddress, false)
+
+	token := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: esdtSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{token},
+		},
+	}
+	isPaused, err := pauseFunc.IsPaused(token)
+	require.Nil(t, err)
+	assert.False(t, isPaused)
+
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, globalSettings, input)
+	require.Nil(t, err)
+	isPaused, _ = pauseFunc.IsPaused(token)
+	assert.True(t, isPaused)
+	isPaused, _ = unPauseFunc.IsPaused(token)
+	assert.True(t, isPaused)
+	isPaused, _ = pauseFunc.IsPaused([]byte("otherToken"))
+	assert.False(t, isPaused)
+
+	_, err = unPauseFunc.ProcessBuiltinFunction(nil, globalSettings, input)
+	require.Nil(t, err)
+	isPaused, _ = pauseFunc.IsPaused(token)
+	assert.False(t, isPaused)
+}
+
+func TestESDTPause_IsPausedMissingGlobalSettingsShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, state.ErrAccNotFound
+		},
+	}
+	pauseFunc, _ := NewESDTPauseFunc(accounts, mock.NewOneShardCoordinatorMock(), []byte("esdtSC"), true)
+
+	isPaused, err := pauseFunc.IsPaused([]byte("token"))
+	assert.Nil(t, err)
+	assert.False(t, isPaused)
+}
+
+func TestESDTPause_IsPausedGetAccountFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, expectedErr
+		},
+	}
+	pauseFunc, _ := NewESDTPauseFunc(accounts, mock.NewOneShardCoordinatorMock(), []byte("esdtSC"), true)
+
+	_, err := pauseFunc.IsPaused([]byte("token"))
+	assert.Equal(t, expectedErr, err)
+}
@@ -1,43 +1,54 @@
-//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. esdt.proto
 package builtInFunctions
 
 import (
+	"bytes"
 	"encoding/hex"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-const esdtKeyIdentifier = "esdt"
+const esdtKeyIdentifier = core.ESDTKeyIdentifier
+
+// esdtReturnedTransferMarker is appended by the destination shard when it sends back a rejected transfer, so that the
+// returned value is credited to the original sender even if the token got paused or the account frozen in between
+const esdtReturnedTransferMarker = "returned"
 
 var _ process.BuiltinFunction = (*esdtTransfer)(nil)
 
 var zero = big.NewInt(0)
 
 type esdtTransfer struct {
-	funcGasCost uint64
-	marshalizer marshal.Marshalizer
-	keyPrefix   []byte
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	keyPrefix    []byte
+	pauseHandler process.ESDTPauseHandler
 }
 
 // NewESDTTransferFunc returns the esdt transfer built-in function component
 func NewESDTTransferFunc(
 	funcGasCost uint64,
 	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
 ) (*esdtTransfer, error) {
 	if check.IfNil(marshalizer) {
 		return nil, process.ErrNilMarshalizer
 	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilESDTPauseHandler
+	}
 
 	e := &esdtTransfer{
-		funcGasCost: funcGasCost,
-		marshalizer: marshalizer,
-		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		funcGasCost:  funcGasCost,
+		marshalizer:  marshalizer,
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		pauseHandler: pauseHandler,
 	}
 
 	return e, nil
@@ -51,7 +62,8 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 	if vmInput == nil {
 		return nil, process.ErrNilVmInput
 	}
-	if len(vmInput.Arguments) != 2 {
+	isReturnedTransfer := len(vmInput.Arguments) == 3 && bytes.Equal(vmInput.Arguments[2], []byte(esdtReturnedTransferMarker))
+	if len(vmInput.Arguments) != 2 && !isReturnedTransfer {
 		return nil, process.ErrInvalidArguments
 	}
 	if vmInput.CallValue.Cmp(zero) != 0 {
@@ -64,17 +76,32 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 	}
 
 	gasRemaining := uint64(0)
-	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	tokenName := vmInput.Arguments[0]
+	esdtTokenKey := append(e.keyPrefix, tokenName...)
 	log.Trace("esdtTransfer", "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "value", value, "token", esdtTokenKey)
 
 	if !check.IfNil(acntSnd) {
+		// only the destination shard can send back a transfer
+		if isReturnedTransfer {
+			return nil, process.ErrInvalidArguments
+		}
 		// gas is paid only by sender
 		if vmInput.GasProvided < e.funcGasCost {
 			return nil, process.ErrNotEnoughGas
 		}
+		err := e.checkNotPaused(tokenName)
+		if err != nil {
+			return nil, err
+		}
+		if !check.IfNil(acntDst) {
+			err := e.checkDestination(acntDst, tokenName, esdtTokenKey)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		gasRemaining = vmInput.GasProvided - e.funcGasCost
-		err := e.addToESDTBalance(acntSnd, esdtTokenKey, big.NewInt(0).Neg(value))
+		err = addToESDTBalance(e.marshalizer, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), true)
 		if err != nil {
 			return nil, err
 		}
@@ -82,7 +109,14 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 
 	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining}
 	if !check.IfNil(acntDst) {
-		err := e.addToESDTBalance(acntDst, esdtTokenKey, value)
+		if check.IfNil(acntSnd) && !isReturnedTransfer {
+			err := e.checkDestination(acntDst, tokenName, esdtTokenKey)
+			if err != nil {
+				return e.createReturnedTransferOutput(vmInput, err), nil
+			}
+		}
+
+		err := addToESDTBalance(e.marshalizer, acntDst, esdtTokenKey, value, false)
 		if err != nil {
 			return nil, err
 		}
@@ -104,36 +138,108 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 	return vmOutput, nil
 }
 
-func (e *esdtTransfer) addToESDTBalance(userAcnt state.UserAccountHandler, key []byte, value *big.Int) error {
-	esdtData, err := e.getESDTDataFromKey(userAcnt, key)
+func (e *esdtTransfer) checkDestination(acntDst state.UserAccountHandler, tokenName []byte, esdtTokenKey []byte) error {
+	err := e.checkNotPaused(tokenName)
 	if err != nil {
 		return err
 	}
 
+	esdtData, err := getESDTDataFromKey(e.marshalizer, acntDst, esdtTokenKey)
+	if err != nil {
+		return err
+	}
+	if esdtData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+
+	return nil
+}
+
+func (e *esdtTransfer) checkNotPaused(tokenName []byte) error {
+	isPaused, err := e.pauseHandler.IsPaused(tokenName)
+	if err != nil {
+		return err
+	}
+	if isPaused {
+		return process.ErrESDTTokenIsPaused
+	}
+
+	return nil
+}
+
+// createReturnedTransferOutput sends the value back to the sender when the transfer is rejected in the destination
+// shard, as the value was already subtracted from the sender's balance in its own shard
+func (e *esdtTransfer) createReturnedTransferOutput(vmInput *vmcommon.ContractCallInput, err error) *vmcommon.VMOutput {
+	log.Trace("esdtTransfer rejected on destination", "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "error", err)
+
+	esdtTransferTxData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(vmInput.Arguments[0]) +
+		"@" + hex.EncodeToString(vmInput.Arguments[1]) + "@" + hex.EncodeToString([]byte(esdtReturnedTransferMarker))
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:     vmcommon.UserError,
+		ReturnMessage:  err.Error(),
+		OutputAccounts: make(map[string]*vmcommon.OutputAccount),
+	}
+	vmOutput.OutputAccounts[string(vmInput.CallerAddr)] = &vmcommon.OutputAccount{
+		Address: vmInput.CallerAddr,
+		Data:    []byte(esdtTransferTxData),
+	}
+
+	return vmOutput
+}
+
+func addToESDTBalance(
+	marshalizer marshal.Marshalizer,
+	userAcnt state.UserAccountHandler,
+	key []byte,
+	value *big.Int,
+	checkFrozen bool,
+) error {
+	esdtData, err := getESDTDataFromKey(marshalizer, userAcnt, key)
+	if err != nil {
+		return err
+	}
+	if checkFrozen && esdtData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+
 	esdtData.Value.Add(esdtData.Value, value)
 	if esdtData.Value.Cmp(zero) < 0 {
 		return process.ErrInsufficientFunds
 	}
 
-	marshalledData, err := e.marshalizer.Marshal(esdtData)
+	log.Trace("esdt after balance change", "addr", userAcnt.AddressBytes(), "value", esdtData.Value, "tokenKey", key)
+
+	return saveESDTData(marshalizer, userAcnt, key, esdtData)
+}
+
+func saveESDTData(
+	marshalizer marshal.Marshalizer,
+	userAcnt state.UserAccountHandler,
+	key []byte,
+	esdtData *esdt.ESDigitalToken,
+) error {
+	marshalledData, err := marshalizer.Marshal(esdtData)
 	if err != nil {
 		return err
 	}
 
-	log.Trace("esdt after transfer", "addr", userAcnt.AddressBytes(), "value", esdtData.Value, "tokenKey", key)
 	userAcnt.DataTrieTracker().SaveKeyValue(key, marshalledData)
 
 	return nil
 }
 
-func (e *esdtTransfer) getESDTDataFromKey(userAcnt state.UserAccountHandler, key []byte) (*ESDigitalToken, error) {
-	esdtData := &ESDigitalToken{Value: big.NewInt(0)}
+func getESDTDataFromKey(
+	marshalizer marshal.Marshalizer,
+	userAcnt state.UserAccountHandler,
+	key []byte,
+) (*esdt.ESDigitalToken, error) {
+	esdtData := &esdt.ESDigitalToken{Value: big.NewInt(0)}
 	marshalledData, err := userAcnt.DataTrieTracker().RetrieveValue(key)
 	if err != nil || len(marshalledData) == 0 {
 		return esdtData, nil
 	}
 
-	err = e.marshalizer.Unmarshal(esdtData, marshalledData)
+	err = marshalizer.Unmarshal(esdtData, marshalledData)
 	if err != nil {
 		return nil, err
 	}
@@ -1,20 +1,26 @@
 package builtInFunctions
 
 import (
+	"encoding/hex"
+	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	dataEsdt "github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestESDTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
-	esdt, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{})
+	esdt, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{}, &mock.ESDTPauseHandlerStub{})
 	_, err := esdt.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, process.ErrNilVmInput)
 
@@ -44,7 +50,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -62,7 +68,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
 	assert.Equal(t, err, process.ErrInsufficientFunds)
 
 	esdtKey := append(esdt.keyPrefix, key...)
-	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	esdtToken := &dataEsdt.ESDigitalToken{Value: big.NewInt(100)}
 	marshalledData, _ := marshalizer.Marshal(esdtToken)
 	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
 
@@ -81,7 +87,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSenderInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -95,7 +101,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSenderInShard(t *testing.T) {
 	accSnd, _ := state.NewUserAccount([]byte("snd"))
 
 	esdtKey := append(esdt.keyPrefix, key...)
-	esdtToken := &ESDigitalToken{Value: big.NewInt(100)}
+	esdtToken := &dataEsdt.ESDigitalToken{Value: big.NewInt(100)}
 	marshalledData, _ := marshalizer.Marshal(esdtToken)
 	accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshalledData)
 
@@ -110,7 +116,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	esdt, _ := NewESDTTransferFunc(10, marshalizer)
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
 		VMInput: vmcommon.VMInput{
@@ -126,8 +132,180 @@ func TestESDTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	_, err := esdt.ProcessBuiltinFunction(nil, accDst, input)
 	assert.Nil(t, err)
 	esdtKey := append(esdt.keyPrefix, key...)
-	esdtToken := &ESDigitalToken{}
+	esdtToken := &dataEsdt.ESDigitalToken{}
 	marshalledData, _ := accDst.DataTrieTracker().RetrieveValue(esdtKey)
 	_ = marshalizer.Unmarshal(esdtToken, marshalledData)
 	assert.True(t, esdtToken.Value.Cmp(big.NewInt(10)) == 0)
 }
+
+func TestNewESDTTransferFunc_NilPauseHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdt, err := NewESDTTransferFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.Equal(t, process.ErrNilESDTPauseHandler, err)
+	assert.Nil(t, esdt)
+}
+
+func saveESDTToken(t *testing.T, marshalizer marshal.Marshalizer, acnt state.UserAccountHandler, key []byte, esdtToken *dataEsdt.ESDigitalToken) {
+	marshalledData, err := marshalizer.Marshal(esdtToken)
+	require.Nil(t, err)
+	acnt.DataTrieTracker().SaveKeyValue(key, marshalledData)
+}
+
+func createESDTTransferInput(key []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("snd"),
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{key, big.NewInt(value).Bytes()},
+		},
+		RecipientAddr: []byte("dst"),
+	}
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionPausedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(tokenName []byte) (bool, error) {
+			return true, nil
+		},
+	}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, pauseHandler)
+
+	key := []byte("key")
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtKey := append(esdt.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, accSnd, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(100)})
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, nil, createESDTTransferInput(key, 10))
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionPauseCheckFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(tokenName []byte) (bool, error) {
+			return false, expectedErr
+		},
+	}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, pauseHandler)
+
+	key := []byte("key")
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtKey := append(esdt.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, accSnd, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(100)})
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, nil, createESDTTransferInput(key, 10))
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionFrozenSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+
+	key := []byte("key")
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtKey := append(esdt.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, accSnd, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(100), Frozen: true})
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, nil, createESDTTransferInput(key, 10))
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionFrozenDestinationInSameShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+
+	key := []byte("key")
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey := append(esdt.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, accSnd, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(100)})
+	saveESDTToken(t, marshalizer, accDst, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(0), Frozen: true})
+
+	_, err := esdt.ProcessBuiltinFunction(accSnd, accDst, createESDTTransferInput(key, 10))
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+
+	esdtToken, _ := getESDTDataFromKey(marshalizer, accSnd, esdtKey)
+	assert.Equal(t, big.NewInt(100), esdtToken.Value)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionFrozenDestinationCrossShardShouldSendBack(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+
+	key := []byte("key")
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey := append(esdt.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, accDst, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(5), Frozen: true})
+
+	input := createESDTTransferInput(key, 10)
+	vmOutput, err := esdt.ProcessBuiltinFunction(nil, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+
+	esdtToken, _ := getESDTDataFromKey(marshalizer, accDst, esdtKey)
+	assert.Equal(t, big.NewInt(5), esdtToken.Value)
+
+	expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(key) + "@" +
+		hex.EncodeToString(big.NewInt(10).Bytes()) + "@" + hex.EncodeToString([]byte(esdtReturnedTransferMarker))
+	returnedAccount := vmOutput.OutputAccounts[string(input.CallerAddr)]
+	require.NotNil(t, returnedAccount)
+	assert.Equal(t, []byte(expectedData), returnedAccount.Data)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionReturnedTransferIgnoresFrozenAndPaused(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.ESDTPauseHandlerStub{
+		IsPausedCalled: func(tokenName []byte) (bool, error) {
+			return true, nil
+		},
+	}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, pauseHandler)
+
+	key := []byte("key")
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	esdtKey := append(esdt.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, accDst, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(5), Frozen: true})
+
+	input := createESDTTransferInput(key, 10)
+	input.Arguments = append(input.Arguments, []byte(esdtReturnedTransferMarker))
+	vmOutput, err := esdt.ProcessBuiltinFunction(nil, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	esdtToken, _ := getESDTDataFromKey(marshalizer, accDst, esdtKey)
+	assert.Equal(t, big.NewInt(15), esdtToken.Value)
+	assert.True(t, esdtToken.Frozen)
+}
+
+func TestESDTTransfer_ProcessBuiltInFunctionReturnedTransferFromSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdt, _ := NewESDTTransferFunc(10, marshalizer, &mock.ESDTPauseHandlerStub{})
+
+	key := []byte("key")
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	esdtKey := append(esdt.keyPrefix, key...)
+	saveESDTToken(t, marshalizer, accSnd, esdtKey, &dataEsdt.ESDigitalToken{Value: big.NewInt(100)})
+
+	input := createESDTTransferInput(key, 10)
+	input.Arguments = append(input.Arguments, []byte(esdtReturnedTransferMarker))
+	_, err := esdt.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
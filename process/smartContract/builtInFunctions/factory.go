@@ -4,8 +4,11 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -17,10 +20,19 @@ type ArgsCreateBuiltInFunctionContainer struct {
 	MapDNSAddresses      map[string]struct{}
 	EnableUserNameChange bool
 	Marshalizer          marshal.Marshalizer
+	Accounts             state.AccountsAdapter
+	ShardCoordinator     sharding.Coordinator
 }
 
 // CreateBuiltInFunctionContainer will create the list of built-in functions
 func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (process.BuiltInFunctionContainer, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
 	gasConfig, err := createGasConfig(args.GasMap)
 	if err != nil {
 		return nil, err
@@ -58,7 +70,25 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (pr
 		return nil, err
 	}
 
-	newFunc, err = NewESDTTransferFunc(gasConfig.BuiltInCost.ESDTTransfer, args.Marshalizer)
+	pauseFunc, err := NewESDTPauseFunc(args.Accounts, args.ShardCoordinator, factory.ESDTSCAddress, true)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTPause, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTPauseFunc(args.Accounts, args.ShardCoordinator, factory.ESDTSCAddress, false)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTUnPause, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTTransferFunc(gasConfig.BuiltInCost.ESDTTransfer, args.Marshalizer, pauseFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +97,42 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (pr
 		return nil, err
 	}
 
+	newFunc, err = NewESDTBurnFunc(gasConfig.BuiltInCost.ESDTBurn, args.Marshalizer, factory.ESDTSCAddress)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, factory.ESDTSCAddress, true, false)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTFreeze, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, factory.ESDTSCAddress, false, false)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTUnFreeze, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, factory.ESDTSCAddress, false, true)
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTWipe, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
@@ -18,6 +18,8 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		MapDNSAddresses:      make(map[string]struct{}),
 		EnableUserNameChange: false,
 		Marshalizer:          &mock.MarshalizerMock{},
+		Accounts:             &mock.AccountsStub{},
+		ShardCoordinator:     mock.NewOneShardCoordinatorMock(),
 	}
 
 	return args
@@ -48,6 +50,7 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveUserName"] = value
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
+	gasMap["ESDTBurn"] = value
 
 	return gasMap
 }
@@ -67,8 +70,20 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, container)
 
+	args = createMockArguments()
+	args.Accounts = nil
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+	assert.Nil(t, container)
+
+	args = createMockArguments()
+	args.ShardCoordinator = nil
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.Nil(t, container)
+
 	args = createMockArguments()
 	container, err = CreateBuiltInFunctionContainer(args)
 	assert.Nil(t, err)
-	assert.Equal(t, container.Len(), 5)
+	assert.Equal(t, container.Len(), 11)
 }
@@ -0,0 +1,83 @@
+package smartContract
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgsHistoricalSCQueryService holds the arguments needed to create a new historical SC query service. The historical
+// state query service has to run its virtual machines on top of the historical accounts adapter, which must not be
+// the one used by the block processor
+type ArgsHistoricalSCQueryService struct {
+	CurrentStateQueryService    process.SCQueryServiceHandler
+	HistoricalStateQueryService process.SCQueryService
+	HistoricalAccounts          state.AccountsAdapter
+}
+
+// HistoricalSCQueryService executes the SC queries against the current state, or, if the query specifies a root
+// hash, against the state with that root hash, for as long as it has not been pruned
+type HistoricalSCQueryService struct {
+	currentStateQueryService    process.SCQueryServiceHandler
+	historicalStateQueryService process.SCQueryService
+	historicalAccounts          state.AccountsAdapter
+	mutHistoricalQuery          sync.Mutex
+}
+
+// NewHistoricalSCQueryService returns a new instance of HistoricalSCQueryService
+func NewHistoricalSCQueryService(args ArgsHistoricalSCQueryService) (*HistoricalSCQueryService, error) {
+	if check.IfNil(args.CurrentStateQueryService) {
+		return nil, process.ErrNilSCQueryService
+	}
+	if check.IfNil(args.HistoricalStateQueryService) {
+		return nil, process.ErrNilSCQueryService
+	}
+	if check.IfNil(args.HistoricalAccounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+
+	return &HistoricalSCQueryService{
+		currentStateQueryService:    args.CurrentStateQueryService,
+		historicalStateQueryService: args.HistoricalStateQueryService,
+		historicalAccounts:          args.HistoricalAccounts,
+	}, nil
+}
+
+// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract, against the state
+// selected by the query
+func (service *HistoricalSCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if len(query.RootHash) == 0 {
+		return service.currentStateQueryService.ExecuteQuery(query)
+	}
+
+	service.mutHistoricalQuery.Lock()
+	defer service.mutHistoricalQuery.Unlock()
+
+	err := service.historicalAccounts.RecreateTrie(query.RootHash)
+	if errors.Is(err, trie.ErrHashNotFound) {
+		return nil, fmt.Errorf("%w: root hash %s: %s", state.ErrStatePruned, hex.EncodeToString(query.RootHash), err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return service.historicalStateQueryService.ExecuteQuery(query)
+}
+
+// ComputeScCallGasLimit will estimate how many gas a transaction will consume, against the current state
+func (service *HistoricalSCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	return service.currentStateQueryService.ComputeScCallGasLimit(tx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (service *HistoricalSCQueryService) IsInterfaceNil() bool {
+	return service == nil
+}
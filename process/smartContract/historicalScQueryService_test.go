@@ -0,0 +1,187 @@
+package smartContract
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsHistoricalSCQueryService() ArgsHistoricalSCQueryService {
+	return ArgsHistoricalSCQueryService{
+		CurrentStateQueryService:    &mock.ScQueryStub{},
+		HistoricalStateQueryService: &mock.ScQueryStub{},
+		HistoricalAccounts:          &mock.AccountsStub{},
+	}
+}
+
+func TestNewHistoricalSCQueryService_NilCurrentStateQueryServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalSCQueryService()
+	args.CurrentStateQueryService = nil
+	service, err := NewHistoricalSCQueryService(args)
+
+	assert.Nil(t, service)
+	assert.Equal(t, process.ErrNilSCQueryService, err)
+}
+
+func TestNewHistoricalSCQueryService_NilHistoricalStateQueryServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalSCQueryService()
+	args.HistoricalStateQueryService = nil
+	service, err := NewHistoricalSCQueryService(args)
+
+	assert.Nil(t, service)
+	assert.Equal(t, process.ErrNilSCQueryService, err)
+}
+
+func TestNewHistoricalSCQueryService_NilHistoricalAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalSCQueryService()
+	args.HistoricalAccounts = nil
+	service, err := NewHistoricalSCQueryService(args)
+
+	assert.Nil(t, service)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewHistoricalSCQueryService_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	service, err := NewHistoricalSCQueryService(createMockArgsHistoricalSCQueryService())
+
+	assert.Nil(t, err)
+	assert.False(t, service.IsInterfaceNil())
+}
+
+func TestHistoricalSCQueryService_ExecuteQueryWithoutRootHashShouldUseCurrentState(t *testing.T) {
+	t.Parallel()
+
+	expectedOutput := &vmcommon.VMOutput{ReturnMessage: "current"}
+	args := createMockArgsHistoricalSCQueryService()
+	args.CurrentStateQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return expectedOutput, nil
+		},
+	}
+	args.HistoricalStateQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not queried the historical state")
+			return nil, nil
+		},
+	}
+	args.HistoricalAccounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			assert.Fail(t, "should have not recreated the historical state")
+			return nil
+		},
+	}
+	service, _ := NewHistoricalSCQueryService(args)
+
+	output, err := service.ExecuteQuery(&process.SCQuery{ScAddress: []byte("sc"), FuncName: "get"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOutput, output)
+}
+
+func TestHistoricalSCQueryService_ExecuteQueryWithRootHashShouldUseHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	recreatedRootHash := make([]byte, 0)
+	expectedOutput := &vmcommon.VMOutput{ReturnMessage: "historical"}
+	args := createMockArgsHistoricalSCQueryService()
+	args.CurrentStateQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not queried the current state")
+			return nil, nil
+		},
+	}
+	args.HistoricalStateQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return expectedOutput, nil
+		},
+	}
+	args.HistoricalAccounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+	}
+	service, _ := NewHistoricalSCQueryService(args)
+
+	output, err := service.ExecuteQuery(&process.SCQuery{ScAddress: []byte("sc"), FuncName: "get", RootHash: rootHash})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOutput, output)
+	assert.Equal(t, rootHash, recreatedRootHash)
+}
+
+func TestHistoricalSCQueryService_ExecuteQueryOnPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalSCQueryService()
+	args.HistoricalStateQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not queried the historical state")
+			return nil, nil
+		},
+	}
+	args.HistoricalAccounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return trie.ErrHashNotFound
+		},
+	}
+	service, _ := NewHistoricalSCQueryService(args)
+
+	output, err := service.ExecuteQuery(&process.SCQuery{ScAddress: []byte("sc"), FuncName: "get", RootHash: []byte("root hash")})
+
+	assert.Nil(t, output)
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, state.ErrStatePruned))
+}
+
+func TestHistoricalSCQueryService_ExecuteQueryRecreateTrieErrorShouldNotSignalPrunedState(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsHistoricalSCQueryService()
+	args.HistoricalAccounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return expectedErr
+		},
+	}
+	service, _ := NewHistoricalSCQueryService(args)
+
+	output, err := service.ExecuteQuery(&process.SCQuery{ScAddress: []byte("sc"), FuncName: "get", RootHash: []byte("root hash")})
+
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestHistoricalSCQueryService_ComputeScCallGasLimitShouldUseCurrentState(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalSCQueryService()
+	args.CurrentStateQueryService = &mock.ScQueryStub{
+		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+			return 42, nil
+		},
+	}
+	service, _ := NewHistoricalSCQueryService(args)
+
+	gasLimit, err := service.ComputeScCallGasLimit(&transaction.Transaction{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(42), gasLimit)
+}
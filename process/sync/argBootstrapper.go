@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
@@ -36,6 +37,7 @@ type ArgBaseBootstrapper struct {
 	EpochHandler        dataRetriever.EpochHandler
 	MiniblocksProvider  process.MiniBlockProvider
 	Uint64Converter     typeConverters.Uint64ByteSliceConverter
+	Indexer             indexer.Indexer
 }
 
 // ArgShardBootstrapper holds all dependencies required by the bootstrap data factory in order to create
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -108,6 +109,7 @@ type baseBootstrap struct {
 	mutRcvMiniBlocks   sync.Mutex
 	miniBlocksProvider process.MiniBlockProvider
 	poolsHolder        dataRetriever.PoolsHolder
+	indexer            indexer.Indexer
 	mutRequestHeaders  sync.Mutex
 	cancelFunc         func()
 }
@@ -442,6 +444,9 @@ func checkBootstrapNilParameters(arguments ArgBaseBootstrapper) error {
 	if check.IfNil(arguments.MiniblocksProvider) {
 		return process.ErrNilMiniBlocksProvider
 	}
+	if check.IfNil(arguments.Indexer) {
+		return process.ErrNilIndexer
+	}
 
 	return nil
 }
@@ -761,6 +766,9 @@ func (boot *baseBootstrap) rollBackOneBlock(
 
 	boot.cleanCachesAndStorageOnRollback(currHeader)
 
+	// the revert is sent asynchronously, as the other indexer calls, so that a slow indexer does not delay the sync
+	go boot.indexer.RevertIndexedBlock(currHeader, currBlockBody)
+
 	return nil
 }
 
@@ -187,6 +187,7 @@ func CreateShardBootstrapMockArguments() sync.ArgShardBootstrapper {
 		EpochHandler:        &mock.EpochStartTriggerStub{},
 		MiniblocksProvider:  &mock.MiniBlocksProviderStub{},
 		Uint64Converter:     &mock.Uint64ByteSliceConverterMock{},
+		Indexer:             &mock.IndexerMock{},
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -374,6 +375,18 @@ func TestNewShardBootstrap_NilBlackListHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBlackListCacher, err)
 }
 
+func TestNewShardBootstrap_NilIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := CreateShardBootstrapMockArguments()
+	args.Indexer = nil
+
+	bs, err := sync.NewShardBootstrap(args)
+
+	assert.Nil(t, bs)
+	assert.Equal(t, process.ErrNilIndexer, err)
+}
+
 func TestNewShardBootstrap_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1426,6 +1439,13 @@ func TestBootstrap_RollBackIsEmptyCallRollBackOneBlockOkValsShouldWork(t *testin
 			return nil
 		},
 	}
+	revertedHeaderChan := make(chan data.HeaderHandler, 1)
+	args.Indexer = &mock.IndexerMock{
+		RevertIndexedBlockCalled: func(header data.HeaderHandler, _ data.BodyHandler) {
+			revertedHeaderChan <- header
+		},
+	}
+	currentHdr := hdr
 
 	bs, _ := sync.NewShardBootstrap(args)
 	bs.SetForkNonce(currentHdrNonce)
@@ -1437,6 +1457,12 @@ func TestBootstrap_RollBackIsEmptyCallRollBackOneBlockOkValsShouldWork(t *testin
 	assert.True(t, remFlags.flagHdrRemovedFromForkDetector)
 	assert.Equal(t, blkc.GetCurrentBlockHeader(), prevHdr)
 	assert.Equal(t, blkc.GetCurrentBlockHeaderHash(), prevHdrHash)
+	select {
+	case revertedHeader := <-revertedHeaderChan:
+		assert.True(t, revertedHeader == currentHdr)
+	case <-time.After(time.Second):
+		assert.Fail(t, "the indexed block was not reverted")
+	}
 }
 
 func TestBootstrap_RollbackIsEmptyCallRollBackOneBlockToGenesisShouldWork(t *testing.T) {
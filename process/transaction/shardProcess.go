@@ -241,7 +241,7 @@ func (txProc *txProcessor) createReceiptWithReturnedGas(txHash []byte, tx *trans
 	rpt := &receipt.Receipt{
 		Value:   big.NewInt(0).Set(refundValue),
 		SndAddr: tx.SndAddr,
-		Data:    []byte("refundedGas"),
+		Data:    []byte(core.RefundedGasReceiptData),
 		TxHash:  txHash,
 	}
 
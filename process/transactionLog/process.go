@@ -59,7 +59,7 @@ func (tlp *txLogProcessor) GetLog(txHash []byte) (data.LogHandler, error) {
 		return nil, process.ErrLogNotFound
 	}
 
-	var txLog data.LogHandler
+	txLog := &transaction.Log{}
 	err = tlp.marshalizer.Unmarshal(txLog, txLogBuff)
 	if err != nil {
 		return nil, err
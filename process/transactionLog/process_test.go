@@ -162,3 +162,25 @@ func TestTxLogProcessor_GetLogUnmarshalErr(t *testing.T) {
 
 	require.Equal(t, retErr, err)
 }
+
+func TestTxLogProcessor_GetLogShouldWork(t *testing.T) {
+	marshalizer := &mock.MarshalizerMock{}
+	expectedLog := &transaction.Log{
+		Address: []byte("sc address"),
+		Events:  []*transaction.Event{{Identifier: []byte("identifier"), Topics: [][]byte{[]byte("topic")}}},
+	}
+	buff, _ := marshalizer.Marshal(expectedLog)
+	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer: &mock.StorerStub{
+			GetCalled: func(key []byte) (bytes []byte, err error) {
+				return buff, nil
+			},
+		},
+		Marshalizer: marshalizer,
+	})
+
+	txLog, err := txLogProcessor.GetLog([]byte("txhash"))
+
+	require.Nil(t, err)
+	require.Equal(t, expectedLog, txLog)
+}
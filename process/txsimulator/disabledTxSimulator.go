@@ -0,0 +1,24 @@
+package txsimulator
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type disabledTxSimulator struct {
+}
+
+// NewDisabledTxSimulator returns a transaction simulator which does not execute anything. It is used on the nodes
+// which do not support the transaction simulation, like the metachain ones
+func NewDisabledTxSimulator() *disabledTxSimulator {
+	return &disabledTxSimulator{}
+}
+
+// ProcessTx returns ErrSimulationNotSupported
+func (dts *disabledTxSimulator) ProcessTx(_ *transaction.Transaction) (*transaction.SimulationResults, error) {
+	return nil, ErrSimulationNotSupported
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dts *disabledTxSimulator) IsInterfaceNil() bool {
+	return dts == nil
+}
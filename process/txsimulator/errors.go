@@ -0,0 +1,6 @@
+package txsimulator
+
+import "errors"
+
+// ErrSimulationNotSupported signals that the transaction simulation is not supported by this node
+var ErrSimulationNotSupported = errors.New("transaction simulation is not supported on this node")
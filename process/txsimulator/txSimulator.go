@@ -0,0 +1,338 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var log = logger.GetOrCreate("process/txsimulator")
+
+// ArgsTxSimulator holds the arguments needed to create a new transaction simulator. The transaction processor, the
+// intermediate processors, the fee and the gas handlers have to be created on top of the provided accounts adapter,
+// which must not be the one used by the block processor
+type ArgsTxSimulator struct {
+	TransactionProcessor      process.TransactionProcessor
+	IntermediateProcContainer process.IntermediateProcessorContainer
+	TxFeeHandler              process.TransactionFeeHandler
+	GasHandler                process.GasHandler
+	AccountsAdapter           state.AccountsAdapter
+	BlockChainHook            process.BlockChainHookHandler
+	BlockChain                data.ChainHandler
+	TxLogsProcessor           process.TransactionLogProcessorDatabase
+	AddressPubkeyConverter    core.PubkeyConverter
+	ShardCoordinator          sharding.Coordinator
+	Marshalizer               marshal.Marshalizer
+	Hasher                    hashing.Hasher
+}
+
+type transactionSimulator struct {
+	txProcessor            process.TransactionProcessor
+	intermediateProcessors []process.IntermediateTransactionHandler
+	txFeeHandler           process.TransactionFeeHandler
+	gasHandler             process.GasHandler
+	accounts               state.AccountsAdapter
+	blockChainHook         process.BlockChainHookHandler
+	blockChain             data.ChainHandler
+	txLogsProcessor        process.TransactionLogProcessorDatabase
+	addressPubkeyConverter core.PubkeyConverter
+	shardCoordinator       sharding.Coordinator
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	mutSimulation          sync.Mutex
+}
+
+// NewTransactionSimulator creates a component which executes transactions against the last committed state
+// and then discards all the changes
+func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error) {
+	if check.IfNil(args.TransactionProcessor) {
+		return nil, process.ErrNilTxProcessor
+	}
+	if check.IfNil(args.IntermediateProcContainer) {
+		return nil, process.ErrNilIntermediateProcessorContainer
+	}
+	if check.IfNil(args.TxFeeHandler) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.GasHandler) {
+		return nil, process.ErrNilGasHandler
+	}
+	if check.IfNil(args.AccountsAdapter) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return nil, process.ErrNilBlockChainHook
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.TxLogsProcessor) {
+		return nil, process.ErrNilTxLogsProcessor
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	intermediateProcessors := make([]process.IntermediateTransactionHandler, 0)
+	for _, blockType := range []block.Type{block.SmartContractResultBlock, block.ReceiptBlock, block.InvalidBlock} {
+		intermediateProcessor, err := args.IntermediateProcContainer.Get(blockType)
+		if err != nil {
+			return nil, err
+		}
+
+		intermediateProcessors = append(intermediateProcessors, intermediateProcessor)
+	}
+
+	args.TxLogsProcessor.EnableLogToBeSavedInCache()
+
+	return &transactionSimulator{
+		txProcessor:            args.TransactionProcessor,
+		intermediateProcessors: intermediateProcessors,
+		txFeeHandler:           args.TxFeeHandler,
+		gasHandler:             args.GasHandler,
+		accounts:               args.AccountsAdapter,
+		blockChainHook:         args.BlockChainHook,
+		blockChain:             args.BlockChain,
+		txLogsProcessor:        args.TxLogsProcessor,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		shardCoordinator:       args.ShardCoordinator,
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+	}, nil
+}
+
+// ProcessTx executes the provided transaction against the state of the last committed block and returns the
+// resulting balances, smart contract results, receipts and logs. All the state changes are discarded afterwards
+func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	if check.IfNil(tx) {
+		return nil, process.ErrNilTransaction
+	}
+
+	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	lastCommittedHeader := ts.getLastCommittedHeader()
+	if check.IfNil(lastCommittedHeader) {
+		return nil, process.ErrNilBlockHeader
+	}
+
+	err = ts.accounts.RecreateTrie(lastCommittedHeader.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+	ts.blockChainHook.SetCurrentHeader(lastCommittedHeader)
+
+	ts.cleanIntermediateResults()
+	defer func() {
+		ts.cleanIntermediateResults()
+		errRevert := ts.accounts.RevertToSnapshot(0)
+		if errRevert != nil {
+			log.Warn("transactionSimulator.ProcessTx: revert", "error", errRevert.Error())
+		}
+	}()
+
+	retCode, errProcess := ts.txProcessor.ProcessTransaction(tx)
+
+	results := &transaction.SimulationResults{
+		Status:     core.TxStatusExecuted,
+		ReturnCode: retCode.String(),
+		Hash:       hex.EncodeToString(txHash),
+		ScResults:  make(map[string]*transaction.ApiSmartContractResult),
+		Receipts:   make(map[string]*transaction.ApiReceipt),
+	}
+	touchedAddresses := [][]byte{tx.SndAddr, tx.RcvAddr}
+	for hash, intermediateTx := range ts.getIntermediateResults() {
+		encodedHash := hex.EncodeToString([]byte(hash))
+
+		switch currentTx := intermediateTx.(type) {
+		case *smartContractResult.SmartContractResult:
+			results.ScResults[encodedHash] = ts.prepareApiSmartContractResult(encodedHash, currentTx)
+			touchedAddresses = append(touchedAddresses, currentTx.RcvAddr)
+			if results.FailReason == "" && isFailedSmartContractResult(currentTx) {
+				results.FailReason = string(currentTx.ReturnMessage)
+			}
+		case *receipt.Receipt:
+			results.Receipts[encodedHash] = ts.prepareApiReceipt(currentTx)
+		}
+	}
+
+	switch {
+	case errProcess != nil:
+		results.Status = core.TxStatusInvalid
+		results.FailReason = errProcess.Error()
+	case retCode != vmcommon.Ok:
+		results.Status = core.TxStatusFailed
+		if results.FailReason == "" {
+			results.FailReason = retCode.String()
+		}
+	}
+
+	results.Logs = ts.getLogs(txHash)
+	results.Balances = ts.getBalances(touchedAddresses)
+
+	return results, nil
+}
+
+func (ts *transactionSimulator) getLastCommittedHeader() data.HeaderHandler {
+	currentHeader := ts.blockChain.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		return currentHeader
+	}
+
+	return ts.blockChain.GetGenesisHeader()
+}
+
+func (ts *transactionSimulator) cleanIntermediateResults() {
+	for _, intermediateProcessor := range ts.intermediateProcessors {
+		intermediateProcessor.CreateBlockStarted()
+	}
+	ts.txFeeHandler.CreateBlockStarted()
+	ts.gasHandler.Init()
+	ts.txLogsProcessor.Clean()
+	ts.blockChainHook.CleanTempAccounts()
+}
+
+func (ts *transactionSimulator) getIntermediateResults() map[string]data.TransactionHandler {
+	intermediateResults := make(map[string]data.TransactionHandler)
+	for _, intermediateProcessor := range ts.intermediateProcessors {
+		for hash, intermediateTx := range intermediateProcessor.GetAllCurrentFinishedTxs() {
+			intermediateResults[hash] = intermediateTx
+		}
+	}
+
+	return intermediateResults
+}
+
+// isFailedSmartContractResult returns true if the smart contract result was generated because the execution of its
+// parent transaction failed. Such a result has the data field @hex(returnCode)@hex(parentTxHash)
+func isFailedSmartContractResult(scr *smartContractResult.SmartContractResult) bool {
+	tokens := strings.Split(string(scr.Data), "@")
+	if len(tokens) != 3 || len(tokens[0]) > 0 {
+		return false
+	}
+
+	return tokens[1] != hex.EncodeToString([]byte(vmcommon.Ok.String())) && tokens[2] == hex.EncodeToString(scr.PrevTxHash)
+}
+
+// getBalances returns the balances, after the simulated execution, of the provided addresses from the current shard
+func (ts *transactionSimulator) getBalances(addresses [][]byte) map[string]string {
+	balances := make(map[string]string)
+	for _, address := range addresses {
+		if len(address) == 0 || ts.shardCoordinator.ComputeId(address) != ts.shardCoordinator.SelfId() {
+			continue
+		}
+
+		account, err := ts.accounts.GetExistingAccount(address)
+		if err != nil {
+			continue
+		}
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			continue
+		}
+
+		balances[ts.addressPubkeyConverter.Encode(address)] = userAccount.GetBalance().String()
+	}
+
+	return balances
+}
+
+func (ts *transactionSimulator) getLogs(txHash []byte) *transaction.ApiLogs {
+	txLog, found := ts.txLogsProcessor.GetLogFromCache(txHash)
+	if !found || check.IfNil(txLog) {
+		return nil
+	}
+
+	apiLogs := &transaction.ApiLogs{
+		Address: ts.addressPubkeyConverter.Encode(txLog.GetAddress()),
+		Events:  make([]*transaction.ApiLogEvent, 0, len(txLog.GetLogEvents())),
+	}
+	for _, event := range txLog.GetLogEvents() {
+		topics := make([]string, 0, len(event.GetTopics()))
+		for _, topic := range event.GetTopics() {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		apiLogs.Events = append(apiLogs.Events, &transaction.ApiLogEvent{
+			Address:    hex.EncodeToString(event.GetAddress()),
+			Identifier: hex.EncodeToString(event.GetIdentifier()),
+			Topics:     topics,
+			Data:       hex.EncodeToString(event.GetData()),
+		})
+	}
+
+	return apiLogs
+}
+
+func (ts *transactionSimulator) prepareApiSmartContractResult(
+	encodedHash string,
+	scr *smartContractResult.SmartContractResult,
+) *transaction.ApiSmartContractResult {
+	value := "0"
+	if scr.Value != nil {
+		value = scr.Value.String()
+	}
+
+	return &transaction.ApiSmartContractResult{
+		Hash:           encodedHash,
+		Nonce:          scr.Nonce,
+		Value:          value,
+		Receiver:       ts.addressPubkeyConverter.Encode(scr.RcvAddr),
+		Sender:         ts.addressPubkeyConverter.Encode(scr.SndAddr),
+		Data:           string(scr.Data),
+		PrevTxHash:     hex.EncodeToString(scr.PrevTxHash),
+		OriginalTxHash: hex.EncodeToString(scr.OriginalTxHash),
+		GasLimit:       scr.GasLimit,
+		GasPrice:       scr.GasPrice,
+		CallType:       int(scr.CallType),
+		ReturnMessage:  string(scr.ReturnMessage),
+	}
+}
+
+func (ts *transactionSimulator) prepareApiReceipt(rpt *receipt.Receipt) *transaction.ApiReceipt {
+	value := "0"
+	if rpt.Value != nil {
+		value = rpt.Value.String()
+	}
+
+	return &transaction.ApiReceipt{
+		Value:  value,
+		Sender: ts.addressPubkeyConverter.Encode(rpt.SndAddr),
+		Data:   string(rpt.Data),
+		TxHash: hex.EncodeToString(rpt.TxHash),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *transactionSimulator) IsInterfaceNil() bool {
+	return ts == nil
+}
@@ -0,0 +1,356 @@
+package txsimulator_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
+	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var senderAddress = []byte("sender")
+var receiverAddress = []byte("receiver")
+
+func createIntermediateProcContainer(scrResults map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) process.IntermediateProcessorContainer {
+	container := containers.NewIntermediateTransactionHandlersContainer()
+	_ = container.Add(block.SmartContractResultBlock, &mock.IntermediateTransactionHandlerMock{
+		GetAllCurrentFinishedTxsCalled: func() map[string]data.TransactionHandler {
+			return scrResults
+		},
+	})
+	_ = container.Add(block.ReceiptBlock, &mock.IntermediateTransactionHandlerMock{
+		GetAllCurrentFinishedTxsCalled: func() map[string]data.TransactionHandler {
+			return receipts
+		},
+	})
+	_ = container.Add(block.InvalidBlock, &mock.IntermediateTransactionHandlerMock{})
+
+	return container
+}
+
+func createAccountsStub() *mock.AccountsStub {
+	return &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+}
+
+func createMockArgsTxSimulator() txsimulator.ArgsTxSimulator {
+	return txsimulator.ArgsTxSimulator{
+		TransactionProcessor: &mock.TxProcessorMock{
+			ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.Ok, nil
+			},
+		},
+		IntermediateProcContainer: createIntermediateProcContainer(nil, nil),
+		TxFeeHandler:              &mock.FeeAccumulatorStub{},
+		GasHandler:                &mock.GasHandlerMock{},
+		AccountsAdapter:           createAccountsStub(),
+		BlockChainHook:            &mock.BlockChainHookHandlerMock{},
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: []byte("root hash")}
+			},
+		},
+		TxLogsProcessor:        &mock.TxLogsProcessorDatabaseStub{},
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		ShardCoordinator:       mock.NewMultipleShardsCoordinatorMock(),
+		Marshalizer:            &mock.MarshalizerMock{},
+		Hasher:                 &mock.HasherMock{},
+	}
+}
+
+func TestNewTransactionSimulator_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modifier    func(args *txsimulator.ArgsTxSimulator)
+		expectedErr error
+	}{
+		{"nil tx processor", func(args *txsimulator.ArgsTxSimulator) { args.TransactionProcessor = nil }, process.ErrNilTxProcessor},
+		{"nil intermediate container", func(args *txsimulator.ArgsTxSimulator) { args.IntermediateProcContainer = nil }, process.ErrNilIntermediateProcessorContainer},
+		{"nil tx fee handler", func(args *txsimulator.ArgsTxSimulator) { args.TxFeeHandler = nil }, process.ErrNilEconomicsFeeHandler},
+		{"nil gas handler", func(args *txsimulator.ArgsTxSimulator) { args.GasHandler = nil }, process.ErrNilGasHandler},
+		{"nil accounts", func(args *txsimulator.ArgsTxSimulator) { args.AccountsAdapter = nil }, process.ErrNilAccountsAdapter},
+		{"nil blockchain hook", func(args *txsimulator.ArgsTxSimulator) { args.BlockChainHook = nil }, process.ErrNilBlockChainHook},
+		{"nil blockchain", func(args *txsimulator.ArgsTxSimulator) { args.BlockChain = nil }, process.ErrNilBlockChain},
+		{"nil tx logs processor", func(args *txsimulator.ArgsTxSimulator) { args.TxLogsProcessor = nil }, process.ErrNilTxLogsProcessor},
+		{"nil pubkey converter", func(args *txsimulator.ArgsTxSimulator) { args.AddressPubkeyConverter = nil }, process.ErrNilPubkeyConverter},
+		{"nil shard coordinator", func(args *txsimulator.ArgsTxSimulator) { args.ShardCoordinator = nil }, process.ErrNilShardCoordinator},
+		{"nil marshalizer", func(args *txsimulator.ArgsTxSimulator) { args.Marshalizer = nil }, process.ErrNilMarshalizer},
+		{"nil hasher", func(args *txsimulator.ArgsTxSimulator) { args.Hasher = nil }, process.ErrNilHasher},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgsTxSimulator()
+		tt.modifier(&args)
+
+		ts, err := txsimulator.NewTransactionSimulator(args)
+		assert.True(t, check.IfNil(ts), tt.name)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+	}
+}
+
+func TestNewTransactionSimulator_MissingIntermediateProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.IntermediateProcContainer = containers.NewIntermediateTransactionHandlersContainer()
+
+	ts, err := txsimulator.NewTransactionSimulator(args)
+	assert.True(t, check.IfNil(ts))
+	assert.NotNil(t, err)
+}
+
+func TestNewTransactionSimulator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	logsSavedInCache := false
+	args.TxLogsProcessor = &mock.TxLogsProcessorDatabaseStub{
+		EnableLogToBeSavedInCacheCalled: func() {
+			logsSavedInCache = true
+		},
+	}
+
+	ts, err := txsimulator.NewTransactionSimulator(args)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(ts))
+	assert.True(t, logsSavedInCache)
+}
+
+func TestTransactionSimulator_ProcessTxNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	args.BlockChain = &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+	}
+	ts, _ := txsimulator.NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{})
+	assert.Nil(t, results)
+	assert.Equal(t, process.ErrNilBlockHeader, err)
+}
+
+func TestTransactionSimulator_ProcessTxShouldUseGenesisWhenNoBlockWasCommitted(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSimulator()
+	genesisRootHash := []byte("genesis root hash")
+	args.BlockChain = &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: genesisRootHash}
+		},
+	}
+	var recreatedRootHash []byte
+	accounts := createAccountsStub()
+	accounts.RecreateTrieCalled = func(rootHash []byte) error {
+		recreatedRootHash = rootHash
+		return nil
+	}
+	args.AccountsAdapter = accounts
+	ts, _ := txsimulator.NewTransactionSimulator(args)
+
+	_, err := ts.ProcessTx(&transaction.Transaction{})
+	assert.Nil(t, err)
+	assert.Equal(t, genesisRootHash, recreatedRootHash)
+}
+
+func TestTransactionSimulator_ProcessTxRecreateTrieErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsTxSimulator()
+	accounts := createAccountsStub()
+	accounts.RecreateTrieCalled = func(rootHash []byte) error {
+		return expectedErr
+	}
+	args.AccountsAdapter = accounts
+	ts, _ := txsimulator.NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{})
+	assert.Nil(t, results)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestTransactionSimulator_ProcessTxShouldReturnResultsAndRevertState(t *testing.T) {
+	t.Parallel()
+
+	scr := &smartContractResult.SmartContractResult{
+		Value:   big.NewInt(10),
+		RcvAddr: []byte("scr receiver"),
+		SndAddr: receiverAddress,
+		Data:    []byte("@" + hex.EncodeToString([]byte("ok"))),
+	}
+	rpt := &receipt.Receipt{
+		Value:   big.NewInt(5),
+		SndAddr: senderAddress,
+		Data:    []byte(core.RefundedGasReceiptData),
+	}
+	args := createMockArgsTxSimulator()
+	args.IntermediateProcContainer = createIntermediateProcContainer(
+		map[string]data.TransactionHandler{"scr": scr},
+		map[string]data.TransactionHandler{"receipt": rpt},
+	)
+	revertCalled := false
+	accounts := createAccountsStub()
+	accounts.RevertToSnapshotCalled = func(snapshot int) error {
+		revertCalled = true
+		assert.Equal(t, 0, snapshot)
+		return nil
+	}
+	accounts.GetExistingAccountCalled = func(address []byte) (state.AccountHandler, error) {
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(int64(len(address))))
+		return account, nil
+	}
+	args.AccountsAdapter = accounts
+	args.TxLogsProcessor = &mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(txHash []byte) (data.LogHandler, bool) {
+			return &transaction.Log{
+				Address: receiverAddress,
+				Events:  []*transaction.Event{{Identifier: []byte("id")}},
+			}, true
+		},
+	}
+	ts, _ := txsimulator.NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{SndAddr: senderAddress, RcvAddr: receiverAddress})
+	require.Nil(t, err)
+
+	assert.True(t, revertCalled)
+	assert.Equal(t, core.TxStatusExecuted, results.Status)
+	assert.Equal(t, vmcommon.Ok.String(), results.ReturnCode)
+	assert.Empty(t, results.FailReason)
+	require.Equal(t, 1, len(results.ScResults))
+	assert.Equal(t, "10", results.ScResults[hex.EncodeToString([]byte("scr"))].Value)
+	require.Equal(t, 1, len(results.Receipts))
+	assert.Equal(t, core.RefundedGasReceiptData, results.Receipts[hex.EncodeToString([]byte("receipt"))].Data)
+	require.NotNil(t, results.Logs)
+	assert.Equal(t, hex.EncodeToString(receiverAddress), results.Logs.Address)
+	assert.Equal(t, hex.EncodeToString([]byte("id")), results.Logs.Events[0].Identifier)
+	assert.Equal(t, map[string]string{
+		hex.EncodeToString(senderAddress):          "6",
+		hex.EncodeToString(receiverAddress):        "8",
+		hex.EncodeToString([]byte("scr receiver")): "12",
+	}, results.Balances)
+}
+
+func TestTransactionSimulator_ProcessTxShouldCleanTheFeesAndTheGas(t *testing.T) {
+	t.Parallel()
+
+	txFeeHandler, _ := postprocess.NewFeeAccumulator()
+	gasHandler, _ := preprocess.NewGasComputation(&mock.FeeHandlerStub{}, &mock.TxTypeHandlerMock{})
+	args := createMockArgsTxSimulator()
+	args.TxFeeHandler = txFeeHandler
+	args.GasHandler = gasHandler
+	args.TransactionProcessor = &mock.TxProcessorMock{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			txFeeHandler.ProcessTransactionFee(big.NewInt(100), big.NewInt(10), []byte("tx hash"))
+			gasHandler.SetGasConsumed(1000, []byte("tx hash"))
+			gasHandler.SetGasRefunded(100, []byte("tx hash"))
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := txsimulator.NewTransactionSimulator(args)
+
+	_, err := ts.ProcessTx(&transaction.Transaction{SndAddr: senderAddress, RcvAddr: receiverAddress})
+	require.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(0), txFeeHandler.GetAccumulatedFees())
+	assert.Equal(t, big.NewInt(0), txFeeHandler.GetDeveloperFees())
+	assert.Equal(t, uint64(0), gasHandler.TotalGasConsumed())
+	assert.Equal(t, uint64(0), gasHandler.TotalGasRefunded())
+}
+
+func TestTransactionSimulator_ProcessTxFailedExecutionShouldReturnFailReason(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	scr := &smartContractResult.SmartContractResult{
+		Value:         big.NewInt(0),
+		RcvAddr:       senderAddress,
+		SndAddr:       receiverAddress,
+		Data:          []byte("@" + hex.EncodeToString([]byte("user error")) + "@" + hex.EncodeToString(txHash)),
+		PrevTxHash:    txHash,
+		ReturnMessage: []byte("function not found"),
+	}
+	args := createMockArgsTxSimulator()
+	args.TransactionProcessor = &mock.TxProcessorMock{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			return vmcommon.UserError, nil
+		},
+	}
+	args.IntermediateProcContainer = createIntermediateProcContainer(map[string]data.TransactionHandler{"scr": scr}, nil)
+	ts, _ := txsimulator.NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{SndAddr: senderAddress, RcvAddr: receiverAddress})
+	require.Nil(t, err)
+
+	assert.Equal(t, core.TxStatusFailed, results.Status)
+	assert.Equal(t, vmcommon.UserError.String(), results.ReturnCode)
+	assert.Equal(t, "function not found", results.FailReason)
+}
+
+func TestTransactionSimulator_ProcessTxProcessingErrorShouldReturnInvalid(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("insufficient funds")
+	args := createMockArgsTxSimulator()
+	args.TransactionProcessor = &mock.TxProcessorMock{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			return vmcommon.UserError, expectedErr
+		},
+	}
+	revertCalled := false
+	accounts := createAccountsStub()
+	accounts.RevertToSnapshotCalled = func(snapshot int) error {
+		revertCalled = true
+		return nil
+	}
+	args.AccountsAdapter = accounts
+	ts, _ := txsimulator.NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{SndAddr: senderAddress, RcvAddr: receiverAddress})
+	require.Nil(t, err)
+
+	assert.True(t, revertCalled)
+	assert.Equal(t, core.TxStatusInvalid, results.Status)
+	assert.Equal(t, expectedErr.Error(), results.FailReason)
+}
+
+func TestDisabledTxSimulator_ProcessTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dts := txsimulator.NewDisabledTxSimulator()
+	assert.False(t, check.IfNil(dts))
+
+	results, err := dts.ProcessTx(&transaction.Transaction{})
+	assert.Nil(t, results)
+	assert.Equal(t, txsimulator.ErrSimulationNotSupported, err)
+}
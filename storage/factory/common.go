@@ -13,13 +13,15 @@ const allFiles = -1
 // GetCacherFromConfig will return the cache config needed for storage unit from a config came from the toml file
 func GetCacherFromConfig(cfg config.CacheConfig) storageUnit.CacheConfig {
 	return storageUnit.CacheConfig{
-		Name:                 cfg.Name,
-		Capacity:             cfg.Capacity,
-		SizePerSender:        cfg.SizePerSender,
-		SizeInBytes:          cfg.SizeInBytes,
-		SizeInBytesPerSender: cfg.SizeInBytesPerSender,
-		Type:                 storageUnit.CacheType(cfg.Type),
-		Shards:               cfg.Shards,
+		Name:                                 cfg.Name,
+		Capacity:                             cfg.Capacity,
+		SizePerSender:                        cfg.SizePerSender,
+		SizeInBytes:                          cfg.SizeInBytes,
+		SizeInBytesPerSender:                 cfg.SizeInBytesPerSender,
+		Type:                                 storageUnit.CacheType(cfg.Type),
+		Shards:                               cfg.Shards,
+		ScoreComputer:                        cfg.ScoreComputer,
+		MinGasPriceBumpPercentForReplacement: cfg.MinGasPriceBumpPercentForReplacement,
 	}
 }
 
@@ -25,6 +25,7 @@ type ArgsNewOpenStorageUnits struct {
 	DefaultDBPath             string
 	DefaultEpochString        string
 	DefaultShardString        string
+	DefaultStaticDbString     string
 }
 
 type openStorageUnits struct {
@@ -37,6 +38,7 @@ type openStorageUnits struct {
 	defaultDBPath             string
 	defaultEpochString        string
 	defaultShardString        string
+	defaultStaticDbString     string
 }
 
 // NewStorageUnitOpenHandler creates an openStorageUnits component
@@ -49,6 +51,7 @@ func NewStorageUnitOpenHandler(args ArgsNewOpenStorageUnits) (*openStorageUnits,
 		defaultDBPath:             args.DefaultDBPath,
 		defaultEpochString:        args.DefaultEpochString,
 		defaultShardString:        args.DefaultShardString,
+		defaultStaticDbString:     args.DefaultStaticDbString,
 		bootstrapDataProvider:     args.BootstrapDataProvider,
 		latestStorageDataProvider: args.LatestStorageDataProvider,
 	}
@@ -58,11 +61,64 @@ func NewStorageUnitOpenHandler(args ArgsNewOpenStorageUnits) (*openStorageUnits,
 
 // GetMostRecentBootstrapStorageUnit will open bootstrap storage unit
 func (o *openStorageUnits) GetMostRecentBootstrapStorageUnit() (storage.Storer, error) {
-	parentDir, lastEpoch, err := o.latestStorageDataProvider.GetParentDirAndLastEpoch()
+	pathWithoutShard, mostRecentShard, err := o.getMostRecentShardDirectory()
 	if err != nil {
 		return nil, err
 	}
 
+	persisterPath := filepath.Join(
+		pathWithoutShard,
+		fmt.Sprintf("%s_%s", o.defaultShardString, mostRecentShard),
+		o.generalConfig.BootstrapStorage.DB.FilePath,
+	)
+
+	return openStorageUnit(o.generalConfig.BootstrapStorage.DB, persisterPath)
+}
+
+// GetMostRecentShard returns the shard, as found in the directory names, holding the most up-to-date bootstrap data
+// in the last epoch directory
+func (o *openStorageUnits) GetMostRecentShard() (string, error) {
+	_, mostRecentShard, err := o.getMostRecentShardDirectory()
+
+	return mostRecentShard, err
+}
+
+// OpenStaticStorageUnit will open the storage unit described by the provided DB config from the static directory
+// of the given shard
+func (o *openStorageUnits) OpenStaticStorageUnit(dbConfig config.DBConfig, shardIDStr string) (storage.Storer, error) {
+	persisterPath := filepath.Join(
+		o.workingDir,
+		o.defaultDBPath,
+		o.chainID,
+		o.defaultStaticDbString,
+		fmt.Sprintf("%s_%s", o.defaultShardString, shardIDStr),
+		dbConfig.FilePath,
+	)
+
+	return openStorageUnit(dbConfig, persisterPath)
+}
+
+// OpenEpochStorageUnit will open the storage unit described by the provided DB config from the directory of the
+// given epoch and shard
+func (o *openStorageUnits) OpenEpochStorageUnit(dbConfig config.DBConfig, epoch uint32, shardIDStr string) (storage.Storer, error) {
+	persisterPath := filepath.Join(
+		o.workingDir,
+		o.defaultDBPath,
+		o.chainID,
+		fmt.Sprintf("%s_%d", o.defaultEpochString, epoch),
+		fmt.Sprintf("%s_%s", o.defaultShardString, shardIDStr),
+		dbConfig.FilePath,
+	)
+
+	return openStorageUnit(dbConfig, persisterPath)
+}
+
+func (o *openStorageUnits) getMostRecentShardDirectory() (string, string, error) {
+	parentDir, lastEpoch, err := o.latestStorageDataProvider.GetParentDirAndLastEpoch()
+	if err != nil {
+		return "", "", err
+	}
+
 	// TODO: refactor this - as it works with bootstrap storage unit only
 	persisterFactory := NewPersisterFactory(o.generalConfig.BootstrapStorage.DB)
 	pathWithoutShard := filepath.Join(
@@ -71,20 +127,19 @@ func (o *openStorageUnits) GetMostRecentBootstrapStorageUnit() (storage.Storer,
 	)
 	shardIdsStr, err := o.latestStorageDataProvider.GetShardsFromDirectory(pathWithoutShard)
 	if err != nil {
-		return nil, err
+		return "", "", err
 	}
 
 	mostRecentShard, err := o.getMostUpToDateDirectory(pathWithoutShard, shardIdsStr, persisterFactory)
 	if err != nil {
-		return nil, err
+		return "", "", err
 	}
 
-	persisterPath := filepath.Join(
-		pathWithoutShard,
-		fmt.Sprintf("%s_%s", o.defaultShardString, mostRecentShard),
-		o.generalConfig.BootstrapStorage.DB.FilePath,
-	)
+	return pathWithoutShard, mostRecentShard, nil
+}
 
+func openStorageUnit(dbConfig config.DBConfig, persisterPath string) (storage.Storer, error) {
+	persisterFactory := NewPersisterFactory(dbConfig)
 	persister, err := createDB(persisterFactory, persisterPath)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,9 @@ package factory
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -10,7 +13,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgsOpenStorageUnits() ArgsNewOpenStorageUnits {
@@ -143,3 +148,84 @@ func TestGetMostRecentBootstrapStorageUnit(t *testing.T) {
 	assert.NotNil(t, storer)
 
 }
+
+func TestGetMostRecentShard(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOpenStorageUnits()
+	args.LatestStorageDataProvider = &mock.LatestStorageDataProviderStub{
+		GetShardsFromDirectoryCalled: func(path string) ([]string, error) {
+			return []string{"0", "metachain"}, nil
+		},
+	}
+	args.BootstrapDataProvider = &mock.BootStrapDataProviderStub{
+		LoadForPathCalled: func(persisterFactory storage.PersisterFactory, path string) (*bootstrapStorage.BootstrapData, storage.Storer, error) {
+			if strings.Contains(path, "Shard_metachain") {
+				return &bootstrapStorage.BootstrapData{LastRound: 100}, nil, nil
+			}
+			return &bootstrapStorage.BootstrapData{LastRound: 10}, nil, nil
+		},
+	}
+	suoh, _ := NewStorageUnitOpenHandler(args)
+
+	shardIDStr, err := suoh.GetMostRecentShard()
+	assert.NoError(t, err)
+	assert.Equal(t, "metachain", shardIDStr)
+}
+
+func TestOpenStaticStorageUnit(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOpenStorageUnits()
+	args.WorkingDir, _ = ioutil.TempDir("", "open_storage")
+	args.ChainID = "chain"
+	args.DefaultDBPath = "db"
+	args.DefaultStaticDbString = "Static"
+	suoh, _ := NewStorageUnitOpenHandler(args)
+
+	dbConfig := config.DBConfig{
+		FilePath:          "AccountsTrie/MainDB",
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+	storer, err := suoh.OpenStaticStorageUnit(dbConfig, "0")
+	require.NoError(t, err)
+	_ = storer.Put([]byte("key"), []byte("value"))
+	_ = storer.Close()
+
+	_, err = os.Stat(filepath.Join(args.WorkingDir, "db", "chain", "Static", "Shard_0", "AccountsTrie", "MainDB"))
+	assert.NoError(t, err)
+
+	storer, err = suoh.OpenStaticStorageUnit(dbConfig, "0")
+	require.NoError(t, err)
+	value, err := storer.Get([]byte("key"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	_ = storer.Close()
+}
+
+func TestOpenEpochStorageUnit(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOpenStorageUnits()
+	args.WorkingDir, _ = ioutil.TempDir("", "open_storage")
+	args.ChainID = "chain"
+	args.DefaultDBPath = "db"
+	suoh, _ := NewStorageUnitOpenHandler(args)
+
+	dbConfig := config.DBConfig{
+		FilePath:          "BlockHeaders",
+		Type:              string(storageUnit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+	storer, err := suoh.OpenEpochStorageUnit(dbConfig, 3, "metachain")
+	require.NoError(t, err)
+	_ = storer.Close()
+
+	_, err = os.Stat(filepath.Join(args.WorkingDir, "db", "chain", "Epoch_3", "Shard_metachain", "BlockHeaders"))
+	assert.NoError(t, err)
+}
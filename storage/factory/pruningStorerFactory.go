@@ -193,6 +193,21 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	addressTxHistoryUnit, transactionResultsUnit, err := psf.createAddressTxHistoryUnitsIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	txPoolJournalUnit, err := psf.createTxPoolJournalUnitIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	logsIndexUnit, err := psf.createLogsIndexUnitIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, txUnit)
 	store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
@@ -208,6 +223,16 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if !check.IfNil(addressTxHistoryUnit) {
+		store.AddStorer(dataRetriever.AddressTxHistoryUnit, addressTxHistoryUnit)
+		store.AddStorer(dataRetriever.TransactionResultsUnit, transactionResultsUnit)
+	}
+	if !check.IfNil(txPoolJournalUnit) {
+		store.AddStorer(dataRetriever.TxPoolJournalUnit, txPoolJournalUnit)
+	}
+	if !check.IfNil(logsIndexUnit) {
+		store.AddStorer(dataRetriever.LogsIndexUnit, logsIndexUnit)
+	}
 
 	return store, err
 }
@@ -337,6 +362,21 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, txLogsUnit)
 
+	addressTxHistoryUnit, transactionResultsUnit, err := psf.createAddressTxHistoryUnitsIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	txPoolJournalUnit, err := psf.createTxPoolJournalUnitIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	logsIndexUnit, err := psf.createLogsIndexUnitIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.MetaBlockUnit, metaBlockUnit)
 	store.AddStorer(dataRetriever.BlockHeaderUnit, headerUnit)
@@ -353,10 +393,66 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	store.AddStorer(dataRetriever.BootstrapUnit, bootstrapUnit)
 	store.AddStorer(dataRetriever.StatusMetricsUnit, statusMetricsStorageUnit)
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
+	if !check.IfNil(addressTxHistoryUnit) {
+		store.AddStorer(dataRetriever.AddressTxHistoryUnit, addressTxHistoryUnit)
+		store.AddStorer(dataRetriever.TransactionResultsUnit, transactionResultsUnit)
+	}
+	if !check.IfNil(txPoolJournalUnit) {
+		store.AddStorer(dataRetriever.TxPoolJournalUnit, txPoolJournalUnit)
+	}
+	if !check.IfNil(logsIndexUnit) {
+		store.AddStorer(dataRetriever.LogsIndexUnit, logsIndexUnit)
+	}
 
 	return store, err
 }
 
+func (psf *StorageServiceFactory) createAddressTxHistoryUnitsIfNeeded() (storage.Storer, storage.Storer, error) {
+	if !psf.generalConfig.AddressTxHistory.Enabled {
+		return nil, nil, nil
+	}
+
+	historyUnit, err := psf.createStaticUnit(psf.generalConfig.AddressTxHistory.HistoryStorage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transactionResultsUnit, err := psf.createStaticUnit(psf.generalConfig.AddressTxHistory.TransactionResultsStorage)
+	if err != nil {
+		_ = historyUnit.Close()
+		return nil, nil, err
+	}
+
+	return historyUnit, transactionResultsUnit, nil
+}
+
+func (psf *StorageServiceFactory) createTxPoolJournalUnitIfNeeded() (storage.Storer, error) {
+	if !psf.generalConfig.TxPoolJournal.Enabled {
+		return nil, nil
+	}
+
+	return psf.createStaticUnit(psf.generalConfig.TxPoolJournal.Storage)
+}
+
+func (psf *StorageServiceFactory) createLogsIndexUnitIfNeeded() (storage.Storer, error) {
+	if !psf.generalConfig.LogsIndex.Enabled {
+		return nil, nil
+	}
+
+	return psf.createStaticUnit(psf.generalConfig.LogsIndex.IndexStorage)
+}
+
+func (psf *StorageServiceFactory) createStaticUnit(storageConfig config.StorageConfig) (storage.Storer, error) {
+	dbConfig := GetDBFromConfig(storageConfig.DB)
+	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	dbConfig.FilePath = psf.pathManager.PathForStatic(shardId, storageConfig.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		GetBloomFromConfig(storageConfig.Bloom))
+}
+
 func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.StorageConfig) *pruning.StorerArgs {
 	cleanOldEpochsData := psf.generalConfig.StoragePruning.CleanOldEpochsData
 	numOfEpochsToKeep := uint32(psf.generalConfig.StoragePruning.NumEpochsToKeep)
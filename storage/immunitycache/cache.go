@@ -17,10 +17,12 @@ const hospitalityUpperLimit = 10000
 
 // ImmunityCache is a cache-like structure
 type ImmunityCache struct {
-	config      CacheConfig
-	chunks      []*immunityChunk
-	hospitality atomic.Counter
-	mutex       sync.RWMutex
+	config              CacheConfig
+	chunks              []*immunityChunk
+	hospitality         atomic.Counter
+	mutex               sync.RWMutex
+	mutexEvictedHandler sync.RWMutex
+	onEvicted           func(key []byte, value interface{})
 }
 
 // NewImmunityCache creates a new cache
@@ -147,7 +149,8 @@ func (ic *ImmunityCache) Peek(key []byte) (value interface{}, ok bool) {
 func (ic *ImmunityCache) HasOrAdd(key []byte, value interface{}, sizeInBytes int) (has, added bool) {
 	cacheItem := newCacheItem(value, string(key), sizeInBytes)
 	chunk := ic.getChunkByKeyWithLock(string(key))
-	has, added = chunk.AddItem(cacheItem)
+	has, added, evicted := chunk.AddItem(cacheItem)
+	ic.notifyEvicted(evicted)
 	if !has {
 		if added {
 			ic.hospitality.Increment()
@@ -159,6 +162,38 @@ func (ic *ImmunityCache) HasOrAdd(key []byte, value interface{}, sizeInBytes int
 	return has, added
 }
 
+// notifyEvicted is called after the chunk has been unlocked, so the handler is free to access the cache
+func (ic *ImmunityCache) notifyEvicted(evicted []*cacheItem) {
+	if len(evicted) == 0 {
+		return
+	}
+
+	ic.mutexEvictedHandler.RLock()
+	onEvicted := ic.onEvicted
+	ic.mutexEvictedHandler.RUnlock()
+
+	if onEvicted == nil {
+		return
+	}
+
+	for _, item := range evicted {
+		onEvicted([]byte(item.key), item.payload)
+	}
+}
+
+// RegisterOnEvicted registers the handler to be called for each item evicted from the cache in order to make room
+// for new items. The explicitly removed items are not reported
+func (ic *ImmunityCache) RegisterOnEvicted(handler func(key []byte, value interface{})) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler")
+		return
+	}
+
+	ic.mutexEvictedHandler.Lock()
+	ic.onEvicted = handler
+	ic.mutexEvictedHandler.Unlock()
+}
+
 // Put adds an item in the cache
 func (ic *ImmunityCache) Put(key []byte, value interface{}, sizeInBytes int) (evicted bool) {
 	ic.HasOrAdd(key, value, sizeInBytes)
@@ -232,6 +267,15 @@ func (ic *ImmunityCache) NumBytes() int {
 	return numBytes
 }
 
+// NumEvicted returns the number of items evicted since the cache was created (or last cleared)
+func (ic *ImmunityCache) NumEvicted() int {
+	numEvicted := 0
+	for _, chunk := range ic.getChunksWithLock() {
+		numEvicted += chunk.NumEvicted()
+	}
+	return numEvicted
+}
+
 // Keys returns all keys
 func (ic *ImmunityCache) Keys() [][]byte {
 	count := ic.Count()
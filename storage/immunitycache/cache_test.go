@@ -66,9 +66,11 @@ func TestImmunityCache_ImmunizeAgainstEviction(t *testing.T) {
 
 	cache.addTestItems("e", "f", "g", "h")
 	require.ElementsMatch(t, []string{"a", "b", "c", "d", "e", "f", "g", "h"}, keysAsStrings(cache.Keys()))
+	require.Equal(t, 0, cache.NumEvicted())
 
 	cache.addTestItems("i", "j", "k", "l")
 	require.ElementsMatch(t, []string{"a", "b", "e", "f", "i", "j", "k", "l"}, keysAsStrings(cache.Keys()))
+	require.Equal(t, 4, cache.NumEvicted())
 
 	require.Equal(t, 4, cache.CountImmune())
 	cache.Remove([]byte("e"))
@@ -76,6 +78,28 @@ func TestImmunityCache_ImmunizeAgainstEviction(t *testing.T) {
 	require.Equal(t, 2, cache.CountImmune())
 }
 
+func TestImmunityCache_RegisterOnEvictedShouldReportTheEvictedItems(t *testing.T) {
+	cache := newCacheToTest(1, 8, maxNumBytesUpperBound)
+
+	evicted := make([]string, 0)
+	cache.RegisterOnEvicted(func(key []byte, value interface{}) {
+		evicted = append(evicted, string(key))
+		require.Equal(t, fmt.Sprintf("foo-%s", key), value)
+	})
+
+	cache.addTestItems("a", "b", "c", "d")
+	cache.ImmunizeKeys(keysAsBytes([]string{"a", "b"}))
+	cache.addTestItems("e", "f", "g", "h")
+	require.Len(t, evicted, 0)
+
+	cache.addTestItems("i", "j")
+	require.ElementsMatch(t, []string{"c", "d"}, evicted)
+	require.Equal(t, 2, cache.NumEvicted())
+
+	cache.Remove([]byte("a"))
+	require.Len(t, evicted, 2)
+}
+
 func TestImmunityCache_ImmunizeDoesNothingIfCapacityReached(t *testing.T) {
 	cache := newCacheToTest(1, 4, maxNumBytesUpperBound)
 
@@ -16,6 +16,7 @@ type immunityChunk struct {
 	itemsAsList *list.List
 	immuneKeys  map[string]struct{}
 	numBytes    int
+	numEvicted  int
 	mutex       sync.RWMutex
 }
 
@@ -68,35 +69,37 @@ func (chunk *immunityChunk) getItemNoLock(key string) (*cacheItem, bool) {
 	return wrapper.item, true
 }
 
-func (chunk *immunityChunk) AddItem(item *cacheItem) (has, added bool) {
+// AddItem adds an item in the chunk. It also returns the items evicted in order to make room for it
+func (chunk *immunityChunk) AddItem(item *cacheItem) (has, added bool, evicted []*cacheItem) {
 	chunk.mutex.Lock()
 	defer chunk.mutex.Unlock()
 
-	err := chunk.evictItemsIfCapacityExceededNoLock()
+	evicted, err := chunk.evictItemsIfCapacityExceededNoLock()
 	if err != nil {
 		// No more room for the new item
-		return false, false
+		return false, false, evicted
 	}
 
 	// Discard duplicates
 	if chunk.itemExistsNoLock(item) {
-		return true, false
+		return true, false, evicted
 	}
 
 	chunk.addItemNoLock(item)
 	chunk.immunizeItemOnAddNoLock(item)
 	chunk.trackNumBytesOnAddNoLock(item)
-	return false, true
+	return false, true, evicted
 }
 
-func (chunk *immunityChunk) evictItemsIfCapacityExceededNoLock() error {
+func (chunk *immunityChunk) evictItemsIfCapacityExceededNoLock() ([]*cacheItem, error) {
 	if !chunk.isCapacityExceededNoLock() {
-		return nil
+		return nil, nil
 	}
 
-	numRemoved, err := chunk.evictItemsNoLock()
-	chunk.monitorEvictionNoLock(numRemoved, err)
-	return err
+	evicted, err := chunk.evictItemsNoLock()
+	chunk.numEvicted += len(evicted)
+	chunk.monitorEvictionNoLock(len(evicted), err)
+	return evicted, err
 }
 
 func (chunk *immunityChunk) isCapacityExceededNoLock() bool {
@@ -105,30 +108,30 @@ func (chunk *immunityChunk) isCapacityExceededNoLock() bool {
 	return tooManyItems || tooManyBytes
 }
 
-func (chunk *immunityChunk) evictItemsNoLock() (numRemoved int, err error) {
+func (chunk *immunityChunk) evictItemsNoLock() (evicted []*cacheItem, err error) {
 	numToRemoveEachStep := int(chunk.config.numItemsToPreemptivelyEvict)
 
 	// We perform the first step out of the loop in order to detect & return error
-	numRemovedInStep := chunk.removeOldestNoLock(numToRemoveEachStep)
-	numRemoved += numRemovedInStep
+	evictedInStep := chunk.removeOldestNoLock(numToRemoveEachStep)
+	evicted = append(evicted, evictedInStep...)
 
-	if numRemovedInStep == 0 {
-		return 0, storage.ErrFailedCacheEviction
+	if len(evictedInStep) == 0 {
+		return nil, storage.ErrFailedCacheEviction
 	}
 
-	for chunk.isCapacityExceededNoLock() && numRemovedInStep == numToRemoveEachStep {
-		numRemovedInStep = chunk.removeOldestNoLock(numToRemoveEachStep)
-		numRemoved += numRemovedInStep
+	for chunk.isCapacityExceededNoLock() && len(evictedInStep) == numToRemoveEachStep {
+		evictedInStep = chunk.removeOldestNoLock(numToRemoveEachStep)
+		evicted = append(evicted, evictedInStep...)
 	}
 
-	return numRemoved, nil
+	return evicted, nil
 }
 
-func (chunk *immunityChunk) removeOldestNoLock(numToRemove int) int {
-	numRemoved := 0
+func (chunk *immunityChunk) removeOldestNoLock(numToRemove int) []*cacheItem {
+	removed := make([]*cacheItem, 0, numToRemove)
 	element := chunk.itemsAsList.Front()
 
-	for element != nil && numRemoved < numToRemove {
+	for element != nil && len(removed) < numToRemove {
 		item := element.Value.(*cacheItem)
 
 		if item.isImmuneToEviction() {
@@ -140,10 +143,10 @@ func (chunk *immunityChunk) removeOldestNoLock(numToRemove int) int {
 		element = element.Next()
 
 		chunk.removeNoLock(elementToRemove)
-		numRemoved++
+		removed = append(removed, item)
 	}
 
-	return numRemoved
+	return removed
 }
 
 func (chunk *immunityChunk) removeNoLock(element *list.Element) {
@@ -215,7 +218,7 @@ func (chunk *immunityChunk) trackNumBytesOnRemoveNoLock(item *cacheItem) {
 func (chunk *immunityChunk) RemoveOldest(numToRemove int) int {
 	chunk.mutex.Lock()
 	defer chunk.mutex.Unlock()
-	return chunk.removeOldestNoLock(numToRemove)
+	return len(chunk.removeOldestNoLock(numToRemove))
 }
 
 // Count counts the items
@@ -239,6 +242,13 @@ func (chunk *immunityChunk) NumBytes() int {
 	return chunk.numBytes
 }
 
+// NumEvicted returns the number of items evicted from the chunk
+func (chunk *immunityChunk) NumEvicted() int {
+	chunk.mutex.RLock()
+	defer chunk.mutex.RUnlock()
+	return chunk.numEvicted
+}
+
 // KeysInOrder gets the keys, in order
 func (chunk *immunityChunk) KeysInOrder() [][]byte {
 	chunk.mutex.RLock()
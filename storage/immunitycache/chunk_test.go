@@ -37,12 +37,12 @@ func TestImmunityChunk_AddItemIgnoresDuplicates(t *testing.T) {
 	chunk.addTestItems("x", "y", "z")
 	require.Equal(t, 3, chunk.Count())
 
-	has, added := chunk.AddItem(newCacheItem("foo", "a", 1))
+	has, added, _ := chunk.AddItem(newCacheItem("foo", "a", 1))
 	require.False(t, has)
 	require.True(t, added)
 	require.Equal(t, 4, chunk.Count())
 
-	has, added = chunk.AddItem(newCacheItem("bar", "x", 1))
+	has, added, _ = chunk.AddItem(newCacheItem("bar", "x", 1))
 	require.True(t, has)
 	require.False(t, added)
 	require.Equal(t, 4, chunk.Count())
@@ -70,7 +70,7 @@ func TestImmunityChunk_AddItemDoesNotEvictImmuneItems(t *testing.T) {
 	require.Equal(t, []string{"x", "y", "b"}, keysAsStrings(chunk.KeysInOrder()))
 
 	_, _ = chunk.ImmunizeKeys(keysAsBytes([]string{"b"}))
-	has, added := chunk.AddItem(newCacheItem("foo", "c", 1))
+	has, added, _ := chunk.AddItem(newCacheItem("foo", "c", 1))
 	require.False(t, has)
 	require.False(t, added)
 	require.Equal(t, []string{"x", "y", "b"}, keysAsStrings(chunk.KeysInOrder()))
@@ -98,6 +98,6 @@ func newChunkToTest(maxNumItems uint32, numMaxBytes uint32) *immunityChunk {
 
 func (chunk *immunityChunk) addTestItems(keys ...string) {
 	for _, key := range keys {
-		_, _ = chunk.AddItem(newCacheItem("foo", key, 100))
+		_, _, _ = chunk.AddItem(newCacheItem("foo", key, 100))
 	}
 }
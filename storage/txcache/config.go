@@ -19,6 +19,13 @@ const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
 
+// DefaultScoreComputer is the score computer relying on the minimum gas price, used if none is configured
+const DefaultScoreComputer = "Default"
+
+// GasPricePercentileScoreComputer is the score computer ranking the senders by the percentile of their average gas
+// price among the senders in the pool. It does not depend on the minimum gas price
+const GasPricePercentileScoreComputer = "GasPricePercentile"
+
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
 	Name                          string
@@ -30,11 +37,16 @@ type ConfigSourceMe struct {
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
 	MinGasPriceNanoErd            uint32
+	ScoreComputer                 string
+	// MinGasPriceBumpPercentForReplacement is the minimum increase (as percent) of the gas price a transaction must
+	// bring in order to replace a pending transaction of the same sender, having the same nonce. 0 disables replace-by-fee
+	MinGasPriceBumpPercentForReplacement uint32
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                            uint32
+	maxNumBytes                          uint32
+	minGasPriceBumpPercentForReplacement uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -51,8 +63,14 @@ func (config *ConfigSourceMe) verify() error {
 	if config.CountPerSenderThreshold < maxNumItemsPerSenderLowerBound {
 		return fmt.Errorf("%w: config.CountPerSenderThreshold is invalid", storage.ErrInvalidConfig)
 	}
-	if config.MinGasPriceNanoErd < minGasPriceNanoErdLowerBound {
-		return fmt.Errorf("%w: config.MinGasPriceNanoErd is invalid", storage.ErrInvalidConfig)
+	switch config.ScoreComputer {
+	case "", DefaultScoreComputer:
+		if config.MinGasPriceNanoErd < minGasPriceNanoErdLowerBound {
+			return fmt.Errorf("%w: config.MinGasPriceNanoErd is invalid", storage.ErrInvalidConfig)
+		}
+	case GasPricePercentileScoreComputer:
+	default:
+		return fmt.Errorf("%w: config.ScoreComputer is invalid", storage.ErrInvalidConfig)
 	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
@@ -69,10 +87,19 @@ func (config *ConfigSourceMe) verify() error {
 	return nil
 }
 
+func (config *ConfigSourceMe) createScoreComputer() scoreComputer {
+	if config.ScoreComputer == GasPricePercentileScoreComputer {
+		return newGasPricePercentileScoreComputer()
+	}
+
+	return newDefaultScoreComputer(config.MinGasPriceNanoErd)
+}
+
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:                          config.NumBytesPerSenderThreshold,
+		maxNumTxs:                            config.CountPerSenderThreshold,
+		minGasPriceBumpPercentForReplacement: config.MinGasPriceBumpPercentForReplacement,
 	}
 }
 
@@ -96,6 +96,24 @@ func (cache *CrossTxCache) RemoveTxByHash(txHash []byte) bool {
 	return cache.RemoveWithResult(txHash)
 }
 
+// RegisterOnDropped registers the handler to be called for each transaction evicted from the cache because the
+// capacity was exceeded. The explicitly removed transactions are not reported
+func (cache *CrossTxCache) RegisterOnDropped(handler func(tx *WrappedTransaction)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler")
+		return
+	}
+
+	cache.ImmunityCache.RegisterOnEvicted(func(_ []byte, value interface{}) {
+		tx, ok := value.(*WrappedTransaction)
+		if !ok {
+			return
+		}
+
+		handler(tx)
+	})
+}
+
 // ForEachTransaction iterates over the transactions in the cache
 func (cache *CrossTxCache) ForEachTransaction(function ForEachTransaction) {
 	cache.ForEachItem(func(key []byte, item interface{}) {
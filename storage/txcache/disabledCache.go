@@ -35,6 +35,10 @@ func (cache *DisabledCache) RemoveTxByHash(_ []byte) bool {
 	return false
 }
 
+// RegisterOnDropped does nothing
+func (cache *DisabledCache) RegisterOnDropped(_ func(tx *WrappedTransaction)) {
+}
+
 // Len returns zero
 func (cache *DisabledCache) Len() int {
 	return 0
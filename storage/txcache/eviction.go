@@ -69,8 +69,10 @@ func (cache *TxCache) areThereTooManyTxs() bool {
 
 // This is called concurrently by two goroutines: the eviction one and the sweeping one
 func (cache *TxCache) doEvictItems(txsToEvict [][]byte, sendersToEvict []string) (countTxs uint32, countSenders uint32) {
-	countTxs = cache.txByHash.RemoveTxsBulk(txsToEvict)
+	countTxs = cache.removeDroppedTxs(txsToEvict)
 	countSenders = cache.txListBySender.RemoveSendersBulk(sendersToEvict)
+	cache.numEvictedTxs.Add(int64(countTxs))
+	cache.numEvictedSenders.Add(int64(countSenders))
 	return
 }
 
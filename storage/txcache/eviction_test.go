@@ -44,6 +44,44 @@ func TestEviction_EvictSendersWhileTooManyTxs(t *testing.T) {
 	require.Equal(t, int64(100), cache.txByHash.counter.Get())
 }
 
+func TestEviction_EvictSendersShouldReportTheDroppedTxs(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                          "untitled",
+		NumChunks:                     16,
+		CountThreshold:                100,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 20,
+		NumBytesThreshold:             maxNumBytesUpperBound,
+		NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+		MinGasPriceNanoErd:            100,
+	}
+
+	cache, err := NewTxCache(config)
+	require.Nil(t, err)
+
+	droppedTxs := make([]*WrappedTransaction, 0)
+	cache.RegisterOnDropped(func(tx *WrappedTransaction) {
+		droppedTxs = append(droppedTxs, tx)
+	})
+
+	for index := 0; index < 200; index++ {
+		sender := string(createFakeSenderAddress(index))
+		cache.AddTx(createTx([]byte{byte(index)}, sender, uint64(1)))
+	}
+
+	cache.makeSnapshotOfSenders()
+	_, nTxs, _ := cache.evictSendersInLoop()
+
+	require.Equal(t, uint32(100), nTxs)
+	require.Len(t, droppedTxs, 100)
+	for _, tx := range droppedTxs {
+		require.False(t, cache.Has(tx.TxHash))
+	}
+
+	cache.RemoveTxByHash([]byte{byte(199)})
+	require.Len(t, droppedTxs, 100)
+}
+
 func TestEviction_EvictSendersWhileTooManyBytes(t *testing.T) {
 	numBytesPerTx := uint32(1000)
 
@@ -0,0 +1,129 @@
+package txcache
+
+// NonceGap represents an interval of nonces, [From, To], missing from the pending transactions of a sender
+type NonceGap struct {
+	From uint64
+	To   uint64
+}
+
+// PendingTransaction holds the main fields of a transaction waiting in the cache
+type PendingTransaction struct {
+	TxHash   []byte
+	Nonce    uint64
+	GasPrice uint64
+	GasLimit uint64
+}
+
+// SenderInfo holds the pending transactions of a sender, as seen by the cache
+type SenderInfo struct {
+	Sender              []byte
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	Transactions        []*PendingTransaction
+	Gaps                []NonceGap
+	Score               uint32
+	NumFailedSelections int64
+	IsInGracePeriod     bool
+	NumBytes            uint64
+}
+
+// CacheStatistics holds summary statistics of a transactions cache
+type CacheStatistics struct {
+	Name              string
+	NumTxs            uint64
+	NumBytes          uint64
+	NumSenders        uint64
+	NumEvictedTxs     uint64
+	NumEvictedSenders uint64
+	NumReplacedTxs    uint64
+}
+
+// GetSenderInfo returns the pending transactions of a sender, sorted by nonce, along with the detected nonce gaps
+func (cache *TxCache) GetSenderInfo(sender []byte) (*SenderInfo, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.getSenderInfo(), true
+}
+
+// GetStatistics returns the summary statistics of the cache
+func (cache *TxCache) GetStatistics() *CacheStatistics {
+	return &CacheStatistics{
+		Name:              cache.name,
+		NumTxs:            cache.CountTx(),
+		NumBytes:          uint64(cache.NumBytes()),
+		NumSenders:        cache.CountSenders(),
+		NumEvictedTxs:     cache.numEvictedTxs.GetUint64(),
+		NumEvictedSenders: cache.numEvictedSenders.GetUint64(),
+		NumReplacedTxs:    cache.numReplacedTxs.GetUint64(),
+	}
+}
+
+// GetSenderInfo returns no information, as the cross shard transactions are not grouped by sender
+func (cache *CrossTxCache) GetSenderInfo(_ []byte) (*SenderInfo, bool) {
+	return nil, false
+}
+
+// GetStatistics returns the summary statistics of the cache
+func (cache *CrossTxCache) GetStatistics() *CacheStatistics {
+	return &CacheStatistics{
+		Name:          cache.config.Name,
+		NumTxs:        uint64(cache.Len()),
+		NumBytes:      uint64(cache.NumBytes()),
+		NumEvictedTxs: uint64(cache.NumEvicted()),
+	}
+}
+
+// GetSenderInfo returns no information
+func (cache *DisabledCache) GetSenderInfo(_ []byte) (*SenderInfo, bool) {
+	return nil, false
+}
+
+// GetStatistics returns empty statistics
+func (cache *DisabledCache) GetStatistics() *CacheStatistics {
+	return &CacheStatistics{}
+}
+
+func (listForSender *txListForSender) getSenderInfo() *SenderInfo {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	info := &SenderInfo{
+		Sender:              []byte(listForSender.sender),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		Transactions:        make([]*PendingTransaction, 0, listForSender.countTx()),
+		Gaps:                make([]NonceGap, 0),
+		Score:               listForSender.getLastComputedScore(),
+		NumFailedSelections: listForSender.numFailedSelections.Get(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+		NumBytes:            listForSender.totalBytes.GetUint64(),
+	}
+
+	// The gaps are computed with respect to the account nonce (if known), then between the consecutive transactions
+	expectedNonce := info.AccountNonce
+	isExpectedNonceKnown := info.AccountNonceKnown
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		tx := element.Value.(*WrappedTransaction)
+		nonce := tx.Tx.GetNonce()
+
+		info.Transactions = append(info.Transactions, &PendingTransaction{
+			TxHash:   tx.TxHash,
+			Nonce:    nonce,
+			GasPrice: tx.Tx.GetGasPrice(),
+			GasLimit: tx.Tx.GetGasLimit(),
+		})
+
+		if isExpectedNonceKnown && nonce > expectedNonce {
+			info.Gaps = append(info.Gaps, NonceGap{From: expectedNonce, To: nonce - 1})
+		}
+		if !isExpectedNonceKnown || nonce >= expectedNonce {
+			expectedNonce = nonce + 1
+			isExpectedNonceKnown = true
+		}
+	}
+
+	return info
+}
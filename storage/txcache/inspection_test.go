@@ -0,0 +1,97 @@
+package txcache
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_GetSenderInfo(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice-7"), "alice", 7, 128, 50000, 1000000000))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-4"), "alice", 4, 128, 50000, 1000000000))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-5"), "alice", 5, 128, 50000, 1000000000))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-10"), "alice", 10, 128, 50000, 1000000000))
+
+	info, ok := cache.GetSenderInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, []byte("alice"), info.Sender)
+	require.False(t, info.AccountNonceKnown)
+	require.Equal(t, uint64(512), info.NumBytes)
+	require.Equal(t, 4, len(info.Transactions))
+	require.Equal(t, uint64(4), info.Transactions[0].Nonce)
+	require.Equal(t, []byte("hash-alice-4"), info.Transactions[0].TxHash)
+	require.Equal(t, uint64(10), info.Transactions[3].Nonce)
+	require.Equal(t, []NonceGap{{From: 6, To: 6}, {From: 8, To: 9}}, info.Gaps)
+
+	cache.NotifyAccountNonce([]byte("alice"), 1)
+	info, _ = cache.GetSenderInfo([]byte("alice"))
+	require.True(t, info.AccountNonceKnown)
+	require.Equal(t, uint64(1), info.AccountNonce)
+	require.Equal(t, []NonceGap{{From: 1, To: 3}, {From: 6, To: 6}, {From: 8, To: 9}}, info.Gaps)
+
+	_, ok = cache.GetSenderInfo([]byte("bob"))
+	require.False(t, ok)
+}
+
+func TestTxCache_GetSenderInfoWithDuplicatedNoncesAndAlreadyExecutedNonces(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice-2"), "alice", 2, 128, 50000, 1000000000))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-3a"), "alice", 3, 128, 50000, 1000000000))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-3b"), "alice", 3, 128, 50000, 2000000000))
+	cache.AddTx(createTxWithParams([]byte("hash-alice-4"), "alice", 4, 128, 50000, 1000000000))
+	cache.NotifyAccountNonce([]byte("alice"), 3)
+
+	info, ok := cache.GetSenderInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, 4, len(info.Transactions))
+	require.Empty(t, info.Gaps)
+}
+
+func TestTxCache_GetStatisticsShouldCountEvictions(t *testing.T) {
+	cache := newCacheToTest(maxNumBytesPerSenderUpperBound, 3)
+
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-alice-3"), "alice", 3))
+	cache.AddTx(createTx([]byte("hash-alice-4"), "alice", 4))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+
+	statistics := cache.GetStatistics()
+	require.Equal(t, "test", statistics.Name)
+	require.Equal(t, uint64(4), statistics.NumTxs)
+	require.Equal(t, uint64(2), statistics.NumSenders)
+	require.Equal(t, uint64(cache.NumBytes()), statistics.NumBytes)
+	require.Equal(t, uint64(1), statistics.NumEvictedTxs)
+	require.Equal(t, uint64(0), statistics.NumEvictedSenders)
+
+	cache.evictSendersAndTheirTxs([]*txListForSender{cache.getListForSender("bob")})
+	statistics = cache.GetStatistics()
+	require.Equal(t, uint64(2), statistics.NumEvictedTxs)
+	require.Equal(t, uint64(1), statistics.NumEvictedSenders)
+}
+
+func TestCrossTxCache_GetStatistics(t *testing.T) {
+	cache := newCrossTxCacheToTest(1, 4, math.MaxUint16)
+
+	cache.addTestTxs("a", "b", "c", "d", "e")
+
+	statistics := cache.GetStatistics()
+	require.Equal(t, "test", statistics.Name)
+	require.Equal(t, uint64(4), statistics.NumTxs)
+	require.Equal(t, uint64(1), statistics.NumEvictedTxs)
+
+	_, ok := cache.GetSenderInfo([]byte("alice"))
+	require.False(t, ok)
+}
+
+func TestDisabledCache_GetStatisticsAndSenderInfo(t *testing.T) {
+	cache := NewDisabledCache()
+
+	require.Equal(t, &CacheStatistics{}, cache.GetStatistics())
+	_, ok := cache.GetSenderInfo([]byte("alice"))
+	require.False(t, ok)
+}
@@ -2,6 +2,8 @@ package txcache
 
 type scoreComputer interface {
 	computeScore(scoreParams senderScoreParams) uint32
+	forgetSender(sender string)
+	needsRescoring() bool
 }
 
 // ForEachTransaction is an iterator callback
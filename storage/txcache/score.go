@@ -10,12 +10,15 @@ var _ scoreComputer = (*defaultScoreComputer)(nil)
 // TODO (continued): We should not rely on any order of magnitude known a priori.
 // TODO (continued): The score formula should work even if minGasPrice = 0.
 type senderScoreParams struct {
-	count uint64
+	sender string
+	count  uint64
 	// Size is in bytes
 	size uint64
 	// Fee is in nano ERD
 	fee uint64
 	gas uint64
+	// Price is in "atomic" units, as found in the transactions
+	avgGasPrice uint64
 }
 
 type defaultScoreComputer struct {
@@ -36,6 +39,15 @@ func (computer *defaultScoreComputer) computeScore(scoreParams senderScoreParams
 	return truncatedScore
 }
 
+// forgetSender does nothing, as the score of a sender does not depend on the other senders
+func (computer *defaultScoreComputer) forgetSender(_ string) {
+}
+
+// needsRescoring returns false, as the score of a sender does not depend on the other senders
+func (computer *defaultScoreComputer) needsRescoring() bool {
+	return false
+}
+
 // TODO (optimization): switch to integer operations (as opposed to float operations).
 func (computer *defaultScoreComputer) computeRawScore(params senderScoreParams) float64 {
 	allParamsDefined := params.fee > 0 && params.gas > 0 && params.size > 0 && params.count > 0
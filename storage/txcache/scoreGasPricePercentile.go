@@ -0,0 +1,148 @@
+package txcache
+
+import (
+	"math/bits"
+	"sync"
+)
+
+var _ scoreComputer = (*gasPricePercentileScoreComputer)(nil)
+
+// Each power of two of the gas price is split in 2^numSubBucketsBits buckets
+const numSubBucketsBits = 2
+const numGasPriceBuckets = (64 + 1) << numSubBucketsBits
+
+// The weight of the gas price percentile, against the penalties given by the number and the size of the transactions
+const percentileScoreWeight = 8
+
+// gasPricePercentileScoreComputer ranks the senders by the percentile of their average gas price among the senders
+// currently in the pool, so it does not depend on the minimum gas price: the formula holds when the minimum gas price
+// is zero or when it changes (e.g. at an epoch boundary), since the senders are only compared to each other.
+// The percentile is then lowered by the number of transactions and the size of the sender's list. Only integer
+// operations are used.
+// Since a sender entering, leaving or moving in the distribution changes the percentiles of the others, the scores of
+// all the senders are recomputed at the start of the next selection, so that they are ranked against the same
+// distribution.
+type gasPricePercentileScoreComputer struct {
+	mutex                  sync.Mutex
+	bucketBySender         map[string]int
+	numPerBucket           [numGasPriceBuckets]uint64
+	numSendersTotal        uint64
+	percentileByBucket     [numGasPriceBuckets]uint64
+	isPercentileTableStale bool
+	isDistributionChanged  bool
+}
+
+func newGasPricePercentileScoreComputer() *gasPricePercentileScoreComputer {
+	return &gasPricePercentileScoreComputer{
+		bucketBySender: make(map[string]int),
+	}
+}
+
+// computeScore computes the score of the sender, as an integer 0-99
+func (computer *gasPricePercentileScoreComputer) computeScore(params senderScoreParams) uint32 {
+	if params.count == 0 {
+		computer.forgetSender(params.sender)
+		return 0
+	}
+
+	percentile := computer.updateAndGetPercentile(params.sender, params.avgGasPrice)
+
+	// We use size in ~kB
+	const bytesInKB = 1000
+	countPenalty := uint64(bits.Len64(params.count))
+	sizePenalty := uint64(bits.Len64(params.size / bytesInKB))
+
+	score := percentile * percentileScoreWeight / (percentileScoreWeight + countPenalty + sizePenalty)
+	if score >= uint64(numberOfScoreChunks) {
+		score = uint64(numberOfScoreChunks) - 1
+	}
+
+	return uint32(score)
+}
+
+// updateAndGetPercentile moves the sender in the bucket of its average gas price and returns the percentile (0-100)
+// of this bucket. The senders in the same bucket count as a half, so that a sender alone in the pool gets 50
+func (computer *gasPricePercentileScoreComputer) updateAndGetPercentile(sender string, avgGasPrice uint64) uint64 {
+	bucket := gasPriceBucket(avgGasPrice)
+
+	computer.mutex.Lock()
+	defer computer.mutex.Unlock()
+
+	previousBucket, ok := computer.bucketBySender[sender]
+	if !ok || previousBucket != bucket {
+		computer.removeSenderFromBucket(sender)
+		computer.bucketBySender[sender] = bucket
+		computer.numPerBucket[bucket]++
+		computer.numSendersTotal++
+		computer.onDistributionChanged()
+	}
+
+	if computer.isPercentileTableStale {
+		computer.computePercentiles()
+	}
+
+	return computer.percentileByBucket[bucket]
+}
+
+// computePercentiles computes the percentile of each bucket, so that rescoring all the senders against an unchanged
+// distribution does not walk the buckets for each sender
+func (computer *gasPricePercentileScoreComputer) computePercentiles() {
+	numBelow := uint64(0)
+	for i := 0; i < numGasPriceBuckets; i++ {
+		computer.percentileByBucket[i] = 0
+		if computer.numSendersTotal > 0 {
+			computer.percentileByBucket[i] = (2*numBelow + computer.numPerBucket[i]) * 100 / (2 * computer.numSendersTotal)
+		}
+		numBelow += computer.numPerBucket[i]
+	}
+
+	computer.isPercentileTableStale = false
+}
+
+func (computer *gasPricePercentileScoreComputer) onDistributionChanged() {
+	computer.isPercentileTableStale = true
+	computer.isDistributionChanged = true
+}
+
+// needsRescoring returns true if the distribution changed since the previous call, in which case the scores computed
+// for the other senders are stale
+func (computer *gasPricePercentileScoreComputer) needsRescoring() bool {
+	computer.mutex.Lock()
+	defer computer.mutex.Unlock()
+
+	isDistributionChanged := computer.isDistributionChanged
+	computer.isDistributionChanged = false
+
+	return isDistributionChanged
+}
+
+// forgetSender removes the sender from the gas price distribution
+func (computer *gasPricePercentileScoreComputer) forgetSender(sender string) {
+	computer.mutex.Lock()
+	computer.removeSenderFromBucket(sender)
+	computer.mutex.Unlock()
+}
+
+func (computer *gasPricePercentileScoreComputer) removeSenderFromBucket(sender string) {
+	bucket, ok := computer.bucketBySender[sender]
+	if !ok {
+		return
+	}
+
+	delete(computer.bucketBySender, sender)
+	computer.numPerBucket[bucket]--
+	computer.numSendersTotal--
+	computer.onDistributionChanged()
+}
+
+// gasPriceBucket maps a gas price to a bucket on a logarithmic scale: the buckets keep the order of the gas prices
+// and each one covers a quarter of a power of two, whatever the order of magnitude of the prices
+func gasPriceBucket(gasPrice uint64) int {
+	bitLen := bits.Len64(gasPrice)
+	if bitLen <= numSubBucketsBits {
+		return int(gasPrice)
+	}
+
+	subBucket := int(gasPrice>>uint(bitLen-1-numSubBucketsBits)) & (1<<numSubBucketsBits - 1)
+	return bitLen<<numSubBucketsBits | subBucket
+}
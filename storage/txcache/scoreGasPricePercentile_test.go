@@ -0,0 +1,160 @@
+package txcache
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_gasPriceBucket_keepsOrder(t *testing.T) {
+	prices := []uint64{0, 1, 2, 3, 4, 5, 7, 8, 1000, 1500, oneBillion, 2 * oneBillion, 200 * oneBillion, math.MaxUint64}
+
+	for i := 1; i < len(prices); i++ {
+		require.LessOrEqual(t, gasPriceBucket(prices[i-1]), gasPriceBucket(prices[i]))
+	}
+
+	require.Equal(t, 0, gasPriceBucket(0))
+	require.Less(t, gasPriceBucket(1000), gasPriceBucket(1500))
+	require.Equal(t, gasPriceBucket(oneBillion), gasPriceBucket(oneBillion+1))
+	require.Less(t, gasPriceBucket(math.MaxUint64), numGasPriceBuckets)
+}
+
+func TestGasPricePercentileScoreComputer_computeScore(t *testing.T) {
+	computer := newGasPricePercentileScoreComputer()
+
+	// Alone in the pool: midrank
+	scoreAlice := computer.computeScore(senderScoreParams{sender: "alice", count: 1, size: 100, avgGasPrice: 100 * oneBillion})
+	require.Equal(t, uint32(44), scoreAlice)
+
+	scoreBob := computer.computeScore(senderScoreParams{sender: "bob", count: 1, size: 100, avgGasPrice: 200 * oneBillion})
+	scoreCarol := computer.computeScore(senderScoreParams{sender: "carol", count: 1, size: 100, avgGasPrice: 0})
+	require.Greater(t, scoreBob, scoreCarol)
+	require.Equal(t, uint64(3), computer.numSendersTotal)
+
+	// Same gas price, more transactions and bytes: lower score
+	scoreDave := computer.computeScore(senderScoreParams{sender: "dave", count: 1000, size: 1000000, avgGasPrice: 200 * oneBillion})
+	scoreBob = computer.computeScore(senderScoreParams{sender: "bob", count: 1, size: 100, avgGasPrice: 200 * oneBillion})
+	require.Less(t, scoreDave, scoreBob)
+	require.Equal(t, uint64(4), computer.numSendersTotal)
+}
+
+func TestGasPricePercentileScoreComputer_scoreIsRelativeToOtherSenders(t *testing.T) {
+	computer := newGasPricePercentileScoreComputer()
+	_ = computer.computeScore(senderScoreParams{sender: "bob", count: 1, size: 100, avgGasPrice: 1})
+	scoreWithLowPrices := computer.computeScore(senderScoreParams{sender: "alice", count: 1, size: 100, avgGasPrice: 2})
+
+	otherComputer := newGasPricePercentileScoreComputer()
+	_ = otherComputer.computeScore(senderScoreParams{sender: "bob", count: 1, size: 100, avgGasPrice: 1000 * oneBillion})
+	scoreWithHighPrices := otherComputer.computeScore(senderScoreParams{sender: "alice", count: 1, size: 100, avgGasPrice: 2000 * oneBillion})
+
+	require.Equal(t, scoreWithLowPrices, scoreWithHighPrices)
+	require.Equal(t, uint32(66), scoreWithLowPrices)
+}
+
+func TestGasPricePercentileScoreComputer_forgetSender(t *testing.T) {
+	computer := newGasPricePercentileScoreComputer()
+	_ = computer.computeScore(senderScoreParams{sender: "alice", count: 1, size: 100, avgGasPrice: oneBillion})
+	_ = computer.computeScore(senderScoreParams{sender: "bob", count: 1, size: 100, avgGasPrice: oneBillion})
+	require.Equal(t, uint64(2), computer.numSendersTotal)
+
+	computer.forgetSender("alice")
+	computer.forgetSender("alice")
+	require.Equal(t, uint64(1), computer.numSendersTotal)
+	require.Len(t, computer.bucketBySender, 1)
+
+	score := computer.computeScore(senderScoreParams{sender: "bob", count: 0})
+	require.Equal(t, uint32(0), score)
+	require.Equal(t, uint64(0), computer.numSendersTotal)
+	require.Len(t, computer.bucketBySender, 0)
+}
+
+func TestGasPricePercentileScoreComputer_withTxCacheAndZeroGasPrices(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		ScoreComputer:              GasPricePercentileScoreComputer,
+	}
+	cache, err := NewTxCache(config)
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice"), "alice", 1, 128, 50000, 0))
+	cache.AddTx(createTxWithParams([]byte("hash-bob"), "bob", 1, 128, 50000, oneBillion))
+
+	alice, _ := cache.txListBySender.getListForSender("alice")
+	bob, _ := cache.txListBySender.getListForSender("bob")
+	require.Less(t, alice.getLastComputedScore(), bob.getLastComputedScore())
+
+	cache.RemoveTxByHash([]byte("hash-alice"))
+	require.Equal(t, uint64(1), cache.txListBySender.scoreComputer.(*gasPricePercentileScoreComputer).numSendersTotal)
+}
+
+func Test_uint128Sum(t *testing.T) {
+	sum := uint128Sum{}
+	sum.add(math.MaxUint64)
+	sum.add(math.MaxUint64)
+	sum.add(2)
+	require.Equal(t, uint128Sum{hi: 2, lo: 0}, sum)
+	// Averages above the uint64 range saturate
+	require.Equal(t, uint64(math.MaxUint64), sum.average(2))
+	require.Equal(t, uint64(1)<<63, sum.average(4))
+
+	sum.subtract(math.MaxUint64)
+	require.Equal(t, uint64(1)<<63, sum.average(2))
+
+	empty := uint128Sum{}
+	require.Equal(t, uint64(0), empty.average(0))
+}
+
+func TestGasPricePercentileScoreComputer_laterHighPriceSenderShouldLowerEarlierSenderScore(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		ScoreComputer:              GasPricePercentileScoreComputer,
+	}
+	cache, err := NewTxCache(config)
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice"), "alice", 1, 128, 50000, oneBillion))
+	alice, _ := cache.txListBySender.getListForSender("alice")
+	scoreAliceAlone := alice.getLastComputedScore()
+
+	cache.AddTx(createTxWithParams([]byte("hash-bob"), "bob", 1, 128, 50000, 10*oneBillion))
+	bob, _ := cache.txListBySender.getListForSender("bob")
+	require.Equal(t, scoreAliceAlone, alice.getLastComputedScore())
+
+	// the scores of all the senders are recomputed against the new distribution when selecting
+	_ = cache.SelectTransactions(10, 10)
+	require.Less(t, alice.getLastComputedScore(), scoreAliceAlone)
+	require.Less(t, alice.getLastComputedScore(), bob.getLastComputedScore())
+
+	// unchanged distribution, no rescoring is needed
+	require.False(t, cache.txListBySender.scoreComputer.needsRescoring())
+}
+
+func TestGasPricePercentileScoreComputer_scoresShouldNotDependOnInsertionOrder(t *testing.T) {
+	prices := map[string]uint64{"alice": 1, "bob": 2, "carol": 3}
+
+	scoresInOrder := func(order []string) map[string]uint32 {
+		computer := newGasPricePercentileScoreComputer()
+		txMap := newTxListBySenderMap(4, senderConstraints{maxNumBytes: math.MaxUint32, maxNumTxs: math.MaxUint32}, computer)
+		for i, sender := range order {
+			txMap.addTx(createTxWithParams([]byte(fmt.Sprintf("hash%d", i)), sender, 1, 128, 50000, prices[sender]*oneBillion))
+		}
+		txMap.rescoreSendersIfNeeded()
+
+		scores := make(map[string]uint32)
+		for _, sender := range order {
+			listForSender, _ := txMap.getListForSender(sender)
+			scores[sender] = listForSender.getLastComputedScore()
+		}
+		return scores
+	}
+
+	require.Equal(t, scoresInOrder([]string{"alice", "bob", "carol"}), scoresInOrder([]string{"carol", "bob", "alice"}))
+}
@@ -185,3 +185,10 @@ type disabledScoreComputer struct {
 func (computer *disabledScoreComputer) computeScore(_ senderScoreParams) uint32 {
 	return 0
 }
+
+func (computer *disabledScoreComputer) forgetSender(_ string) {
+}
+
+func (computer *disabledScoreComputer) needsRescoring() bool {
+	return false
+}
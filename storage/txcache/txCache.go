@@ -24,6 +24,11 @@ type TxCache struct {
 	numSendersWithInitialGap  atomic.Counter
 	numSendersWithMiddleGap   atomic.Counter
 	numSendersInGracePeriod   atomic.Counter
+	numEvictedTxs             atomic.Counter
+	numEvictedSenders         atomic.Counter
+	numReplacedTxs            atomic.Counter
+	mutexDroppedHandler       sync.RWMutex
+	onDropped                 func(tx *WrappedTransaction)
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 }
@@ -41,7 +46,7 @@ func NewTxCache(config ConfigSourceMe) (*TxCache, error) {
 	// Note: for simplicity, we use the same "numChunks" for both internal concurrent maps
 	numChunks := config.NumChunks
 	senderConstraints := config.getSenderConstraints()
-	scoreComputer := newDefaultScoreComputer(config.MinGasPriceNanoErd)
+	scoreComputer := config.createScoreComputer()
 
 	txCache := &TxCache{
 		name:            config.Name,
@@ -67,7 +72,7 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	}
 
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	addedInBySender, evicted, replaced := cache.txListBySender.addTx(tx)
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
 		// - A adds to "txByHash"
@@ -77,9 +82,14 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		log.Trace("TxCache.AddTx(): slight inconsistency detected:", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "addedInByHash", addedInByHash, "addedInBySender", addedInBySender)
 	}
 
+	if len(replaced) > 0 {
+		cache.removeReplacedTxs(tx, replaced)
+	}
+
 	if len(evicted) > 0 {
 		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
-		cache.txByHash.RemoveTxsBulk(evicted)
+		numEvicted := cache.removeDroppedTxs(evicted)
+		cache.numEvictedTxs.Add(int64(numEvicted))
 	}
 
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
@@ -87,6 +97,49 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	return true, addedInByHash || addedInBySender
 }
 
+func (cache *TxCache) removeReplacedTxs(replacement *WrappedTransaction, replacedTxHashes [][]byte) {
+	numReplaced := cache.removeDroppedTxs(replacedTxHashes)
+	cache.numReplacedTxs.Add(int64(numReplaced))
+	log.Trace("TxCache.AddTx(): replaced by fee", "name", cache.name, "txs", replacedTxHashes, "replacement", replacement.TxHash)
+}
+
+// removeDroppedTxs removes from the map by hash the transactions already removed from the lists of their senders
+// and reports them to the dropped handler
+func (cache *TxCache) removeDroppedTxs(txHashes [][]byte) uint32 {
+	cache.mutexDroppedHandler.RLock()
+	onDropped := cache.onDropped
+	cache.mutexDroppedHandler.RUnlock()
+
+	numRemoved := uint32(0)
+	for _, txHash := range txHashes {
+		tx, ok := cache.txByHash.removeTx(string(txHash))
+		if !ok {
+			continue
+		}
+
+		numRemoved++
+		if onDropped != nil {
+			onDropped(tx)
+		}
+	}
+
+	return numRemoved
+}
+
+// RegisterOnDropped registers the handler to be called for each transaction dropped from the cache: evicted because
+// the capacity was exceeded, swept because of its nonce gap or replaced by a transaction with the same sender and
+// nonce, but a sufficiently higher gas price. The explicitly removed transactions are not reported
+func (cache *TxCache) RegisterOnDropped(handler func(tx *WrappedTransaction)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler")
+		return
+	}
+
+	cache.mutexDroppedHandler.Lock()
+	cache.onDropped = handler
+	cache.mutexDroppedHandler.Unlock()
+}
+
 // GetByTxHash gets the transaction by hash
 func (cache *TxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	tx, ok := cache.txByHash.getTx(string(txHash))
@@ -147,6 +200,7 @@ func (cache *TxCache) doSelectTransactions(numRequested int, batchSizePerSender
 }
 
 func (cache *TxCache) getSendersEligibleForSelection() []*txListForSender {
+	cache.txListBySender.rescoreSendersIfNeeded()
 	return cache.txListBySender.getSnapshotDescending()
 }
 
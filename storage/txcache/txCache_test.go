@@ -60,6 +60,17 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig.MinGasPriceNanoErd = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.MinGasPriceNanoErd")
 
+	badConfig = config
+	badConfig.ScoreComputer = "unknown"
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.ScoreComputer")
+
+	percentileConfig := config
+	percentileConfig.ScoreComputer = GasPricePercentileScoreComputer
+	percentileConfig.MinGasPriceNanoErd = 0
+	cache, err = NewTxCache(percentileConfig)
+	require.Nil(t, err)
+	require.NotNil(t, cache)
+
 	badConfig = withEvictionConfig
 	badConfig.NumBytesThreshold = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.NumBytesThreshold")
@@ -565,6 +576,40 @@ func TestTxCache_NoCriticalInconsistency_WhenConcurrentAdditionsAndRemovals(t *t
 	cache.Clear()
 }
 
+func TestTxCache_AddTx_ReplacesByFee(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                                 "test",
+		NumChunks:                            16,
+		NumBytesPerSenderThreshold:           maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:              math.MaxUint32,
+		MinGasPriceNanoErd:                   100,
+		MinGasPriceBumpPercentForReplacement: 10,
+	}
+	cache, err := NewTxCache(config)
+	require.Nil(t, err)
+
+	replacedTxs := make([]*WrappedTransaction, 0)
+	cache.RegisterOnDropped(func(tx *WrappedTransaction) {
+		replacedTxs = append(replacedTxs, tx)
+	})
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+	ok, added := cache.AddTx(createTxWithParams([]byte("hash-alice-1++"), "alice", 1, 128, 50000, 2*oneBillion))
+	require.True(t, ok)
+	require.True(t, added)
+
+	require.False(t, cache.Has([]byte("hash-alice-1")))
+	require.True(t, cache.Has([]byte("hash-alice-1++")))
+	require.Equal(t, uint64(1), cache.CountTx())
+	require.Len(t, replacedTxs, 1)
+	require.Equal(t, []byte("hash-alice-1"), replacedTxs[0].TxHash)
+	require.Equal(t, uint64(1), cache.GetStatistics().NumReplacedTxs)
+
+	selection := cache.SelectTransactions(10, 10)
+	require.Len(t, selection, 1)
+	require.Equal(t, []byte("hash-alice-1++"), selection[0].TxHash)
+}
+
 func newUnconstrainedCacheToTest() *TxCache {
 	cache, err := NewTxCache(ConfigSourceMe{
 		Name:                       "test",
@@ -30,7 +30,7 @@ func newTxListBySenderMap(nChunksHint uint32, senderConstraints senderConstraint
 }
 
 // addTx adds a transaction in the map, in the corresponding list (selected by its sender)
-func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, [][]byte) {
+func (txMap *txListBySenderMap) addTx(tx *WrappedTransaction) (bool, [][]byte, [][]byte) {
 	sender := string(tx.Tx.GetSndAddr())
 	listForSender := txMap.getOrAddListForSender(sender)
 	return listForSender.AddTx(tx)
@@ -105,6 +105,7 @@ func (txMap *txListBySenderMap) removeSender(sender string) bool {
 	_, removed := txMap.backingMap.Remove(sender)
 	if removed {
 		txMap.counter.Decrement()
+		txMap.scoreComputer.forgetSender(sender)
 	}
 
 	return removed
@@ -123,6 +124,18 @@ func (txMap *txListBySenderMap) RemoveSendersBulk(senders []string) uint32 {
 	return numRemoved
 }
 
+// rescoreSendersIfNeeded recomputes the scores of all the senders, if the score computer signals that the scores
+// computed so far are stale, e.g. because they are relative to the other senders, which have changed
+func (txMap *txListBySenderMap) rescoreSendersIfNeeded() {
+	if !txMap.scoreComputer.needsRescoring() {
+		return
+	}
+
+	for _, listForSender := range txMap.getSnapshotAscending() {
+		listForSender.recomputeScore()
+	}
+}
+
 func (txMap *txListBySenderMap) notifyAccountNonce(accountKey []byte, nonce uint64) {
 	sender := string(accountKey)
 	listForSender, ok := txMap.getListForSender(sender)
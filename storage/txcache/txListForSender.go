@@ -3,6 +3,7 @@ package txcache
 import (
 	"bytes"
 	"container/list"
+	"math/bits"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
@@ -28,6 +29,7 @@ type txListForSender struct {
 	totalBytes          atomic.Counter
 	totalGas            atomic.Counter
 	totalFee            atomic.Counter
+	totalGasPrice       uint128Sum
 	numFailedSelections atomic.Counter
 	onScoreChange       scoreChangeCallback
 
@@ -49,14 +51,16 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 
 // AddTx adds a transaction in sender's list
 // This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]byte) {
+// If replace-by-fee is enabled, the transactions with the same nonce and a gas price outbid by the configured bump
+// are removed from the list, and their hashes are returned as "replaced"
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]byte, [][]byte) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
-		return false, nil
+		return false, nil, nil
 	}
 
 	if insertionPlace == nil {
@@ -66,9 +70,56 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]b
 	}
 
 	listForSender.onAddedTransaction(tx)
+	replaced := listForSender.applyReplacement(tx)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+	return true, evicted, replaced
+}
+
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) applyReplacement(incomingTx *WrappedTransaction) [][]byte {
+	bumpPercent := listForSender.constraints.minGasPriceBumpPercentForReplacement
+	if bumpPercent == 0 {
+		return nil
+	}
+
+	incomingNonce := incomingTx.Tx.GetNonce()
+	incomingGasPrice := incomingTx.Tx.GetGasPrice()
+	replacedTxHashes := make([][]byte, 0)
+
+	for element := listForSender.items.Front(); element != nil; {
+		next := element.Next()
+		currentTx := element.Value.(*WrappedTransaction)
+		currentTxNonce := currentTx.Tx.GetNonce()
+		if currentTxNonce > incomingNonce {
+			break
+		}
+
+		shouldReplace := currentTxNonce == incomingNonce &&
+			!currentTx.sameAs(incomingTx) &&
+			isGasPriceBumpedEnough(currentTx.Tx.GetGasPrice(), incomingGasPrice, bumpPercent)
+		if shouldReplace {
+			listForSender.items.Remove(element)
+			listForSender.onRemovedListElement(element)
+			replacedTxHashes = append(replacedTxHashes, currentTx.TxHash)
+		}
+
+		element = next
+	}
+
+	return replacedTxHashes
+}
+
+// isGasPriceBumpedEnough checks that newGasPrice >= oldGasPrice * (100 + bumpPercent) / 100, on 128 bits
+func isGasPriceBumpedEnough(oldGasPrice uint64, newGasPrice uint64, bumpPercent uint32) bool {
+	requiredHi, requiredLo := bits.Mul64(oldGasPrice, 100+uint64(bumpPercent))
+	offeredHi, offeredLo := bits.Mul64(newGasPrice, 100)
+
+	if offeredHi != requiredHi {
+		return offeredHi > requiredHi
+	}
+
+	return offeredLo >= requiredLo
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -105,6 +156,15 @@ func (listForSender *txListForSender) onAddedTransaction(tx *WrappedTransaction)
 	listForSender.totalBytes.Add(tx.Size)
 	listForSender.totalGas.Add(int64(estimateTxGas(tx)))
 	listForSender.totalFee.Add(int64(estimateTxFee(tx)))
+	listForSender.totalGasPrice.add(tx.Tx.GetGasPrice())
+}
+
+// recomputeScore computes the score of the sender once more, as the scores of the other senders may have changed it
+func (listForSender *txListForSender) recomputeScore() {
+	listForSender.mutex.Lock()
+	defer listForSender.mutex.Unlock()
+
+	listForSender.triggerScoreChange()
 }
 
 func (listForSender *txListForSender) triggerScoreChange() {
@@ -118,8 +178,16 @@ func (listForSender *txListForSender) getScoreParams() senderScoreParams {
 	gas := listForSender.totalGas.GetUint64()
 	size := listForSender.totalBytes.GetUint64()
 	count := listForSender.countTx()
-
-	return senderScoreParams{count: count, size: size, fee: fee, gas: gas}
+	avgGasPrice := listForSender.totalGasPrice.average(count)
+
+	return senderScoreParams{
+		sender:      listForSender.sender,
+		count:       count,
+		size:        size,
+		fee:         fee,
+		gas:         gas,
+		avgGasPrice: avgGasPrice,
+	}
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -177,6 +245,7 @@ func (listForSender *txListForSender) onRemovedListElement(element *list.Element
 	listForSender.totalBytes.Subtract(value.Size)
 	listForSender.totalGas.Subtract(int64(estimateTxGas(value)))
 	listForSender.totalFee.Subtract(int64(estimateTxFee(value)))
+	listForSender.totalGasPrice.subtract(value.Tx.GetGasPrice())
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -48,13 +48,13 @@ func TestListForSender_AddTx_SortsCorrectlyWhenSameNonceSamePrice(t *testing.T)
 func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1))
+	added, _, _ := list.AddTx(createTx([]byte("tx1"), ".", 1))
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3))
+	added, _, _ = list.AddTx(createTx([]byte("tx3"), ".", 3))
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2))
 	require.False(t, added)
 }
 
@@ -67,17 +67,17 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2))
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3))
+	_, evicted, _ := list.AddTx(createTx([]byte("tx3"), ".", 3))
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42))
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
 
 	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42))
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
 }
@@ -88,24 +88,71 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42))
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42))
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42))
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42))
+	_, evicted, _ := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42))
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42))
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42))
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100))
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100))
 	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 }
 
+func TestListForSender_AddTx_ReplacesByFee(t *testing.T) {
+	list := newTxListForSender(".", &senderConstraints{
+		maxNumBytes:                          math.MaxUint32,
+		maxNumTxs:                            math.MaxUint32,
+		minGasPriceBumpPercentForReplacement: 10,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 50000, 100))
+	list.AddTx(createTxWithParams([]byte("b"), ".", 2, 128, 50000, 100))
+
+	// Bump too small, the transactions are kept side by side
+	_, _, replaced := list.AddTx(createTxWithParams([]byte("b+"), ".", 2, 128, 50000, 109))
+	require.Len(t, replaced, 0)
+	require.Equal(t, []string{"a", "b+", "b"}, list.getTxHashesAsStrings())
+
+	// Outbids both "b" (by 20%) and "b+" (by ~10.09%)
+	_, _, replaced = list.AddTx(createTxWithParams([]byte("b++"), ".", 2, 128, 50000, 120))
+	require.Equal(t, []string{"b+", "b"}, hashesAsStrings(replaced))
+	require.Equal(t, []string{"a", "b++"}, list.getTxHashesAsStrings())
+	require.Equal(t, int64(256), list.totalBytes.Get())
+	require.Equal(t, int64(100000), list.totalGas.Get())
+	require.Equal(t, uint64(110), list.totalGasPrice.average(list.countTx()))
+
+	// A lower gas price does not replace anything
+	_, _, replaced = list.AddTx(createTxWithParams([]byte("a-"), ".", 1, 128, 50000, 50))
+	require.Len(t, replaced, 0)
+	require.Equal(t, []string{"a", "a-", "b++"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_AddTx_DoesNotReplaceWhenReplaceByFeeIsDisabled(t *testing.T) {
+	list := newUnconstrainedListToTest()
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 50000, 100))
+	_, _, replaced := list.AddTx(createTxWithParams([]byte("a++"), ".", 1, 128, 50000, 1000))
+	require.Len(t, replaced, 0)
+	require.Equal(t, []string{"a++", "a"}, list.getTxHashesAsStrings())
+}
+
+func Test_isGasPriceBumpedEnough(t *testing.T) {
+	require.True(t, isGasPriceBumpedEnough(100, 110, 10))
+	require.False(t, isGasPriceBumpedEnough(100, 109, 10))
+	require.True(t, isGasPriceBumpedEnough(0, 0, 10))
+	require.False(t, isGasPriceBumpedEnough(math.MaxUint64, math.MaxUint64, 1))
+	require.True(t, isGasPriceBumpedEnough(math.MaxUint64/2, math.MaxUint64, 100))
+	require.False(t, isGasPriceBumpedEnough(math.MaxUint64/2+1, math.MaxUint64, 100))
+}
+
 func TestListForSender_findTx(t *testing.T) {
 	list := newUnconstrainedListToTest()
 
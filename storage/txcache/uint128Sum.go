@@ -0,0 +1,38 @@
+package txcache
+
+import (
+	"math"
+	"math/bits"
+)
+
+// uint128Sum holds an exact sum of uint64 values, so that averages can be computed with integer operations only
+// and without overflowing, whatever the order of magnitude of the summed values
+type uint128Sum struct {
+	hi uint64
+	lo uint64
+}
+
+func (sum *uint128Sum) add(value uint64) {
+	var carry uint64
+	sum.lo, carry = bits.Add64(sum.lo, value, 0)
+	sum.hi += carry
+}
+
+func (sum *uint128Sum) subtract(value uint64) {
+	var borrow uint64
+	sum.lo, borrow = bits.Sub64(sum.lo, value, 0)
+	sum.hi -= borrow
+}
+
+// average returns the sum divided by the given count, saturated to math.MaxUint64
+func (sum *uint128Sum) average(count uint64) uint64 {
+	if count == 0 {
+		return 0
+	}
+	if sum.hi >= count {
+		return math.MaxUint64
+	}
+
+	quotient, _ := bits.Div64(sum.hi, sum.lo, count)
+	return quotient
+}
@@ -8,6 +8,7 @@ import (
 // ShardedDataStub -
 type ShardedDataStub struct {
 	RegisterOnAddedCalled                  func(func(key []byte, value interface{}))
+	RegisterOnRemovedCalled                func(func(key []byte, value interface{}))
 	ShardDataStoreCalled                   func(cacheID string) storage.Cacher
 	AddDataCalled                          func(key []byte, data interface{}, sizeInBytes int, cacheID string)
 	SearchFirstDataCalled                  func(key []byte) (value interface{}, ok bool)
@@ -35,6 +36,13 @@ func (shardedData *ShardedDataStub) RegisterOnAdded(handler func(key []byte, val
 	}
 }
 
+// RegisterOnRemoved -
+func (shardedData *ShardedDataStub) RegisterOnRemoved(handler func(key []byte, value interface{})) {
+	if shardedData.RegisterOnRemovedCalled != nil {
+		shardedData.RegisterOnRemovedCalled(handler)
+	}
+}
+
 // ShardDataStore -
 func (shardedData *ShardedDataStub) ShardDataStore(cacheID string) storage.Cacher {
 	return shardedData.ShardDataStoreCalled(cacheID)
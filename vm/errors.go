@@ -107,6 +107,9 @@ var ErrInvalidBaseIssuingCost = errors.New("invalid base issuing cost")
 // ErrNilHasher signals that an operation has been attempted to or with a nil hasher implementation
 var ErrNilHasher = errors.New("nil Hasher")
 
+// ErrNilEvidenceVerifier signals that a nil evidence verifier has been provided
+var ErrNilEvidenceVerifier = errors.New("nil evidence verifier")
+
 // ErrNilMarshalizer signals that an operation has been attempted to or with a nil Marshalizer implementation
 var ErrNilMarshalizer = errors.New("nil Marshalizer")
 
@@ -116,6 +119,36 @@ var ErrNegativeInitialSupply = errors.New("negative initial supply was provided"
 // ErrTokenAlreadyRegistered signals that token was already registered
 var ErrTokenAlreadyRegistered = errors.New("token was already registered")
 
+// ErrNoTokenWithGivenName signals that there is no token registered under the given name
+var ErrNoTokenWithGivenName = errors.New("no token with given name")
+
+// ErrNotTokenOwner signals that the caller is not the owner of the token
+var ErrNotTokenOwner = errors.New("caller is not the token owner")
+
+// ErrTokenNotMintable signals that the token was not issued as mintable
+var ErrTokenNotMintable = errors.New("token is not mintable")
+
+// ErrTokenNotBurnable signals that the token was not issued as burnable
+var ErrTokenNotBurnable = errors.New("token is not burnable")
+
+// ErrCannotFreeze signals that the token was not issued with the freeze property
+var ErrCannotFreeze = errors.New("token cannot be frozen")
+
+// ErrCannotWipe signals that the token was not issued with the wipe property
+var ErrCannotWipe = errors.New("token cannot be wiped")
+
+// ErrCannotPause signals that the token was not issued with the pause property
+var ErrCannotPause = errors.New("token cannot be paused")
+
+// ErrTokenIsPaused signals that the operation is not allowed while the token is paused
+var ErrTokenIsPaused = errors.New("token is paused")
+
+// ErrSupplyOverflow signals that the token supply would exceed the maximum allowed value
+var ErrSupplyOverflow = errors.New("token supply overflow")
+
+// ErrBurnValueExceedsSupply signals that the burnt value would exceed the minted supply
+var ErrBurnValueExceedsSupply = errors.New("burn value exceeds the token supply")
+
 // ErrNilSystemSCConfig signals that nil system sc config was provided
 var ErrNilSystemSCConfig = errors.New("nil system sc config")
 
@@ -136,3 +169,6 @@ var ErrVotedForAProposalThatNotBeginsYet = errors.New("voted for a proposal that
 
 // ErrNilPublicKey signals that nil public key has been provided
 var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrInvalidNumOfShards signals that an invalid number of shards has been provided
+var ErrInvalidNumOfShards = errors.New("invalid number of shards")
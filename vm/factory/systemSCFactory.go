@@ -16,10 +16,12 @@ type systemSCFactory struct {
 	validatorSettings   vm.ValidatorSettingsHandler
 	nodesConfigProvider vm.NodesConfigProvider
 	sigVerifier         vm.MessageSignVerifier
+	evidenceVerifier    vm.EvidenceVerifier
 	gasCost             vm.GasCost
 	marshalizer         marshal.Marshalizer
 	hasher              hashing.Hasher
 	systemSCConfig      *config.SystemSmartContractsConfig
+	numOfShards         uint32
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
@@ -28,10 +30,12 @@ type ArgsNewSystemSCFactory struct {
 	ValidatorSettings   vm.ValidatorSettingsHandler
 	NodesConfigProvider vm.NodesConfigProvider
 	SigVerifier         vm.MessageSignVerifier
+	EvidenceVerifier    vm.EvidenceVerifier
 	GasMap              map[string]map[string]uint64
 	Marshalizer         marshal.Marshalizer
 	Hasher              hashing.Hasher
 	SystemSCConfig      *config.SystemSmartContractsConfig
+	NumOfShards         uint32
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -45,6 +49,9 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	if check.IfNil(args.SigVerifier) {
 		return nil, vm.ErrNilMessageSignVerifier
 	}
+	if check.IfNil(args.EvidenceVerifier) {
+		return nil, vm.ErrNilEvidenceVerifier
+	}
 	if check.IfNil(args.NodesConfigProvider) {
 		return nil, vm.ErrNilNodesConfigProvider
 	}
@@ -62,10 +69,12 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 		systemEI:            args.SystemEI,
 		validatorSettings:   args.ValidatorSettings,
 		sigVerifier:         args.SigVerifier,
+		evidenceVerifier:    args.EvidenceVerifier,
 		nodesConfigProvider: args.NodesConfigProvider,
 		marshalizer:         args.Marshalizer,
 		hasher:              args.Hasher,
 		systemSCConfig:      args.SystemSCConfig,
+		numOfShards:         args.NumOfShards,
 	}
 
 	err := scf.createGasConfig(args.GasMap)
@@ -127,6 +136,7 @@ func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, err
 		MaximumPercentageToBleed: scf.validatorSettings.MaximumPercentageToBleed(),
 		GasCost:                  scf.gasCost,
 		Marshalizer:              scf.marshalizer,
+		EvidenceVerifier:         scf.evidenceVerifier,
 	}
 	staking, err := systemSmartContracts.NewStakingSmartContract(argsStaking)
 	return staking, err
@@ -155,6 +165,7 @@ func (scf *systemSCFactory) createESDTContract() (vm.SystemSmartContract, error)
 		Marshalizer:   scf.marshalizer,
 		Hasher:        scf.hasher,
 		ESDTSCConfig:  scf.systemSCConfig.ESDTSystemSCConfig,
+		NumOfShards:   scf.numOfShards,
 	}
 	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
 	return esdt, err
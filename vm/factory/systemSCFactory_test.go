@@ -19,10 +19,12 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 		SystemEI:            &mock.SystemEIStub{},
 		ValidatorSettings:   &mock.ValidatorSettingsStub{},
 		SigVerifier:         &mock.MessageSignVerifierMock{},
+		EvidenceVerifier:    &mock.EvidenceVerifierStub{},
 		GasMap:              gasSchedule,
 		NodesConfigProvider: &mock.NodesConfigProviderStub{},
 		Marshalizer:         &mock.MarshalizerMock{},
 		Hasher:              &mock.HasherMock{},
+		NumOfShards:         2,
 		SystemSCConfig: &config.SystemSmartContractsConfig{
 			ESDTSystemSCConfig: config.ESDTSystemSCConfig{
 				BaseIssuingCost: "100000000",
@@ -50,6 +52,17 @@ func TestNewSystemSCFactory_NilSystemEI(t *testing.T) {
 	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
 }
 
+func TestNewSystemSCFactory_NilEvidenceVerifier(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockNewSystemScFactoryArgs()
+	arguments.EvidenceVerifier = nil
+	scFactory, err := NewSystemSCFactory(arguments)
+
+	assert.Nil(t, scFactory)
+	assert.Equal(t, vm.ErrNilEvidenceVerifier, err)
+}
+
 func TestNewSystemSCFactory_NilEconomicsData(t *testing.T) {
 	t.Parallel()
 
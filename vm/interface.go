@@ -3,6 +3,7 @@ package vm
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/data/slash"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -91,3 +92,9 @@ type NodesConfigProvider interface {
 	MinNumberOfNodes() uint32
 	IsInterfaceNil() bool
 }
+
+// EvidenceVerifier checks if an evidence proves that a validator signed two conflicting headers
+type EvidenceVerifier interface {
+	Verify(evidence *slash.Evidence) error
+	IsInterfaceNil() bool
+}
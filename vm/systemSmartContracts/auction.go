@@ -134,9 +134,8 @@ func (s *stakingAuctionSC) Execute(args *vmcommon.ContractCallInput) vmcommon.Re
 		return s.setConfig(args)
 	case "changeRewardAddress":
 		return s.changeRewardAddress(args)
-	// TODO: activate this after validation
-	//case "changeValidatorKeys":
-	//	return s.changeValidatorKeys(args)
+	case "changeValidatorKeys":
+		return s.changeValidatorKeys(args)
 	case "unJail":
 		return s.unJail(args)
 	}
@@ -268,8 +267,8 @@ func (s *stakingAuctionSC) changeValidatorKeys(args *vmcommon.ContractCallInput)
 
 	numNodesToChange := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
 	expectedNumArguments := numNodesToChange*3 + 1
-	if uint64(len(args.Arguments)) < expectedNumArguments {
-		retMessage := fmt.Sprintf("invalid number of arguments: expected min %d, got %d", expectedNumArguments, len(args.Arguments))
+	if numNodesToChange == 0 || uint64(len(args.Arguments)) != expectedNumArguments {
+		retMessage := fmt.Sprintf("invalid number of arguments: expected %d, got %d", expectedNumArguments, len(args.Arguments))
 		s.eei.AddReturnMessage(retMessage)
 		return vmcommon.UserError
 	}
@@ -290,17 +289,33 @@ func (s *stakingAuctionSC) changeValidatorKeys(args *vmcommon.ContractCallInput)
 		return vmcommon.UserError
 	}
 
+	if uint64(len(registrationData.BlsPubKeys)) < numNodesToChange {
+		s.eei.AddReturnMessage("cannot change more bls keys than registered")
+		return vmcommon.UserError
+	}
+
 	for i := 1; i < len(args.Arguments); i += 3 {
 		oldBlsKey := args.Arguments[i]
 		newBlsKey := args.Arguments[i+1]
 		signedWithNewKey := args.Arguments[i+2]
 
-		err := s.sigVerifier.Verify(args.CallerAddr, signedWithNewKey, newBlsKey)
+		if len(oldBlsKey) != len(newBlsKey) {
+			s.eei.AddReturnMessage("invalid bls key length")
+			return vmcommon.UserError
+		}
+
+		// the signature proves the caller holds the private key of the new bls key
+		err = s.sigVerifier.Verify(args.CallerAddr, signedWithNewKey, newBlsKey)
 		if err != nil {
 			s.eei.AddReturnMessage("invalid signature: error " + err.Error())
 			return vmcommon.UserError
 		}
 
+		if s.isBLSKeyRegistered(newBlsKey) {
+			s.eei.AddReturnMessage("new bls key is already registered: " + hex.EncodeToString(newBlsKey))
+			return vmcommon.UserError
+		}
+
 		err = s.replaceBLSKey(registrationData, oldBlsKey, newBlsKey)
 		if err != nil {
 			s.eei.AddReturnMessage("cannot replace bls key: error " + err.Error())
@@ -463,6 +478,15 @@ func (s *stakingAuctionSC) getBLSRegisteredData(blsKey []byte) (*vmcommon.VMOutp
 	return s.executeOnStakingSC([]byte("get@" + hex.EncodeToString(blsKey)))
 }
 
+func (s *stakingAuctionSC) isBLSKeyRegistered(blsKey []byte) bool {
+	vmOutput, err := s.getBLSRegisteredData(blsKey)
+	if err != nil {
+		return true
+	}
+
+	return len(vmOutput.ReturnData) > 0 && len(vmOutput.ReturnData[0]) > 0
+}
+
 func (s *stakingAuctionSC) getNewValidKeys(registeredKeys [][]byte, keysFromArgument [][]byte) ([][]byte, error) {
 	registeredKeysMap := make(map[string]struct{})
 
@@ -480,9 +504,7 @@ func (s *stakingAuctionSC) getNewValidKeys(registeredKeys [][]byte, keysFromArgu
 	}
 
 	for _, newKey := range newKeys {
-		vmOutput, err := s.getBLSRegisteredData(newKey)
-		if err != nil ||
-			(len(vmOutput.ReturnData) > 0 && len(vmOutput.ReturnData[0]) > 0) {
+		if s.isBLSKeyRegistered(newKey) {
 			return nil, vm.ErrKeyAlreadyRegistered
 		}
 	}
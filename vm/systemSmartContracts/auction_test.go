@@ -1823,7 +1823,6 @@ func TestAuctionStakingSC_ChangeRewardAddress(t *testing.T) {
 }
 
 func TestAuctionStakingSC_ChangeValidatorKeys(t *testing.T) {
-	t.Skip("function is disabled for now as it is not fully tested")
 	t.Parallel()
 
 	receiverAddr := []byte("receiverAddress")
@@ -1834,35 +1833,61 @@ func TestAuctionStakingSC_ChangeValidatorKeys(t *testing.T) {
 	nodesToRunBytes := big.NewInt(1).Bytes()
 	blockChainHook := &mock.BlockChainHookStub{}
 	args := createMockArgumentsForAuction()
-	args.Eei = createVmContextWithStakingSc(minStakeValue, unboundPeriod, blockChainHook)
+	eei := createVmContextWithStakingSc(minStakeValue, unboundPeriod, blockChainHook)
+	args.Eei = eei
 
 	sc, _ := NewStakingAuctionSmartContract(args)
 
 	// changeValidatorKeys should err not enough arguments
-	newKey := []byte("newKey")
+	newKey := []byte("newKey1")
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, nil, vmcommon.UserError)
 	// changeValidatorKeys should error because address is not belongs to any validator
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
 	//do stake
 	stake(t, sc, args.ValidatorSettings.GenesisNodePrice(), receiverAddr, stakerAddress, stakerPubKey, nodesToRunBytes)
 	// changeValidatorKeys should error not enough arguments
-	nodesToRunBytes = big.NewInt(2).Bytes()
-	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
+	changeValidatorKeys(t, sc, big.NewInt(2).Bytes(), stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
+	// changeValidatorKeys should error zero nodes to change
+	changeValidatorKeys(t, sc, big.NewInt(0).Bytes(), stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
+	// changeValidatorKeys should error invalid key length
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, []byte("newKey"), []byte("signed"), vmcommon.UserError)
 	// changeValidatorKeys should error verify sig will return error
-	nodesToRunBytes = big.NewInt(1).Bytes()
-	args.SigVerifier = &mock.MessageSignVerifierMock{
+	sc.sigVerifier = &mock.MessageSignVerifierMock{
 		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
 			return errors.New("new")
 		},
 	}
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
+	sc.sigVerifier = &mock.MessageSignVerifierMock{}
 	// changeValidatorKeys should error wrong old key
-	args.SigVerifier = &mock.MessageSignVerifierMock{}
-	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, []byte("wrong"), newKey, []byte("signed"), vmcommon.UserError)
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, []byte("wrongKy"), newKey, []byte("signed"), vmcommon.UserError)
+	// changeValidatorKeys should error new key already registered
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, stakerPubKey, []byte("signed"), vmcommon.UserError)
+
+	eei.SetSCAddress(args.StakingSCAddress)
+	oldStakedData := eei.GetStorage(stakerPubKey)
+	eei.SetSCAddress([]byte("addr"))
 
 	// changeValidatorKeys should work
-	newKey = []byte("newKey1")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 5
+	}
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.Ok)
+
+	registrationData, _ := sc.getOrCreateRegistrationData(stakerAddress)
+	assert.Equal(t, [][]byte{newKey}, registrationData.BlsPubKeys)
+
+	eei.SetSCAddress(args.StakingSCAddress)
+	assert.Equal(t, 0, len(eei.GetStorage(stakerPubKey)))
+
+	expectedStakedData := &StakedData{}
+	_ = json.Unmarshal(oldStakedData, expectedStakedData)
+	expectedStakedData.PreviousBlsKey = stakerPubKey
+	expectedStakedData.ChangedKeyNonce = 5
+
+	stakedData := &StakedData{}
+	_ = json.Unmarshal(eei.GetStorage(newKey), stakedData)
+	assert.Equal(t, expectedStakedData, stakedData)
 }
 
 func createVmContextWithStakingSc(stakeValue *big.Int, unboundPeriod uint64, blockChainHook vmcommon.BlockchainHook) *vmContext {
@@ -25,6 +25,10 @@ const canFreeze = "canFreeze"
 const canWipe = "canWipe"
 
 const conversionBase = 10
+const maxSupplyBitLength = 256
+
+// esdtReturnedTransferMarker is the last argument of an ESDT transfer sent back by the destination shard
+const esdtReturnedTransferMarker = "returned"
 
 type esdt struct {
 	eei             vm.SystemEI
@@ -34,6 +38,7 @@ type esdt struct {
 	eSDTSCAddress   []byte
 	marshalizer     marshal.Marshalizer
 	hasher          hashing.Hasher
+	numOfShards     uint32
 }
 
 // ArgsNewESDTSmartContract defines the arguments needed for the esdt contract
@@ -44,6 +49,7 @@ type ArgsNewESDTSmartContract struct {
 	ESDTSCAddress []byte
 	Marshalizer   marshal.Marshalizer
 	Hasher        hashing.Hasher
+	NumOfShards   uint32
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -57,6 +63,9 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 	if check.IfNil(args.Hasher) {
 		return nil, vm.ErrNilHasher
 	}
+	if args.NumOfShards == 0 {
+		return nil, vm.ErrInvalidNumOfShards
+	}
 
 	baseIssuingCost, ok := big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, conversionBase)
 	if !ok || baseIssuingCost.Cmp(big.NewInt(0)) < 0 {
@@ -71,6 +80,7 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		eSDTSCAddress:   args.ESDTSCAddress,
 		hasher:          args.Hasher,
 		marshalizer:     args.Marshalizer,
+		numOfShards:     args.NumOfShards,
 	}, nil
 }
 
@@ -87,18 +97,24 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.issue(args)
 	case "issueProtected":
 		return e.issueProtected(args)
-	case "burn":
+	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
 		return e.mint(args)
 	case "freeze":
-		return e.freeze(args)
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTFreeze)
+	case "unFreeze":
+		return e.toggleFreeze(args, core.BuiltInFunctionESDTUnFreeze)
 	case "wipe":
 		return e.wipe(args)
+	case core.BuiltInFunctionESDTWipe:
+		return e.addWipedValue(args)
+	case core.BuiltInFunctionESDTTransfer:
+		return e.returnedTransfer(args)
 	case "pause":
-		return e.pause(args)
+		return e.togglePause(args, true)
 	case "unPause":
-		return e.unpause(args)
+		return e.togglePause(args, false)
 	case "claim":
 		return e.claim(args)
 	case "configChange":
@@ -179,6 +195,9 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 	if initialSupply.Cmp(big.NewInt(0)) < 0 {
 		return vm.ErrNegativeInitialSupply
 	}
+	if initialSupply.BitLen() > maxSupplyBitLength {
+		return vm.ErrSupplyOverflow
+	}
 
 	data := e.eei.GetStorage(tokenName)
 	if len(data) > 0 {
@@ -213,52 +232,353 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 		}
 	}
 
-	marshalledData, err := e.marshalizer.Marshal(newESDTToken)
+	err := e.saveToken(tokenName, newESDTToken)
 	if err != nil {
 		return err
 	}
 
-	e.eei.SetStorage(tokenName, marshalledData)
+	return e.transferESDT(owner, tokenName, initialSupply)
+}
+
+// burn is reached through the ESDTBurn built-in function: the burnt value was already subtracted from the caller's
+// balance in its own shard, so any failure here has to send the tokens back instead of returning an error code
+func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("function accepts only token name and value as arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(big.NewInt(0)) != 0 {
+		e.eei.AddReturnMessage("function is not payable")
+		return vmcommon.UserError
+	}
 
-	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(initialSupply.Bytes())
-	err = e.eei.Transfer(owner, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+	if !isCallFromBuiltInBurn(args) {
+		e.eei.AddReturnMessage("burn can be called only through the ESDTBurn built-in function")
+		return vmcommon.UserError
+	}
+
+	burntValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if burntValue.Cmp(big.NewInt(0)) <= 0 {
+		e.eei.AddReturnMessage("negative or zero value to burn")
+		return vmcommon.UserError
+	}
+
+	err := e.doBurn(args.CallerAddr, args.Arguments[0], burntValue)
+	if err == nil {
+		return vmcommon.Ok
+	}
+
+	e.eei.AddReturnMessage(err.Error())
+	err = e.transferESDT(args.CallerAddr, args.Arguments[0], burntValue)
 	if err != nil {
-		return err
+		return vmcommon.UserError
 	}
 
-	return nil
+	return vmcommon.Ok
 }
 
-func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
-	return vmcommon.Ok
+// isCallFromBuiltInBurn returns true if the value was already subtracted by the ESDTBurn built-in function in the
+// caller's shard: only a user transaction, which reaches the metachain as a direct call, goes through it
+func isCallFromBuiltInBurn(args *vmcommon.ContractCallInput) bool {
+	return args.CallType == vmcommon.DirectCall && !core.IsSmartContractAddress(args.CallerAddr)
+}
+
+func (e *esdt) doBurn(caller []byte, tokenName []byte, burntValue *big.Int) error {
+	token, err := e.getExistingToken(tokenName)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(token.IssuerAddress, caller) {
+		return vm.ErrNotTokenOwner
+	}
+	if !token.Burnable {
+		return vm.ErrTokenNotBurnable
+	}
+
+	newBurntValue := big.NewInt(0).Add(token.BurntValue, burntValue)
+	if newBurntValue.Cmp(token.MintedValue) > 0 {
+		return vm.ErrBurnValueExceedsSupply
+	}
+
+	token.BurntValue = newBurntValue
+
+	return e.saveToken(tokenName, token)
 }
 
 func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	if len(args.Arguments) < 2 || len(args.Arguments) > 3 {
+		e.eei.AddReturnMessage("function accepts token name, value and an optional destination as arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(big.NewInt(0)) != 0 {
+		e.eei.AddReturnMessage("function is not payable")
+		return vmcommon.UserError
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		return vmcommon.OutOfGas
+	}
+
+	tokenName := args.Arguments[0]
+	token, err := e.getExistingToken(tokenName)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(token.IssuerAddress, args.CallerAddr) {
+		e.eei.AddReturnMessage(vm.ErrNotTokenOwner.Error())
+		return vmcommon.UserError
+	}
+	if !token.Mintable {
+		e.eei.AddReturnMessage(vm.ErrTokenNotMintable.Error())
+		return vmcommon.UserError
+	}
+	if token.Paused {
+		e.eei.AddReturnMessage(vm.ErrTokenIsPaused.Error())
+		return vmcommon.UserError
+	}
+
+	mintValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if mintValue.Cmp(big.NewInt(0)) <= 0 {
+		e.eei.AddReturnMessage("negative or zero value to mint")
+		return vmcommon.UserError
+	}
+
+	newMintedValue := big.NewInt(0).Add(token.MintedValue, mintValue)
+	if newMintedValue.BitLen() > maxSupplyBitLength {
+		e.eei.AddReturnMessage(vm.ErrSupplyOverflow.Error())
+		return vmcommon.UserError
+	}
+
+	destination := token.IssuerAddress
+	if len(args.Arguments) == 3 {
+		if len(args.Arguments[2]) != len(args.CallerAddr) {
+			e.eei.AddReturnMessage("invalid destination address length")
+			return vmcommon.FunctionWrongSignature
+		}
+		destination = args.Arguments[2]
+	}
+
+	token.MintedValue = newMintedValue
+	err = e.saveToken(tokenName, token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = e.transferESDT(destination, tokenName, mintValue)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) freeze(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+// returnedTransfer removes from the minted supply the value of a minting transfer which was rejected in the
+// destination shard, as the sent back tokens are not credited to any account
+func (e *esdt) returnedTransfer(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 3 || !bytes.Equal(args.Arguments[2], []byte(esdtReturnedTransferMarker)) {
+		e.eei.AddReturnMessage("only returned transfers are accepted")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(big.NewInt(0)) != 0 {
+		e.eei.AddReturnMessage("function is not payable")
+		return vmcommon.UserError
+	}
+
+	returnedValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if returnedValue.Cmp(big.NewInt(0)) <= 0 {
+		e.eei.AddReturnMessage("negative or zero returned value")
+		return vmcommon.UserError
+	}
+
+	tokenName := args.Arguments[0]
+	token, err := e.getExistingToken(tokenName)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	newMintedValue := big.NewInt(0).Sub(token.MintedValue, returnedValue)
+	if newMintedValue.Cmp(token.BurntValue) < 0 {
+		e.eei.AddReturnMessage(vm.ErrBurnValueExceedsSupply.Error())
+		return vmcommon.UserError
+	}
+
+	token.MintedValue = newMintedValue
+	err = e.saveToken(tokenName, token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
+func (e *esdt) toggleFreeze(args *vmcommon.ContractCallInput, builtInFunc string) vmcommon.ReturnCode {
+	token, returnCode := e.basicOwnershipChecks(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanFreeze {
+		e.eei.AddReturnMessage(vm.ErrCannotFreeze.Error())
+		return vmcommon.UserError
+	}
+
+	return e.sendToAccount(args, builtInFunc, 0)
+}
+
 func (e *esdt) wipe(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+	token, returnCode := e.basicOwnershipChecks(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanWipe {
+		e.eei.AddReturnMessage(vm.ErrCannotWipe.Error())
+		return vmcommon.UserError
+	}
+
+	// the wiped balance is only known in the account's shard, which reports it back with the gas left from this call
+	gasToForward := args.GasProvided - e.gasCost.MetaChainSystemSCsCost.ESDTOperations
+	err := e.eei.UseGas(gasToForward)
+	if err != nil {
+		return vmcommon.OutOfGas
+	}
+
+	return e.sendToAccount(args, core.BuiltInFunctionESDTWipe, gasToForward)
+}
+
+// addWipedValue is reached through the ESDTWipe built-in function, which reports back the balance it wiped in the
+// account's shard: the wiped value is added to the burnt value, so that it is no longer part of the token's supply
+func (e *esdt) addWipedValue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("function accepts only token name and value as arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(big.NewInt(0)) != 0 {
+		e.eei.AddReturnMessage("function is not payable")
+		return vmcommon.UserError
+	}
+	// the forwarded gas only pays for this report, it is not refunded to the wiped account
+	err := e.eei.UseGas(args.GasProvided)
+	if err != nil {
+		return vmcommon.OutOfGas
+	}
+
+	wipedValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if wipedValue.Cmp(big.NewInt(0)) <= 0 {
+		e.eei.AddReturnMessage("negative or zero wiped value")
+		return vmcommon.UserError
+	}
+
+	tokenName := args.Arguments[0]
+	token, err := e.getExistingToken(tokenName)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	newBurntValue := big.NewInt(0).Add(token.BurntValue, wipedValue)
+	if newBurntValue.Cmp(token.MintedValue) > 0 {
+		e.eei.AddReturnMessage(vm.ErrBurnValueExceedsSupply.Error())
+		return vmcommon.UserError
+	}
+
+	token.BurntValue = newBurntValue
+	err = e.saveToken(tokenName, token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) pause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+// sendToAccount calls the given built-in function on the account provided as second argument, in its own shard
+func (e *esdt) sendToAccount(args *vmcommon.ContractCallInput, builtInFunc string, gasLimit uint64) vmcommon.ReturnCode {
+	address := args.Arguments[1]
+	if len(address) != len(args.CallerAddr) {
+		e.eei.AddReturnMessage("invalid address length")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	txData := builtInFunc + "@" + hex.EncodeToString(args.Arguments[0])
+	err := e.eei.Transfer(address, e.eSDTSCAddress, big.NewInt(0), []byte(txData), gasLimit)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) unpause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+func (e *esdt) togglePause(args *vmcommon.ContractCallInput, pause bool) vmcommon.ReturnCode {
+	token, returnCode := e.basicOwnershipChecks(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanPause {
+		e.eei.AddReturnMessage(vm.ErrCannotPause.Error())
+		return vmcommon.UserError
+	}
+	if token.Paused == pause {
+		e.eei.AddReturnMessage("token is already in the requested pause state")
+		return vmcommon.UserError
+	}
+
+	token.Paused = pause
+	err := e.saveToken(args.Arguments[0], token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	builtInFunc := core.BuiltInFunctionESDTUnPause
+	if pause {
+		builtInFunc = core.BuiltInFunctionESDTPause
+	}
+
+	// every shard keeps its own copy of the paused flag, so that transfers can be rejected where they are processed
+	txData := builtInFunc + "@" + hex.EncodeToString(args.Arguments[0])
+	for shardID := uint32(0); shardID < e.numOfShards; shardID++ {
+		err = e.eei.Transfer(core.ESDTGlobalSettingsAddress(shardID), e.eSDTSCAddress, big.NewInt(0), []byte(txData), 0)
+		if err != nil {
+			e.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
 	return vmcommon.Ok
 }
 
+func (e *esdt) basicOwnershipChecks(args *vmcommon.ContractCallInput, numOfArguments int) (*ESDTData, vmcommon.ReturnCode) {
+	if len(args.Arguments) != numOfArguments {
+		e.eei.AddReturnMessage("invalid number of arguments")
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(big.NewInt(0)) != 0 {
+		e.eei.AddReturnMessage("function is not payable")
+		return nil, vmcommon.UserError
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		return nil, vmcommon.OutOfGas
+	}
+
+	token, err := e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if !bytes.Equal(token.IssuerAddress, args.CallerAddr) {
+		e.eei.AddReturnMessage(vm.ErrNotTokenOwner.Error())
+		return nil, vmcommon.UserError
+	}
+
+	return token, vmcommon.Ok
+}
+
 func (e *esdt) configChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	//TODO: implement me
 	return vmcommon.Ok
@@ -274,6 +594,37 @@ func (e *esdt) esdtControlChanges(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+func (e *esdt) getExistingToken(tokenName []byte) (*ESDTData, error) {
+	marshalledData := e.eei.GetStorage(tokenName)
+	if len(marshalledData) == 0 {
+		return nil, vm.ErrNoTokenWithGivenName
+	}
+
+	token := &ESDTData{}
+	err := e.marshalizer.Unmarshal(token, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (e *esdt) saveToken(tokenName []byte, token *ESDTData) error {
+	marshalledData, err := e.marshalizer.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	e.eei.SetStorage(tokenName, marshalledData)
+	return nil
+}
+
+// transferESDT credits the destination through the ESDTTransfer built-in function, executed in the destination shard
+func (e *esdt) transferESDT(destination []byte, tokenName []byte, value *big.Int) error {
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(value.Bytes())
+	return e.eei.Transfer(destination, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (e *esdt) IsInterfaceNil() bool {
 	return e == nil
@@ -7,10 +7,14 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
@@ -23,6 +27,7 @@ func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
 		ESDTSCAddress: []byte("address"),
 		Marshalizer:   &mock.MarshalizerMock{},
 		Hasher:        &mock.HasherMock{},
+		NumOfShards:   2,
 	}
 }
 
@@ -38,6 +43,17 @@ func TestNewESDTSmartContract(t *testing.T) {
 	assert.NotNil(t, e)
 }
 
+func TestNewESDTSmartContract_InvalidNumOfShardsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.NumOfShards = 0
+	e, err := NewESDTSmartContract(args)
+
+	assert.Equal(t, vm.ErrInvalidNumOfShards, err)
+	assert.Nil(t, e)
+}
+
 func TestEsdt_ExecuteIssue(t *testing.T) {
 	t.Parallel()
 
@@ -137,3 +153,454 @@ func TestEsdt_ExecuteIssueProtected(t *testing.T) {
 
 	assert.Equal(t, vmcommon.Ok, output)
 }
+
+func createESDTWithIssuedToken(t *testing.T, owner []byte, tokenName []byte, properties ...string) (*esdt, *vmContext) {
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{})
+	args := createMockArgumentsForESDT()
+	args.Eei = eei
+	eei.SetSCAddress(args.ESDTSCAddress)
+	e, _ := NewESDTSmartContract(args)
+
+	arguments := [][]byte{tokenName, big.NewInt(100).Bytes()}
+	for _, property := range properties {
+		arguments = append(arguments, []byte(property))
+	}
+
+	vmInput := createESDTCallInput(owner, "issue", arguments...)
+	vmInput.CallValue = big.NewInt(0).Set(e.baseIssuingCost)
+	eei.SetGasProvided(args.GasCost.MetaChainSystemSCsCost.ESDTIssue)
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+	eei.softCleanCache()
+
+	return e, eei
+}
+
+func createESDTCallInput(caller []byte, function string, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: caller,
+			Arguments:  arguments,
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: []byte("address"),
+		Function:      function,
+	}
+}
+
+func esdtTransferData(tokenName []byte, value *big.Int) []byte {
+	return []byte(core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(value.Bytes()))
+}
+
+func TestEsdt_ExecuteMintNotOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, []byte("owner"), tokenName, mintable)
+
+	vmInput := createESDTCallInput([]byte("other"), "mint", tokenName, big.NewInt(10).Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrNotTokenOwner.Error(), eei.returnMessage)
+}
+
+func TestEsdt_ExecuteMintNotMintableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, burnable)
+
+	vmInput := createESDTCallInput(owner, "mint", tokenName, big.NewInt(10).Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrTokenNotMintable.Error(), eei.returnMessage)
+}
+
+func TestEsdt_ExecuteMintUnknownTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	e, eei := createESDTWithIssuedToken(t, owner, []byte("01234567891"), mintable)
+
+	vmInput := createESDTCallInput(owner, "mint", []byte("unknownToken"), big.NewInt(10).Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrNoTokenWithGivenName.Error(), eei.returnMessage)
+}
+
+func TestEsdt_ExecuteMintSupplyOverflowShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, mintable)
+
+	maxSupply := big.NewInt(0).Lsh(big.NewInt(1), maxSupplyBitLength)
+	mintValue := big.NewInt(0).Sub(maxSupply, big.NewInt(100))
+	vmInput := createESDTCallInput(owner, "mint", tokenName, mintValue.Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrSupplyOverflow.Error(), eei.returnMessage)
+
+	mintValue.Sub(mintValue, big.NewInt(1))
+	vmInput = createESDTCallInput(owner, "mint", tokenName, mintValue.Bytes())
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_ExecuteMintShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	destination := []byte("desti")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, mintable)
+
+	mintValue := big.NewInt(50)
+	vmInput := createESDTCallInput(owner, "mint", tokenName, mintValue.Bytes(), destination)
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	token, err := e.getExistingToken(tokenName)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(150), token.MintedValue)
+
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, esdtTransferData(tokenName, mintValue), vmOutput.OutputAccounts[string(destination)].Data)
+}
+
+func TestEsdt_ExecuteMintPausedTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, mintable, canPause)
+
+	vmInput := createESDTCallInput(owner, "pause", tokenName)
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+	eei.softCleanCache()
+
+	vmInput = createESDTCallInput(owner, "mint", tokenName, big.NewInt(10).Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrTokenIsPaused.Error(), eei.returnMessage)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(100), token.MintedValue)
+}
+
+func TestEsdt_ExecuteReturnedMintTransferShouldDecreaseTheSupply(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	destination := []byte("desti")
+	tokenName := []byte("01234567891")
+	e, _ := createESDTWithIssuedToken(t, owner, tokenName, mintable)
+
+	mintValue := big.NewInt(50)
+	vmInput := createESDTCallInput(owner, "mint", tokenName, mintValue.Bytes(), destination)
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+	vmInput = createESDTCallInput(destination, core.BuiltInFunctionESDTTransfer, tokenName, mintValue.Bytes(), []byte(esdtReturnedTransferMarker))
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(100), token.MintedValue)
+}
+
+func TestEsdt_ExecuteTransferNotReturnedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, _ := createESDTWithIssuedToken(t, owner, tokenName, mintable)
+
+	vmInput := createESDTCallInput(owner, core.BuiltInFunctionESDTTransfer, tokenName, big.NewInt(10).Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(100), token.MintedValue)
+}
+
+func TestEsdt_ExecuteReturnedTransferBelowBurntValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, burnable)
+
+	vmInput := createESDTCallInput(owner, core.BuiltInFunctionESDTBurn, tokenName, big.NewInt(40).Bytes())
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+	vmInput = createESDTCallInput(owner, core.BuiltInFunctionESDTTransfer, tokenName, big.NewInt(61).Bytes(), []byte(esdtReturnedTransferMarker))
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrBurnValueExceedsSupply.Error(), eei.returnMessage)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(100), token.MintedValue)
+}
+
+func TestEsdt_ExecuteBurnNotOwnerShouldSendBackTheTokens(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("other")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, []byte("owner"), tokenName, burnable)
+
+	burnValue := big.NewInt(10)
+	vmInput := createESDTCallInput(caller, core.BuiltInFunctionESDTBurn, tokenName, burnValue.Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, vm.ErrNotTokenOwner.Error(), eei.returnMessage)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(0), token.BurntValue)
+
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, esdtTransferData(tokenName, burnValue), vmOutput.OutputAccounts[string(caller)].Data)
+}
+
+func TestEsdt_ExecuteBurnNotBurnableShouldSendBackTheTokens(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, mintable)
+
+	burnValue := big.NewInt(10)
+	vmInput := createESDTCallInput(owner, core.BuiltInFunctionESDTBurn, tokenName, burnValue.Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, vm.ErrTokenNotBurnable.Error(), eei.returnMessage)
+
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, esdtTransferData(tokenName, burnValue), vmOutput.OutputAccounts[string(owner)].Data)
+}
+
+func TestEsdt_ExecuteBurnMoreThanSupplyShouldSendBackTheTokens(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, burnable)
+
+	burnValue := big.NewInt(101)
+	vmInput := createESDTCallInput(owner, core.BuiltInFunctionESDTBurn, tokenName, burnValue.Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, vm.ErrBurnValueExceedsSupply.Error(), eei.returnMessage)
+
+	vmOutput := eei.CreateVMOutput()
+	assert.Equal(t, esdtTransferData(tokenName, burnValue), vmOutput.OutputAccounts[string(owner)].Data)
+}
+
+func TestEsdt_ExecuteBurnShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, burnable)
+
+	vmInput := createESDTCallInput(owner, core.BuiltInFunctionESDTBurn, tokenName, big.NewInt(40).Bytes())
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(40), token.BurntValue)
+	assert.Equal(t, big.NewInt(100), token.MintedValue)
+
+	vmOutput := eei.CreateVMOutput()
+	_, sentBack := vmOutput.OutputAccounts[string(owner)]
+	assert.False(t, sentBack)
+}
+
+func TestEsdt_ExecuteBurnNotThroughBuiltInFunctionShouldNotSendBackTheTokens(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	scAddress := make([]byte, 32)
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, mintable)
+
+	asyncCallInput := createESDTCallInput(owner, core.BuiltInFunctionESDTBurn, tokenName, big.NewInt(10).Bytes())
+	asyncCallInput.CallType = vmcommon.AsynchronousCall
+	scCallInput := createESDTCallInput(scAddress, core.BuiltInFunctionESDTBurn, tokenName, big.NewInt(10).Bytes())
+
+	for _, vmInput := range []*vmcommon.ContractCallInput{asyncCallInput, scCallInput} {
+		output := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+
+		vmOutput := eei.CreateVMOutput()
+		_, sentBack := vmOutput.OutputAccounts[string(vmInput.CallerAddr)]
+		assert.False(t, sentBack)
+	}
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(0), token.BurntValue)
+}
+
+func TestEsdt_ExecuteFreezeNotOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, []byte("owner"), tokenName, canFreeze)
+
+	vmInput := createESDTCallInput([]byte("other"), "freeze", tokenName, []byte("holde"))
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrNotTokenOwner.Error(), eei.returnMessage)
+}
+
+func TestEsdt_ExecuteFreezeCannotFreezeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, canWipe)
+
+	vmInput := createESDTCallInput(owner, "freeze", tokenName, []byte("holde"))
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrCannotFreeze.Error(), eei.returnMessage)
+}
+
+func TestEsdt_ExecuteFreezeAndUnFreezeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	holder := []byte("holde")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, canFreeze)
+
+	vmInput := createESDTCallInput(owner, "freeze", tokenName, holder)
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	vmOutput := eei.CreateVMOutput()
+	expectedData := core.BuiltInFunctionESDTFreeze + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(holder)].Data)
+
+	eei.softCleanCache()
+	vmInput = createESDTCallInput(owner, "unFreeze", tokenName, holder)
+	output = e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	vmOutput = eei.CreateVMOutput()
+	expectedData = core.BuiltInFunctionESDTUnFreeze + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(holder)].Data)
+}
+
+func TestEsdt_ExecuteWipe(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	holder := []byte("holde")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, canFreeze)
+
+	vmInput := createESDTCallInput(owner, "wipe", tokenName, holder)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrCannotWipe.Error(), eei.returnMessage)
+
+	e, eei = createESDTWithIssuedToken(t, owner, tokenName, canWipe)
+	vmInput = createESDTCallInput(owner, "wipe", tokenName, []byte("short"+"address"))
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput = createESDTCallInput(owner, "wipe", tokenName, holder)
+	vmInput.GasProvided = 500
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	vmOutput := eei.CreateVMOutput()
+	expectedData := core.BuiltInFunctionESDTWipe + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), vmOutput.OutputAccounts[string(holder)].Data)
+	assert.Equal(t, uint64(500), vmOutput.OutputAccounts[string(holder)].GasLimit)
+}
+
+func TestEsdt_ExecuteWipedValueShouldBeBurnt(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	holder := []byte("holde")
+	tokenName := []byte("01234567891")
+	e, _ := createESDTWithIssuedToken(t, owner, tokenName, canWipe)
+
+	vmInput := createESDTCallInput(holder, core.BuiltInFunctionESDTWipe, tokenName, big.NewInt(30).Bytes())
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(30), token.BurntValue)
+	assert.Equal(t, big.NewInt(100), token.MintedValue)
+}
+
+func TestEsdt_ExecuteWipedValueMoreThanSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	holder := []byte("holde")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, canWipe)
+
+	vmInput := createESDTCallInput(holder, core.BuiltInFunctionESDTWipe, tokenName, big.NewInt(101).Bytes())
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrBurnValueExceedsSupply.Error(), eei.returnMessage)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(0), token.BurntValue)
+}
+
+func TestEsdt_ExecutePauseShouldNotifyAllShards(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, canPause)
+
+	vmInput := createESDTCallInput(owner, "unPause", tokenName)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = createESDTCallInput(owner, "pause", tokenName)
+	output = e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.True(t, token.Paused)
+
+	vmOutput := eei.CreateVMOutput()
+	expectedData := core.BuiltInFunctionESDTPause + "@" + hex.EncodeToString(tokenName)
+	for shardID := uint32(0); shardID < e.numOfShards; shardID++ {
+		outAcc := vmOutput.OutputAccounts[string(core.ESDTGlobalSettingsAddress(shardID))]
+		require.NotNil(t, outAcc)
+		assert.Equal(t, []byte(expectedData), outAcc.Data)
+	}
+
+	eei.softCleanCache()
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = createESDTCallInput(owner, "unPause", tokenName)
+	output = e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	token, _ = e.getExistingToken(tokenName)
+	assert.False(t, token.Paused)
+}
+
+func TestEsdt_ExecutePauseCannotPauseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	e, eei := createESDTWithIssuedToken(t, owner, tokenName, canFreeze)
+
+	vmInput := createESDTCallInput(owner, "pause", tokenName)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrCannotPause.Error(), eei.returnMessage)
+}
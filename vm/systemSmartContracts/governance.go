@@ -4,7 +4,9 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -21,6 +23,7 @@ const hardForkPrefix = "hardFork"
 const proposalPrefix = "proposal"
 const whiteListPrefix = "whiteList"
 const validatorPrefix = "validator"
+const representativePrefix = "representative"
 const hardForkEpochGracePeriod = 2
 const githubCommitLength = 40
 
@@ -585,33 +588,24 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 	}
 
 	voterAddress := args.CallerAddr
-	validatorAddress := args.CallerAddr
 	if len(args.Arguments) == 3 {
-		validatorAddress = args.Arguments[2]
-	}
-	numStakedNodes, err := g.numOfStakedNodes(validatorAddress)
-	if err != nil || numStakedNodes == 0 {
-		g.eei.AddReturnMessage("address has 0 voting power")
-		return vmcommon.UserError
+		// the vote which names a validator is kept for backward compatibility: it is accepted only if the validator
+		// delegated vote power to the caller and, as any other vote, it counts with the combined vote power
+		delegatedVotePower, errDelegated := g.delegatedVotePower(args.Arguments[2], voterAddress)
+		if errDelegated != nil {
+			return g.votePowerErrorReturnCode(errDelegated)
+		}
+		if delegatedVotePower <= 0 {
+			g.eei.AddReturnMessage("address has 0 voting power")
+			return vmcommon.UserError
+		}
 	}
 
-	numNodesToVote := int32(0)
-	validatorData, err := g.getOrCreateValidatorData(validatorAddress, int32(numStakedNodes))
+	numNodesToVote, err := g.votePower(voterAddress)
 	if err != nil {
-		log.Warn("getOrCreateValidatorData", "err", err)
-		g.eei.AddReturnMessage("getOrCreateValidator data error" + err.Error())
-		return vmcommon.UserError
+		return g.votePowerErrorReturnCode(err)
 	}
-
-	found := false
-	for _, voter := range validatorData.Delegators {
-		if bytes.Equal(voter.Address, voterAddress) {
-			found = true
-			numNodesToVote = voter.NumNodes
-			break
-		}
-	}
-	if !found || numNodesToVote <= 0 {
+	if numNodesToVote <= 0 {
 		g.eei.AddReturnMessage("address has 0 voting power")
 		return vmcommon.UserError
 	}
@@ -625,6 +619,15 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+func (g *governanceContract) votePowerErrorReturnCode(err error) vmcommon.ReturnCode {
+	g.eei.AddReturnMessage("votePower error " + err.Error())
+	if errors.Is(err, vm.ErrNotEnoughGas) {
+		return vmcommon.OutOfGas
+	}
+
+	return vmcommon.UserError
+}
+
 func (g *governanceContract) isValidVoteString(vote string) bool {
 	switch vote {
 	case "yes":
@@ -678,7 +681,9 @@ func (g *governanceContract) voteForProposal(
 		return vm.ErrVotedForAnExpiredProposal
 	}
 
-	generalProposal.Voters = append(generalProposal.Voters, voter)
+	if len(oldValue) == 0 {
+		generalProposal.Voters = append(generalProposal.Voters, voter)
+	}
 	g.addVotedDataToProposal(generalProposal, oldValue, -oldNum)
 	g.addVotedDataToProposal(generalProposal, vote, numVotes)
 
@@ -754,23 +759,343 @@ func (g *governanceContract) getOrCreateValidatorData(address []byte, numNodes i
 
 	oldNumNodes := validatorData.NumNodes
 	validatorData.NumNodes = numNodes
-	if len(validatorData.Delegators) == 1 {
-		return validatorData, nil
+	if oldNumNodes != numNodes {
+		log.Trace("difference in old num nodes and new num nodes with delegated voting", "old", oldNumNodes, "new", numNodes)
 	}
+	g.setOwnVotePower(validatorData, address)
 
-	log.Trace("difference in old num nodes and new num nodes with delegated voting", oldNumNodes, numNodes)
+	return validatorData, nil
+}
+
+// setOwnVotePower recomputes the number of nodes the validator votes with by itself as the nodes which were
+// not delegated to any representative
+func (g *governanceContract) setOwnVotePower(validatorData *ValidatorData, address []byte) {
+	delegatedNodes := int32(0)
+	var ownVoterData *VoterData
+	for _, voter := range validatorData.Delegators {
+		if bytes.Equal(voter.Address, address) {
+			ownVoterData = voter
+			continue
+		}
+		delegatedNodes += voter.NumNodes
+	}
+
+	if ownVoterData == nil {
+		ownVoterData = &VoterData{Address: address}
+		validatorData.Delegators = append([]*VoterData{ownVoterData}, validatorData.Delegators...)
+	}
+
+	ownVoterData.NumNodes = validatorData.NumNodes - delegatedNodes
+	if ownVoterData.NumNodes < 0 {
+		ownVoterData.NumNodes = 0
+	}
+}
+
+func (g *governanceContract) saveValidatorData(address []byte, validatorData *ValidatorData) error {
+	marshaledData, err := g.marshalizer.Marshal(validatorData)
+	if err != nil {
+		return err
+	}
+
+	key := append([]byte(validatorPrefix), address...)
+	g.eei.SetStorage(key, marshaledData)
+
+	return nil
+}
+
+func (g *governanceContract) getValidatorData(address []byte) (*ValidatorData, error) {
+	key := append([]byte(validatorPrefix), address...)
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) == 0 {
+		return nil, vm.ErrEmptyStorage
+	}
+
+	validatorData := &ValidatorData{}
+	err := g.marshalizer.Unmarshal(validatorData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
 
 	return validatorData, nil
 }
 
-func (g *governanceContract) delegateVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("delegateVotePower not yet implemented")
-	return vmcommon.UserError
+func (g *governanceContract) getRepresentativeData(address []byte) (*RepresentativeData, error) {
+	representativeData := &RepresentativeData{
+		Validators: make([][]byte, 0),
+	}
+
+	key := append([]byte(representativePrefix), address...)
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) == 0 {
+		return representativeData, nil
+	}
+
+	err := g.marshalizer.Unmarshal(representativeData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return representativeData, nil
 }
 
-func (g *governanceContract) revokeVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("revokeVotePower not yet implemented")
-	return vmcommon.UserError
+func (g *governanceContract) saveRepresentativeData(address []byte, representativeData *RepresentativeData) error {
+	key := append([]byte(representativePrefix), address...)
+	if len(representativeData.Validators) == 0 {
+		g.eei.SetStorage(key, nil)
+		return nil
+	}
+
+	marshaledData, err := g.marshalizer.Marshal(representativeData)
+	if err != nil {
+		return err
+	}
+	g.eei.SetStorage(key, marshaledData)
+
+	return nil
+}
+
+func (g *governanceContract) checkVotePowerArguments(args *vmcommon.ContractCallInput, gasCost uint64) ([]byte, int32, vmcommon.ReturnCode) {
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("callValue expected to be 0")
+		return nil, 0, vmcommon.UserError
+	}
+	err := g.eei.UseGas(gasCost)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return nil, 0, vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 2")
+		return nil, 0, vmcommon.FunctionWrongSignature
+	}
+
+	representative := args.Arguments[0]
+	if len(representative) != len(args.CallerAddr) {
+		g.eei.AddReturnMessage("first argument should be a valid address")
+		return nil, 0, vmcommon.UserError
+	}
+	if bytes.Equal(representative, args.CallerAddr) {
+		g.eei.AddReturnMessage("first argument should be different than caller")
+		return nil, 0, vmcommon.UserError
+	}
+
+	numNodes, ok := big.NewInt(0).SetString(string(args.Arguments[1]), conversionBase)
+	if !ok || numNodes.Cmp(zero) <= 0 || !numNodes.IsInt64() || numNodes.Int64() > math.MaxInt32 {
+		g.eei.AddReturnMessage("second argument should be a positive number of nodes")
+		return nil, 0, vmcommon.UserError
+	}
+
+	return representative, int32(numNodes.Int64()), vmcommon.Ok
+}
+
+// delegateVotePower moves the voting power of a number of staked nodes of the caller to a representative
+func (g *governanceContract) delegateVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	representative, numNodes, returnCode := g.checkVotePowerArguments(args, g.gasCost.MetaChainSystemSCsCost.DelegateVote)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	numStakedNodes, err := g.numOfStakedNodes(args.CallerAddr)
+	if err != nil || numStakedNodes == 0 {
+		g.eei.AddReturnMessage("address has 0 voting power")
+		return vmcommon.UserError
+	}
+
+	validatorData, err := g.getOrCreateValidatorData(args.CallerAddr, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	found := false
+	for _, voter := range validatorData.Delegators {
+		if bytes.Equal(voter.Address, args.CallerAddr) && voter.NumNodes < numNodes {
+			g.eei.AddReturnMessage("not enough voting power to delegate")
+			return vmcommon.UserError
+		}
+		if bytes.Equal(voter.Address, representative) {
+			found = true
+			voter.NumNodes += numNodes
+		}
+	}
+	if !found {
+		validatorData.Delegators = append(validatorData.Delegators, &VoterData{
+			Address:  representative,
+			NumNodes: numNodes,
+		})
+	}
+	g.setOwnVotePower(validatorData, args.CallerAddr)
+
+	err = g.saveValidatorData(args.CallerAddr, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	if found {
+		return vmcommon.Ok
+	}
+
+	representativeData, err := g.getRepresentativeData(representative)
+	if err != nil {
+		g.eei.AddReturnMessage("getRepresentativeData error " + err.Error())
+		return vmcommon.UserError
+	}
+	representativeData.Validators = append(representativeData.Validators, args.CallerAddr)
+	err = g.saveRepresentativeData(representative, representativeData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveRepresentativeData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// revokeVotePower gives back to the caller the voting power of a number of nodes delegated to a representative.
+// As the results of a proposal are computed when it is closed, the revocation applies to all the open proposals
+func (g *governanceContract) revokeVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	representative, numNodes, returnCode := g.checkVotePowerArguments(args, g.gasCost.MetaChainSystemSCsCost.RevokeVote)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	validatorData, err := g.getValidatorData(args.CallerAddr)
+	if err != nil {
+		g.eei.AddReturnMessage("no vote power was delegated")
+		return vmcommon.UserError
+	}
+
+	index := -1
+	for i, voter := range validatorData.Delegators {
+		if bytes.Equal(voter.Address, representative) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		g.eei.AddReturnMessage("no vote power was delegated to the given representative")
+		return vmcommon.UserError
+	}
+
+	representativeVoter := validatorData.Delegators[index]
+	if representativeVoter.NumNodes < numNodes {
+		g.eei.AddReturnMessage("cannot revoke more than the delegated vote power")
+		return vmcommon.UserError
+	}
+
+	representativeVoter.NumNodes -= numNodes
+	removeRepresentative := representativeVoter.NumNodes == 0
+	if removeRepresentative {
+		validatorData.Delegators = append(validatorData.Delegators[:index], validatorData.Delegators[index+1:]...)
+	}
+	g.setOwnVotePower(validatorData, args.CallerAddr)
+
+	err = g.saveValidatorData(args.CallerAddr, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	if !removeRepresentative {
+		return vmcommon.Ok
+	}
+
+	representativeData, err := g.getRepresentativeData(representative)
+	if err != nil {
+		g.eei.AddReturnMessage("getRepresentativeData error " + err.Error())
+		return vmcommon.UserError
+	}
+	for i, validator := range representativeData.Validators {
+		if bytes.Equal(validator, args.CallerAddr) {
+			representativeData.Validators = append(representativeData.Validators[:i], representativeData.Validators[i+1:]...)
+			break
+		}
+	}
+	err = g.saveRepresentativeData(representative, representativeData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveRepresentativeData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// votePower returns the combined voting power of an address: the staked nodes which were not delegated and all the
+// nodes which were delegated to it by other validators. Every validator is read from the auction SC, so the caller
+// pays the get cost once for the address and once for each validator it represents
+func (g *governanceContract) votePower(address []byte) (int32, error) {
+	votePower, err := g.delegatedVotePower(address, address)
+	if err != nil {
+		return 0, err
+	}
+
+	representativeData, err := g.getRepresentativeData(address)
+	if err != nil {
+		return 0, err
+	}
+	for _, validator := range representativeData.Validators {
+		delegatedVotePower, errDelegated := g.delegatedVotePower(validator, address)
+		if errDelegated != nil {
+			return 0, errDelegated
+		}
+		votePower += delegatedVotePower
+	}
+
+	return votePower, nil
+}
+
+// delegatedVotePower returns the number of nodes the voter votes with on behalf of the validator, computed against
+// the nodes the validator has staked at the moment of the call
+func (g *governanceContract) delegatedVotePower(validatorAddress []byte, voterAddress []byte) (int32, error) {
+	numStakedNodes, err := g.numOfStakedNodes(validatorAddress)
+	if errors.Is(err, vm.ErrNotEnoughGas) {
+		return 0, err
+	}
+	if err != nil || numStakedNodes == 0 {
+		return 0, nil
+	}
+
+	validatorData, err := g.getValidatorData(validatorAddress)
+	if errors.Is(err, vm.ErrEmptyStorage) {
+		if bytes.Equal(validatorAddress, voterAddress) {
+			return int32(numStakedNodes), nil
+		}
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	validatorData.NumNodes = int32(numStakedNodes)
+
+	return numNodesOfVoter(validatorData, validatorAddress, voterAddress), nil
+}
+
+// numNodesOfVoter caps the delegated nodes, in delegation order, to the number of nodes of the validator data, so
+// an unstake lowers the vote power of the representatives. The validator votes by itself with the remaining nodes
+func numNodesOfVoter(validatorData *ValidatorData, validatorAddress []byte, voterAddress []byte) int32 {
+	remainingNodes := validatorData.NumNodes
+	for _, voter := range validatorData.Delegators {
+		if bytes.Equal(voter.Address, validatorAddress) {
+			continue
+		}
+
+		numNodes := voter.NumNodes
+		if numNodes > remainingNodes {
+			numNodes = remainingNodes
+		}
+		remainingNodes -= numNodes
+
+		if bytes.Equal(voter.Address, voterAddress) {
+			return numNodes
+		}
+	}
+
+	if bytes.Equal(validatorAddress, voterAddress) && remainingNodes > 0 {
+		return remainingNodes
+	}
+
+	return 0
 }
 
 func (g *governanceContract) executeOnAuctionSC(data []byte) (*vmcommon.VMOutput, error) {
@@ -783,6 +1108,9 @@ func (g *governanceContract) numOfStakedNodes(address []byte) (uint32, error) {
 	if err != nil {
 		return 0, err
 	}
+	if vmOutput.ReturnCode == vmcommon.OutOfGas {
+		return 0, vm.ErrNotEnoughGas
+	}
 	if vmOutput.ReturnCode != vmcommon.Ok {
 		return 0, vm.ErrNotEnoughQualifiedNodes
 	}
@@ -836,7 +1164,11 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 	}
 
 	generalProposal.Closed = true
-	err = g.computeEndResults(generalProposal)
+	err = g.computeEndResults(proposal, generalProposal)
+	if errors.Is(err, vm.ErrNotEnoughGas) {
+		g.eei.AddReturnMessage("not enough gas to tally the votes")
+		return vmcommon.OutOfGas
+	}
 	if err != nil {
 		g.eei.AddReturnMessage("computeEndResults error" + err.Error())
 		return vmcommon.UserError
@@ -856,11 +1188,19 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 	return vmcommon.Ok
 }
 
-func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error {
+// computeEndResults tallies the votes with the voting power each voter has when the proposal is closed, so the
+// delegations and revocations made while the proposal was open are taken into account
+func (g *governanceContract) computeEndResults(reference []byte, proposal *GeneralProposal) error {
 	baseConfig, err := g.getConfig()
 	if err != nil {
 		return err
 	}
+
+	err = g.tallyVotes(reference, proposal)
+	if err != nil {
+		return err
+	}
+
 	totalVotes := proposal.Yes + proposal.No + proposal.DontCare + proposal.Veto
 	if totalVotes < baseConfig.MinQuorum {
 		proposal.Voted = false
@@ -880,6 +1220,36 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 	return nil
 }
 
+// tallyVotes recomputes the vote power of every voter, so the closing transaction pays the auction SC reads of all the
+// voters and fails with not enough gas instead of ignoring the voters it can not afford to read
+func (g *governanceContract) tallyVotes(reference []byte, proposal *GeneralProposal) error {
+	// proposals without voters (e.g. the white list at genesis) keep their initial results
+	if len(proposal.Voters) == 0 {
+		return nil
+	}
+
+	proposal.Yes = 0
+	proposal.No = 0
+	proposal.Veto = 0
+	proposal.DontCare = 0
+
+	for _, voter := range proposal.Voters {
+		voteData, err := g.getOrCreateVoteData(reference, voter)
+		if err != nil {
+			return err
+		}
+
+		votePower, err := g.votePower(voter)
+		if err != nil {
+			return err
+		}
+
+		g.addVotedDataToProposal(proposal, voteData.VoteValue, votePower)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (g *governanceContract) IsInterfaceNil() bool {
 	return g == nil
@@ -469,6 +469,45 @@ func (m *VoteData) GetVoteValue() string {
 	return ""
 }
 
+type RepresentativeData struct {
+	Validators [][]byte `protobuf:"bytes,1,rep,name=Validators,proto3" json:"Validators"`
+}
+
+func (m *RepresentativeData) Reset()      { *m = RepresentativeData{} }
+func (*RepresentativeData) ProtoMessage() {}
+func (*RepresentativeData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{7}
+}
+func (m *RepresentativeData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepresentativeData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RepresentativeData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepresentativeData.Merge(m, src)
+}
+func (m *RepresentativeData) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepresentativeData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepresentativeData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepresentativeData proto.InternalMessageInfo
+
+func (m *RepresentativeData) GetValidators() [][]byte {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
 	proto.RegisterType((*WhiteListProposal)(nil), "proto.WhiteListProposal")
@@ -477,65 +516,67 @@ func init() {
 	proto.RegisterType((*VoterData)(nil), "proto.VoterData")
 	proto.RegisterType((*ValidatorData)(nil), "proto.ValidatorData")
 	proto.RegisterType((*VoteData)(nil), "proto.VoteData")
+	proto.RegisterType((*RepresentativeData)(nil), "proto.RepresentativeData")
 }
 
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
+	// 857 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8b, 0x23, 0x45,
-	0x18, 0x4e, 0xe7, 0x63, 0x26, 0xa9, 0xc9, 0xec, 0x66, 0xdb, 0x65, 0x69, 0x45, 0xba, 0x42, 0x40,
+	0x18, 0x4e, 0xe7, 0x63, 0x36, 0xa9, 0xc9, 0xec, 0x66, 0xcb, 0x65, 0x69, 0x45, 0xba, 0x42, 0x40,
 	0x08, 0xc8, 0x26, 0xa0, 0x82, 0xa0, 0x08, 0xbb, 0x9d, 0xf9, 0xd8, 0x01, 0xb7, 0x59, 0x6b, 0x86,
-	0x88, 0xe2, 0xa5, 0x92, 0xae, 0xe9, 0x34, 0x9b, 0xf4, 0x1b, 0xaa, 0xaa, 0x77, 0x10, 0x2f, 0xfe,
-	0x00, 0x0f, 0xfa, 0x2f, 0xc4, 0x5f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x75,
-	0xda, 0x9f, 0x20, 0x55, 0x9d, 0x74, 0xd2, 0xc9, 0x1c, 0xf4, 0xd2, 0xf5, 0x3e, 0xcf, 0xd3, 0xef,
-	0x47, 0xd5, 0xfb, 0x56, 0xa1, 0x56, 0x08, 0x6f, 0x18, 0x8f, 0x69, 0x3c, 0x66, 0xbd, 0x39, 0x07,
-	0x09, 0x76, 0xcd, 0x2c, 0xef, 0x3d, 0x0d, 0x23, 0x39, 0x49, 0x46, 0xbd, 0x31, 0xcc, 0xfa, 0x21,
-	0x84, 0xd0, 0x37, 0xf4, 0x28, 0xb9, 0x34, 0xc8, 0x00, 0x63, 0x65, 0x5e, 0x9d, 0x9f, 0xaa, 0xe8,
-	0xe1, 0x29, 0x8b, 0x19, 0xa7, 0xd3, 0x57, 0x1c, 0xe6, 0x20, 0xe8, 0xd4, 0xfe, 0x14, 0x1d, 0x9e,
-	0x09, 0x91, 0x30, 0xfe, 0x3c, 0x08, 0x38, 0x13, 0xc2, 0xb1, 0xda, 0x56, 0xb7, 0xe9, 0x3d, 0x52,
-	0x29, 0x2e, 0x0a, 0xa4, 0x08, 0xed, 0x4f, 0x50, 0xf3, 0x34, 0x92, 0x2f, 0x92, 0xd1, 0x00, 0x66,
-	0xb3, 0x48, 0x3a, 0x65, 0xe3, 0xd7, 0x52, 0x29, 0x2e, 0xf0, 0xa4, 0x80, 0xec, 0xcf, 0xd0, 0x83,
-	0x73, 0x49, 0xb9, 0x1c, 0x82, 0x64, 0x3e, 0xc4, 0x63, 0xe6, 0x54, 0xda, 0x56, 0xb7, 0xea, 0xd9,
-	0x2a, 0xc5, 0x5b, 0x0a, 0xd9, 0xc2, 0x3a, 0xe3, 0x71, 0x1c, 0xac, 0x3d, 0xab, 0xc6, 0xd3, 0x64,
-	0xdc, 0xe4, 0x49, 0x01, 0xd9, 0xef, 0xa2, 0xca, 0x37, 0x4c, 0x38, 0xb5, 0xb6, 0xd5, 0xad, 0x79,
-	0xfb, 0x2a, 0xc5, 0x1a, 0x12, 0xfd, 0xb1, 0x9f, 0xa0, 0xb2, 0x0f, 0xce, 0x9e, 0x51, 0xf6, 0x54,
-	0x8a, 0xcb, 0x3e, 0x90, 0xb2, 0x0f, 0xf6, 0xfb, 0xa8, 0x3a, 0x64, 0x12, 0x9c, 0x7d, 0xa3, 0xd4,
-	0x55, 0x8a, 0x0d, 0x26, 0xe6, 0x6b, 0x77, 0x51, 0xfd, 0x08, 0x62, 0x39, 0xa0, 0x9c, 0x39, 0x75,
-	0xf3, 0x47, 0x53, 0xa5, 0x38, 0xe7, 0x48, 0x6e, 0xd9, 0x18, 0xd5, 0x74, 0x1d, 0x81, 0xd3, 0x68,
-	0x5b, 0xdd, 0xba, 0xd7, 0x50, 0x29, 0xce, 0x08, 0x92, 0x2d, 0x76, 0x07, 0xed, 0x69, 0x83, 0x0b,
-	0x07, 0xb5, 0x2b, 0xdd, 0xa6, 0x87, 0x54, 0x8a, 0x97, 0x0c, 0x59, 0xae, 0x7a, 0xd7, 0x17, 0x30,
-	0x27, 0xec, 0x92, 0x71, 0xa6, 0x77, 0x7d, 0xb0, 0x3e, 0xe7, 0x4d, 0x9e, 0x14, 0x90, 0x8e, 0x3c,
-	0x98, 0x82, 0x60, 0x81, 0xd3, 0x34, 0xb9, 0x4d, 0xe4, 0x8c, 0x21, 0xcb, 0xb5, 0xf3, 0x8b, 0x85,
-	0x1e, 0x7d, 0x3d, 0x89, 0x24, 0xfb, 0x32, 0x12, 0x32, 0x1f, 0x88, 0x67, 0xa8, 0x95, 0x93, 0xc5,
-	0x99, 0x78, 0xac, 0x52, 0xbc, 0xa3, 0x91, 0x1d, 0x46, 0xf7, 0x78, 0x15, 0xed, 0x5c, 0x52, 0x99,
-	0x88, 0xe5, 0x6c, 0x98, 0x1e, 0x17, 0x15, 0xb2, 0x85, 0x3b, 0x7f, 0x58, 0xa8, 0xf5, 0x82, 0xf2,
-	0xe0, 0x04, 0xf8, 0xeb, 0xbc, 0xa4, 0x2f, 0xd0, 0xc3, 0xe3, 0x39, 0x8c, 0x27, 0x17, 0xb0, 0x92,
-	0x4c, 0x45, 0x87, 0xde, 0x3b, 0x2a, 0xc5, 0xdb, 0x12, 0xd9, 0x26, 0xec, 0x13, 0x64, 0xfb, 0xec,
-	0xea, 0x1c, 0x2e, 0xe5, 0x15, 0xe5, 0x6c, 0xc8, 0xb8, 0x88, 0x20, 0x5e, 0xd6, 0xf4, 0x44, 0xa5,
-	0xf8, 0x1e, 0x95, 0xdc, 0xc3, 0xdd, 0xb3, 0xaf, 0xca, 0x7f, 0xde, 0xd7, 0xdf, 0x65, 0xd4, 0x3a,
-	0xcd, 0x6f, 0xf1, 0x00, 0xe2, 0xcb, 0x28, 0xd4, 0x93, 0xe4, 0x27, 0x33, 0x1f, 0x02, 0x96, 0x1d,
-	0x71, 0x25, 0x9b, 0xa4, 0x15, 0x47, 0x72, 0xcb, 0xfe, 0x10, 0x35, 0x5e, 0x46, 0xf1, 0x57, 0x09,
-	0xf0, 0x64, 0x66, 0x2a, 0xaf, 0x79, 0x87, 0x2a, 0xc5, 0x6b, 0x92, 0xac, 0x4d, 0xdd, 0xc1, 0x97,
-	0x51, 0xfc, 0x8a, 0x0a, 0x71, 0x31, 0xe1, 0x4c, 0x4c, 0x60, 0x1a, 0x98, 0x4a, 0x6b, 0x59, 0x07,
-	0xb7, 0x35, 0xb2, 0xc3, 0x2c, 0x23, 0xe8, 0x69, 0x5f, 0x47, 0xa8, 0x16, 0x22, 0x14, 0x34, 0xb2,
-	0xc3, 0xd8, 0x6f, 0xd0, 0xc1, 0xea, 0x04, 0x4e, 0x18, 0x33, 0xb7, 0xaf, 0xe9, 0x5d, 0xa8, 0x14,
-	0x6f, 0xd2, 0xbf, 0xfd, 0x89, 0x9f, 0xcf, 0xa8, 0x9c, 0xf4, 0x47, 0x51, 0xd8, 0x3b, 0x8b, 0xe5,
-	0xe7, 0x1b, 0xcf, 0xd9, 0xf1, 0x94, 0x43, 0x1c, 0xf8, 0x4c, 0x5e, 0x01, 0x7f, 0xdd, 0x67, 0x06,
-	0x3d, 0x0d, 0xa1, 0x1f, 0x50, 0x49, 0x7b, 0x5e, 0x14, 0x9e, 0xe9, 0x3b, 0x26, 0x24, 0xe3, 0x64,
-	0x33, 0x62, 0xe7, 0x3b, 0xd4, 0x30, 0xf7, 0xe6, 0x88, 0x4a, 0x6a, 0x7f, 0x80, 0xf6, 0x8b, 0x13,
-	0x7c, 0xa0, 0x52, 0xbc, 0xa2, 0xc8, 0xca, 0x28, 0xb4, 0xa1, 0xbc, 0xbe, 0xd0, 0xbb, 0x6d, 0xe8,
-	0xfc, 0x80, 0x0e, 0x87, 0x74, 0x1a, 0x05, 0x54, 0x42, 0x96, 0xe1, 0x19, 0x42, 0x47, 0x6c, 0xca,
-	0x42, 0x4d, 0xe8, 0x24, 0x95, 0xee, 0xc1, 0x47, 0xad, 0xec, 0xb5, 0xed, 0xe5, 0x75, 0x78, 0x0f,
-	0x54, 0x8a, 0x37, 0xfe, 0x23, 0x1b, 0xf6, 0xff, 0x48, 0x4e, 0x51, 0x5d, 0x87, 0x34, 0x79, 0x33,
-	0x2f, 0x0d, 0xb3, 0xad, 0x2d, 0xbd, 0x56, 0x3a, 0xc9, 0x55, 0x3d, 0x39, 0xda, 0x18, 0xd2, 0x69,
-	0xc2, 0x4c, 0x82, 0x46, 0x36, 0x39, 0x39, 0x49, 0xd6, 0xa6, 0xe7, 0x5f, 0xdf, 0xba, 0xa5, 0x9b,
-	0x5b, 0xb7, 0xf4, 0xf6, 0xd6, 0xb5, 0x7e, 0x5c, 0xb8, 0xd6, 0xaf, 0x0b, 0xd7, 0xfa, 0x7d, 0xe1,
-	0x5a, 0xd7, 0x0b, 0xd7, 0xba, 0x59, 0xb8, 0xd6, 0x5f, 0x0b, 0xd7, 0xfa, 0x67, 0xe1, 0x96, 0xde,
-	0x2e, 0x5c, 0xeb, 0xe7, 0x3b, 0xb7, 0x74, 0x7d, 0xe7, 0x96, 0x6e, 0xee, 0xdc, 0xd2, 0xb7, 0x8f,
-	0xc5, 0xf7, 0x42, 0xb2, 0xd9, 0xf9, 0x8c, 0x72, 0x39, 0x80, 0x58, 0x72, 0x3a, 0x96, 0x62, 0xb4,
-	0x67, 0x4e, 0xe2, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x85, 0xae, 0x19, 0xc1, 0x06,
-	0x00, 0x00,
+	0x88, 0xe2, 0xa5, 0x92, 0x7e, 0xa7, 0xd3, 0x6c, 0xd2, 0x15, 0xaa, 0xaa, 0x67, 0x10, 0x2f, 0xfe,
+	0x00, 0x0f, 0xfa, 0x2f, 0xc4, 0x5f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x7d,
+	0xda, 0x9f, 0x20, 0x55, 0x9d, 0x74, 0xd2, 0xc9, 0x1c, 0xf4, 0x92, 0x7a, 0x9f, 0xe7, 0xc9, 0xfb,
+	0x55, 0xef, 0x5b, 0x8d, 0x5a, 0x01, 0xbf, 0x04, 0x11, 0xb1, 0x68, 0x0c, 0xbd, 0xb9, 0xe0, 0x8a,
+	0xe3, 0x9a, 0x39, 0xde, 0x7b, 0x16, 0x84, 0x6a, 0x12, 0x8f, 0x7a, 0x63, 0x3e, 0xeb, 0x07, 0x3c,
+	0xe0, 0x7d, 0x43, 0x8f, 0xe2, 0x0b, 0x83, 0x0c, 0x30, 0x56, 0xe6, 0xd5, 0xf9, 0xa9, 0x8a, 0x1e,
+	0x9d, 0x40, 0x04, 0x82, 0x4d, 0x5f, 0x0b, 0x3e, 0xe7, 0x92, 0x4d, 0xf1, 0xa7, 0xe8, 0xe0, 0x54,
+	0xca, 0x18, 0xc4, 0x0b, 0xdf, 0x17, 0x20, 0xa5, 0x6d, 0xb5, 0xad, 0x6e, 0xd3, 0x7d, 0x9c, 0x26,
+	0xa4, 0x28, 0xd0, 0x22, 0xc4, 0x9f, 0xa0, 0xe6, 0x49, 0xa8, 0x5e, 0xc6, 0xa3, 0x01, 0x9f, 0xcd,
+	0x42, 0x65, 0x97, 0x8d, 0x5f, 0x2b, 0x4d, 0x48, 0x81, 0xa7, 0x05, 0x84, 0x3f, 0x43, 0x0f, 0xcf,
+	0x14, 0x13, 0x6a, 0xc8, 0x15, 0x78, 0x3c, 0x1a, 0x83, 0x5d, 0x69, 0x5b, 0xdd, 0xaa, 0x8b, 0xd3,
+	0x84, 0x6c, 0x29, 0x74, 0x0b, 0xeb, 0x8c, 0x47, 0x91, 0xbf, 0xf6, 0xac, 0x1a, 0x4f, 0x93, 0x71,
+	0x93, 0xa7, 0x05, 0x84, 0xdf, 0x45, 0x95, 0x6f, 0x40, 0xda, 0xb5, 0xb6, 0xd5, 0xad, 0xb9, 0x0f,
+	0xd2, 0x84, 0x68, 0x48, 0xf5, 0x0f, 0x7e, 0x8a, 0xca, 0x1e, 0xb7, 0xf7, 0x8c, 0xb2, 0x97, 0x26,
+	0xa4, 0xec, 0x71, 0x5a, 0xf6, 0x38, 0x7e, 0x1f, 0x55, 0x87, 0xa0, 0xb8, 0xfd, 0xc0, 0x28, 0xf5,
+	0x34, 0x21, 0x06, 0x53, 0xf3, 0x8b, 0xbb, 0xa8, 0x7e, 0xc8, 0x23, 0x35, 0x60, 0x02, 0xec, 0xba,
+	0xf9, 0x47, 0x33, 0x4d, 0x48, 0xce, 0xd1, 0xdc, 0xc2, 0x04, 0xd5, 0x74, 0x1d, 0xbe, 0xdd, 0x68,
+	0x5b, 0xdd, 0xba, 0xdb, 0x48, 0x13, 0x92, 0x11, 0x34, 0x3b, 0x70, 0x07, 0xed, 0x69, 0x43, 0x48,
+	0x1b, 0xb5, 0x2b, 0xdd, 0xa6, 0x8b, 0xd2, 0x84, 0x2c, 0x19, 0xba, 0x3c, 0x75, 0xd7, 0xe7, 0x7c,
+	0x4e, 0xe1, 0x02, 0x04, 0xe8, 0xae, 0xf7, 0xd7, 0xf7, 0xbc, 0xc9, 0xd3, 0x02, 0xd2, 0x91, 0x07,
+	0x53, 0x2e, 0xc1, 0xb7, 0x9b, 0x26, 0xb7, 0x89, 0x9c, 0x31, 0x74, 0x79, 0x76, 0x7e, 0xb1, 0xd0,
+	0xe3, 0xaf, 0x27, 0xa1, 0x82, 0x2f, 0x43, 0xa9, 0xf2, 0x85, 0x78, 0x8e, 0x5a, 0x39, 0x59, 0xdc,
+	0x89, 0x27, 0x69, 0x42, 0x76, 0x34, 0xba, 0xc3, 0xe8, 0x19, 0xaf, 0xa2, 0x9d, 0x29, 0xa6, 0x62,
+	0xb9, 0xdc, 0x0d, 0x33, 0xe3, 0xa2, 0x42, 0xb7, 0x70, 0xe7, 0x0f, 0x0b, 0xb5, 0x5e, 0x32, 0xe1,
+	0x1f, 0x73, 0xf1, 0x26, 0x2f, 0xe9, 0x0b, 0xf4, 0xe8, 0x68, 0xce, 0xc7, 0x93, 0x73, 0xbe, 0x92,
+	0x4c, 0x45, 0x07, 0xee, 0x3b, 0x69, 0x42, 0xb6, 0x25, 0xba, 0x4d, 0xe0, 0x63, 0x84, 0x3d, 0xb8,
+	0x3a, 0xe3, 0x17, 0xea, 0x8a, 0x09, 0x18, 0x82, 0x90, 0x21, 0x8f, 0x96, 0x35, 0x3d, 0x4d, 0x13,
+	0x72, 0x8f, 0x4a, 0xef, 0xe1, 0xee, 0xe9, 0xab, 0xf2, 0x9f, 0xfb, 0xfa, 0xbb, 0x8c, 0x5a, 0x27,
+	0xf9, 0x2b, 0x1e, 0xf0, 0xe8, 0x22, 0x0c, 0xf4, 0x26, 0x79, 0xf1, 0xcc, 0xe3, 0x3e, 0x64, 0x57,
+	0x5c, 0xc9, 0x36, 0x69, 0xc5, 0xd1, 0xdc, 0xc2, 0x1f, 0xa2, 0xc6, 0xab, 0x30, 0xfa, 0x2a, 0xe6,
+	0x22, 0x9e, 0x99, 0xca, 0x6b, 0xee, 0x41, 0x9a, 0x90, 0x35, 0x49, 0xd7, 0xa6, 0x9e, 0xe0, 0xab,
+	0x30, 0x7a, 0xcd, 0xa4, 0x3c, 0x9f, 0x08, 0x90, 0x13, 0x3e, 0xf5, 0x4d, 0xa5, 0xb5, 0x6c, 0x82,
+	0xdb, 0x1a, 0xdd, 0x61, 0x96, 0x11, 0xf4, 0xb6, 0xaf, 0x23, 0x54, 0x0b, 0x11, 0x0a, 0x1a, 0xdd,
+	0x61, 0xf0, 0x25, 0xda, 0x5f, 0xdd, 0xc0, 0x31, 0x80, 0x79, 0x7d, 0x4d, 0xf7, 0x3c, 0x4d, 0xc8,
+	0x26, 0xfd, 0xdb, 0x9f, 0xe4, 0xc5, 0x8c, 0xa9, 0x49, 0x7f, 0x14, 0x06, 0xbd, 0xd3, 0x48, 0x7d,
+	0xbe, 0xf1, 0x39, 0x3b, 0x9a, 0x0a, 0x1e, 0xf9, 0x1e, 0xa8, 0x2b, 0x2e, 0xde, 0xf4, 0xc1, 0xa0,
+	0x67, 0x01, 0xef, 0xfb, 0x4c, 0xb1, 0x9e, 0x1b, 0x06, 0xa7, 0xfa, 0x8d, 0x49, 0x05, 0x82, 0x6e,
+	0x46, 0xec, 0x7c, 0x87, 0x1a, 0xe6, 0xdd, 0x1c, 0x32, 0xc5, 0xf0, 0x07, 0xe8, 0x41, 0x71, 0x83,
+	0xf7, 0xd3, 0x84, 0xac, 0x28, 0xba, 0x32, 0x0a, 0x63, 0x28, 0xaf, 0x1f, 0xf4, 0xee, 0x18, 0x3a,
+	0x3f, 0xa0, 0x83, 0x21, 0x9b, 0x86, 0x3e, 0x53, 0x3c, 0xcb, 0xf0, 0x1c, 0xa1, 0x43, 0x98, 0x42,
+	0xa0, 0x09, 0x9d, 0xa4, 0xd2, 0xdd, 0xff, 0xa8, 0x95, 0x7d, 0x6d, 0x7b, 0x79, 0x1d, 0xee, 0xc3,
+	0x34, 0x21, 0x1b, 0xff, 0xa3, 0x1b, 0xf6, 0xff, 0x48, 0xce, 0x50, 0x5d, 0x87, 0x34, 0x79, 0x33,
+	0x2f, 0x0d, 0xb3, 0xd6, 0x96, 0x5e, 0x2b, 0x9d, 0xe6, 0xaa, 0xde, 0x1c, 0x6d, 0x0c, 0xd9, 0x34,
+	0x06, 0x93, 0xa0, 0x91, 0x6d, 0x4e, 0x4e, 0xd2, 0xb5, 0xd9, 0x39, 0x44, 0x98, 0xc2, 0x5c, 0x80,
+	0x84, 0x48, 0x31, 0x15, 0x5e, 0x66, 0xc9, 0x7a, 0x08, 0xe5, 0x5d, 0x67, 0x4d, 0x36, 0xb3, 0x96,
+	0xd6, 0x2c, 0xdd, 0xb0, 0x5d, 0xef, 0xfa, 0xd6, 0x29, 0xdd, 0xdc, 0x3a, 0xa5, 0xb7, 0xb7, 0x8e,
+	0xf5, 0xe3, 0xc2, 0xb1, 0x7e, 0x5d, 0x38, 0xd6, 0xef, 0x0b, 0xc7, 0xba, 0x5e, 0x38, 0xd6, 0xcd,
+	0xc2, 0xb1, 0xfe, 0x5a, 0x38, 0xd6, 0x3f, 0x0b, 0xa7, 0xf4, 0x76, 0xe1, 0x58, 0x3f, 0xdf, 0x39,
+	0xa5, 0xeb, 0x3b, 0xa7, 0x74, 0x73, 0xe7, 0x94, 0xbe, 0x7d, 0x22, 0xbf, 0x97, 0x0a, 0x66, 0x67,
+	0x33, 0x26, 0xd4, 0x80, 0x47, 0x4a, 0xb0, 0xb1, 0x92, 0xa3, 0x3d, 0x73, 0x9f, 0x1f, 0xff, 0x3b,
+	0x00, 0x6c, 0xd4, 0xf4, 0x5d, 0x07, 0x07, 0x00, 0x00,
 }
 
 func (this *GeneralProposal) Equal(that interface{}) bool {
@@ -782,6 +823,35 @@ func (this *VoteData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RepresentativeData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RepresentativeData)
+	if !ok {
+		that2, ok := that.(RepresentativeData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Validators) != len(that1.Validators) {
+		return false
+	}
+	for i := range this.Validators {
+		if !bytes.Equal(this.Validators[i], that1.Validators[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GeneralProposal) GoString() string {
 	if this == nil {
 		return "nil"
@@ -875,6 +945,16 @@ func (this *VoteData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RepresentativeData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.RepresentativeData{")
+	s = append(s, "Validators: "+fmt.Sprintf("%#v", this.Validators)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringGovernance(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1231,6 +1311,38 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RepresentativeData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepresentativeData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepresentativeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintGovernance(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovernance(v)
 	base := offset
@@ -1406,6 +1518,21 @@ func (m *VoteData) Size() (n int) {
 	return n
 }
 
+func (m *RepresentativeData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, b := range m.Validators {
+			l = len(b)
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGovernance(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1508,6 +1635,16 @@ func (this *VoteData) String() string {
 	}, "")
 	return s
 }
+func (this *RepresentativeData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepresentativeData{`,
+		`Validators:` + fmt.Sprintf("%v", this.Validators) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGovernance(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2601,6 +2738,91 @@ func (m *VoteData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepresentativeData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepresentativeData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepresentativeData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, make([]byte, postIndex-iNdEx))
+			copy(m.Validators[len(m.Validators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovernance(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -422,6 +422,14 @@ func testExecuteVote(t *testing.T, vote []byte) {
 				validatorDataBytes, _ := json.Marshal(validatorData)
 				return validatorDataBytes
 			}
+			if bytes.Equal(key, append([]byte(representativePrefix), callerAddr...)) {
+				representativeData := &RepresentativeData{
+					Validators: [][]byte{validatorAddr},
+				}
+
+				representativeDataBytes, _ := json.Marshal(representativeData)
+				return representativeDataBytes
+			}
 			generalProposal := &GeneralProposal{
 				Voted: true,
 			}
@@ -446,7 +454,6 @@ func testExecuteVote(t *testing.T, vote []byte) {
 	callInput.Arguments = [][]byte{
 		proposalToVote,
 		vote,
-		validatorAddr,
 	}
 
 	retCode := gsc.Execute(callInput)
@@ -600,3 +607,286 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+func createGovernanceWithStakedNodes(
+	t *testing.T,
+	stakedNodes map[string]uint32,
+) (*governanceContract, *mock.BlockChainHookStub) {
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{})
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return &mock.SystemSCStub{
+			ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+				numStaked, ok := stakedNodes[string(args.Arguments[0])]
+				if !ok {
+					return vmcommon.UserError
+				}
+
+				auctionDataBytes, _ := json.Marshal(&AuctionData{NumStaked: numStaked})
+				eei.Finish(auctionDataBytes)
+				return vmcommon.Ok
+			},
+		}, nil
+	}})
+
+	args := createMockGovernanceArgs()
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+	eei.SetSCAddress(args.GovernanceSCAddress)
+
+	initGovernanceSc(t, gsc, []byte("owner"), args.GovernanceSCAddress)
+
+	return gsc, blockChainHook
+}
+
+func changeVotePower(g *governanceContract, function string, validatorAddr, representativeAddr []byte, numNodes int) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), function, validatorAddr, []byte("governanceSC"))
+	callInput.Arguments = [][]byte{
+		representativeAddr,
+		[]byte(fmt.Sprintf("%d", numNodes)),
+	}
+
+	return g.Execute(callInput)
+}
+
+func TestGovernanceContract_DelegateVotePowerErrors(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("vala1")
+	representativeAddr := []byte("repr1")
+	gsc, _ := createGovernanceWithStakedNodes(t, map[string]uint32{string(validatorAddr): 2})
+
+	callInput := createVMInput(big.NewInt(1), "delegateVotePower", validatorAddr, []byte("governanceSC"))
+	callInput.Arguments = [][]byte{representativeAddr, []byte("1")}
+	require.Equal(t, vmcommon.UserError, gsc.Execute(callInput))
+
+	callInput.CallValue = big.NewInt(0)
+	callInput.Arguments = [][]byte{representativeAddr}
+	require.Equal(t, vmcommon.FunctionWrongSignature, gsc.Execute(callInput))
+
+	require.Equal(t, vmcommon.UserError, changeVotePower(gsc, "delegateVotePower", validatorAddr, []byte("invalidLength"), 1))
+	require.Equal(t, vmcommon.UserError, changeVotePower(gsc, "delegateVotePower", validatorAddr, validatorAddr, 1))
+	require.Equal(t, vmcommon.UserError, changeVotePower(gsc, "delegateVotePower", validatorAddr, representativeAddr, 0))
+	require.Equal(t, vmcommon.UserError, changeVotePower(gsc, "delegateVotePower", representativeAddr, validatorAddr, 1))
+	require.Equal(t, vmcommon.UserError, changeVotePower(gsc, "delegateVotePower", validatorAddr, representativeAddr, 3))
+	require.Equal(t, vmcommon.UserError, changeVotePower(gsc, "revokeVotePower", validatorAddr, representativeAddr, 1))
+}
+
+func TestGovernanceContract_DelegateAndRevokeVotePower(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr1 := []byte("vala1")
+	validatorAddr2 := []byte("vala2")
+	representativeAddr := []byte("repr1")
+	gsc, _ := createGovernanceWithStakedNodes(t, map[string]uint32{
+		string(validatorAddr1): 3,
+		string(validatorAddr2): 1,
+	})
+
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr1, representativeAddr, 2))
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr2, representativeAddr, 1))
+
+	votePower, _ := gsc.votePower(representativeAddr)
+	require.Equal(t, int32(3), votePower)
+	votePower, _ = gsc.votePower(validatorAddr1)
+	require.Equal(t, int32(1), votePower)
+	votePower, _ = gsc.votePower(validatorAddr2)
+	require.Equal(t, int32(0), votePower)
+
+	require.Equal(t, vmcommon.UserError, changeVotePower(gsc, "revokeVotePower", validatorAddr1, representativeAddr, 3))
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "revokeVotePower", validatorAddr1, representativeAddr, 1))
+	votePower, _ = gsc.votePower(representativeAddr)
+	require.Equal(t, int32(2), votePower)
+
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "revokeVotePower", validatorAddr2, representativeAddr, 1))
+	votePower, _ = gsc.votePower(representativeAddr)
+	require.Equal(t, int32(1), votePower)
+	votePower, _ = gsc.votePower(validatorAddr2)
+	require.Equal(t, int32(1), votePower)
+
+	representativeData, _ := gsc.getRepresentativeData(representativeAddr)
+	require.Equal(t, [][]byte{validatorAddr1}, representativeData.Validators)
+}
+
+func TestGovernanceContract_CloseProposalCountsDelegatedVotePower(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr1 := []byte("vala1")
+	validatorAddr2 := []byte("vala2")
+	representativeAddr := []byte("repr1")
+	gsc, blockChainHook := createGovernanceWithStakedNodes(t, map[string]uint32{
+		string(validatorAddr1): 1,
+		string(validatorAddr2): 1,
+	})
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr1, representativeAddr, 1))
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr2, representativeAddr, 1))
+
+	proposal := votedProposalByRepresentative(t, gsc, blockChainHook, representativeAddr)
+	require.True(t, proposal.Closed)
+	require.True(t, proposal.Voted)
+	require.Equal(t, int32(2), proposal.Yes)
+}
+
+func TestGovernanceContract_RevokeVotePowerAppliesToOpenProposals(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr1 := []byte("vala1")
+	validatorAddr2 := []byte("vala2")
+	representativeAddr := []byte("repr1")
+	gsc, blockChainHook := createGovernanceWithStakedNodes(t, map[string]uint32{
+		string(validatorAddr1): 1,
+		string(validatorAddr2): 1,
+	})
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr1, representativeAddr, 1))
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr2, representativeAddr, 1))
+
+	proposal := votedProposalByRepresentative(t, gsc, blockChainHook, representativeAddr, func() {
+		require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "revokeVotePower", validatorAddr2, representativeAddr, 1))
+	})
+	require.True(t, proposal.Closed)
+	require.False(t, proposal.Voted)
+	require.Equal(t, int32(1), proposal.Yes)
+}
+
+func votedProposalByRepresentative(
+	t *testing.T,
+	gsc *governanceContract,
+	blockChainHook *mock.BlockChainHookStub,
+	representativeAddr []byte,
+	beforeClose ...func(),
+) *GeneralProposal {
+	recipientAddr := []byte("governanceSC")
+	genesisWLAddr := []byte("genesisAddr")
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+
+	startNonce := uint64(100)
+	stopNonce := uint64(1000)
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, gitHubCommit, startNonce, stopNonce)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return startNonce + 1
+	}
+	voteProposal(t, gsc, representativeAddr, gitHubCommit, recipientAddr, "yes")
+	for _, handler := range beforeClose {
+		handler()
+	}
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return stopNonce + 1
+	}
+	closeProposal(t, gsc, genesisWLAddr, gitHubCommit, recipientAddr)
+
+	proposal, err := gsc.getGeneralProposal(gitHubCommit)
+	require.Nil(t, err)
+
+	return proposal
+}
+
+func TestGovernanceContract_VotePowerIsCappedToTheStakedNodes(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("vala1")
+	representativeAddr1 := []byte("repr1")
+	representativeAddr2 := []byte("repr2")
+	stakedNodes := map[string]uint32{string(validatorAddr): 4}
+	gsc, _ := createGovernanceWithStakedNodes(t, stakedNodes)
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr, representativeAddr1, 2))
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr, representativeAddr2, 1))
+
+	stakedNodes[string(validatorAddr)] = 2
+	votePower, _ := gsc.votePower(representativeAddr1)
+	require.Equal(t, int32(2), votePower)
+	votePower, _ = gsc.votePower(representativeAddr2)
+	require.Equal(t, int32(0), votePower)
+	votePower, _ = gsc.votePower(validatorAddr)
+	require.Equal(t, int32(0), votePower)
+
+	delete(stakedNodes, string(validatorAddr))
+	votePower, _ = gsc.votePower(representativeAddr1)
+	require.Equal(t, int32(0), votePower)
+}
+
+func TestGovernanceContract_VoteOnBehalfOfValidator(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr1 := []byte("vala1")
+	validatorAddr2 := []byte("vala2")
+	representativeAddr := []byte("repr1")
+	gsc, blockChainHook := createGovernanceWithStakedNodes(t, map[string]uint32{
+		string(validatorAddr1): 2,
+		string(validatorAddr2): 1,
+	})
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr1, representativeAddr, 1))
+
+	recipientAddr := []byte("governanceSC")
+	genesisWLAddr := []byte("genesisAddr")
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, gitHubCommit, 100, 1000)
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 101
+	}
+
+	voteOnBehalfOf := func(validatorAddr []byte) vmcommon.ReturnCode {
+		callInput := createVMInput(big.NewInt(0), "vote", representativeAddr, recipientAddr)
+		callInput.Arguments = [][]byte{gitHubCommit, []byte("yes"), validatorAddr}
+		return gsc.Execute(callInput)
+	}
+	require.Equal(t, vmcommon.FunctionWrongSignature, voteOnBehalfOf([]byte("invalidLength")))
+	require.Equal(t, vmcommon.FunctionWrongSignature, voteOnBehalfOf(representativeAddr))
+	require.Equal(t, vmcommon.UserError, voteOnBehalfOf(validatorAddr2))
+	require.Equal(t, vmcommon.Ok, voteOnBehalfOf(validatorAddr1))
+
+	voteData, _ := gsc.getOrCreateVoteData(gitHubCommit, representativeAddr)
+	require.Equal(t, int32(1), voteData.NumVotes)
+}
+
+func TestGovernanceContract_CloseProposalWithoutGasForTheTallyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("vala1")
+	representativeAddr := []byte("repr1")
+	gsc, blockChainHook := createGovernanceWithStakedNodes(t, map[string]uint32{string(validatorAddr): 1})
+	require.Equal(t, vmcommon.Ok, changeVotePower(gsc, "delegateVotePower", validatorAddr, representativeAddr, 1))
+
+	recipientAddr := []byte("governanceSC")
+	genesisWLAddr := []byte("genesisAddr")
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, gitHubCommit, 100, 1000)
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 101
+	}
+	voteProposal(t, gsc, representativeAddr, gitHubCommit, recipientAddr, "yes")
+
+	_ = gsc.eei.(*vmContext).SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return &mock.SystemSCStub{
+			ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+				return vmcommon.OutOfGas
+			},
+		}, nil
+	}})
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1001
+	}
+	callInput := createVMInput(big.NewInt(0), "closeProposal", genesisWLAddr, recipientAddr)
+	callInput.Arguments = [][]byte{gitHubCommit}
+	require.Equal(t, vmcommon.OutOfGas, gsc.Execute(callInput))
+
+	proposal, _ := gsc.getGeneralProposal(gitHubCommit)
+	require.False(t, proposal.Closed)
+}
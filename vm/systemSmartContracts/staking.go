@@ -3,6 +3,7 @@ package systemSmartContracts
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -11,6 +12,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -20,6 +22,7 @@ var log = logger.GetOrCreate("vm/systemsmartcontracts")
 
 const ownerKey = "owner"
 const nodesConfigKey = "nodesConfig"
+const doubleSigningReportPrefix = "doubleSigning_"
 
 type stakingSC struct {
 	eei                      vm.SystemEI
@@ -33,6 +36,7 @@ type stakingSC struct {
 	gasCost                  vm.GasCost
 	minNumNodes              int64
 	marshalizer              marshal.Marshalizer
+	evidenceVerifier         vm.EvidenceVerifier
 }
 
 // ArgsNewStakingSmartContract holds the arguments needed to create a StakingSmartContract
@@ -48,6 +52,7 @@ type ArgsNewStakingSmartContract struct {
 	MaximumPercentageToBleed float64
 	GasCost                  vm.GasCost
 	Marshalizer              marshal.Marshalizer
+	EvidenceVerifier         vm.EvidenceVerifier
 }
 
 // NewStakingSmartContract creates a staking smart contract
@@ -72,6 +77,9 @@ func NewStakingSmartContract(
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
+	if check.IfNil(args.EvidenceVerifier) {
+		return nil, vm.ErrNilEvidenceVerifier
+	}
 
 	reg := &stakingSC{
 		minStakeValue:            big.NewInt(0).Set(args.MinStakeValue),
@@ -85,6 +93,7 @@ func NewStakingSmartContract(
 		gasCost:                  args.GasCost,
 		minNumNodes:              int64(args.MinNumNodes),
 		marshalizer:              args.Marshalizer,
+		evidenceVerifier:         args.EvidenceVerifier,
 	}
 	return reg, nil
 }
@@ -122,6 +131,8 @@ func (r *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return r.changeRewardAddress(args)
 	case "changeValidatorKeys":
 		return r.changeValidatorKey(args)
+	case "reportDoubleSigning":
+		return r.reportDoubleSigning(args)
 	}
 
 	return vmcommon.UserError
@@ -263,6 +274,20 @@ func (r *stakingSC) changeValidatorKey(args *vmcommon.ContractCallInput) vmcommo
 		return vmcommon.Ok
 	}
 
+	newKeyData, err := r.getOrCreateRegisteredData(newKey)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(newKeyData.RewardAddress) != 0 {
+		r.eei.AddReturnMessage("new bls key is already registered")
+		return vmcommon.UserError
+	}
+
+	// the previous key is kept so the peer account (list, shard and rating) is moved to the new key
+	stakedData.PreviousBlsKey = oldKey
+	stakedData.ChangedKeyNonce = r.eei.BlockChainHook().CurrentNonce()
+
 	r.eei.SetStorage(oldKey, nil)
 	err = r.saveStakingData(newKey, stakedData)
 	if err != nil {
@@ -406,13 +431,7 @@ func (r *stakingSC) jail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 			return vmcommon.UserError
 		}
 
-		if stakedData.UnJailedNonce <= stakedData.JailedNonce {
-			r.addToJailedNodes()
-		}
-
-		stakedData.JailedRound = r.eei.BlockChainHook().CurrentRound()
-		stakedData.JailedNonce = r.eei.BlockChainHook().CurrentNonce()
-		err = r.saveStakingData(argument, stakedData)
+		err = r.jailNode(argument, stakedData)
 		if err != nil {
 			r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
 			return vmcommon.UserError
@@ -422,6 +441,91 @@ func (r *stakingSC) jail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.Ok
 }
 
+// doubleSigningReportKey builds the report key from the public key followed by the fixed width shard and round,
+// so that the raw public key bytes can not make two different reports collide
+func doubleSigningReportKey(evidence *slash.Evidence) []byte {
+	reportKey := append([]byte(doubleSigningReportPrefix), evidence.PubKey...)
+
+	shardAndRound := make([]byte, 12)
+	binary.BigEndian.PutUint32(shardAndRound[:4], evidence.ShardID)
+	binary.BigEndian.PutUint64(shardAndRound[4:], evidence.Round)
+
+	return append(reportKey, shardAndRound...)
+}
+
+func (r *stakingSC) reportDoubleSigning(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		r.eei.AddReturnMessage("reportDoubleSigning function does not accept funds")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		retMessage := fmt.Sprintf("reportDoubleSigning function called with wrong number of arguments: expected %d, got %d", 1, len(args.Arguments))
+		r.eei.AddReturnMessage(retMessage)
+		return vmcommon.UserError
+	}
+
+	err := r.eei.UseGas(r.gasCost.MetaChainSystemSCsCost.ReportDoubleSigning)
+	if err != nil {
+		r.eei.AddReturnMessage("insufficient gas limit")
+		return vmcommon.OutOfGas
+	}
+
+	evidence := &slash.Evidence{}
+	err = r.marshalizer.Unmarshal(evidence, args.Arguments[0])
+	if err != nil {
+		r.eei.AddReturnMessage("cannot unmarshal evidence: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	err = r.evidenceVerifier.Verify(evidence)
+	if err != nil {
+		r.eei.AddReturnMessage("invalid evidence: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	// a key is jailed once per shard and round, whatever the type of the evidence
+	reportKey := doubleSigningReportKey(evidence)
+	if len(r.eei.GetStorage(reportKey)) > 0 {
+		r.eei.AddReturnMessage("evidence already reported")
+		return vmcommon.UserError
+	}
+
+	stakedData, err := r.getOrCreateRegisteredData(evidence.PubKey)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(stakedData.RewardAddress) == 0 {
+		r.eei.AddReturnMessage("cannot jail a key that is not registered")
+		return vmcommon.UserError
+	}
+
+	err = r.jailNode(evidence.PubKey, stakedData)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	r.eei.SetStorage(reportKey, args.CallerAddr)
+	log.Debug("validator jailed for double signing",
+		"pk", hex.EncodeToString(evidence.PubKey),
+		"round", evidence.Round,
+		"reporter", hex.EncodeToString(args.CallerAddr))
+
+	return vmcommon.Ok
+}
+
+func (r *stakingSC) jailNode(blsKey []byte, stakedData *StakedData) error {
+	if stakedData.UnJailedNonce <= stakedData.JailedNonce {
+		r.addToJailedNodes()
+	}
+
+	stakedData.JailedRound = r.eei.BlockChainHook().CurrentRound()
+	stakedData.JailedNonce = r.eei.BlockChainHook().CurrentNonce()
+
+	return r.saveStakingData(blsKey, stakedData)
+}
+
 func (r *stakingSC) get(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) < 1 {
 		r.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected min %d, got %d", 1, 0))
@@ -29,16 +29,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StakedData struct {
-	RegisterNonce uint64        `protobuf:"varint,1,opt,name=RegisterNonce,proto3" json:"RegisterNonce"`
-	StakedNonce   uint64        `protobuf:"varint,2,opt,name=StakedNonce,proto3" json:"StakedNonce"`
-	Staked        bool          `protobuf:"varint,3,opt,name=Staked,proto3" json:"Staked"`
-	UnStakedNonce uint64        `protobuf:"varint,4,opt,name=UnStakedNonce,proto3" json:"UnStakedNonce"`
-	UnStakedEpoch uint32        `protobuf:"varint,5,opt,name=UnStakedEpoch,proto3" json:"UnStakedEpoch"`
-	RewardAddress []byte        `protobuf:"bytes,6,opt,name=RewardAddress,proto3" json:"RewardAddress"`
-	StakeValue    *math_big.Int `protobuf:"bytes,7,opt,name=StakeValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"StakeValue"`
-	JailedRound   uint64        `protobuf:"varint,8,opt,name=JailedRound,proto3" json:"JailedRound"`
-	JailedNonce   uint64        `protobuf:"varint,9,opt,name=JailedNonce,proto3" json:"JailedNonce"`
-	UnJailedNonce uint64        `protobuf:"varint,10,opt,name=UnJailedNonce,proto3" json:"UnJailedNonce"`
+	RegisterNonce   uint64        `protobuf:"varint,1,opt,name=RegisterNonce,proto3" json:"RegisterNonce"`
+	StakedNonce     uint64        `protobuf:"varint,2,opt,name=StakedNonce,proto3" json:"StakedNonce"`
+	Staked          bool          `protobuf:"varint,3,opt,name=Staked,proto3" json:"Staked"`
+	UnStakedNonce   uint64        `protobuf:"varint,4,opt,name=UnStakedNonce,proto3" json:"UnStakedNonce"`
+	UnStakedEpoch   uint32        `protobuf:"varint,5,opt,name=UnStakedEpoch,proto3" json:"UnStakedEpoch"`
+	RewardAddress   []byte        `protobuf:"bytes,6,opt,name=RewardAddress,proto3" json:"RewardAddress"`
+	StakeValue      *math_big.Int `protobuf:"bytes,7,opt,name=StakeValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"StakeValue"`
+	JailedRound     uint64        `protobuf:"varint,8,opt,name=JailedRound,proto3" json:"JailedRound"`
+	JailedNonce     uint64        `protobuf:"varint,9,opt,name=JailedNonce,proto3" json:"JailedNonce"`
+	UnJailedNonce   uint64        `protobuf:"varint,10,opt,name=UnJailedNonce,proto3" json:"UnJailedNonce"`
+	PreviousBlsKey  []byte        `protobuf:"bytes,11,opt,name=PreviousBlsKey,proto3" json:"PreviousBlsKey"`
+	ChangedKeyNonce uint64        `protobuf:"varint,12,opt,name=ChangedKeyNonce,proto3" json:"ChangedKeyNonce"`
 }
 
 func (m *StakedData) Reset()      { *m = StakedData{} }
@@ -139,6 +141,20 @@ func (m *StakedData) GetUnJailedNonce() uint64 {
 	return 0
 }
 
+func (m *StakedData) GetPreviousBlsKey() []byte {
+	if m != nil {
+		return m.PreviousBlsKey
+	}
+	return nil
+}
+
+func (m *StakedData) GetChangedKeyNonce() uint64 {
+	if m != nil {
+		return m.ChangedKeyNonce
+	}
+	return 0
+}
+
 type StakingNodesConfig struct {
 	MinNumNodes int64 `protobuf:"varint,1,opt,name=MinNumNodes,proto3" json:"MinNumNodes"`
 	StakedNodes int64 `protobuf:"varint,2,opt,name=StakedNodes,proto3" json:"StakedNodes"`
@@ -202,37 +218,40 @@ func init() {
 func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
 
 var fileDescriptor_289e7c8aea278311 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xb1, 0x8e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0xbb, 0x5e, 0x39, 0x0c, 0x15, 0x22, 0x62, 0x88, 0x18, 0x9c, 0xaa, 0x53, 0x97,
-	0x6b, 0x84, 0x18, 0x18, 0x98, 0xae, 0xe5, 0x86, 0x43, 0x22, 0x83, 0x2b, 0x18, 0xd8, 0xdc, 0xda,
-	0xe7, 0x46, 0x6d, 0xec, 0x53, 0xec, 0xe8, 0xc4, 0xc6, 0x23, 0xf0, 0x18, 0x08, 0x89, 0xf7, 0x60,
-	0xec, 0x58, 0x09, 0xa9, 0xd0, 0x74, 0x41, 0x9d, 0xee, 0x11, 0x50, 0xec, 0xa2, 0x7c, 0x61, 0x72,
-	0x7f, 0x7f, 0xeb, 0x67, 0x7d, 0xfd, 0xdb, 0xc1, 0x3d, 0x63, 0xd9, 0x32, 0x53, 0x72, 0x74, 0x5b,
-	0x68, 0xab, 0xc3, 0x33, 0xb7, 0x3c, 0xbf, 0x90, 0x99, 0x5d, 0x94, 0xb3, 0xd1, 0x5c, 0xe7, 0x89,
-	0xd4, 0x52, 0x27, 0x2e, 0x9e, 0x95, 0x37, 0x8e, 0x1c, 0xb8, 0x5f, 0xde, 0x1a, 0xfc, 0xec, 0x60,
-	0x3c, 0xb5, 0x6c, 0x29, 0xf8, 0x1b, 0x66, 0x59, 0xf8, 0x0a, 0xf7, 0xa8, 0x90, 0x99, 0xb1, 0xa2,
-	0x48, 0xb5, 0x9a, 0x8b, 0x08, 0xf5, 0xd1, 0xb0, 0x33, 0x7e, 0x7a, 0xd8, 0xc6, 0xed, 0x0d, 0xda,
-	0xc6, 0xf0, 0x05, 0x7e, 0xe4, 0x8f, 0xf1, 0xda, 0x89, 0xd3, 0x9e, 0x1c, 0xb6, 0x31, 0x8c, 0x29,
-	0x84, 0x70, 0x80, 0xbb, 0x1e, 0xa3, 0xd3, 0x3e, 0x1a, 0x9e, 0x8f, 0xf1, 0x61, 0x1b, 0x1f, 0x13,
-	0x7a, 0x5c, 0xeb, 0x79, 0xde, 0x2b, 0x78, 0x70, 0xa7, 0x99, 0xa7, 0xb5, 0x41, 0xdb, 0x08, 0xc5,
-	0xab, 0x5b, 0x3d, 0x5f, 0x44, 0x67, 0x7d, 0x34, 0xec, 0xb5, 0x45, 0xb7, 0x41, 0xdb, 0xe8, 0x1b,
-	0xb8, 0x63, 0x05, 0xbf, 0xe4, 0xbc, 0x10, 0xc6, 0x44, 0xdd, 0x3e, 0x1a, 0x3e, 0xfe, 0xd7, 0x00,
-	0xd8, 0xa0, 0x6d, 0x0c, 0xcd, 0xb1, 0xc8, 0x0f, 0x6c, 0x55, 0x8a, 0xe8, 0x81, 0xb3, 0xa6, 0x87,
-	0x6d, 0x0c, 0xd2, 0x6f, 0xbf, 0xe2, 0xcb, 0x9c, 0xd9, 0x45, 0x32, 0xcb, 0xe4, 0xe8, 0x5a, 0xd9,
-	0xd7, 0xe0, 0xae, 0xae, 0x56, 0x85, 0x56, 0x3c, 0x15, 0xf6, 0x4e, 0x17, 0xcb, 0x44, 0x38, 0xba,
-	0x90, 0x3a, 0xe1, 0xcc, 0xb2, 0xd1, 0x38, 0x93, 0xd7, 0xca, 0x4e, 0x58, 0xdd, 0x37, 0x05, 0x07,
-	0xd6, 0xb5, 0xbf, 0x65, 0xd9, 0x4a, 0x70, 0xaa, 0x4b, 0xc5, 0xa3, 0xf3, 0xa6, 0x76, 0x10, 0x53,
-	0x08, 0x8d, 0xe2, 0x0b, 0x7d, 0xf8, 0xbf, 0x72, 0xbc, 0x29, 0x00, 0xbe, 0x4c, 0x28, 0x61, 0x78,
-	0x0b, 0x50, 0x6b, 0xe3, 0xe0, 0x3b, 0xc2, 0xe1, 0xd4, 0xbf, 0xd2, 0x54, 0x73, 0x61, 0x26, 0x5a,
-	0xdd, 0x64, 0xb2, 0x1e, 0xe1, 0x5d, 0xa6, 0xd2, 0x32, 0x77, 0xa1, 0x7b, 0x63, 0xa7, 0x7e, 0x04,
-	0x10, 0x53, 0x08, 0xf0, 0x7d, 0xd5, 0xca, 0x49, 0xa3, 0x80, 0x98, 0x42, 0x80, 0x7f, 0xb4, 0x56,
-	0x4e, 0x1b, 0x05, 0xc4, 0x14, 0xc2, 0x38, 0x5d, 0xef, 0x48, 0xb0, 0xd9, 0x91, 0xe0, 0x7e, 0x47,
-	0xd0, 0xe7, 0x8a, 0xa0, 0xaf, 0x15, 0x41, 0x3f, 0x2a, 0x82, 0xd6, 0x15, 0x41, 0x9b, 0x8a, 0xa0,
-	0xdf, 0x15, 0x41, 0x7f, 0x2a, 0x12, 0xdc, 0x57, 0x04, 0x7d, 0xd9, 0x93, 0x60, 0xbd, 0x27, 0xc1,
-	0x66, 0x4f, 0x82, 0x8f, 0xcf, 0xcc, 0x27, 0x63, 0x45, 0x3e, 0xcd, 0x59, 0x61, 0x27, 0x5a, 0xd9,
-	0x82, 0xcd, 0xad, 0x99, 0x75, 0xdd, 0x47, 0xf6, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf,
-	0x4e, 0x35, 0x5d, 0xab, 0x03, 0x00, 0x00,
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0x7d, 0x4d, 0x13, 0xca, 0xb5, 0xa1, 0xc2, 0x30, 0x58, 0x0c, 0xe7, 0x28, 0x53, 0x96,
+	0x26, 0x42, 0x0c, 0x48, 0x20, 0x86, 0x26, 0x74, 0x28, 0x15, 0x11, 0xba, 0x08, 0x06, 0xb6, 0x4b,
+	0x7c, 0x75, 0xac, 0x24, 0x77, 0x95, 0xef, 0x4c, 0x95, 0x8d, 0x47, 0xe0, 0x31, 0x10, 0x12, 0xef,
+	0xc1, 0x98, 0x09, 0x65, 0x32, 0xc4, 0x59, 0x90, 0xa7, 0x3e, 0x02, 0xf2, 0x5d, 0xaa, 0x7c, 0xf6,
+	0x64, 0xff, 0xfe, 0x9f, 0x7e, 0x67, 0xfb, 0x7f, 0x3e, 0xdc, 0x54, 0x9a, 0xcd, 0x22, 0x11, 0x76,
+	0x6f, 0x62, 0xa9, 0xa5, 0x5b, 0x37, 0x97, 0x67, 0x67, 0x61, 0xa4, 0xa7, 0xc9, 0xb8, 0x3b, 0x91,
+	0x8b, 0x5e, 0x28, 0x43, 0xd9, 0x33, 0xf1, 0x38, 0xb9, 0x36, 0x64, 0xc0, 0xdc, 0x59, 0xab, 0xfd,
+	0xbb, 0x8e, 0xf1, 0x48, 0xb3, 0x19, 0x0f, 0xde, 0x32, 0xcd, 0xdc, 0x97, 0xb8, 0x49, 0x79, 0x18,
+	0x29, 0xcd, 0xe3, 0xa1, 0x14, 0x13, 0xee, 0xa1, 0x16, 0xea, 0x1c, 0xf6, 0x1f, 0xe7, 0xa9, 0x5f,
+	0x1e, 0xd0, 0x32, 0xba, 0xcf, 0xf1, 0xb1, 0x5d, 0xc6, 0x6a, 0x07, 0x46, 0x3b, 0xcd, 0x53, 0x1f,
+	0xc6, 0x14, 0x82, 0xdb, 0xc6, 0x0d, 0x8b, 0x5e, 0xad, 0x85, 0x3a, 0x47, 0x7d, 0x9c, 0xa7, 0xfe,
+	0x2e, 0xa1, 0xbb, 0x6b, 0xf1, 0x3e, 0x1f, 0x05, 0x5c, 0xf8, 0x70, 0xff, 0x3e, 0xa5, 0x01, 0x2d,
+	0x23, 0x14, 0x2f, 0x6e, 0xe4, 0x64, 0xea, 0xd5, 0x5b, 0xa8, 0xd3, 0x2c, 0x8b, 0x66, 0x40, 0xcb,
+	0x68, 0x1b, 0xb8, 0x65, 0x71, 0x70, 0x1e, 0x04, 0x31, 0x57, 0xca, 0x6b, 0xb4, 0x50, 0xe7, 0xe4,
+	0xbe, 0x01, 0x30, 0xa0, 0x65, 0x74, 0xd5, 0xae, 0xc8, 0x4f, 0x6c, 0x9e, 0x70, 0xef, 0x81, 0xb1,
+	0x46, 0x79, 0xea, 0x83, 0xf4, 0xc7, 0x1f, 0xff, 0x7c, 0xc1, 0xf4, 0xb4, 0x37, 0x8e, 0xc2, 0xee,
+	0xa5, 0xd0, 0xaf, 0xc1, 0x5e, 0x5d, 0xcc, 0x63, 0x29, 0x82, 0x21, 0xd7, 0xb7, 0x32, 0x9e, 0xf5,
+	0xb8, 0xa1, 0xb3, 0x50, 0xf6, 0x02, 0xa6, 0x59, 0xb7, 0x1f, 0x85, 0x97, 0x42, 0x0f, 0x58, 0xd1,
+	0x37, 0x05, 0x0b, 0x16, 0xb5, 0xbf, 0x63, 0xd1, 0x9c, 0x07, 0x54, 0x26, 0x22, 0xf0, 0x8e, 0xf6,
+	0xb5, 0x83, 0x98, 0x42, 0xd8, 0x2b, 0xb6, 0xd0, 0x87, 0x55, 0x65, 0xb7, 0x53, 0x00, 0x6c, 0x99,
+	0x50, 0xc2, 0x70, 0x17, 0xa0, 0x56, 0x46, 0xf7, 0x15, 0x7e, 0xf4, 0x21, 0xe6, 0x5f, 0x22, 0x99,
+	0xa8, 0xfe, 0x5c, 0x5d, 0xf1, 0xa5, 0x77, 0x6c, 0x7a, 0x71, 0xf3, 0xd4, 0xaf, 0x4c, 0x68, 0x85,
+	0xdd, 0x37, 0xf8, 0x74, 0x30, 0x65, 0x22, 0xe4, 0xc1, 0x15, 0x5f, 0xda, 0xc7, 0x9e, 0x98, 0xc7,
+	0x3e, 0xc9, 0x53, 0xbf, 0x3a, 0xa2, 0xd5, 0xa0, 0xfd, 0x13, 0x61, 0x77, 0x64, 0x0f, 0xc8, 0x50,
+	0x06, 0x5c, 0x0d, 0xa4, 0xb8, 0x8e, 0xc2, 0xe2, 0xeb, 0xdf, 0x47, 0x62, 0x98, 0x2c, 0x4c, 0x68,
+	0x7e, 0xef, 0x9a, 0xfd, 0x7a, 0x10, 0x53, 0x08, 0xf0, 0xd7, 0x2e, 0x94, 0x83, 0xbd, 0x02, 0x62,
+	0x0a, 0x01, 0x76, 0x5c, 0x28, 0xb5, 0xbd, 0x02, 0x62, 0x0a, 0xa1, 0x3f, 0x5c, 0x6d, 0x88, 0xb3,
+	0xde, 0x10, 0xe7, 0x6e, 0x43, 0xd0, 0xd7, 0x8c, 0xa0, 0xef, 0x19, 0x41, 0xbf, 0x32, 0x82, 0x56,
+	0x19, 0x41, 0xeb, 0x8c, 0xa0, 0xbf, 0x19, 0x41, 0xff, 0x32, 0xe2, 0xdc, 0x65, 0x04, 0x7d, 0xdb,
+	0x12, 0x67, 0xb5, 0x25, 0xce, 0x7a, 0x4b, 0x9c, 0xcf, 0x4f, 0xd5, 0x52, 0x69, 0xbe, 0x18, 0x2d,
+	0x58, 0xac, 0x07, 0x52, 0xe8, 0x98, 0x4d, 0xb4, 0x1a, 0x37, 0xcc, 0xf9, 0x7e, 0xf1, 0x7f, 0x00,
+	0x4c, 0xcf, 0x20, 0x5a, 0x26, 0x04, 0x00, 0x00,
 }
 
 func (this *StakedData) Equal(that interface{}) bool {
@@ -287,6 +306,12 @@ func (this *StakedData) Equal(that interface{}) bool {
 	if this.UnJailedNonce != that1.UnJailedNonce {
 		return false
 	}
+	if !bytes.Equal(this.PreviousBlsKey, that1.PreviousBlsKey) {
+		return false
+	}
+	if this.ChangedKeyNonce != that1.ChangedKeyNonce {
+		return false
+	}
 	return true
 }
 func (this *StakingNodesConfig) Equal(that interface{}) bool {
@@ -323,7 +348,7 @@ func (this *StakedData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 14)
+	s := make([]string, 0, 16)
 	s = append(s, "&systemSmartContracts.StakedData{")
 	s = append(s, "RegisterNonce: "+fmt.Sprintf("%#v", this.RegisterNonce)+",\n")
 	s = append(s, "StakedNonce: "+fmt.Sprintf("%#v", this.StakedNonce)+",\n")
@@ -335,6 +360,8 @@ func (this *StakedData) GoString() string {
 	s = append(s, "JailedRound: "+fmt.Sprintf("%#v", this.JailedRound)+",\n")
 	s = append(s, "JailedNonce: "+fmt.Sprintf("%#v", this.JailedNonce)+",\n")
 	s = append(s, "UnJailedNonce: "+fmt.Sprintf("%#v", this.UnJailedNonce)+",\n")
+	s = append(s, "PreviousBlsKey: "+fmt.Sprintf("%#v", this.PreviousBlsKey)+",\n")
+	s = append(s, "ChangedKeyNonce: "+fmt.Sprintf("%#v", this.ChangedKeyNonce)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -378,6 +405,18 @@ func (m *StakedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChangedKeyNonce != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ChangedKeyNonce))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PreviousBlsKey) > 0 {
+		i -= len(m.PreviousBlsKey)
+		copy(dAtA[i:], m.PreviousBlsKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PreviousBlsKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.UnJailedNonce != 0 {
 		i = encodeVarintStaking(dAtA, i, uint64(m.UnJailedNonce))
 		i--
@@ -532,6 +571,13 @@ func (m *StakedData) Size() (n int) {
 	if m.UnJailedNonce != 0 {
 		n += 1 + sovStaking(uint64(m.UnJailedNonce))
 	}
+	l = len(m.PreviousBlsKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ChangedKeyNonce != 0 {
+		n += 1 + sovStaking(uint64(m.ChangedKeyNonce))
+	}
 	return n
 }
 
@@ -574,6 +620,8 @@ func (this *StakedData) String() string {
 		`JailedRound:` + fmt.Sprintf("%v", this.JailedRound) + `,`,
 		`JailedNonce:` + fmt.Sprintf("%v", this.JailedNonce) + `,`,
 		`UnJailedNonce:` + fmt.Sprintf("%v", this.UnJailedNonce) + `,`,
+		`PreviousBlsKey:` + fmt.Sprintf("%v", this.PreviousBlsKey) + `,`,
+		`ChangedKeyNonce:` + fmt.Sprintf("%v", this.ChangedKeyNonce) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -852,6 +900,59 @@ func (m *StakedData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousBlsKey = append(m.PreviousBlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousBlsKey == nil {
+				m.PreviousBlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedKeyNonce", wireType)
+			}
+			m.ChangedKeyNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangedKeyNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -3,12 +3,15 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"math"
 	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/slash"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -30,6 +33,7 @@ func createMockStakingScArguments() ArgsNewStakingSmartContract {
 		MaximumPercentageToBleed: 0,
 		MinNumNodes:              0,
 		Marshalizer:              &mock.MarshalizerMock{},
+		EvidenceVerifier:         &mock.EvidenceVerifierStub{},
 	}
 }
 
@@ -103,6 +107,17 @@ func TestNewStakingSmartContract_NegativeStakeValueShouldErr(t *testing.T) {
 	assert.Equal(t, vm.ErrNegativeInitialStakeValue, err)
 }
 
+func TestNewStakingSmartContract_NilEvidenceVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.EvidenceVerifier = nil
+	stakingSmartContract, err := NewStakingSmartContract(args)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrNilEvidenceVerifier, err)
+}
+
 func TestNewStakingSmartContract(t *testing.T) {
 	t.Parallel()
 
@@ -1130,6 +1145,169 @@ func TestStakingSc_StakeJailAndUnJail(t *testing.T) {
 	require.Equal(t, big.NewInt(999), registrationData.StakeValue)
 }
 
+func TestStakingSc_ReportDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
+		return nil, nil
+	}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.Eei = eei
+	args.EvidenceVerifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence *slash.Evidence) error {
+			if bytes.Equal(evidence.First.Header, evidence.Second.Header) {
+				return errors.New("headers not conflicting")
+			}
+			return nil
+		},
+	}
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	stakerPubKey := []byte("stakerPublicKey")
+	reporterAddress := []byte("reporterAddr")
+	evidence := createDoubleSigningEvidence(stakerPubKey, 37)
+
+	// cannot report a key that is not registered
+	doReportDoubleSigning(t, stakingSmartContract, reporterAddress, evidence, vmcommon.UserError)
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey)
+	// an evidence holding the same header twice is not accepted
+	notConflicting := createDoubleSigningEvidence(stakerPubKey, 37)
+	notConflicting.Second = notConflicting.First
+	doReportDoubleSigning(t, stakingSmartContract, reporterAddress, notConflicting, vmcommon.UserError)
+
+	blockChainHook.CurrentRoundCalled = func() uint64 {
+		return 1000
+	}
+	doReportDoubleSigning(t, stakingSmartContract, reporterAddress, evidence, vmcommon.Ok)
+	// the same evidence cannot be reported twice
+	doReportDoubleSigning(t, stakingSmartContract, reporterAddress, evidence, vmcommon.UserError)
+	// the key cannot be jailed again for the same round with an evidence of another type
+	doubleProposal := createDoubleSigningEvidence(stakerPubKey, 37)
+	doubleProposal.Type = slash.DoubleProposalEvidence
+	doReportDoubleSigning(t, stakingSmartContract, reporterAddress, doubleProposal, vmcommon.UserError)
+
+	var registrationData StakedData
+	data := stakingSmartContract.eei.GetStorage(stakerPubKey)
+	_ = json.Unmarshal(data, &registrationData)
+	assert.Equal(t, uint64(1000), registrationData.JailedRound)
+	assert.Equal(t, int64(1), stakingSmartContract.getConfig().JailedNodes)
+
+	// a jailed validator cannot unStake
+	doUnStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey, vmcommon.UserError)
+}
+
+func TestStakingSc_ReportDoubleSigningInvalidCallShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.EvidenceVerifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence *slash.Evidence) error {
+			return errors.New("signature mismatch")
+		},
+	}
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{})
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	evidenceBytes, _ := args.Marshalizer.Marshal(createDoubleSigningEvidence([]byte("pubKey"), 37))
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "reportDoubleSigning"
+
+	arguments.Arguments = [][]byte{evidenceBytes}
+	arguments.CallValue = big.NewInt(10)
+	assert.Equal(t, vmcommon.UserError, stakingSmartContract.Execute(arguments))
+
+	arguments.CallValue = big.NewInt(0)
+	arguments.Arguments = [][]byte{evidenceBytes, []byte("extra")}
+	assert.Equal(t, vmcommon.UserError, stakingSmartContract.Execute(arguments))
+
+	arguments.Arguments = [][]byte{[]byte("invalid evidence")}
+	assert.Equal(t, vmcommon.UserError, stakingSmartContract.Execute(arguments))
+
+	// signatures not verified
+	arguments.Arguments = [][]byte{evidenceBytes}
+	assert.Equal(t, vmcommon.UserError, stakingSmartContract.Execute(arguments))
+}
+
+func TestDoubleSigningReportKey_ShouldHoldFixedWidthShardAndRound(t *testing.T) {
+	t.Parallel()
+
+	pubKey := []byte("pubKey_1")
+	evidence := createDoubleSigningEvidence(pubKey, 2)
+	evidence.ShardID = 1
+
+	expectedKey := append([]byte(doubleSigningReportPrefix), pubKey...)
+	expectedKey = append(expectedKey, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2)
+	assert.Equal(t, expectedKey, doubleSigningReportKey(evidence))
+
+	otherEvidence := createDoubleSigningEvidence([]byte("pubKey"), 2)
+	otherEvidence.ShardID = 1
+	assert.NotEqual(t, doubleSigningReportKey(evidence), doubleSigningReportKey(otherEvidence))
+}
+
+func TestStakingSc_ChangeValidatorKey(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	stakerPubKey := []byte("stakerPublicKey")
+	otherPubKey := []byte("otherPublicKey1")
+	newPubKey := []byte("newPublicKey123")
+
+	setStakeValueCurrentEpoch(t, stakingSmartContract, stakingAccessAddress, big.NewInt(100), vmcommon.Ok)
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey)
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, otherPubKey)
+
+	// wrong access address should not work
+	doChangeValidatorKey(t, stakingSmartContract, []byte("addr"), stakerPubKey, newPubKey, vmcommon.UserError)
+	// keys with different lengths should not work
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, []byte("short"), vmcommon.UserError)
+	// new key already registered should not work
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, otherPubKey, vmcommon.UserError)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 10
+	}
+	oldData := stakingSmartContract.eei.GetStorage(stakerPubKey)
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, newPubKey, vmcommon.Ok)
+	require.Equal(t, 0, len(stakingSmartContract.eei.GetStorage(stakerPubKey)))
+
+	expectedData := StakedData{}
+	_ = json.Unmarshal(oldData, &expectedData)
+	expectedData.PreviousBlsKey = stakerPubKey
+	expectedData.ChangedKeyNonce = 10
+
+	var registrationData StakedData
+	_ = json.Unmarshal(stakingSmartContract.eei.GetStorage(newPubKey), &registrationData)
+	require.Equal(t, expectedData, registrationData)
+}
+
+func doChangeValidatorKey(t *testing.T, sc *stakingSC, callerAddr, oldKey, newKey []byte, expectedCode vmcommon.ReturnCode) {
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "changeValidatorKeys"
+	arguments.CallerAddr = callerAddr
+	arguments.Arguments = [][]byte{oldKey, newKey}
+
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, expectedCode, retCode)
+}
+
 func doUnJail(t *testing.T, sc *stakingSC, callerAddr, addrToUnJail []byte, expectedCode vmcommon.ReturnCode) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "unJail"
@@ -1140,6 +1318,32 @@ func doUnJail(t *testing.T, sc *stakingSC, callerAddr, addrToUnJail []byte, expe
 	assert.Equal(t, expectedCode, retCode)
 }
 
+func createDoubleSigningEvidence(pubKey []byte, round uint64) *slash.Evidence {
+	marshalizer := &mock.MarshalizerMock{}
+	firstHeader, _ := marshalizer.Marshal(&block.Header{Round: round, RootHash: []byte("root hash 1")})
+	secondHeader, _ := marshalizer.Marshal(&block.Header{Round: round, RootHash: []byte("root hash 2")})
+
+	return &slash.Evidence{
+		Type:    slash.DoubleSigningEvidence,
+		PubKey:  pubKey,
+		ShardID: 0,
+		Round:   round,
+		First:   slash.SignedHeader{Header: firstHeader, Signature: []byte("signature 1")},
+		Second:  slash.SignedHeader{Header: secondHeader, Signature: []byte("signature 2")},
+	}
+}
+
+func doReportDoubleSigning(t *testing.T, sc *stakingSC, callerAddr []byte, evidence *slash.Evidence, expectedCode vmcommon.ReturnCode) {
+	evidenceBytes, _ := sc.marshalizer.Marshal(evidence)
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "reportDoubleSigning"
+	arguments.CallerAddr = callerAddr
+	arguments.Arguments = [][]byte{evidenceBytes}
+
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, expectedCode, retCode)
+}
+
 func doJail(t *testing.T, sc *stakingSC, callerAddr, addrToJail []byte, expectedCode vmcommon.ReturnCode) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "jail"